@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-cluster}
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+
+// ClusterPropertyHistory is a bounded rolling history of the properties observed for a member cluster.
+//
+// The hub agent creates a ClusterPropertyHistory object for each member cluster, with the same name
+// as the MemberCluster object, when the property history feature is enabled; it records the property
+// values that the member cluster reports over a configurable window, and exposes aggregates over the
+// window (e.g., the 95th percentile of the available CPU capacity) as additional properties on the
+// MemberCluster object, which can be used in property selectors and property sorters.
+//
+// The object is owned by the MemberCluster object, and is deleted with it.
+type ClusterPropertyHistory struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Properties are the histories of the properties observed for the member cluster, keyed by
+	// the property names. Resource properties, e.g., `resources.kubernetes-fleet.io/available-cpu`,
+	// are included as well.
+	// +optional
+	Properties map[PropertyName]PropertyHistory `json:"properties,omitempty"`
+}
+
+// PropertyHistory is the rolling history of a cluster property.
+type PropertyHistory struct {
+	// Samples are the values of the property observed within the history window, ordered by
+	// their observation times, oldest first.
+	// +kubebuilder:validation:MaxItems=1000
+	// +optional
+	Samples []PropertyValue `json:"samples,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterPropertyHistoryList contains a list of ClusterPropertyHistory.
+type ClusterPropertyHistoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterPropertyHistory `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterPropertyHistory{}, &ClusterPropertyHistoryList{})
+}
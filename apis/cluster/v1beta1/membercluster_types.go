@@ -78,6 +78,53 @@ type MemberClusterSpec struct {
 	// DeleteOptions for deleting the MemberCluster.
 	// +optional
 	DeleteOptions *DeleteOptions `json:"deleteOptions,omitempty"`
+
+	// If specified, the MemberCluster is in maintenance during the maintenance window: Fleet stops
+	// scheduling placements to it, and drains the placements already on it as the drain policy asks.
+	// Maintenance ends automatically at its end time, if any.
+	// +optional
+	Maintenance *Maintenance `json:"maintenance,omitempty"`
+}
+
+// MaintenanceDrainPolicy identifies which placements are drained from a MemberCluster in maintenance.
+// +enum
+type MaintenanceDrainPolicy string
+
+const (
+	// MaintenanceDrainPolicyPickN drains the placements of the PickN placement type only, which are
+	// moved to other member clusters by the scheduler.
+	MaintenanceDrainPolicyPickN MaintenanceDrainPolicy = "PickN"
+
+	// MaintenanceDrainPolicyAll drains the placements of both the PickN and the PickAll placement types.
+	MaintenanceDrainPolicyAll MaintenanceDrainPolicy = "All"
+)
+
+// Maintenance describes a maintenance window of a MemberCluster.
+//
+// Placements of the PickFixed placement type are never drained, as they cannot be evicted; ResourcePlacements
+// are not drained either.
+// +kubebuilder:validation:XValidation:rule="!has(self.startTime) || !has(self.endTime) || self.startTime < self.endTime",message="startTime must be before endTime"
+type Maintenance struct {
+	// Reason is a human-readable explanation of the maintenance.
+	// +kubebuilder:validation:MaxLength=256
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// StartTime is when the maintenance starts. If not specified, the maintenance starts right away.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is when the maintenance ends. If not specified, the maintenance lasts until the field
+	// is removed.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// DrainPolicy decides which placements are drained from the MemberCluster during the maintenance.
+	// Can be "PickN", or "All". Default is PickN.
+	// +kubebuilder:validation:Enum=PickN;All
+	// +kubebuilder:default=PickN
+	// +optional
+	DrainPolicy MaintenanceDrainPolicy `json:"drainPolicy,omitempty"`
 }
 
 // DeleteValidationMode identifies the type of validation when deleting a MemberCluster.
@@ -166,10 +213,21 @@ type Taint struct {
 	Value string `json:"value,omitempty"`
 
 	// The effect of the taint on ClusterResourcePlacements that do not tolerate the taint.
-	// Only NoSchedule is supported.
-	// +kubebuilder:validation:Enum=NoSchedule
+	// NoSchedule and NoExecute are supported.
+	// With NoSchedule, the ClusterResourcePlacements are not scheduled to the MemberCluster, but the ones
+	// already on it stay there.
+	// With NoExecute, the placements already on the MemberCluster are also evicted from it after the
+	// TolerationSeconds of their tolerations if any; ClusterResourcePlacements are evicted through
+	// ClusterResourcePlacementEvictions, which respect their disruption budgets, and ResourcePlacements
+	// are evicted right away, as they have no disruption budgets.
+	// +kubebuilder:validation:Enum=NoSchedule;NoExecute
 	// +required
 	Effect corev1.TaintEffect `json:"effect"`
+
+	// TimeAdded is the time at which the NoExecute taint was added to the MemberCluster, from which the
+	// TolerationSeconds of the tolerations are counted. It is set by Fleet for NoExecute taints only.
+	// +optional
+	TimeAdded *metav1.Time `json:"timeAdded,omitempty"`
 }
 
 // MemberClusterConditionType defines a specific condition of a member cluster.
@@ -214,6 +272,35 @@ const (
 	// - "False" means the cluster property collection has failed.
 	// - "Unknown" means it is unknown whether the cluster property collection has succeeded or not.
 	ConditionTypeClusterPropertyCollectionSucceeded MemberClusterConditionType = "ClusterPropertyCollectionSucceeded"
+
+	// ConditionTypeMemberClusterFailedOver indicates the failover condition of the given member cluster.
+	// The condition is absent unless Fleet has failed the placements on the member cluster over to other clusters,
+	// and the member cluster is not picked for placements while the condition is present.
+	// Its condition status can be one of the following:
+	// - "True" means the member agent has stopped sending heartbeats and the placements have been failed over.
+	// - "False" means the member agent has resumed sending heartbeats; the condition is removed once the member
+	//   cluster has stayed available for the recovery period.
+	ConditionTypeMemberClusterFailedOver MemberClusterConditionType = "FailedOver"
+
+	// ConditionTypeMemberClusterInMaintenance indicates the maintenance condition of the given member cluster.
+	// The condition is absent unless the member cluster has a maintenance specified.
+	// Its condition status can be one of the following:
+	// - "True" means the member cluster is in its maintenance window.
+	// - "False" means the maintenance window has not started yet, or has ended.
+	ConditionTypeMemberClusterInMaintenance MemberClusterConditionType = "InMaintenance"
+
+	// ConditionTypeMemberClusterDrained indicates the drain condition of the given member cluster in maintenance.
+	// The condition is absent unless the member cluster is in its maintenance window.
+	// Its condition status can be one of the following:
+	// - "True" means all the placements to drain have left the member cluster.
+	// - "False" means some placements to drain are still on the member cluster.
+	ConditionTypeMemberClusterDrained MemberClusterConditionType = "Drained"
+)
+
+const (
+	// MaintenanceTaintKey is the key of the NoSchedule taint that Fleet adds to a member cluster in its
+	// maintenance window, so that no placements are scheduled to it.
+	MaintenanceTaintKey = "kubernetes-fleet.io/maintenance"
 )
 
 //+kubebuilder:object:root=true
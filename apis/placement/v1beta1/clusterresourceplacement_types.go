@@ -355,7 +355,52 @@ type PlacementPolicy struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:XValidation:rule="self.all(x, x.operator != 'Exists' || !has(x.value) || size(x.value) == 0)",message="value must be empty when operator is Exists"
 	// +kubebuilder:validation:XValidation:rule="self.all(x, (has(x.key) && size(x.key) > 0) || x.operator == 'Exists')",message="operator must be Exists when key is empty"
+	// +kubebuilder:validation:XValidation:rule="self.all(x, !has(x.tolerationSeconds) || (has(x.effect) && x.effect == 'NoExecute'))",message="effect must be NoExecute when tolerationSeconds is set"
 	Tolerations []Toleration `json:"tolerations,omitempty"`
+
+	// Failover configures how Fleet moves the resources away from a selected member cluster whose member agent
+	// has stopped sending heartbeats.
+	// By default, the fleet-wide failover settings of the hub agent apply.
+	// Only valid if the placement type is "PickN".
+	// +kubebuilder:validation:Optional
+	Failover *FailoverPolicy `json:"failover,omitempty"`
+}
+
+// FailoverType describes whether Fleet fails a placement over from unavailable member clusters.
+// +enum
+type FailoverType string
+
+const (
+	// AutomaticFailoverType instructs Fleet to reschedule the placement onto other member clusters once a selected
+	// member cluster has been unavailable for a period of time.
+	AutomaticFailoverType FailoverType = "Automatic"
+
+	// DisabledFailoverType instructs Fleet to keep the placement on the selected member clusters no matter how long
+	// they have been unavailable.
+	DisabledFailoverType FailoverType = "Disabled"
+)
+
+// FailoverPolicy configures how Fleet moves the resources away from a selected member cluster whose member agent
+// has stopped sending heartbeats.
+//
+// Once a selected member cluster has been unavailable for the configured period, Fleet removes the placement from the
+// cluster and the scheduler picks another cluster instead. The resources on the unavailable cluster are cleaned up
+// once its member agent comes back. The number of member clusters failed over at the same time is capped fleet-wide,
+// and a recovered member cluster is only picked again after it stays available for a period of time.
+type FailoverPolicy struct {
+	// Type of failover. Can be "Automatic" or "Disabled". Default is Automatic.
+	// +kubebuilder:validation:Enum=Automatic;Disabled
+	// +kubebuilder:default=Automatic
+	// +kubebuilder:validation:Optional
+	Type FailoverType `json:"type,omitempty"`
+
+	// AfterSeconds is how long the member agent of a selected member cluster must have stopped sending heartbeats
+	// before Fleet fails the placement over from the cluster.
+	// Defaults to the fleet-wide setting of the hub agent, or 300 seconds if the fleet-wide failover is disabled.
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=86400
+	// +kubebuilder:validation:Optional
+	AfterSeconds *int32 `json:"afterSeconds,omitempty"`
 }
 
 // Affinity is a group of cluster affinity scheduling rules. More to be added.
@@ -363,6 +408,35 @@ type Affinity struct {
 	// ClusterAffinity contains cluster affinity scheduling rules for the selected resources.
 	// +kubebuilder:validation:Optional
 	ClusterAffinity *ClusterAffinity `json:"clusterAffinity,omitempty"`
+
+	// ClusterProximity prefers member clusters that are close to a reference in terms of network latency.
+	// This field is ignored if the placement type is "PickAll".
+	// +kubebuilder:validation:Optional
+	ClusterProximity *ClusterProximity `json:"clusterProximity,omitempty"`
+}
+
+// ClusterProximity prefers member clusters that are close to a reference in terms of network latency.
+//
+// The latency is measured by the member agents, which probe a set of peers periodically and report
+// the results as cluster properties. The scheduler adds the weight to the score of the member cluster
+// with the lowest latency to the reference, 0 to the one with the highest latency, and a linearly
+// interpolated value to the others. Member clusters that do not report the latency to the reference
+// get no score; the reference itself, if it is a member cluster, is considered to have no latency.
+type ClusterProximity struct {
+	// Reference is the name of the peer to measure the proximity against, e.g., the name of a member
+	// cluster or a region, as configured in the member agents.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9]([-a-zA-Z0-9_.]*[a-zA-Z0-9])?$`
+	Reference string `json:"reference"`
+
+	// Weight is the score that the closest member cluster to the reference gets, in the range [1, 100].
+	// Default is 100.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=100
+	// +kubebuilder:validation:Optional
+	Weight int32 `json:"weight,omitempty"`
 }
 
 // ClusterAffinity contains cluster affinity scheduling rules for the selected resources.
@@ -640,9 +714,70 @@ type RolloutStrategy struct {
 	// ReportBackStrategy describes how to report back the status of applied resources on the member cluster.
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:XValidation:rule="(self == null) || (self.type == 'Mirror' ? size(self.destination) != 0 : true)",message="when reportBackStrategy.type is 'Mirror', a destination must be specified"
+	// +kubebuilder:validation:XValidation:rule="(self == null) || (self.type == 'Projected' ? (has(self.statusPaths) && size(self.statusPaths) != 0) : true)",message="when reportBackStrategy.type is 'Projected', at least one status path must be specified"
 	ReportBackStrategy *ReportBackStrategy `json:"reportBackStrategy,omitempty"`
+
+	// AutoUpdateRun configures the update runs that Fleet creates automatically whenever a new resource
+	// snapshot is created for the placement. Present only if RolloutStrategyType = External.
+	// If it's not set, the update runs must be created manually.
+	// +kubebuilder:validation:Optional
+	AutoUpdateRun *AutoUpdateRunConfig `json:"autoUpdateRun,omitempty"`
+}
+
+// AutoUpdateRunConfig describes how update runs are created automatically for a placement with the
+// External rollout strategy.
+type AutoUpdateRunConfig struct {
+	// StagedUpdateStrategyName is the name of the update strategy that the automatically created update runs use.
+	// It refers to a ClusterStagedUpdateStrategy for a ClusterResourcePlacement, or to a StagedUpdateStrategy
+	// in the same namespace for a ResourcePlacement.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=255
+	StagedUpdateStrategyName string `json:"stagedRolloutStrategyName"`
+
+	// InitialState is the state that the automatically created update runs start with.
+	// Run: the update run starts to execute right away (default).
+	// Initialize: the update run is initialized, and it's up to the user to set its state to Run.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Run
+	// +kubebuilder:validation:Enum=Initialize;Run
+	InitialState State `json:"initialState,omitempty"`
+
+	// ConcurrencyPolicy specifies what happens when a new resource snapshot is created while an update run
+	// of the placement is still in flight.
+	// Queue: the new update run is not created until no update run is in flight (default).
+	// Supersede: the automatically created update runs in flight are stopped, and the new update run is
+	// created as soon as they have stopped.
+	// Only the update run for the latest resource snapshot is created; the intermediate resource snapshots
+	// created while waiting are skipped.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Queue
+	// +kubebuilder:validation:Enum=Queue;Supersede
+	ConcurrencyPolicy AutoUpdateRunConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// HistoryLimit is the number of update runs created automatically that are kept once they are no longer
+	// in flight, i.e., they have finished, stopped, or never started; the ones for the oldest resource snapshots
+	// are deleted first. Defaults to 5.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	HistoryLimit *int32 `json:"historyLimit,omitempty"`
 }
 
+// AutoUpdateRunConcurrencyPolicy describes how an automatically created update run is handled when
+// another update run of the same placement is in flight.
+// +enum
+type AutoUpdateRunConcurrencyPolicy string
+
+const (
+	// AutoUpdateRunConcurrencyPolicyQueue waits for the update runs in flight to finish before creating the new update run.
+	AutoUpdateRunConcurrencyPolicyQueue AutoUpdateRunConcurrencyPolicy = "Queue"
+
+	// AutoUpdateRunConcurrencyPolicySupersede stops the automatically created update runs in flight and creates
+	// the new update run once they have stopped.
+	AutoUpdateRunConcurrencyPolicySupersede AutoUpdateRunConcurrencyPolicy = "Supersede"
+)
+
 // ApplyStrategy describes when and how to apply the selected resource to the target cluster.
 // Note: If multiple CRPs try to place the same resource with different apply strategy, the later ones will fail with the
 // reason ApplyConflictBetweenPlacements.
@@ -850,6 +985,59 @@ type ApplyStrategy struct {
 	// +kubebuilder:validation:Enum=Always;IfNoDiff;Never
 	// +kubebuilder:validation:Optional
 	WhenToTakeOver WhenToTakeOverType `json:"whenToTakeOver,omitempty"`
+
+	// Impersonation specifies the identity that Fleet will impersonate when it applies
+	// (creates, patches, or takes over) resources on the member cluster side.
+	//
+	// By default Fleet applies resources with the identity of the Fleet member agent, which
+	// usually has cluster-admin privileges. Set this field if you would like the apply ops to
+	// be subject to the RBAC permissions granted to a specific service account or user on the
+	// member cluster instead; any apply op that the identity is not authorized to perform will
+	// be reported as an apply error.
+	//
+	// Note that the Fleet member agent must be allowed to impersonate the specified identity.
+	// Identities reserved for system components, i.e., users and groups prefixed with `system:`
+	// and service accounts in the `kube-system` namespace, and service accounts in the namespace
+	// of the Fleet member agent are never impersonated.
+	// The dry-run apply ops that Fleet runs for drift detection and diff reporting with partial
+	// comparison are performed with the specified identity as well; Fleet will still read
+	// resources from the member cluster with its own identity.
+	// +kubebuilder:validation:Optional
+	Impersonation *ImpersonationConfig `json:"impersonation,omitempty"`
+}
+
+// ImpersonationConfig describes the identity that Fleet impersonates when applying resources
+// on a member cluster.
+//
+// Exactly one of ServiceAccount and User must be specified.
+// +kubebuilder:validation:XValidation:rule="has(self.serviceAccount) != has(self.user)",message="exactly one of serviceAccount and user must be specified"
+type ImpersonationConfig struct {
+	// ServiceAccount is the service account on the member cluster to impersonate.
+	// +kubebuilder:validation:Optional
+	ServiceAccount *ServiceAccountReference `json:"serviceAccount,omitempty"`
+
+	// User is the name of the user on the member cluster to impersonate.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	User *string `json:"user,omitempty"`
+
+	// Groups is the list of groups to impersonate along with the user or service account.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=10
+	Groups []string `json:"groups,omitempty"`
+}
+
+// ServiceAccountReference is a reference to a service account on a member cluster.
+type ServiceAccountReference struct {
+	// Namespace is the namespace of the service account.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the service account.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
 }
 
 // ComparisonOptionType describes the compare option that Fleet uses to detect drifts and/or
@@ -1015,6 +1203,36 @@ type RollingUpdateConfig struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Minimum=0
 	UnavailablePeriodSeconds *int `json:"unavailablePeriodSeconds,omitempty"`
+
+	// Steps is an optional schedule that rolls out the latest resources progressively, e.g., to 1 cluster,
+	// then to 10% of the clusters, then to 50% of the clusters and finally to all the clusters.
+	// Each step caps the number of clusters that run the latest resources, and the next step only starts
+	// after all the clusters that run the latest resources are available and the pause of the step has passed.
+	// The rollout halts in a step if any of the updated clusters fails to become available.
+	// MaxUnavailable and MaxSurge still apply within each step.
+	// All the clusters are updated after the last step.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=10
+	Steps []RollingUpdateStep `json:"steps,omitempty"`
+}
+
+// RollingUpdateStep is a step in the progressive rollout of the latest resources.
+type RollingUpdateStep struct {
+	// Clusters is the number of clusters that run the latest resources at the end of the step.
+	// The number is calculated the same way as MaxUnavailable.
+	// Value can be an absolute number (ex: 1) or a percentage of the desired number of clusters (ex: 10%).
+	// Absolute number is calculated from percentage by rounding up.
+	// +kubebuilder:validation:XIntOrString
+	// +kubebuilder:validation:Pattern="^((100|[0-9]{1,2})%|[0-9]+)$"
+	// +kubebuilder:validation:Required
+	Clusters intstr.IntOrString `json:"clusters"`
+
+	// PauseSeconds is how long to wait after all the clusters of the step are available before the next
+	// step starts, which gives time to verify the latest resources on those clusters.
+	// Default is 0.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	PauseSeconds *int `json:"pauseSeconds,omitempty"`
 }
 
 // PlacementStatus defines the observed status of the ClusterResourcePlacement and ResourcePlacement object.
@@ -1316,10 +1534,19 @@ type Toleration struct {
 	Value string `json:"value,omitempty"`
 
 	// Effect indicates the taint effect to match. Empty means match all taint effects.
-	// When specified, only allowed value is NoSchedule.
-	// +kubebuilder:validation:Enum=NoSchedule
+	// When specified, allowed values are NoSchedule and NoExecute.
+	// +kubebuilder:validation:Enum=NoSchedule;NoExecute
 	// +kubebuilder:validation:Optional
 	Effect corev1.TaintEffect `json:"effect,omitempty"`
+
+	// TolerationSeconds is the period of time the toleration tolerates a NoExecute taint, which must be
+	// the effect of the toleration when this field is set.
+	// The ClusterResourcePlacement is evicted from the cluster once the period has passed since the taint
+	// was added, and the cluster is not picked again by the scheduler.
+	// By default, it is not set, which means the taint is tolerated forever.
+	// Zero and negative values are treated as 0, i.e., evict right away.
+	// +kubebuilder:validation:Optional
+	TolerationSeconds *int64 `json:"tolerationSeconds,omitempty"`
 }
 
 // ClusterResourcePlacementConditionType defines a specific condition of a cluster resource placement object.
@@ -1592,6 +1819,11 @@ const (
 	// ReportBackStrategyTypeMirror enables status back-reporting by
 	// copying the status fields verbatim to some destination on the hub cluster side.
 	ReportBackStrategyTypeMirror ReportBackStrategyType = "Mirror"
+
+	// ReportBackStrategyTypeProjected enables status back-reporting by
+	// copying only the status fields selected by a list of JSONPath expressions to some
+	// destination on the hub cluster side.
+	ReportBackStrategyTypeProjected ReportBackStrategyType = "Projected"
 )
 
 type ReportBackDestination string
@@ -1620,27 +1852,46 @@ type ReportBackStrategy struct {
 	// * Mirror: status back-reporting is enabled by copying the status fields verbatim to
 	//   a destination on the hub cluster side; see the Destination field for more information.
 	//
+	// * Projected: status back-reporting is enabled by copying only the status fields selected by
+	//   the JSONPath expressions in the StatusPaths field to a destination on the hub cluster side;
+	//   see the Destination field for more information. This helps keep the size of Work objects
+	//   in check when the applied resources have large statuses.
+	//
 	// +kubebuilder:default=Disabled
-	// +kubebuilder:validation:Enum=Disabled;Mirror
+	// +kubebuilder:validation:Enum=Disabled;Mirror;Projected
 	// +kubebuilder:validation:Required
 	Type ReportBackStrategyType `json:"type"`
 
-	// Destination dictates where to copy the status fields to when the report back strategy type is Mirror.
+	// Destination dictates where to copy the status fields to when the report back strategy type is Mirror
+	// or Projected.
 	//
 	// Available options include:
 	//
-	// * OriginalResource: the status fields will be copied verbatim to the original resource on the hub cluster side.
-	//   This is only performed when the placement object has a scheduling policy that selects exactly one member cluster
-	//   (i.e., a pickFixed scheduling policy with exactly one cluster name, or a pickN scheduling policy with the numberOfClusters
-	//   field set to 1).
+	// * OriginalResource: with the Mirror type, the status fields will be copied verbatim to the original resource
+	//   on the hub cluster side. This is only performed when the placement object has a scheduling policy that selects
+	//   exactly one member cluster (i.e., a pickFixed scheduling policy with exactly one cluster name, or a pickN
+	//   scheduling policy with the numberOfClusters field set to 1).
+	//   With the Projected type, the selected status fields from all member clusters will be aggregated into a
+	//   per-cluster map, which is kept in the `kubernetes-fleet.io/projected-status` annotation of the original
+	//   resource on the hub cluster side; there is no restriction on the scheduling policy.
 	//
-	// * WorkAPI: the status fields will be copied verbatim via the Work API on the hub cluster side. Users may look up
+	// * WorkAPI: the status fields will be copied via the Work API on the hub cluster side. Users may look up
 	//   the status of a specific resource applied to a specific member cluster by inspecting the corresponding Work object
 	//   on the hub cluster side. This is the default behavior.
 	//
 	// +kubebuilder:validation:Enum=OriginalResource;WorkAPI
 	// +kubebuilder:validation:Optional
 	Destination *ReportBackDestination `json:"destination,omitempty"`
+
+	// StatusPaths is a list of JSONPath expressions that select the status fields to report back when the
+	// report back strategy type is Projected, e.g., `.status.readyReplicas` or `.status.loadBalancer.ingress`.
+	// Each expression must select the `.status` field or fields under it. Fields that are not present on a resource are not reported.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=20
+	// +kubebuilder:validation:items:MaxLength=256
+	// +kubebuilder:validation:items:Pattern=`^\.status([.\[].*)?$`
+	StatusPaths []string `json:"statusPaths,omitempty"`
 }
 
 // ClusterResourcePlacementList contains a list of ClusterResourcePlacement.
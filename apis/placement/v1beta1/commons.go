@@ -53,6 +53,8 @@ const (
 	StagedUpdateStrategyKind = "StagedUpdateStrategy"
 	// ApprovalRequestKind is the kind of the ApprovalRequest.
 	ApprovalRequestKind = "ApprovalRequest"
+	// ClusterFleetUpdateRunKind is the kind of the ClusterFleetUpdateRun.
+	ClusterFleetUpdateRunKind = "ClusterFleetUpdateRun"
 	// ClusterResourcePlacementEvictionKind is the kind of the ClusterResourcePlacementEviction.
 	ClusterResourcePlacementEvictionKind = "ClusterResourcePlacementEviction"
 	// ClusterResourcePlacementDisruptionBudgetKind is the kind of the ClusterResourcePlacementDisruptionBudget.
@@ -63,6 +65,10 @@ const (
 	ClusterResourceEnvelopeKind = "ClusterResourceEnvelope"
 	// ClusterResourcePlacementStatusKind is the kind of the ClusterResourcePlacementStatus.
 	ClusterResourcePlacementStatusKind = "ClusterResourcePlacementStatus"
+	// ClusterResourcePlacementWorkloadSummaryKind is the kind of the ClusterResourcePlacementWorkloadSummary.
+	ClusterResourcePlacementWorkloadSummaryKind = "ClusterResourcePlacementWorkloadSummary"
+	// ResourcePlacementWorkloadSummaryKind is the kind of the ResourcePlacementWorkloadSummary.
+	ResourcePlacementWorkloadSummaryKind = "ResourcePlacementWorkloadSummary"
 )
 
 const (
@@ -156,6 +162,11 @@ const (
 	// This is used to remember if an "unscheduled" binding was moved from a "bound" state or a "scheduled" state.
 	PreviousBindingStateAnnotation = FleetPrefix + "previous-binding-state"
 
+	// ProjectedStatusAnnotation is added to an original resource on the hub cluster side to keep the status
+	// fields projected from its copies on member clusters, as a JSON map keyed by member cluster name; it is
+	// set only when the Projected report back strategy is used with the OriginalResource destination.
+	ProjectedStatusAnnotation = FleetPrefix + "projected-status"
+
 	// UpdateRunFinalizer is used by the UpdateRun controller to make sure that the UpdateRun
 	// object is not deleted until all its dependent resources are deleted.
 	UpdateRunFinalizer = FleetPrefix + "stagedupdaterun-finalizer"
@@ -163,7 +174,15 @@ const (
 	// TargetUpdateRunLabel indicates the target update run on a staged run related object.
 	TargetUpdateRunLabel = FleetPrefix + "targetUpdateRun"
 
-	// TaskTypeLabel indicates the task type (before-stage or after-stage) on a staged run related object.
+	// RolledBackUpdateRunLabel is set on a rollback update run and indicates the name of the failed
+	// update run that it rolls back.
+	RolledBackUpdateRunLabel = FleetPrefix + "rolledBackUpdateRun"
+
+	// FleetUpdateRunLabel is set on the update runs created for a ClusterFleetUpdateRun and indicates the name
+	// of the ClusterFleetUpdateRun.
+	FleetUpdateRunLabel = FleetPrefix + "fleetUpdateRun"
+
+	// TaskTypeLabel indicates the task type (before-stage, after-stage or cluster) on a staged run related object.
 	TaskTypeLabel = FleetPrefix + "taskType"
 
 	// UpdateRunDeleteStageName is the name of delete stage in the staged update run.
@@ -181,11 +200,26 @@ const (
 	// AfterStageTaskLabelValue is the after stage task label value.
 	AfterStageTaskLabelValue = "afterStage"
 
+	// ClusterApprovalTaskLabelValue is the task type label value of the per-cluster approval requests.
+	ClusterApprovalTaskLabelValue = "cluster"
+
 	// BeforeStageApprovalTaskNameFmt is the format of the before stage approval task name.
 	BeforeStageApprovalTaskNameFmt = "%s-before-%s"
 
 	// AfterStageApprovalTaskNameFmt is the format of the after stage approval task name.
 	AfterStageApprovalTaskNameFmt = "%s-after-%s"
+
+	// ClusterApprovalTaskNameFmt is the format of the per-cluster approval request name, built from the update run
+	// name, the stage name and the cluster name.
+	ClusterApprovalTaskNameFmt = "%s-%s-cluster-%s"
+
+	// WebhookHeadersSecretLabel must be set to "true" on a Secret before its data can be sent as the HTTP headers
+	// of Webhook stage tasks.
+	WebhookHeadersSecretLabel = FleetPrefix + "webhook-headers"
+
+	// VerificationJobWorkLabel is set on the Works that place the Jobs of VerificationJob stage tasks onto
+	// member clusters. Its value is the namespace of the update run, which is empty for ClusterStagedUpdateRuns.
+	VerificationJobWorkLabel = FleetPrefix + "verificationJobOf"
 )
 
 var (
@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:Cluster
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-placement},shortName=cfur
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.spec.stagedRolloutStrategyName`,name="Strategy",type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Initialized")].status`,name="Initialized",type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Progressing")].status`,name="Progressing",type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Succeeded")].status`,name="Succeeded",type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) < 61",message="metadata.name max length is 60"
+
+// ClusterFleetUpdateRun updates the resources of several ClusterResourcePlacements together, stage by stage,
+// with the same ClusterStagedUpdateStrategy.
+//
+// Fleet creates a ClusterStagedUpdateRun for each of the placements, named after the ClusterFleetUpdateRun and
+// the index of the placement, and coordinates them as a group:
+//   - The update runs move to the next stage together, i.e., no update run starts a stage until all of them
+//     have completed the previous stage.
+//   - On each cluster, the placements are updated in the order they are listed, i.e., a placement only starts
+//     updating a cluster after all the placements listed before it have finished updating that cluster.
+//   - The stage tasks are shared by the group: the before-stage tasks of a stage are run by the update run of
+//     the first placement only, and the after-stage tasks by the update run of the last placement only, after
+//     all the update runs have finished updating the clusters in the stage.
+//
+// The whole group fails if any of its update runs fails.
+type ClusterFleetUpdateRun struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The desired state of ClusterFleetUpdateRun.
+	// +kubebuilder:validation:Required
+	Spec FleetUpdateRunSpec `json:"spec"`
+
+	// The observed status of ClusterFleetUpdateRun.
+	// +kubebuilder:validation:Optional
+	Status FleetUpdateRunStatus `json:"status,omitempty"`
+}
+
+// GetCondition returns the condition of the ClusterFleetUpdateRun.
+func (c *ClusterFleetUpdateRun) GetCondition(conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(c.Status.Conditions, conditionType)
+}
+
+// SetConditions sets the conditions of the ClusterFleetUpdateRun.
+func (c *ClusterFleetUpdateRun) SetConditions(conditions ...metav1.Condition) {
+	c.Status.Conditions = conditions
+}
+
+// FleetUpdateRunSpec defines the placements updated together and the strategy that they are updated with.
+// +kubebuilder:validation:XValidation:rule="!(has(oldSelf.state) && oldSelf.state == 'Initialize' && self.state == 'Stop')",message="invalid state transition: cannot transition from Initialize to Stop"
+// +kubebuilder:validation:XValidation:rule="!(has(oldSelf.state) && oldSelf.state == 'Run' && self.state == 'Initialize')",message="invalid state transition: cannot transition from Run to Initialize"
+// +kubebuilder:validation:XValidation:rule="!(has(oldSelf.state) && oldSelf.state == 'Stop' && self.state == 'Initialize')",message="invalid state transition: cannot transition from Stop to Initialize"
+type FleetUpdateRunSpec struct {
+	// Placements is the ordered list of the ClusterResourcePlacements to update together.
+	// On each cluster, a placement is only updated after all the placements listed before it.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="placements is immutable"
+	// +listType=map
+	// +listMapKey=placementName
+	Placements []FleetUpdateRunPlacement `json:"placements"`
+
+	// The name of the ClusterStagedUpdateStrategy that all the placements are updated with.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="stagedRolloutStrategyName is immutable"
+	StagedUpdateStrategyName string `json:"stagedRolloutStrategyName"`
+
+	// State indicates the desired state of the update runs of the placements.
+	// Initialize: The update runs should be initialized but execution should not start (default).
+	// Run: The update runs should execute or resume execution.
+	// Stop: The update runs should stop execution.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Initialize
+	// +kubebuilder:validation:Enum=Initialize;Run;Stop
+	State State `json:"state,omitempty"`
+}
+
+// FleetUpdateRunPlacement specifies a placement updated by a ClusterFleetUpdateRun.
+type FleetUpdateRunPlacement struct {
+	// PlacementName is the name of the ClusterResourcePlacement.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=255
+	PlacementName string `json:"placementName"`
+
+	// The resource snapshot index of the selected resources of the placement to be updated across clusters.
+	// The latest resource snapshot is used if not specified.
+	// +kubebuilder:validation:Optional
+	ResourceSnapshotIndex string `json:"resourceSnapshotIndex,omitempty"`
+}
+
+// FleetUpdateRunStatus defines the observed state of a ClusterFleetUpdateRun.
+type FleetUpdateRunStatus struct {
+	// UpdateRuns lists the update runs created for the placements.
+	// +listType=map
+	// +listMapKey=placementName
+	// +kubebuilder:validation:Optional
+	UpdateRuns []FleetUpdateRunMemberStatus `json:"updateRuns,omitempty"`
+
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	//
+	// Conditions is an array of current observed conditions of the ClusterFleetUpdateRun, aggregated from
+	// the conditions of its update runs.
+	// Known conditions are "Initialized", "Progressing", "Succeeded".
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// FleetUpdateRunMemberStatus describes the update run created for a placement of a ClusterFleetUpdateRun.
+type FleetUpdateRunMemberStatus struct {
+	// PlacementName is the name of the ClusterResourcePlacement.
+	// +kubebuilder:validation:Required
+	PlacementName string `json:"placementName"`
+
+	// UpdateRunName is the name of the ClusterStagedUpdateRun created for the placement.
+	// +kubebuilder:validation:Required
+	UpdateRunName string `json:"updateRunName"`
+}
+
+// ClusterFleetUpdateRunList contains a list of ClusterFleetUpdateRun.
+// +kubebuilder:resource:scope=Cluster
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterFleetUpdateRunList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterFleetUpdateRun `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterFleetUpdateRun{}, &ClusterFleetUpdateRunList{})
+}
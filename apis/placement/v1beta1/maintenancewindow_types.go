@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:Cluster
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-placement},shortName=cmw
+// +kubebuilder:storageversion
+
+// ClusterMaintenanceWindow restricts when Fleet may start updating the resources placed on a set of member
+// clusters, selected by their labels. The rollout of a placement, whether it's driven by the RollingUpdate
+// strategy or by a staged update run, only starts updating a selected cluster while the cluster is inside an
+// open maintenance window.
+//
+// If multiple ClusterMaintenanceWindow objects select the same member cluster, their windows and blackouts are
+// combined: the cluster can be updated when any of the windows is open and none of the blackouts is.
+// Member clusters that are not selected by any ClusterMaintenanceWindow object can be updated at any time.
+type ClusterMaintenanceWindow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the ClusterMaintenanceWindow.
+	// +required
+	Spec ClusterMaintenanceWindowSpec `json:"spec"`
+}
+
+// ClusterMaintenanceWindowSpec is the desired state of the ClusterMaintenanceWindow.
+type ClusterMaintenanceWindowSpec struct {
+	// ClusterSelector is a label query over the member clusters that the maintenance window applies to.
+	// An empty selector selects all the member clusters.
+	// +kubebuilder:validation:Required
+	ClusterSelector metav1.LabelSelector `json:"clusterSelector"`
+
+	// MaintenanceWindowPolicy describes when the selected member clusters can be updated.
+	MaintenanceWindowPolicy `json:",inline"`
+}
+
+// MaintenanceWindowPolicy describes the recurring periods of time during which updates can, or cannot, be started.
+// +kubebuilder:validation:XValidation:rule="has(self.windows) || has(self.blackouts)",message="at least one window or blackout must be specified"
+type MaintenanceWindowPolicy struct {
+	// Windows are the recurring periods of time during which updates can be started.
+	// If no window is specified, updates can be started at any time outside the blackouts.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=20
+	Windows []RecurringTimeWindow `json:"windows,omitempty"`
+
+	// Blackouts are the recurring periods of time during which updates cannot be started, even if a window is open.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=20
+	Blackouts []RecurringTimeWindow `json:"blackouts,omitempty"`
+}
+
+// RecurringTimeWindow is a period of time that opens on a cron schedule and stays open for a fixed duration.
+type RecurringTimeWindow struct {
+	// Schedule is a standard cron expression with five fields (minute, hour, day of month, month, and day of week)
+	// that describes when the window opens, e.g., "0 22 * * 1-5" for 10 PM on weekdays.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=9
+	// +kubebuilder:validation:MaxLength=255
+	Schedule string `json:"schedule"`
+
+	// Duration is how long the window stays open after it opens, e.g., "4h".
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=duration
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone is the IANA name of the time zone that the schedule is interpreted in, e.g., "Europe/Berlin".
+	// Defaults to UTC.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=63
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// ClusterMaintenanceWindowList contains a list of ClusterMaintenanceWindow objects.
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:object:root=true
+type ClusterMaintenanceWindowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of ClusterMaintenanceWindow objects.
+	Items []ClusterMaintenanceWindow `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterMaintenanceWindow{}, &ClusterMaintenanceWindowList{})
+}
@@ -202,6 +202,41 @@ type UpdateRunSpec struct {
 	// +kubebuilder:default=Initialize
 	// +kubebuilder:validation:Enum=Initialize;Run;Stop
 	State State `json:"state,omitempty"`
+
+	// RollbackPolicy specifies what happens to the clusters that have already been updated when the update run fails.
+	// It takes precedence over the rollback policy of the referenced update strategy.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="rollbackPolicy is immutable"
+	RollbackPolicy *RollbackPolicy `json:"rollbackPolicy,omitempty"`
+}
+
+// RollbackPolicyType describes how a failed update run is rolled back.
+// +enum
+type RollbackPolicyType string
+
+const (
+	// RollbackPolicyTypeManual leaves the clusters that have already been updated on the new resource snapshot
+	// when the update run fails; it's up to the user to create another update run to fix them.
+	RollbackPolicyTypeManual RollbackPolicyType = "Manual"
+
+	// RollbackPolicyTypeAutomatic creates a rollback update run when the update run fails. The rollback
+	// update run re-points the clusters that have already been updated to the resource snapshot and override
+	// snapshots they used before the update run started, in the reverse order of the stages.
+	RollbackPolicyTypeAutomatic RollbackPolicyType = "Automatic"
+)
+
+// RollbackPolicy specifies how a failed update run is rolled back.
+type RollbackPolicy struct {
+	// Type of the rollback policy. Can be "Manual" or "Automatic". Default is "Manual".
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Manual
+	// +kubebuilder:validation:Enum=Manual;Automatic
+	Type RollbackPolicyType `json:"type,omitempty"`
+
+	// RollbackOnStuck indicates whether an update run that is stuck waiting for a cluster to be updated
+	// is also treated as failed and rolled back. It's only honored when the type is Automatic.
+	// +kubebuilder:validation:Optional
+	RollbackOnStuck bool `json:"rollbackOnStuck,omitempty"`
 }
 
 // UpdateStrategySpecGetterSetter offers the functionality to work with UpdateStrategySpec.
@@ -266,6 +301,11 @@ type UpdateStrategySpec struct {
 	// +kubebuilder:validation:MaxItems=31
 	// +kubebuilder:validation:Required
 	Stages []StageConfig `json:"stages"`
+
+	// RollbackPolicy specifies what happens to the clusters that have already been updated when an update run
+	// using this strategy fails. The rollback policy of an update run, if set, takes precedence.
+	// +kubebuilder:validation:Optional
+	RollbackPolicy *RollbackPolicy `json:"rollbackPolicy,omitempty"`
 }
 
 // ClusterStagedUpdateStrategyList contains a list of StagedUpdateStrategy.
@@ -288,7 +328,7 @@ func (c *ClusterStagedUpdateStrategyList) GetUpdateStrategyObjs() []UpdateStrate
 
 // StageConfig describes a single update stage.
 // The clusters in each stage are updated sequentially.
-// The update stops if any of the updates fail.
+// The update stops if any of the updates fail, unless the failure is tolerated by MaxFailedClusters.
 type StageConfig struct {
 	// The name of the stage. This MUST be unique within the same StagedUpdateStrategy.
 	// +kubebuilder:validation:MaxLength=63
@@ -322,27 +362,70 @@ type StageConfig struct {
 	// +kubebuilder:validation:Optional
 	MaxConcurrency *intstr.IntOrString `json:"maxConcurrency,omitempty"`
 
+	// MaxFailedClusters specifies the maximum number of clusters that can fail to be updated within this stage
+	// without failing the stage. The failed clusters within the limit are recorded in the stage status and
+	// skipped so that the stage can still succeed.
+	// Value can be an absolute number (ex: 5) or a percentage of the total clusters in the stage (ex: 10%).
+	// Fractional results are rounded down.
+	// A cluster is considered failed if its binding is preempted, or if its binding keeps reporting a failure
+	// for longer than the period after which the update run is considered stuck.
+	// Defaults to 0, which means that any failed cluster fails the stage.
+	// +kubebuilder:validation:XIntOrString
+	// +kubebuilder:validation:Pattern="^(100|[1-9]?[0-9])%$"
+	// +kubebuilder:validation:XValidation:rule="self == null || type(self) != int || self >= 0",message="maxFailedClusters must be at least 0"
+	// +kubebuilder:validation:Optional
+	MaxFailedClusters *intstr.IntOrString `json:"maxFailedClusters,omitempty"`
+
+	// ClusterApprovalSelector is a label query over the clusters in this stage. Each cluster matching the query
+	// requires its own approval before it's updated: when the cluster is up next, an approval request targeting
+	// the cluster is created and the cluster waits, holding one of the MaxConcurrency slots, until the request is
+	// approved or the cluster is skipped.
+	// If the selector is nil, no cluster in the stage requires a per-cluster approval.
+	// +kubebuilder:validation:Optional
+	ClusterApprovalSelector *metav1.LabelSelector `json:"clusterApprovalSelector,omitempty"`
+
 	// The collection of tasks that each stage needs to complete successfully before moving to the next stage.
 	// Each task is executed in parallel and there cannot be more than one task of the same type.
-	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:MaxItems=5
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'Approval' && has(e.waitTime))",message="AfterStageTaskType is Approval, waitTime is not allowed"
 	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'TimedWait' && !has(e.waitTime))",message="AfterStageTaskType is TimedWait, waitTime is required"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'Analysis' && has(e.waitTime))",message="AfterStageTaskType is Analysis, waitTime is not allowed"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'Analysis' && !has(e.analysis))",message="AfterStageTaskType is Analysis, analysis is required"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type != 'Analysis' && has(e.analysis))",message="analysis is only allowed when the AfterStageTaskType is Analysis"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'Webhook' && has(e.waitTime))",message="AfterStageTaskType is Webhook, waitTime is not allowed"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'Webhook' && !has(e.webhook))",message="AfterStageTaskType is Webhook, webhook is required"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type != 'Webhook' && has(e.webhook))",message="webhook is only allowed when the AfterStageTaskType is Webhook"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'VerificationJob' && has(e.waitTime))",message="AfterStageTaskType is VerificationJob, waitTime is not allowed"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'VerificationJob' && !has(e.verificationJob))",message="AfterStageTaskType is VerificationJob, verificationJob is required"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type != 'VerificationJob' && has(e.verificationJob))",message="verificationJob is only allowed when the AfterStageTaskType is VerificationJob"
 	AfterStageTasks []StageTask `json:"afterStageTasks,omitempty"`
 
 	// The collection of tasks that needs to completed successfully by each stage before starting the stage.
 	// Each task is executed in parallel and there cannot be more than one task of the same type.
+	// Only Approval and Webhook tasks are allowed.
 	// +kubebuilder:validation:Optional
-	// +kubebuilder:validation:MaxItems=1
+	// +kubebuilder:validation:MaxItems=2
 	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'Approval' && has(e.waitTime))",message="AfterStageTaskType is Approval, waitTime is not allowed"
 	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'TimedWait')",message="BeforeStageTaskType cannot be TimedWait"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'Analysis')",message="BeforeStageTaskType cannot be Analysis"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'VerificationJob')",message="BeforeStageTaskType cannot be VerificationJob"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'Webhook' && has(e.waitTime))",message="BeforeStageTaskType is Webhook, waitTime is not allowed"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type == 'Webhook' && !has(e.webhook))",message="BeforeStageTaskType is Webhook, webhook is required"
+	// +kubebuilder:validation:XValidation:rule="!self.exists(e, e.type != 'Webhook' && has(e.webhook))",message="webhook is only allowed when the BeforeStageTaskType is Webhook"
 	BeforeStageTasks []StageTask `json:"beforeStageTasks,omitempty"`
+
+	// MaintenanceWindow restricts when the clusters in this stage can start to be updated, on top of the
+	// ClusterMaintenanceWindow objects that select the clusters.
+	// A cluster in the stage only starts to be updated when both allow it.
+	// +kubebuilder:validation:Optional
+	MaintenanceWindow *MaintenanceWindowPolicy `json:"maintenanceWindow,omitempty"`
 }
 
 // StageTask is the pre or post stage task that needs to be completed before starting or moving to the next stage.
 type StageTask struct {
 	// The type of the before or after stage task.
-	// +kubebuilder:validation:Enum=TimedWait;Approval
+	// +kubebuilder:validation:Enum=TimedWait;Approval;Analysis;Webhook;VerificationJob
 	// +kubebuilder:validation:Required
 	Type StageTaskType `json:"type"`
 
@@ -352,6 +435,240 @@ type StageTask struct {
 	// +kubebuilder:validation:Type=string
 	// +kubebuilder:validation:Optional
 	WaitTime *metav1.Duration `json:"waitTime,omitempty"`
+
+	// The metric analysis to run after all the clusters in the current stage complete the update.
+	// Only valid if the task type is Analysis.
+	// +kubebuilder:validation:Optional
+	Analysis *AnalysisConfig `json:"analysis,omitempty"`
+
+	// The external HTTP endpoint to call for a go/no-go decision.
+	// Only valid if the task type is Webhook.
+	// +kubebuilder:validation:Optional
+	Webhook *WebhookConfig `json:"webhook,omitempty"`
+
+	// The Job to run on each cluster in the current stage after all the clusters in the stage complete the update.
+	// Only valid if the task type is VerificationJob.
+	// +kubebuilder:validation:Optional
+	VerificationJob *VerificationJobConfig `json:"verificationJob,omitempty"`
+}
+
+// VerificationJobConfig describes a Job, e.g., a smoke test, that Fleet runs on each cluster in a stage to verify
+// the update before moving on to the next stage.
+//
+// Fleet places a copy of the Job template onto each cluster in the stage via a dedicated Work object, and waits
+// for all the copies to complete. The stage passes if all of them succeed; the update run fails if any of them
+// fails, or if they do not complete in time. The termination messages of the containers in the pods of the Jobs
+// are collected into the status of the task; containers that do not set `terminationMessagePolicy` use
+// `FallbackToLogsOnError`, so that the tail of their logs is collected if they fail.
+type VerificationJobConfig struct {
+	// JobTemplateRef refers to a ResourceEnvelope on the hub cluster that wraps exactly one batch/v1 Job, which
+	// is used as the template of the Jobs. The Jobs are created in the namespace of the envelope, which must exist
+	// on the clusters, with the name of the template suffixed to be unique to the update run and the stage.
+	// The `ttlSecondsAfterFinished` field of the template is ignored; the Jobs are deleted when the task
+	// succeeds or when the update run is deleted.
+	// +kubebuilder:validation:Required
+	JobTemplateRef VerificationJobTemplateReference `json:"jobTemplateRef"`
+
+	// Timeout is the maximum time to wait for the Jobs to complete, counting from the start of the task.
+	// Only hours (h), minutes (m), and seconds (s) units are accepted.
+	// Defaults to 30m.
+	// +kubebuilder:default="30m"
+	// +kubebuilder:validation:Pattern="^(?:(?:0|[1-9][0-9]*)(\\.[0-9]+)?(?:s|m|h))+$"
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// VerificationJobTemplateReference refers to a ResourceEnvelope on the hub cluster.
+type VerificationJobTemplateReference struct {
+	// Name is the name of the ResourceEnvelope.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the ResourceEnvelope. It is required by ClusterStagedUpdateRuns;
+	// StagedUpdateRuns can only refer to ResourceEnvelopes in their own namespace, which is also the default.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// WebhookConfig describes an external HTTP endpoint that decides whether a stage can start or move on to the
+// next stage.
+//
+// The endpoint receives a POST request with a JSON body that describes the update run, the placement, the stage,
+// and the clusters in the stage, and must respond with a 2xx status code and a JSON body of the form
+// `{"decision": "Approve|Reject|RetryLater", "message": "...", "retryAfterSeconds": 60}`, where only the
+// decision is required. A rejection fails the update run; a retry-later response makes the update run call the
+// endpoint again later, after `retryAfterSeconds` if specified or the retry interval otherwise.
+type WebhookConfig struct {
+	// URL is the URL of the endpoint.
+	// +kubebuilder:validation:Pattern="^https?://"
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Required
+	URL string `json:"url"`
+
+	// HeadersSecretRef refers to a Secret on the hub cluster whose data entries are sent as HTTP headers,
+	// e.g., for authentication, with the keys as the header names.
+	// The Secret must have the `kubernetes-fleet.io/webhook-headers` label set to `true`.
+	// +kubebuilder:validation:Optional
+	HeadersSecretRef *WebhookSecretReference `json:"headersSecretRef,omitempty"`
+
+	// Timeout is the timeout of a single call to the endpoint; it must be at most 10s.
+	// Endpoints that need more time to decide should ask to be called again later.
+	// Only hours (h), minutes (m), and seconds (s) units are accepted.
+	// Defaults to 5s.
+	// +kubebuilder:default="5s"
+	// +kubebuilder:validation:Pattern="^(?:(?:0|[1-9][0-9]*)(\\.[0-9]+)?(?:s|m|h))+$"
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// RetryPolicy describes how failed calls are retried.
+	// +kubebuilder:validation:Optional
+	RetryPolicy *WebhookRetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// WebhookSecretReference refers to a Secret on the hub cluster.
+type WebhookSecretReference struct {
+	// Name is the name of the Secret.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the Secret. It is required by ClusterStagedUpdateRuns; StagedUpdateRuns
+	// can only refer to Secrets in their own namespace, which is also the default.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// WebhookRetryPolicy describes how failed calls to a webhook are retried.
+// A call fails if the endpoint cannot be reached, responds with a non-2xx status code, or responds with a
+// malformed body.
+type WebhookRetryPolicy struct {
+	// MaxAttempts is the number of failed calls after which the task fails.
+	// Defaults to 3.
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
+	// +kubebuilder:validation:Optional
+	MaxAttempts int32 `json:"maxAttempts,omitempty"`
+
+	// Interval is the time to wait after a failed call, or after a retry-later response without
+	// `retryAfterSeconds`, before calling the endpoint again.
+	// Only hours (h), minutes (m), and seconds (s) units are accepted.
+	// Defaults to 1m.
+	// +kubebuilder:default="1m"
+	// +kubebuilder:validation:Pattern="^(?:(?:0|[1-9][0-9]*)(\\.[0-9]+)?(?:s|m|h))+$"
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// AnalysisConfig describes a metric analysis that decides whether a stage can move on to the next stage.
+type AnalysisConfig struct {
+	// Provider is the metrics backend to query.
+	// +kubebuilder:validation:Required
+	Provider MetricProviderConfig `json:"provider"`
+
+	// Metrics is the list of metrics to evaluate. The analysis succeeds when all the metrics succeed,
+	// and fails as soon as any of the metrics fails.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:Required
+	// +listType=map
+	// +listMapKey=name
+	Metrics []AnalysisMetric `json:"metrics"`
+}
+
+// MetricProviderType identifies a type of metrics backend.
+// +enum
+type MetricProviderType string
+
+const (
+	// MetricProviderTypePrometheus queries metrics with the Prometheus HTTP API.
+	MetricProviderTypePrometheus MetricProviderType = "Prometheus"
+)
+
+// MetricProviderConfig describes how to reach a metrics backend.
+type MetricProviderConfig struct {
+	// Type is the type of the metrics backend.
+	// +kubebuilder:validation:Enum=Prometheus
+	// +kubebuilder:default=Prometheus
+	// +kubebuilder:validation:Optional
+	Type MetricProviderType `json:"type,omitempty"`
+
+	// Address is the base URL of the metrics backend, e.g., `http://prometheus.monitoring:9090`.
+	// +kubebuilder:validation:Pattern="^https?://"
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Required
+	Address string `json:"address"`
+}
+
+// AnalysisMetric describes a metric to measure periodically and the conditions to evaluate the measurements with.
+type AnalysisMetric struct {
+	// Name is the name of the metric. It must be unique within the analysis.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Query is the query to run against the metrics backend, which must evaluate to a single number
+	// (e.g., a Prometheus scalar, or an instant vector with exactly one sample).
+	//
+	// The following placeholders are replaced before the query runs:
+	// * `{{stage}}`: the name of the stage;
+	// * `{{updateRun}}`: the name of the update run;
+	// * `{{placement}}`: the name of the placement;
+	// * `{{clusters}}`: the names of the clusters in the stage, joined by `|` for use in regular expressions.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	// +kubebuilder:validation:Required
+	Query string `json:"query"`
+
+	// SuccessCondition is the condition that a measurement must satisfy to be considered successful,
+	// e.g., `result >= 0.99`. A condition compares `result` (the measured value) against a number with
+	// one of the `<`, `<=`, `>`, `>=`, `==`, and `!=` operators; multiple comparisons can be joined with `&&`.
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Required
+	SuccessCondition string `json:"successCondition"`
+
+	// FailureCondition is the condition that a measurement must satisfy to be considered failed, in the
+	// same format as SuccessCondition. If not specified, a measurement that does not satisfy the success
+	// condition is considered failed; otherwise, a measurement that satisfies neither condition is
+	// considered inconclusive, which does not count as a failure.
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Optional
+	FailureCondition string `json:"failureCondition,omitempty"`
+
+	// Interval is the time between two measurements; the first measurement is taken one interval after
+	// all the clusters in the stage complete the update.
+	// Only hours (h), minutes (m), and seconds (s) units are accepted.
+	// Defaults to 1m.
+	// +kubebuilder:default="1m"
+	// +kubebuilder:validation:Pattern="^(?:(?:0|[1-9][0-9]*)(\\.[0-9]+)?(?:s|m|h))+$"
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Count is the number of measurements to take before the metric is considered successful.
+	// Defaults to 1.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Optional
+	Count int32 `json:"count,omitempty"`
+
+	// FailureLimit is the number of failed measurements (including measurements that cannot be taken
+	// due to errors) that are tolerated; the metric fails once the number of failed measurements exceeds
+	// this limit.
+	// Defaults to 0.
+	// +kubebuilder:default=0
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Optional
+	FailureLimit int32 `json:"failureLimit,omitempty"`
 }
 
 // UpdateRunStatus defines the observed state of the ClusterStagedUpdateRun.
@@ -401,6 +718,16 @@ type UpdateRunStatus struct {
 	// +kubebuilder:validation:Optional
 	DeletionStageStatus *StageUpdatingStatus `json:"deletionStageStatus,omitempty"`
 
+	// RollbackUpdateRunName is the name of the update run that was created to roll back this update run
+	// after it failed. It's only set when the rollback policy is Automatic.
+	// +kubebuilder:validation:Optional
+	RollbackUpdateRunName string `json:"rollbackUpdateRunName,omitempty"`
+
+	// RolledBackUpdateRunName is the name of the failed update run that this update run rolls back.
+	// It's only set on rollback update runs.
+	// +kubebuilder:validation:Optional
+	RolledBackUpdateRunName string `json:"rolledBackUpdateRunName,omitempty"`
+
 	// +patchMergeKey=type
 	// +patchStrategy=merge
 	// +listType=map
@@ -448,14 +775,19 @@ type StageUpdatingStatus struct {
 	// +kubebuilder:validation:Required
 	Clusters []ClusterUpdatingStatus `json:"clusters"`
 
+	// FailedClusters is the list of the names of the clusters in this stage that have failed to be updated but
+	// are tolerated because of the maxFailedClusters setting of the stage. These clusters are skipped.
+	// +kubebuilder:validation:Optional
+	FailedClusters []string `json:"failedClusters,omitempty"`
+
 	// The status of the post-update tasks associated with the current stage.
 	// Empty if the stage has not finished updating all the clusters.
-	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:MaxItems=5
 	// +kubebuilder:validation:Optional
 	AfterStageTaskStatus []StageTaskStatus `json:"afterStageTaskStatus,omitempty"`
 
 	// The status of the pre-update tasks associated with the current stage.
-	// +kubebuilder:validation:MaxItems=1
+	// +kubebuilder:validation:MaxItems=2
 	// +kubebuilder:validation:Optional
 	BeforeStageTaskStatus []StageTaskStatus `json:"beforeStageTaskStatus,omitempty"`
 
@@ -520,6 +852,32 @@ type ClusterUpdatingStatus struct {
 	// +kubebuilder:validation:Optional
 	ClusterResourceOverrideSnapshots []string `json:"clusterResourceOverrideSnapshots,omitempty"`
 
+	// PreviousState records the resource snapshot and override snapshots that the cluster used when the
+	// update run was initialized. It's used to roll back the cluster if the update run fails.
+	// It's not set if the cluster had no resources placed before the update run.
+	// +kubebuilder:validation:Optional
+	PreviousState *ClusterPreviousState `json:"previousState,omitempty"`
+
+	// ResourceSnapshotIndex is the index of the resource snapshot that the cluster is updated to.
+	// It's only set by rollback update runs, which roll each cluster back to the resource snapshot it used before
+	// the failed update run. If it's not set, the cluster is updated to the resource snapshot of the update run.
+	// +kubebuilder:validation:Optional
+	ResourceSnapshotIndex string `json:"resourceSnapshotIndex,omitempty"`
+
+	// ApprovalState is the state of the per-cluster approval of the cluster.
+	// It's Pending if the cluster requires an approval that has not been given yet, Approved if the update of the
+	// cluster has been approved or manually promoted, and Skipped if the cluster has been manually skipped, in
+	// which case the cluster is not updated by the update run.
+	// It's empty if the cluster does not require an approval and has not been manually promoted or skipped.
+	// +kubebuilder:validation:Enum=Pending;Approved;Skipped
+	// +kubebuilder:validation:Optional
+	ApprovalState ClusterApprovalState `json:"approvalState,omitempty"`
+
+	// The name of the approval request created for the cluster.
+	// It's set when the approval request is created or found.
+	// +kubebuilder:validation:Optional
+	ApprovalRequestName string `json:"approvalRequestName,omitempty"`
+
 	// +patchMergeKey=type
 	// +patchStrategy=merge
 	// +listType=map
@@ -531,6 +889,35 @@ type ClusterUpdatingStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// ClusterApprovalState is the state of the per-cluster approval of a cluster in a staged update run.
+type ClusterApprovalState string
+
+const (
+	// ClusterApprovalStatePending indicates that the cluster is waiting for its approval.
+	ClusterApprovalStatePending ClusterApprovalState = "Pending"
+
+	// ClusterApprovalStateApproved indicates that the update of the cluster has been approved or manually promoted.
+	ClusterApprovalStateApproved ClusterApprovalState = "Approved"
+
+	// ClusterApprovalStateSkipped indicates that the cluster has been manually skipped.
+	ClusterApprovalStateSkipped ClusterApprovalState = "Skipped"
+)
+
+// ClusterPreviousState records the resources that a cluster used before an update run started.
+type ClusterPreviousState struct {
+	// ResourceSnapshotIndex is the index of the resource snapshot that the cluster used.
+	// +kubebuilder:validation:Required
+	ResourceSnapshotIndex string `json:"resourceSnapshotIndex"`
+
+	// ResourceOverrideSnapshots is the list of ResourceOverride snapshots that the cluster used.
+	// +kubebuilder:validation:Optional
+	ResourceOverrideSnapshots []NamespacedName `json:"resourceOverrideSnapshots,omitempty"`
+
+	// ClusterResourceOverrideSnapshots is the list of ClusterResourceOverride snapshot names that the cluster used.
+	// +kubebuilder:validation:Optional
+	ClusterResourceOverrideSnapshots []string `json:"clusterResourceOverrideSnapshots,omitempty"`
+}
+
 // ClusterUpdatingStatusConditionType identifies a specific condition of the UpdatingStatus of the cluster.
 // +enum
 type ClusterUpdatingStatusConditionType string
@@ -550,7 +937,7 @@ const (
 
 type StageTaskStatus struct {
 	// The type of the pre or post update task.
-	// +kubebuilder:validation:Enum=TimedWait;Approval
+	// +kubebuilder:validation:Enum=TimedWait;Approval;Analysis;Webhook;VerificationJob
 	// +kubebuilder:validation:Required
 	Type StageTaskType `json:"type"`
 
@@ -559,13 +946,29 @@ type StageTaskStatus struct {
 	// +kubebuilder:validation:Optional
 	ApprovalRequestName string `json:"approvalRequestName,omitempty"`
 
+	// The measurements taken for the metric analysis of this stage.
+	// Only valid if the AfterStageTaskType is Analysis.
+	// +kubebuilder:validation:Optional
+	AnalysisStatus *AnalysisStatus `json:"analysisStatus,omitempty"`
+
+	// The calls made to the webhook of this stage.
+	// Only valid if the task type is Webhook.
+	// +kubebuilder:validation:Optional
+	WebhookStatus *WebhookStatus `json:"webhookStatus,omitempty"`
+
+	// The status of the Jobs run on the clusters in this stage.
+	// Only valid if the task type is VerificationJob.
+	// +kubebuilder:validation:Optional
+	VerificationJobStatus *VerificationJobStatus `json:"verificationJobStatus,omitempty"`
+
 	// +patchMergeKey=type
 	// +patchStrategy=merge
 	// +listType=map
 	// +listMapKey=type
 	//
 	// Conditions is an array of current observed conditions for the specific type of pre or post update task.
-	// Known conditions are "ApprovalRequestCreated", "WaitTimeElapsed", and "ApprovalRequestApproved".
+	// Known conditions are "ApprovalRequestCreated", "WaitTimeElapsed", "ApprovalRequestApproved", "AnalysisSucceeded",
+	// "WebhookApproved", and "VerificationJobSucceeded".
 	// +kubebuilder:validation:Optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
@@ -580,6 +983,273 @@ const (
 
 	// StageTaskTypeApproval indicates the stage task is an approval.
 	StageTaskTypeApproval StageTaskType = "Approval"
+
+	// StageTaskTypeAnalysis indicates the stage task is a metric analysis.
+	StageTaskTypeAnalysis StageTaskType = "Analysis"
+
+	// StageTaskTypeWebhook indicates the stage task is a call to an external HTTP endpoint.
+	StageTaskTypeWebhook StageTaskType = "Webhook"
+
+	// StageTaskTypeVerificationJob indicates the stage task is a Job run on each cluster in the stage.
+	StageTaskTypeVerificationJob StageTaskType = "VerificationJob"
+)
+
+// VerificationJobStatus is the status of the Jobs run on the clusters in a stage.
+type VerificationJobStatus struct {
+	// The time when the task started.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	StartTime metav1.Time `json:"startTime"`
+
+	// The name of the Jobs created on the clusters.
+	// +kubebuilder:validation:Required
+	JobName string `json:"jobName"`
+
+	// The namespace of the Jobs created on the clusters.
+	// +kubebuilder:validation:Required
+	JobNamespace string `json:"jobNamespace"`
+
+	// The status of the Job on each cluster in the stage.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=clusterName
+	Clusters []ClusterVerificationJobStatus `json:"clusters,omitempty"`
+}
+
+// ClusterVerificationJobStatus is the status of the Job run on a cluster.
+type ClusterVerificationJobStatus struct {
+	// The name of the cluster.
+	// +kubebuilder:validation:Required
+	ClusterName string `json:"clusterName"`
+
+	// The phase of the Job.
+	// +kubebuilder:validation:Enum=Running;Succeeded;Failed
+	// +kubebuilder:validation:Required
+	Phase VerificationJobPhase `json:"phase"`
+
+	// A human-readable message about the Job, e.g., why it has failed or has not been applied.
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+
+	// The termination messages of the containers in the pods of the Job, up to 3, with those of the
+	// failed containers first. Each message is truncated to its last 512 bytes.
+	// +kubebuilder:validation:MaxItems=3
+	// +kubebuilder:validation:Optional
+	TerminationMessages []ContainerTerminationMessage `json:"terminationMessages,omitempty"`
+}
+
+// ContainerTerminationMessage is the termination message of a container in a pod.
+type ContainerTerminationMessage struct {
+	// The name of the pod.
+	// +kubebuilder:validation:Required
+	PodName string `json:"podName"`
+
+	// The name of the container.
+	// +kubebuilder:validation:Required
+	ContainerName string `json:"containerName"`
+
+	// The exit code of the container.
+	// +kubebuilder:validation:Optional
+	ExitCode int32 `json:"exitCode,omitempty"`
+
+	// The reason of the termination, e.g., Error or Completed.
+	// +kubebuilder:validation:Optional
+	Reason string `json:"reason,omitempty"`
+
+	// The termination message of the container.
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+}
+
+// VerificationJobPhase identifies the phase of the Job run on a cluster.
+// +enum
+type VerificationJobPhase string
+
+const (
+	// VerificationJobPhaseRunning indicates the Job has not completed yet, or has not been applied yet.
+	VerificationJobPhaseRunning VerificationJobPhase = "Running"
+
+	// VerificationJobPhaseSucceeded indicates the Job has completed successfully.
+	VerificationJobPhaseSucceeded VerificationJobPhase = "Succeeded"
+
+	// VerificationJobPhaseFailed indicates the Job has failed.
+	VerificationJobPhaseFailed VerificationJobPhase = "Failed"
+)
+
+// WebhookStatus is the status of the calls made to the webhook of a stage.
+type WebhookStatus struct {
+	// The total number of calls made.
+	// +kubebuilder:validation:Optional
+	Attempts int32 `json:"attempts,omitempty"`
+
+	// The number of failed calls, i.e., calls that did not receive a valid response.
+	// +kubebuilder:validation:Optional
+	FailedAttempts int32 `json:"failedAttempts,omitempty"`
+
+	// The earliest time at which the endpoint will be called again.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	NextAttemptTime *metav1.Time `json:"nextAttemptTime,omitempty"`
+
+	// The most recent calls, up to 10, in the order they were made.
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:Optional
+	Calls []WebhookCall `json:"calls,omitempty"`
+}
+
+// WebhookCall summarizes a single call to the webhook of a stage.
+type WebhookCall struct {
+	// The attempt number of the call, starting from 1.
+	// +kubebuilder:validation:Required
+	Attempt int32 `json:"attempt"`
+
+	// The time when the call was made.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	CalledAt metav1.Time `json:"calledAt"`
+
+	// The number of clusters in the stage that were sent in the request.
+	// +kubebuilder:validation:Optional
+	ClusterCount int32 `json:"clusterCount,omitempty"`
+
+	// The HTTP status code of the response. Empty if no response was received.
+	// +kubebuilder:validation:Optional
+	StatusCode int32 `json:"statusCode,omitempty"`
+
+	// The result of the call.
+	// +kubebuilder:validation:Enum=Approved;Rejected;RetryLater;Error
+	// +kubebuilder:validation:Required
+	Result WebhookCallResult `json:"result"`
+
+	// The message returned by the endpoint, or the error encountered.
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+}
+
+// WebhookCallResult identifies the result of a call to a webhook.
+// +enum
+type WebhookCallResult string
+
+const (
+	// WebhookCallResultApproved indicates the endpoint approved the stage.
+	WebhookCallResultApproved WebhookCallResult = "Approved"
+
+	// WebhookCallResultRejected indicates the endpoint rejected the stage.
+	WebhookCallResultRejected WebhookCallResult = "Rejected"
+
+	// WebhookCallResultRetryLater indicates the endpoint asked to be called again later.
+	WebhookCallResultRetryLater WebhookCallResult = "RetryLater"
+
+	// WebhookCallResultError indicates the call did not receive a valid response.
+	WebhookCallResultError WebhookCallResult = "Error"
+)
+
+// AnalysisStatus is the status of the metric analysis of a stage.
+type AnalysisStatus struct {
+	// The time when the analysis started.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	StartTime metav1.Time `json:"startTime"`
+
+	// The status of each metric in the analysis.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Metrics []MetricAnalysisStatus `json:"metrics,omitempty"`
+}
+
+// MetricAnalysisStatus is the status of a metric in the metric analysis.
+type MetricAnalysisStatus struct {
+	// The name of the metric.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// The phase of the metric.
+	// +kubebuilder:validation:Enum=Running;Successful;Failed
+	// +kubebuilder:validation:Required
+	Phase AnalysisPhase `json:"phase"`
+
+	// The number of measurements taken.
+	// +kubebuilder:validation:Optional
+	MeasurementCount int32 `json:"measurementCount,omitempty"`
+
+	// The number of successful measurements.
+	// +kubebuilder:validation:Optional
+	SuccessfulCount int32 `json:"successfulCount,omitempty"`
+
+	// The number of failed measurements, including measurements that cannot be taken due to errors.
+	// +kubebuilder:validation:Optional
+	FailedCount int32 `json:"failedCount,omitempty"`
+
+	// The number of inconclusive measurements.
+	// +kubebuilder:validation:Optional
+	InconclusiveCount int32 `json:"inconclusiveCount,omitempty"`
+
+	// The most recent measurements, up to 10, in the order they were taken.
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:Optional
+	Measurements []Measurement `json:"measurements,omitempty"`
+}
+
+// Measurement is a single measurement of a metric.
+type Measurement struct {
+	// The phase of the measurement.
+	// +kubebuilder:validation:Enum=Successful;Failed;Inconclusive;Error
+	// +kubebuilder:validation:Required
+	Phase MeasurementPhase `json:"phase"`
+
+	// The measured value. Empty if the measurement cannot be taken.
+	// +kubebuilder:validation:Optional
+	Value string `json:"value,omitempty"`
+
+	// A human-readable message about the measurement, e.g., the error encountered.
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+
+	// The time when the measurement was taken.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	MeasuredAt metav1.Time `json:"measuredAt"`
+}
+
+// AnalysisPhase identifies the phase of a metric in the metric analysis.
+// +enum
+type AnalysisPhase string
+
+const (
+	// AnalysisPhaseRunning indicates the metric is still being measured.
+	AnalysisPhaseRunning AnalysisPhase = "Running"
+
+	// AnalysisPhaseSuccessful indicates the metric has been measured the specified number of times
+	// without exceeding the failure limit.
+	AnalysisPhaseSuccessful AnalysisPhase = "Successful"
+
+	// AnalysisPhaseFailed indicates the metric has exceeded the failure limit.
+	AnalysisPhaseFailed AnalysisPhase = "Failed"
+)
+
+// MeasurementPhase identifies the result of a single measurement.
+// +enum
+type MeasurementPhase string
+
+const (
+	// MeasurementPhaseSuccessful indicates the measurement satisfies the success condition.
+	MeasurementPhaseSuccessful MeasurementPhase = "Successful"
+
+	// MeasurementPhaseFailed indicates the measurement is considered failed.
+	MeasurementPhaseFailed MeasurementPhase = "Failed"
+
+	// MeasurementPhaseInconclusive indicates the measurement satisfies neither the success condition
+	// nor the failure condition.
+	MeasurementPhaseInconclusive MeasurementPhase = "Inconclusive"
+
+	// MeasurementPhaseError indicates the measurement cannot be taken, e.g., the query fails.
+	MeasurementPhaseError MeasurementPhase = "Error"
 )
 
 // StageTaskConditionType identifies a specific condition of the AfterStageTask or BeforeStageTask.
@@ -603,6 +1273,24 @@ const (
 	// - "True": The wait time has elapsed.
 	// - "False": The wait time has not elapsed.
 	StageTaskConditionWaitTimeElapsed StageTaskConditionType = "WaitTimeElapsed"
+
+	// StageTaskConditionAnalysisSucceeded indicates if the metric analysis after each stage has succeeded.
+	// Its condition status can be:
+	// - "True": All the metrics in the analysis have succeeded.
+	// - "False": Some metric in the analysis has failed.
+	StageTaskConditionAnalysisSucceeded StageTaskConditionType = "AnalysisSucceeded"
+
+	// StageTaskConditionWebhookApproved indicates if the webhook of the stage has approved it.
+	// Its condition status can be:
+	// - "True": The webhook has approved the stage.
+	// - "False": The webhook has rejected the stage, or the calls to it have failed too many times.
+	StageTaskConditionWebhookApproved StageTaskConditionType = "WebhookApproved"
+
+	// StageTaskConditionVerificationJobSucceeded indicates if the Jobs run on the clusters in the stage have succeeded.
+	// Its condition status can be:
+	// - "True": The Jobs on all the clusters have succeeded.
+	// - "False": The Job on some cluster has failed, or the Jobs have not completed in time.
+	StageTaskConditionVerificationJobSucceeded StageTaskConditionType = "VerificationJobSucceeded"
 )
 
 // ClusterStagedUpdateRunList contains a list of ClusterStagedUpdateRun.
@@ -667,6 +1355,7 @@ type ApprovalRequestObjList interface {
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:JSONPath=`.spec.parentStageRollout`,name="Update-Run",type=string
 // +kubebuilder:printcolumn:JSONPath=`.spec.targetStage`,name="Stage",type=string
+// +kubebuilder:printcolumn:JSONPath=`.spec.targetCluster`,name="Cluster",type=string
 // +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Approved")].status`,name="Approved",type=string
 // +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
 
@@ -675,7 +1364,7 @@ type ApprovalRequestObjList interface {
 //   - `TargetUpdateRun`: Points to the cluster staged update run that this approval request is for.
 //   - `TargetStage`: The name of the stage that this approval request is for.
 //   - `IsLatestUpdateRunApproval`: Indicates whether this approval request is the latest one related to this update run.
-//   - `TaskType`: Indicates whether this approval request is for the before or after stage task.
+//   - `TaskType`: Indicates whether this approval request is for the before or after stage task, or for a cluster.
 type ClusterApprovalRequest struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -730,6 +1419,11 @@ type ApprovalRequestSpec struct {
 	// The name of the update stage that this approval request is for.
 	// +kubebuilder:validation:Required
 	TargetStage string `json:"targetStage"`
+
+	// The name of the member cluster in the stage that this approval request is for.
+	// It's empty if the approval request is for a before-stage or after-stage task.
+	// +kubebuilder:validation:Optional
+	TargetCluster string `json:"targetCluster,omitempty"`
 }
 
 // ApprovalRequestStatus defines the observed state of the ClusterApprovalRequest.
@@ -740,7 +1434,7 @@ type ApprovalRequestStatus struct {
 	// +listMapKey=type
 	//
 	// Conditions is an array of current observed conditions for the specific type of post-update task.
-	// Known conditions are "Approved" and "ApprovalAccepted".
+	// Known conditions are "Approved", "Skipped" and "ApprovalAccepted".
 	// +kubebuilder:validation:Optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
@@ -754,6 +1448,12 @@ const (
 	// - "True": The request is approved.
 	ApprovalRequestConditionApproved ApprovalRequestConditionType = "Approved"
 
+	// ApprovalRequestConditionSkipped indicates if the target cluster of a per-cluster approval request should be
+	// skipped instead of updated. It's ignored for the approval requests of before-stage or after-stage tasks.
+	// Its condition status can be:
+	// - "True": The target cluster is skipped.
+	ApprovalRequestConditionSkipped ApprovalRequestConditionType = "Skipped"
+
 	// ApprovalRequestConditionApprovalAccepted indicates if the approved approval request was accepted.
 	// Its condition status can be:
 	// - "True": The request is approved.
@@ -914,6 +1614,7 @@ func (s *StagedUpdateStrategyList) GetUpdateStrategyObjs() []UpdateStrategyObj {
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:JSONPath=`.spec.parentStageRollout`,name="Update-Run",type=string
 // +kubebuilder:printcolumn:JSONPath=`.spec.targetStage`,name="Stage",type=string
+// +kubebuilder:printcolumn:JSONPath=`.spec.targetCluster`,name="Cluster",type=string
 // +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Approved")].status`,name="Approved",type=string
 // +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
 
@@ -922,7 +1623,7 @@ func (s *StagedUpdateStrategyList) GetUpdateStrategyObjs() []UpdateStrategyObj {
 //   - `TargetUpdateRun`: Points to the staged update run that this approval request is for.
 //   - `TargetStage`: The name of the stage that this approval request is for.
 //   - `IsLatestUpdateRunApproval`: Indicates whether this approval request is the latest one related to this update run.
-//   - `TaskType`: Indicates whether this approval request is for the before or after stage task.
+//   - `TaskType`: Indicates whether this approval request is for the before or after stage task, or for a cluster.
 type ApprovalRequest struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
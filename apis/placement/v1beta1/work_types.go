@@ -204,7 +204,21 @@ type DiffDetails struct {
 	ObservedDiffs []PatchDetail `json:"observedDiffs,omitempty"`
 }
 
+// ProjectedStatusField is the field in the back-reported status that keeps the status fields selected
+// by the Projected report back strategy, as a map keyed by JSONPath expression.
+const ProjectedStatusField = "projectedStatus"
+
+// TerminationMessagesField is the field in the back-reported status of a Job placed by the VerificationJob
+// stage task of a staged update run that keeps the termination messages of the containers in the pods of
+// the Job, as a list of ContainerTerminationMessage.
+const TerminationMessagesField = "terminationMessages"
+
 type BackReportedStatus struct {
+	// ObservedStatus is the back-reported status, wrapped with the API version and kind of the resource.
+	// With the Mirror report back strategy, the whole status is kept in the `status` field; with the
+	// Projected report back strategy, the selected status fields are kept in the `projectedStatus` field,
+	// as a map keyed by JSONPath expression.
+	//
 	// +kubebuilder:validation:EmbeddedResource
 	// +kubebuilder:pruning:PreserveUnknownFields
 	ObservedStatus runtime.RawExtension `json:"observedStatus,omitempty"`
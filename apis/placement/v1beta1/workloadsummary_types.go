@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WorkloadSummaryGetterSetter offers the functionality to work with the workload summary of a
+// workload summary object.
+// +kubebuilder:object:generate=false
+type WorkloadSummaryGetterSetter interface {
+	GetWorkloadSummary() *WorkloadSummary
+	SetWorkloadSummary(WorkloadSummary)
+	SetLastUpdatedTime(metav1.Time)
+}
+
+// WorkloadSummaryObj offers the functionality to work with fleet workload summary objects.
+// +kubebuilder:object:generate=false
+type WorkloadSummaryObj interface {
+	client.Object
+	WorkloadSummaryGetterSetter
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-placement},shortName=crpws
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.summary.readyReplicas`,name="Ready",type=integer
+// +kubebuilder:printcolumn:JSONPath=`.summary.replicas`,name="Replicas",type=integer
+// +kubebuilder:printcolumn:JSONPath=`.lastUpdatedTime`,name="Last-Updated",type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterResourcePlacementWorkloadSummary aggregates the status that member agents back-report
+// for the workloads placed by a ClusterResourcePlacement, so that users can observe the readiness
+// of a workload across the fleet from the hub cluster.
+//
+// Fleet creates and maintains this object only when status back-reporting is enabled on the
+// corresponding ClusterResourcePlacement (see ReportBackStrategy); the object is owned by the
+// ClusterResourcePlacement and is garbage collected together with it.
+//
+// The name of this object is the same as the name of the corresponding ClusterResourcePlacement.
+type ClusterResourcePlacementWorkloadSummary struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Summary is the aggregated workload status across all the clusters the placement has
+	// selected.
+	// +kubebuilder:validation:Optional
+	WorkloadSummary `json:"summary,omitempty"`
+
+	// LastUpdatedTime is the timestamp when this object was last updated.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	LastUpdatedTime metav1.Time `json:"lastUpdatedTime,omitempty"`
+}
+
+// ClusterResourcePlacementWorkloadSummaryList contains a list of ClusterResourcePlacementWorkloadSummary objects.
+// +kubebuilder:resource:scope=Cluster
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterResourcePlacementWorkloadSummaryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterResourcePlacementWorkloadSummary `json:"items"`
+}
+
+// +genclient
+// +genclient:Namespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,categories={fleet,fleet-placement},shortName=rpws
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.summary.readyReplicas`,name="Ready",type=integer
+// +kubebuilder:printcolumn:JSONPath=`.summary.replicas`,name="Replicas",type=integer
+// +kubebuilder:printcolumn:JSONPath=`.lastUpdatedTime`,name="Last-Updated",type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ResourcePlacementWorkloadSummary aggregates the status that member agents back-report
+// for the workloads placed by a ResourcePlacement, so that users can observe the readiness
+// of a workload across the fleet from the hub cluster.
+//
+// Fleet creates and maintains this object only when status back-reporting is enabled on the
+// corresponding ResourcePlacement (see ReportBackStrategy); the object is owned by the
+// ResourcePlacement and is garbage collected together with it.
+//
+// The name and namespace of this object are the same as those of the corresponding ResourcePlacement.
+type ResourcePlacementWorkloadSummary struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Summary is the aggregated workload status across all the clusters the placement has
+	// selected.
+	// +kubebuilder:validation:Optional
+	WorkloadSummary `json:"summary,omitempty"`
+
+	// LastUpdatedTime is the timestamp when this object was last updated.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	LastUpdatedTime metav1.Time `json:"lastUpdatedTime,omitempty"`
+}
+
+// ResourcePlacementWorkloadSummaryList contains a list of ResourcePlacementWorkloadSummary objects.
+// +kubebuilder:resource:scope=Namespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ResourcePlacementWorkloadSummaryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourcePlacementWorkloadSummary `json:"items"`
+}
+
+// WorkloadSummary is the aggregated status of the workloads placed by a placement.
+type WorkloadSummary struct {
+	// ReadyReplicas is the total number of ready replicas of all the workloads across all clusters.
+	// +kubebuilder:validation:Optional
+	ReadyReplicas int32 `json:"readyReplicas"`
+
+	// Replicas is the total number of desired replicas of all the workloads across all clusters.
+	// +kubebuilder:validation:Optional
+	Replicas int32 `json:"replicas"`
+
+	// Resources is the per-resource breakdown of the aggregated workload status.
+	//
+	// Only resources that report replica counts in their status (e.g., Deployments,
+	// StatefulSets, ReplicaSets, and DaemonSets) are included.
+	// +kubebuilder:validation:Optional
+	// +listType=atomic
+	Resources []WorkloadResourceSummary `json:"resources,omitempty"`
+}
+
+// WorkloadResourceSummary is the aggregated status of a single placed resource across clusters.
+type WorkloadResourceSummary struct {
+	// Identifier is the identity of the placed resource.
+	// +kubebuilder:validation:Required
+	ResourceIdentifier `json:",inline"`
+
+	// ReadyReplicas is the total number of ready replicas of the resource across all clusters.
+	// +kubebuilder:validation:Optional
+	ReadyReplicas int32 `json:"readyReplicas"`
+
+	// Replicas is the total number of desired replicas of the resource across all clusters.
+	// +kubebuilder:validation:Optional
+	Replicas int32 `json:"replicas"`
+
+	// ReadyClusterCount is the number of clusters where all the desired replicas of the
+	// resource are ready.
+	// +kubebuilder:validation:Optional
+	ReadyClusterCount int32 `json:"readyClusterCount"`
+
+	// ClusterCount is the number of clusters that have reported status for the resource.
+	// +kubebuilder:validation:Optional
+	ClusterCount int32 `json:"clusterCount"`
+
+	// Clusters is the per-cluster status of the resource.
+	// +kubebuilder:validation:Optional
+	// +listType=atomic
+	Clusters []ClusterWorkloadSummary `json:"clusters,omitempty"`
+}
+
+// ClusterWorkloadSummary is the status of a placed resource in a single member cluster.
+type ClusterWorkloadSummary struct {
+	// ClusterName is the name of the member cluster.
+	// +kubebuilder:validation:Required
+	ClusterName string `json:"clusterName"`
+
+	// ReadyReplicas is the number of ready replicas of the resource in the member cluster.
+	// +kubebuilder:validation:Optional
+	ReadyReplicas int32 `json:"readyReplicas"`
+
+	// Replicas is the number of desired replicas of the resource in the member cluster.
+	// +kubebuilder:validation:Optional
+	Replicas int32 `json:"replicas"`
+
+	// ObservationTime is the time when the member agent last back-reported the status.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	ObservationTime metav1.Time `json:"observationTime,omitempty"`
+}
+
+// GetWorkloadSummary returns the workload summary.
+func (s *ClusterResourcePlacementWorkloadSummary) GetWorkloadSummary() *WorkloadSummary {
+	return &s.WorkloadSummary
+}
+
+// SetWorkloadSummary sets the workload summary.
+func (s *ClusterResourcePlacementWorkloadSummary) SetWorkloadSummary(summary WorkloadSummary) {
+	summary.DeepCopyInto(&s.WorkloadSummary)
+}
+
+// SetLastUpdatedTime sets the last updated time.
+func (s *ClusterResourcePlacementWorkloadSummary) SetLastUpdatedTime(t metav1.Time) {
+	s.LastUpdatedTime = t
+}
+
+// GetWorkloadSummary returns the workload summary.
+func (s *ResourcePlacementWorkloadSummary) GetWorkloadSummary() *WorkloadSummary {
+	return &s.WorkloadSummary
+}
+
+// SetWorkloadSummary sets the workload summary.
+func (s *ResourcePlacementWorkloadSummary) SetWorkloadSummary(summary WorkloadSummary) {
+	summary.DeepCopyInto(&s.WorkloadSummary)
+}
+
+// SetLastUpdatedTime sets the last updated time.
+func (s *ResourcePlacementWorkloadSummary) SetLastUpdatedTime(t metav1.Time) {
+	s.LastUpdatedTime = t
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&ClusterResourcePlacementWorkloadSummary{},
+		&ClusterResourcePlacementWorkloadSummaryList{},
+		&ResourcePlacementWorkloadSummary{},
+		&ResourcePlacementWorkloadSummaryList{})
+}
@@ -34,6 +34,11 @@ func (in *Affinity) DeepCopyInto(out *Affinity) {
 		*out = new(ClusterAffinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClusterProximity != nil {
+		in, out := &in.ClusterProximity, &out.ClusterProximity
+		*out = new(ClusterProximity)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Affinity.
@@ -46,6 +51,72 @@ func (in *Affinity) DeepCopy() *Affinity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnalysisConfig) DeepCopyInto(out *AnalysisConfig) {
+	*out = *in
+	out.Provider = in.Provider
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]AnalysisMetric, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalysisConfig.
+func (in *AnalysisConfig) DeepCopy() *AnalysisConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AnalysisConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnalysisMetric) DeepCopyInto(out *AnalysisMetric) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalysisMetric.
+func (in *AnalysisMetric) DeepCopy() *AnalysisMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(AnalysisMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnalysisStatus) DeepCopyInto(out *AnalysisStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]MetricAnalysisStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalysisStatus.
+func (in *AnalysisStatus) DeepCopy() *AnalysisStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AnalysisStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppliedResourceMeta) DeepCopyInto(out *AppliedResourceMeta) {
 	*out = *in
@@ -164,6 +235,11 @@ func (in *ApplyStrategy) DeepCopyInto(out *ApplyStrategy) {
 		*out = new(ServerSideApplyConfig)
 		**out = **in
 	}
+	if in.Impersonation != nil {
+		in, out := &in.Impersonation, &out.Impersonation
+		*out = new(ImpersonationConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplyStrategy.
@@ -272,6 +348,26 @@ func (in *ApprovalRequestStatus) DeepCopy() *ApprovalRequestStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoUpdateRunConfig) DeepCopyInto(out *AutoUpdateRunConfig) {
+	*out = *in
+	if in.HistoryLimit != nil {
+		in, out := &in.HistoryLimit, &out.HistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoUpdateRunConfig.
+func (in *AutoUpdateRunConfig) DeepCopy() *AutoUpdateRunConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoUpdateRunConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackReportedStatus) DeepCopyInto(out *BackReportedStatus) {
 	*out = *in
@@ -395,6 +491,180 @@ func (in *ClusterDecision) DeepCopy() *ClusterDecision {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterFleetUpdateRun) DeepCopyInto(out *ClusterFleetUpdateRun) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterFleetUpdateRun.
+func (in *ClusterFleetUpdateRun) DeepCopy() *ClusterFleetUpdateRun {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterFleetUpdateRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterFleetUpdateRun) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterFleetUpdateRunList) DeepCopyInto(out *ClusterFleetUpdateRunList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterFleetUpdateRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterFleetUpdateRunList.
+func (in *ClusterFleetUpdateRunList) DeepCopy() *ClusterFleetUpdateRunList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterFleetUpdateRunList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterFleetUpdateRunList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMaintenanceWindow) DeepCopyInto(out *ClusterMaintenanceWindow) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMaintenanceWindow.
+func (in *ClusterMaintenanceWindow) DeepCopy() *ClusterMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterMaintenanceWindow) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMaintenanceWindowList) DeepCopyInto(out *ClusterMaintenanceWindowList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterMaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMaintenanceWindowList.
+func (in *ClusterMaintenanceWindowList) DeepCopy() *ClusterMaintenanceWindowList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterMaintenanceWindowList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterMaintenanceWindowList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMaintenanceWindowSpec) DeepCopyInto(out *ClusterMaintenanceWindowSpec) {
+	*out = *in
+	in.ClusterSelector.DeepCopyInto(&out.ClusterSelector)
+	in.MaintenanceWindowPolicy.DeepCopyInto(&out.MaintenanceWindowPolicy)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMaintenanceWindowSpec.
+func (in *ClusterMaintenanceWindowSpec) DeepCopy() *ClusterMaintenanceWindowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterMaintenanceWindowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPreviousState) DeepCopyInto(out *ClusterPreviousState) {
+	*out = *in
+	if in.ResourceOverrideSnapshots != nil {
+		in, out := &in.ResourceOverrideSnapshots, &out.ResourceOverrideSnapshots
+		*out = make([]NamespacedName, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterResourceOverrideSnapshots != nil {
+		in, out := &in.ClusterResourceOverrideSnapshots, &out.ClusterResourceOverrideSnapshots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPreviousState.
+func (in *ClusterPreviousState) DeepCopy() *ClusterPreviousState {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPreviousState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterProximity) DeepCopyInto(out *ClusterProximity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProximity.
+func (in *ClusterProximity) DeepCopy() *ClusterProximity {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterProximity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterResourceBinding) DeepCopyInto(out *ClusterResourceBinding) {
 	*out = *in
@@ -922,6 +1192,65 @@ func (in *ClusterResourcePlacementStatusList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourcePlacementWorkloadSummary) DeepCopyInto(out *ClusterResourcePlacementWorkloadSummary) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.WorkloadSummary.DeepCopyInto(&out.WorkloadSummary)
+	in.LastUpdatedTime.DeepCopyInto(&out.LastUpdatedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourcePlacementWorkloadSummary.
+func (in *ClusterResourcePlacementWorkloadSummary) DeepCopy() *ClusterResourcePlacementWorkloadSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourcePlacementWorkloadSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterResourcePlacementWorkloadSummary) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourcePlacementWorkloadSummaryList) DeepCopyInto(out *ClusterResourcePlacementWorkloadSummaryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterResourcePlacementWorkloadSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourcePlacementWorkloadSummaryList.
+func (in *ClusterResourcePlacementWorkloadSummaryList) DeepCopy() *ClusterResourcePlacementWorkloadSummaryList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourcePlacementWorkloadSummaryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterResourcePlacementWorkloadSummaryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterResourceSnapshot) DeepCopyInto(out *ClusterResourceSnapshot) {
 	*out = *in
@@ -1122,7 +1451,7 @@ func (in *ClusterStagedUpdateRun) DeepCopyInto(out *ClusterStagedUpdateRun) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -1247,6 +1576,11 @@ func (in *ClusterUpdatingStatus) DeepCopyInto(out *ClusterUpdatingStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PreviousState != nil {
+		in, out := &in.PreviousState, &out.PreviousState
+		*out = new(ClusterPreviousState)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -1267,12 +1601,63 @@ func (in *ClusterUpdatingStatus) DeepCopy() *ClusterUpdatingStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeleteStrategy) DeepCopyInto(out *DeleteStrategy) {
+func (in *ClusterVerificationJobStatus) DeepCopyInto(out *ClusterVerificationJobStatus) {
 	*out = *in
+	if in.TerminationMessages != nil {
+		in, out := &in.TerminationMessages, &out.TerminationMessages
+		*out = make([]ContainerTerminationMessage, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteStrategy.
-func (in *DeleteStrategy) DeepCopy() *DeleteStrategy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVerificationJobStatus.
+func (in *ClusterVerificationJobStatus) DeepCopy() *ClusterVerificationJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVerificationJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterWorkloadSummary) DeepCopyInto(out *ClusterWorkloadSummary) {
+	*out = *in
+	in.ObservationTime.DeepCopyInto(&out.ObservationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkloadSummary.
+func (in *ClusterWorkloadSummary) DeepCopy() *ClusterWorkloadSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterWorkloadSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerTerminationMessage) DeepCopyInto(out *ContainerTerminationMessage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerTerminationMessage.
+func (in *ContainerTerminationMessage) DeepCopy() *ContainerTerminationMessage {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerTerminationMessage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeleteStrategy) DeepCopyInto(out *DeleteStrategy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteStrategy.
+func (in *DeleteStrategy) DeepCopy() *DeleteStrategy {
 	if in == nil {
 		return nil
 	}
@@ -1413,6 +1798,133 @@ func (in *FailedResourcePlacement) DeepCopy() *FailedResourcePlacement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverPolicy) DeepCopyInto(out *FailoverPolicy) {
+	*out = *in
+	if in.AfterSeconds != nil {
+		in, out := &in.AfterSeconds, &out.AfterSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverPolicy.
+func (in *FailoverPolicy) DeepCopy() *FailoverPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetUpdateRunMemberStatus) DeepCopyInto(out *FleetUpdateRunMemberStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetUpdateRunMemberStatus.
+func (in *FleetUpdateRunMemberStatus) DeepCopy() *FleetUpdateRunMemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetUpdateRunMemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetUpdateRunPlacement) DeepCopyInto(out *FleetUpdateRunPlacement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetUpdateRunPlacement.
+func (in *FleetUpdateRunPlacement) DeepCopy() *FleetUpdateRunPlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetUpdateRunPlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetUpdateRunSpec) DeepCopyInto(out *FleetUpdateRunSpec) {
+	*out = *in
+	if in.Placements != nil {
+		in, out := &in.Placements, &out.Placements
+		*out = make([]FleetUpdateRunPlacement, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetUpdateRunSpec.
+func (in *FleetUpdateRunSpec) DeepCopy() *FleetUpdateRunSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetUpdateRunSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetUpdateRunStatus) DeepCopyInto(out *FleetUpdateRunStatus) {
+	*out = *in
+	if in.UpdateRuns != nil {
+		in, out := &in.UpdateRuns, &out.UpdateRuns
+		*out = make([]FleetUpdateRunMemberStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetUpdateRunStatus.
+func (in *FleetUpdateRunStatus) DeepCopy() *FleetUpdateRunStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetUpdateRunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImpersonationConfig) DeepCopyInto(out *ImpersonationConfig) {
+	*out = *in
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(ServiceAccountReference)
+		**out = **in
+	}
+	if in.User != nil {
+		in, out := &in.User, &out.User
+		*out = new(string)
+		**out = **in
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImpersonationConfig.
+func (in *ImpersonationConfig) DeepCopy() *ImpersonationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImpersonationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JSONPatchOverride) DeepCopyInto(out *JSONPatchOverride) {
 	*out = *in
@@ -1429,6 +1941,31 @@ func (in *JSONPatchOverride) DeepCopy() *JSONPatchOverride {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowPolicy) DeepCopyInto(out *MaintenanceWindowPolicy) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]RecurringTimeWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.Blackouts != nil {
+		in, out := &in.Blackouts, &out.Blackouts
+		*out = make([]RecurringTimeWindow, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowPolicy.
+func (in *MaintenanceWindowPolicy) DeepCopy() *MaintenanceWindowPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Manifest) DeepCopyInto(out *Manifest) {
 	*out = *in
@@ -1483,6 +2020,59 @@ func (in *ManifestCondition) DeepCopy() *ManifestCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Measurement) DeepCopyInto(out *Measurement) {
+	*out = *in
+	in.MeasuredAt.DeepCopyInto(&out.MeasuredAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Measurement.
+func (in *Measurement) DeepCopy() *Measurement {
+	if in == nil {
+		return nil
+	}
+	out := new(Measurement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricAnalysisStatus) DeepCopyInto(out *MetricAnalysisStatus) {
+	*out = *in
+	if in.Measurements != nil {
+		in, out := &in.Measurements, &out.Measurements
+		*out = make([]Measurement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricAnalysisStatus.
+func (in *MetricAnalysisStatus) DeepCopy() *MetricAnalysisStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricAnalysisStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricProviderConfig) DeepCopyInto(out *MetricProviderConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricProviderConfig.
+func (in *MetricProviderConfig) DeepCopy() *MetricProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespacedName) DeepCopyInto(out *NamespacedName) {
 	*out = *in
@@ -1705,7 +2295,14 @@ func (in *PlacementPolicy) DeepCopyInto(out *PlacementPolicy) {
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
 		*out = make([]Toleration, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(FailoverPolicy)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -1876,6 +2473,22 @@ func (in *PropertySorter) DeepCopy() *PropertySorter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecurringTimeWindow) DeepCopyInto(out *RecurringTimeWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecurringTimeWindow.
+func (in *RecurringTimeWindow) DeepCopy() *RecurringTimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(RecurringTimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReportBackStrategy) DeepCopyInto(out *ReportBackStrategy) {
 	*out = *in
@@ -1884,6 +2497,11 @@ func (in *ReportBackStrategy) DeepCopyInto(out *ReportBackStrategy) {
 		*out = new(ReportBackDestination)
 		**out = **in
 	}
+	if in.StatusPaths != nil {
+		in, out := &in.StatusPaths, &out.StatusPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportBackStrategy.
@@ -2355,6 +2973,65 @@ func (in *ResourcePlacementList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePlacementWorkloadSummary) DeepCopyInto(out *ResourcePlacementWorkloadSummary) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.WorkloadSummary.DeepCopyInto(&out.WorkloadSummary)
+	in.LastUpdatedTime.DeepCopyInto(&out.LastUpdatedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePlacementWorkloadSummary.
+func (in *ResourcePlacementWorkloadSummary) DeepCopy() *ResourcePlacementWorkloadSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePlacementWorkloadSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourcePlacementWorkloadSummary) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePlacementWorkloadSummaryList) DeepCopyInto(out *ResourcePlacementWorkloadSummaryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourcePlacementWorkloadSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePlacementWorkloadSummaryList.
+func (in *ResourcePlacementWorkloadSummaryList) DeepCopy() *ResourcePlacementWorkloadSummaryList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePlacementWorkloadSummaryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourcePlacementWorkloadSummaryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceSelector) DeepCopyInto(out *ResourceSelector) {
 	*out = *in
@@ -2493,6 +3170,21 @@ func (in *ResourceSnapshotStatus) DeepCopy() *ResourceSnapshotStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollbackPolicy) DeepCopyInto(out *RollbackPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollbackPolicy.
+func (in *RollbackPolicy) DeepCopy() *RollbackPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RollbackPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RollingUpdateConfig) DeepCopyInto(out *RollingUpdateConfig) {
 	*out = *in
@@ -2511,6 +3203,13 @@ func (in *RollingUpdateConfig) DeepCopyInto(out *RollingUpdateConfig) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]RollingUpdateStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingUpdateConfig.
@@ -2523,6 +3222,27 @@ func (in *RollingUpdateConfig) DeepCopy() *RollingUpdateConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingUpdateStep) DeepCopyInto(out *RollingUpdateStep) {
+	*out = *in
+	out.Clusters = in.Clusters
+	if in.PauseSeconds != nil {
+		in, out := &in.PauseSeconds, &out.PauseSeconds
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingUpdateStep.
+func (in *RollingUpdateStep) DeepCopy() *RollingUpdateStep {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingUpdateStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutStrategy) DeepCopyInto(out *RolloutStrategy) {
 	*out = *in
@@ -2546,6 +3266,11 @@ func (in *RolloutStrategy) DeepCopyInto(out *RolloutStrategy) {
 		*out = new(ReportBackStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AutoUpdateRun != nil {
+		in, out := &in.AutoUpdateRun, &out.AutoUpdateRun
+		*out = new(AutoUpdateRunConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategy.
@@ -2686,6 +3411,21 @@ func (in *ServerSideApplyConfig) DeepCopy() *ServerSideApplyConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountReference) DeepCopyInto(out *ServiceAccountReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountReference.
+func (in *ServiceAccountReference) DeepCopy() *ServiceAccountReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StageConfig) DeepCopyInto(out *StageConfig) {
 	*out = *in
@@ -2704,6 +3444,16 @@ func (in *StageConfig) DeepCopyInto(out *StageConfig) {
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.MaxFailedClusters != nil {
+		in, out := &in.MaxFailedClusters, &out.MaxFailedClusters
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.ClusterApprovalSelector != nil {
+		in, out := &in.ClusterApprovalSelector, &out.ClusterApprovalSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AfterStageTasks != nil {
 		in, out := &in.AfterStageTasks, &out.AfterStageTasks
 		*out = make([]StageTask, len(*in))
@@ -2718,6 +3468,11 @@ func (in *StageConfig) DeepCopyInto(out *StageConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindowPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageConfig.
@@ -2738,6 +3493,21 @@ func (in *StageTask) DeepCopyInto(out *StageTask) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(AnalysisConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VerificationJob != nil {
+		in, out := &in.VerificationJob, &out.VerificationJob
+		*out = new(VerificationJobConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageTask.
@@ -2753,6 +3523,21 @@ func (in *StageTask) DeepCopy() *StageTask {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StageTaskStatus) DeepCopyInto(out *StageTaskStatus) {
 	*out = *in
+	if in.AnalysisStatus != nil {
+		in, out := &in.AnalysisStatus, &out.AnalysisStatus
+		*out = new(AnalysisStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WebhookStatus != nil {
+		in, out := &in.WebhookStatus, &out.WebhookStatus
+		*out = new(WebhookStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VerificationJobStatus != nil {
+		in, out := &in.VerificationJobStatus, &out.VerificationJobStatus
+		*out = new(VerificationJobStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -2782,6 +3567,11 @@ func (in *StageUpdatingStatus) DeepCopyInto(out *StageUpdatingStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FailedClusters != nil {
+		in, out := &in.FailedClusters, &out.FailedClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.AfterStageTaskStatus != nil {
 		in, out := &in.AfterStageTaskStatus, &out.AfterStageTaskStatus
 		*out = make([]StageTaskStatus, len(*in))
@@ -2828,7 +3618,7 @@ func (in *StagedUpdateRun) DeepCopyInto(out *StagedUpdateRun) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -2943,6 +3733,11 @@ func (in *StagedUpdateStrategyList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Toleration) DeepCopyInto(out *Toleration) {
 	*out = *in
+	if in.TolerationSeconds != nil {
+		in, out := &in.TolerationSeconds, &out.TolerationSeconds
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Toleration.
@@ -2978,6 +3773,11 @@ func (in *TopologySpreadConstraint) DeepCopy() *TopologySpreadConstraint {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpdateRunSpec) DeepCopyInto(out *UpdateRunSpec) {
 	*out = *in
+	if in.RollbackPolicy != nil {
+		in, out := &in.RollbackPolicy, &out.RollbackPolicy
+		*out = new(RollbackPolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateRunSpec.
@@ -3044,6 +3844,11 @@ func (in *UpdateStrategySpec) DeepCopyInto(out *UpdateStrategySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RollbackPolicy != nil {
+		in, out := &in.RollbackPolicy, &out.RollbackPolicy
+		*out = new(RollbackPolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateStrategySpec.
@@ -3056,6 +3861,172 @@ func (in *UpdateStrategySpec) DeepCopy() *UpdateStrategySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerificationJobConfig) DeepCopyInto(out *VerificationJobConfig) {
+	*out = *in
+	out.JobTemplateRef = in.JobTemplateRef
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationJobConfig.
+func (in *VerificationJobConfig) DeepCopy() *VerificationJobConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VerificationJobConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerificationJobStatus) DeepCopyInto(out *VerificationJobStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ClusterVerificationJobStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationJobStatus.
+func (in *VerificationJobStatus) DeepCopy() *VerificationJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VerificationJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerificationJobTemplateReference) DeepCopyInto(out *VerificationJobTemplateReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationJobTemplateReference.
+func (in *VerificationJobTemplateReference) DeepCopy() *VerificationJobTemplateReference {
+	if in == nil {
+		return nil
+	}
+	out := new(VerificationJobTemplateReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookCall) DeepCopyInto(out *WebhookCall) {
+	*out = *in
+	in.CalledAt.DeepCopyInto(&out.CalledAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookCall.
+func (in *WebhookCall) DeepCopy() *WebhookCall {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookCall)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookConfig) DeepCopyInto(out *WebhookConfig) {
+	*out = *in
+	if in.HeadersSecretRef != nil {
+		in, out := &in.HeadersSecretRef, &out.HeadersSecretRef
+		*out = new(WebhookSecretReference)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(WebhookRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookConfig.
+func (in *WebhookConfig) DeepCopy() *WebhookConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookRetryPolicy) DeepCopyInto(out *WebhookRetryPolicy) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookRetryPolicy.
+func (in *WebhookRetryPolicy) DeepCopy() *WebhookRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookSecretReference) DeepCopyInto(out *WebhookSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookSecretReference.
+func (in *WebhookSecretReference) DeepCopy() *WebhookSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookStatus) DeepCopyInto(out *WebhookStatus) {
+	*out = *in
+	if in.NextAttemptTime != nil {
+		in, out := &in.NextAttemptTime, &out.NextAttemptTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Calls != nil {
+		in, out := &in.Calls, &out.Calls
+		*out = make([]WebhookCall, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookStatus.
+func (in *WebhookStatus) DeepCopy() *WebhookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Work) DeepCopyInto(out *Work) {
 	*out = *in
@@ -3185,6 +4156,51 @@ func (in *WorkStatus) DeepCopy() *WorkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadResourceSummary) DeepCopyInto(out *WorkloadResourceSummary) {
+	*out = *in
+	in.ResourceIdentifier.DeepCopyInto(&out.ResourceIdentifier)
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ClusterWorkloadSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadResourceSummary.
+func (in *WorkloadResourceSummary) DeepCopy() *WorkloadResourceSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadResourceSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadSummary) DeepCopyInto(out *WorkloadSummary) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]WorkloadResourceSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadSummary.
+func (in *WorkloadSummary) DeepCopy() *WorkloadSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadTemplate) DeepCopyInto(out *WorkloadTemplate) {
 	*out = *in
@@ -50,6 +50,7 @@ import (
 	"github.com/kubefleet-dev/kubefleet/cmd/hubagent/workload"
 	"github.com/kubefleet-dev/kubefleet/pkg/admissionpolicymanager"
 	mcv1beta1 "github.com/kubefleet-dev/kubefleet/pkg/controllers/membercluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/hubtransport"
 	readiness "github.com/kubefleet-dev/kubefleet/pkg/utils/informer/readiness"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/validator"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook"
@@ -167,10 +168,12 @@ func main() {
 	if opts.FeatureFlags.EnableV1Beta1APIs {
 		klog.Info("Setting up memberCluster v1beta1 controller")
 		if err = (&mcv1beta1.Reconciler{
-			Client:                  mgr.GetClient(),
-			NetworkingAgentsEnabled: opts.ClusterMgmtOpts.NetworkingAgentsEnabled,
-			MaxConcurrentReconciles: int(math.Ceil(float64(opts.PlacementMgmtOpts.MaxFleetSize) / 100)), //one member cluster reconciler routine per 100 member clusters
-			ForceDeleteWaitTime:     opts.ClusterMgmtOpts.ForceDeleteWaitTime.Duration,
+			Client:                    mgr.GetClient(),
+			NetworkingAgentsEnabled:   opts.ClusterMgmtOpts.NetworkingAgentsEnabled,
+			MaxConcurrentReconciles:   int(math.Ceil(float64(opts.PlacementMgmtOpts.MaxFleetSize) / 100)), //one member cluster reconciler routine per 100 member clusters
+			ForceDeleteWaitTime:       opts.ClusterMgmtOpts.ForceDeleteWaitTime.Duration,
+			PropertyHistoryWindow:     opts.ClusterMgmtOpts.PropertyHistoryWindow.Duration,
+			PropertyHistoryMaxSamples: opts.ClusterMgmtOpts.PropertyHistoryMaxSamples,
 		}).SetupWithManager(mgr, "membercluster-controller"); err != nil {
 			klog.ErrorS(err, "unable to create v1beta1 controller", "controller", "MemberCluster")
 			exitWithErrorFunc()
@@ -267,6 +270,30 @@ func main() {
 		exitWithErrorFunc()
 	}
 
+	if opts.HubTransportGatewayOpts.EnableGateway {
+		// Set up the hub transport gateway, which streams objects to and accepts writes from the
+		// member agents that reach the hub cluster via the gRPC hub transport.
+		tlsConfig, err := hubtransport.NewServerTLSConfig(
+			opts.HubTransportGatewayOpts.CertFile,
+			opts.HubTransportGatewayOpts.KeyFile,
+			opts.HubTransportGatewayOpts.ClientCAFile,
+		)
+		if err != nil {
+			klog.ErrorS(err, "unable to set up TLS for the hub transport gateway")
+			exitWithErrorFunc()
+		}
+		gateway := hubtransport.NewGateway(
+			mgr.GetClient(),
+			opts.HubTransportGatewayOpts.ListenAddress,
+			tlsConfig,
+			opts.HubTransportGatewayOpts.ResyncInterval.Duration,
+		)
+		if err := mgr.Add(gateway); err != nil {
+			klog.ErrorS(err, "unable to set up the hub transport gateway")
+			exitWithErrorFunc()
+		}
+	}
+
 	// Add readiness check for dynamic informer cache AFTER controllers are set up.
 	// This ensures the discovery cache is populated before the hub agent is marked ready,
 	// which is critical for all controllers that rely on dynamic resource discovery.
@@ -19,6 +19,7 @@ package options
 import (
 	"flag"
 	"fmt"
+	"strconv"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -38,6 +39,27 @@ type ClusterManagementOptions struct {
 	// The duration the KubeFleet hub agent will wait before force-deleting a member cluster resource after it has been
 	// marked for deletion.
 	ForceDeleteWaitTime metav1.Duration
+
+	// The duration the member agent of a member cluster must have stopped sending heartbeats before the KubeFleet hub agent
+	// fails the PickN placements on the cluster over to other clusters, for placements that do not specify a failover policy.
+	// Zero disables failover for such placements.
+	ClusterFailoverAfter metav1.Duration
+
+	// The maximum number of member clusters the KubeFleet hub agent fails over at the same time.
+	MaxConcurrentClusterFailovers int
+
+	// The duration a failed-over member cluster must keep sending heartbeats before the KubeFleet hub agent picks it for
+	// placements again.
+	ClusterFailoverRecoveryPeriod metav1.Duration
+
+	// The window over which the KubeFleet hub agent keeps the history of the properties of each member cluster, and
+	// over which it exposes aggregates (min, max, p50, and p95) of the properties as additional properties.
+	// Zero disables the property history.
+	PropertyHistoryWindow metav1.Duration
+
+	// The maximum number of samples the KubeFleet hub agent keeps in the property history for each property; the
+	// samples are spread evenly over the window, i.e., at most one sample is kept every window/max-samples.
+	PropertyHistoryMaxSamples int
 }
 
 // AddFlags adds flags for ClusterManagementOptions to the specified FlagSet.
@@ -60,6 +82,36 @@ func (o *ClusterManagementOptions) AddFlags(flags *flag.FlagSet) {
 		"force-delete-wait-time",
 		"The duration the KubeFleet hub agent will wait before force-deleting a member cluster resource after it has been marked for deletion. Defaults to 15 minutes. Must be a duration in the range [30s, 1h].",
 	)
+
+	flags.Var(
+		newClusterFailoverAfterValueWithValidation(0, &o.ClusterFailoverAfter),
+		"cluster-failover-after",
+		"The duration the member agent of a member cluster must have stopped sending heartbeats before the KubeFleet hub agent fails the PickN placements on the cluster over to other clusters, for placements that do not specify a failover policy. Defaults to 0, which disables failover for such placements. Must be 0 or a duration in the range [1m, 24h].",
+	)
+
+	flags.Var(
+		newMaxConcurrentClusterFailoversValueWithValidation(3, &o.MaxConcurrentClusterFailovers),
+		"max-concurrent-cluster-failovers",
+		"The maximum number of member clusters the KubeFleet hub agent fails over at the same time. Defaults to 3. Must be in the range [1, 100].",
+	)
+
+	flags.Var(
+		newClusterFailoverRecoveryPeriodValueWithValidation(5*time.Minute, &o.ClusterFailoverRecoveryPeriod),
+		"cluster-failover-recovery-period",
+		"The duration a failed-over member cluster must keep sending heartbeats before the KubeFleet hub agent picks it for placements again. Defaults to 5 minutes. Must be a duration in the range [30s, 24h].",
+	)
+
+	flags.Var(
+		newPropertyHistoryWindowValueWithValidation(0, &o.PropertyHistoryWindow),
+		"property-history-window",
+		"The window over which the KubeFleet hub agent keeps the history of the properties of each member cluster, and over which it exposes aggregates (min, max, p50, and p95) of the properties as additional properties, e.g., history.kubernetes-fleet.io/p95/resources.kubernetes-fleet.io/available-cpu. Defaults to 0, which disables the property history. Must be 0 or a duration in the range [5m, 24h].",
+	)
+
+	flags.Var(
+		newPropertyHistoryMaxSamplesValueWithValidation(100, &o.PropertyHistoryMaxSamples),
+		"property-history-max-samples",
+		"The maximum number of samples the KubeFleet hub agent keeps in the property history for each property; the samples are spread evenly over the window, i.e., at most one sample is kept every window/max-samples, and the oldest samples are dropped first. Fewer samples are kept for each property when a member cluster reports many properties, so that the property history stays small. Defaults to 100. Must be in the range [10, 1000].",
+	)
 }
 
 // A list of flag variables that allow pluggable validation logic when parsing the input args.
@@ -109,3 +161,118 @@ func newForceDeleteWaitTimeValueWithValidation(defaultVal time.Duration, p *meta
 	p.Duration = defaultVal
 	return (*ForceDeleteWaitTimeValueWithValidation)(p)
 }
+
+type ClusterFailoverAfterValueWithValidation metav1.Duration
+
+func (v *ClusterFailoverAfterValueWithValidation) String() string {
+	return v.Duration.String()
+}
+
+func (v *ClusterFailoverAfterValueWithValidation) Set(s string) error {
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse duration: %w", err)
+	}
+	if duration != 0 && (duration < time.Minute || duration > 24*time.Hour) {
+		return fmt.Errorf("duration must be 0 or in the range [1m, 24h]")
+	}
+	v.Duration = duration
+	return nil
+}
+
+func newClusterFailoverAfterValueWithValidation(defaultVal time.Duration, p *metav1.Duration) *ClusterFailoverAfterValueWithValidation {
+	p.Duration = defaultVal
+	return (*ClusterFailoverAfterValueWithValidation)(p)
+}
+
+type MaxConcurrentClusterFailoversValueWithValidation int
+
+func (v *MaxConcurrentClusterFailoversValueWithValidation) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *MaxConcurrentClusterFailoversValueWithValidation) Set(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse int value: %w", err)
+	}
+	if n < 1 || n > 100 {
+		return fmt.Errorf("number of max concurrent cluster failovers must be in the range [1, 100]")
+	}
+	*v = MaxConcurrentClusterFailoversValueWithValidation(n)
+	return nil
+}
+
+func newMaxConcurrentClusterFailoversValueWithValidation(defaultVal int, p *int) *MaxConcurrentClusterFailoversValueWithValidation {
+	*p = defaultVal
+	return (*MaxConcurrentClusterFailoversValueWithValidation)(p)
+}
+
+type ClusterFailoverRecoveryPeriodValueWithValidation metav1.Duration
+
+func (v *ClusterFailoverRecoveryPeriodValueWithValidation) String() string {
+	return v.Duration.String()
+}
+
+func (v *ClusterFailoverRecoveryPeriodValueWithValidation) Set(s string) error {
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse duration: %w", err)
+	}
+	if duration < 30*time.Second || duration > 24*time.Hour {
+		return fmt.Errorf("duration must be in the range [30s, 24h]")
+	}
+	v.Duration = duration
+	return nil
+}
+
+func newClusterFailoverRecoveryPeriodValueWithValidation(defaultVal time.Duration, p *metav1.Duration) *ClusterFailoverRecoveryPeriodValueWithValidation {
+	p.Duration = defaultVal
+	return (*ClusterFailoverRecoveryPeriodValueWithValidation)(p)
+}
+
+type PropertyHistoryWindowValueWithValidation metav1.Duration
+
+func (v *PropertyHistoryWindowValueWithValidation) String() string {
+	return v.Duration.String()
+}
+
+func (v *PropertyHistoryWindowValueWithValidation) Set(s string) error {
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse duration: %w", err)
+	}
+	if duration != 0 && (duration < 5*time.Minute || duration > 24*time.Hour) {
+		return fmt.Errorf("duration must be 0 or in the range [5m, 24h]")
+	}
+	v.Duration = duration
+	return nil
+}
+
+func newPropertyHistoryWindowValueWithValidation(defaultVal time.Duration, p *metav1.Duration) *PropertyHistoryWindowValueWithValidation {
+	p.Duration = defaultVal
+	return (*PropertyHistoryWindowValueWithValidation)(p)
+}
+
+type PropertyHistoryMaxSamplesValueWithValidation int
+
+func (v *PropertyHistoryMaxSamplesValueWithValidation) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *PropertyHistoryMaxSamplesValueWithValidation) Set(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse int value: %w", err)
+	}
+	if n < 10 || n > 1000 {
+		return fmt.Errorf("number of max property history samples must be in the range [10, 1000]")
+	}
+	*v = PropertyHistoryMaxSamplesValueWithValidation(n)
+	return nil
+}
+
+func newPropertyHistoryMaxSamplesValueWithValidation(defaultVal int, p *int) *PropertyHistoryMaxSamplesValueWithValidation {
+	*p = defaultVal
+	return (*PropertyHistoryMaxSamplesValueWithValidation)(p)
+}
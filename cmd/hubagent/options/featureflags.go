@@ -51,6 +51,12 @@ type FeatureFlags struct {
 	// ResourcePlacement APIs are a set of KubeFleet APIs for processing namespace scoped resource placements.
 	// This flag does not concern the cluster-scoped placement APIs (`ClusterResourcePlacement` and its related APIs).
 	EnableResourcePlacementAPIs bool
+
+	// Enable the workload summary API support in the KubeFleet hub agent or not.
+	//
+	// Workload summary APIs (`ClusterResourcePlacementWorkloadSummary` and `ResourcePlacementWorkloadSummary`)
+	// aggregate the statuses back-reported from member clusters into a fleet-wide view of workload readiness.
+	EnableWorkloadSummaryAPIs bool
 }
 
 // AddFlags adds flags for FeatureFlags to the specified FlagSet.
@@ -88,6 +94,13 @@ func (o *FeatureFlags) AddFlags(flags *flag.FlagSet) {
 		true,
 		"Enable the ResourcePlacement API support (for namespace-scoped placements) in the KubeFleet hub agent or not.",
 	)
+
+	flags.BoolVar(
+		&o.EnableWorkloadSummaryAPIs,
+		"enable-workload-summary-apis",
+		false,
+		"Enable the workload summary API support (aggregated workload readiness across member clusters) in the KubeFleet hub agent or not.",
+	)
 }
 
 // A list of flag variables that allow pluggable validation logic when parsing the input args.
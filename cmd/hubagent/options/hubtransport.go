@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HubTransportGatewayOptions is a set of options the KubeFleet hub agent exposes for the hub
+// transport gateway, which member agents can reach via a single outbound gRPC stream instead of
+// talking to the hub cluster's API server directly.
+type HubTransportGatewayOptions struct {
+	// Enable the hub transport gateway or not.
+	EnableGateway bool
+
+	// The address the hub transport gateway listens on.
+	ListenAddress string
+
+	// The path to the certificate file the hub transport gateway serves with. This option
+	// applies only if the gateway is enabled.
+	CertFile string
+
+	// The path to the private key file the hub transport gateway serves with. This option
+	// applies only if the gateway is enabled.
+	KeyFile string
+
+	// The path to the CA file the hub transport gateway uses for verifying the client certificates
+	// of member agents. The common name of a client certificate is the name of the member cluster.
+	// This option applies only if the gateway is enabled.
+	ClientCAFile string
+
+	// The interval at which the hub transport gateway re-sends the objects of a member cluster to
+	// its member agent.
+	ResyncInterval metav1.Duration
+}
+
+// AddFlags adds flags for HubTransportGatewayOptions to the specified FlagSet.
+func (o *HubTransportGatewayOptions) AddFlags(flags *flag.FlagSet) {
+	flags.BoolVar(
+		&o.EnableGateway,
+		"enable-hub-transport-gateway",
+		false,
+		"Enable the hub transport gateway or not, which member agents can reach via a single outbound gRPC stream instead of talking to the hub cluster's API server directly.",
+	)
+
+	flags.StringVar(
+		&o.ListenAddress,
+		"hub-transport-gateway-listen-address",
+		":8090",
+		"The address the hub transport gateway listens on.",
+	)
+
+	flags.StringVar(
+		&o.CertFile,
+		"hub-transport-gateway-cert-file",
+		"",
+		"The path to the certificate file the hub transport gateway serves with.",
+	)
+
+	flags.StringVar(
+		&o.KeyFile,
+		"hub-transport-gateway-key-file",
+		"",
+		"The path to the private key file the hub transport gateway serves with.",
+	)
+
+	flags.StringVar(
+		&o.ClientCAFile,
+		"hub-transport-gateway-client-ca-file",
+		"",
+		"The path to the CA file the hub transport gateway uses for verifying the client certificates of member agents.",
+	)
+
+	flags.Var(
+		newHubTransportGatewayResyncIntervalValueWithValidation(30*time.Second, &o.ResyncInterval),
+		"hub-transport-gateway-resync-interval",
+		"The interval at which the hub transport gateway re-sends the objects of a member cluster to its member agent. Defaults to 30 seconds. Must be a duration in the range [1s, 10m].",
+	)
+}
+
+type HubTransportGatewayResyncIntervalValueWithValidation metav1.Duration
+
+func (v *HubTransportGatewayResyncIntervalValueWithValidation) String() string {
+	return v.Duration.String()
+}
+
+func (v *HubTransportGatewayResyncIntervalValueWithValidation) Set(s string) error {
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse duration: %w", err)
+	}
+	if duration < time.Second || duration > 10*time.Minute {
+		return fmt.Errorf("duration must be in the range [1s, 10m]")
+	}
+	v.Duration = duration
+	return nil
+}
+
+func newHubTransportGatewayResyncIntervalValueWithValidation(defaultVal time.Duration, p *metav1.Duration) *HubTransportGatewayResyncIntervalValueWithValidation {
+	p.Duration = defaultVal
+	return (*HubTransportGatewayResyncIntervalValueWithValidation)(p)
+}
@@ -39,6 +39,9 @@ type Options struct {
 
 	// Options that fine-tune how KubeFleet hub agent manages resources placements in the fleet.
 	PlacementMgmtOpts PlacementManagementOptions
+
+	// Options for the hub transport gateway, which member agents can reach via a single outbound gRPC stream.
+	HubTransportGatewayOpts HubTransportGatewayOptions
 }
 
 func NewOptions() *Options {
@@ -52,4 +55,5 @@ func (o *Options) AddFlags(flags *flag.FlagSet) {
 	o.FeatureFlags.AddFlags(flags)
 	o.ClusterMgmtOpts.AddFlags(flags)
 	o.PlacementMgmtOpts.AddFlags(flags)
+	o.HubTransportGatewayOpts.AddFlags(flags)
 }
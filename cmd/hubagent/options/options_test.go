@@ -345,6 +345,7 @@ func TestFeatureFlags(t *testing.T) {
 				"--enable-staged-update-run-apis=false",
 				"--enable-eviction-apis=false",
 				"--enable-resource-placement=false",
+				"--enable-workload-summary-apis=true",
 			},
 			wantFeatureFlags: FeatureFlags{
 				EnableV1Beta1APIs:           true,
@@ -352,6 +353,7 @@ func TestFeatureFlags(t *testing.T) {
 				EnableStagedUpdateRunAPIs:   false,
 				EnableEvictionAPIs:          false,
 				EnableResourcePlacementAPIs: false,
+				EnableWorkloadSummaryAPIs:   true,
 			},
 		},
 		{
@@ -414,9 +416,14 @@ func TestClusterManagementOptions(t *testing.T) {
 			flagSetName: "allDefault",
 			args:        []string{},
 			wantClusterMgmtOpts: ClusterManagementOptions{
-				NetworkingAgentsEnabled: false,
-				UnhealthyThreshold:      metav1.Duration{Duration: 60 * time.Second},
-				ForceDeleteWaitTime:     metav1.Duration{Duration: 15 * time.Minute},
+				NetworkingAgentsEnabled:       false,
+				UnhealthyThreshold:            metav1.Duration{Duration: 60 * time.Second},
+				ForceDeleteWaitTime:           metav1.Duration{Duration: 15 * time.Minute},
+				ClusterFailoverAfter:          metav1.Duration{Duration: 0},
+				MaxConcurrentClusterFailovers: 3,
+				ClusterFailoverRecoveryPeriod: metav1.Duration{Duration: 5 * time.Minute},
+				PropertyHistoryWindow:         metav1.Duration{Duration: 0},
+				PropertyHistoryMaxSamples:     100,
 			},
 		},
 		{
@@ -426,11 +433,21 @@ func TestClusterManagementOptions(t *testing.T) {
 				"--networking-agents-enabled=true",
 				"--cluster-unhealthy-threshold=45s",
 				"--force-delete-wait-time=10m",
+				"--cluster-failover-after=10m",
+				"--max-concurrent-cluster-failovers=5",
+				"--cluster-failover-recovery-period=15m",
+				"--property-history-window=1h",
+				"--property-history-max-samples=60",
 			},
 			wantClusterMgmtOpts: ClusterManagementOptions{
-				NetworkingAgentsEnabled: true,
-				UnhealthyThreshold:      metav1.Duration{Duration: 45 * time.Second},
-				ForceDeleteWaitTime:     metav1.Duration{Duration: 10 * time.Minute},
+				NetworkingAgentsEnabled:       true,
+				UnhealthyThreshold:            metav1.Duration{Duration: 45 * time.Second},
+				ForceDeleteWaitTime:           metav1.Duration{Duration: 10 * time.Minute},
+				ClusterFailoverAfter:          metav1.Duration{Duration: 10 * time.Minute},
+				MaxConcurrentClusterFailovers: 5,
+				ClusterFailoverRecoveryPeriod: metav1.Duration{Duration: 15 * time.Minute},
+				PropertyHistoryWindow:         metav1.Duration{Duration: time.Hour},
+				PropertyHistoryMaxSamples:     60,
 			},
 		},
 		{
@@ -454,6 +471,41 @@ func TestClusterManagementOptions(t *testing.T) {
 			wantErred:        true,
 			wantErrMsgSubStr: "duration must be in the range [30s, 1h]",
 		},
+		{
+			name:             "cluster failover after out of range (too small)",
+			flagSetName:      "clusterFailoverAfterOutOfRangeTooSmall",
+			args:             []string{"--cluster-failover-after=30s"},
+			wantErred:        true,
+			wantErrMsgSubStr: "duration must be 0 or in the range [1m, 24h]",
+		},
+		{
+			name:             "max concurrent cluster failovers out of range",
+			flagSetName:      "maxConcurrentClusterFailoversOutOfRange",
+			args:             []string{"--max-concurrent-cluster-failovers=0"},
+			wantErred:        true,
+			wantErrMsgSubStr: "number of max concurrent cluster failovers must be in the range [1, 100]",
+		},
+		{
+			name:             "cluster failover recovery period out of range (too large)",
+			flagSetName:      "clusterFailoverRecoveryPeriodOutOfRangeTooLarge",
+			args:             []string{"--cluster-failover-recovery-period=25h"},
+			wantErred:        true,
+			wantErrMsgSubStr: "duration must be in the range [30s, 24h]",
+		},
+		{
+			name:             "property history window out of range (too small)",
+			flagSetName:      "propertyHistoryWindowOutOfRangeTooSmall",
+			args:             []string{"--property-history-window=1m"},
+			wantErred:        true,
+			wantErrMsgSubStr: "duration must be 0 or in the range [5m, 24h]",
+		},
+		{
+			name:             "property history max samples out of range",
+			flagSetName:      "propertyHistoryMaxSamplesOutOfRange",
+			args:             []string{"--property-history-max-samples=1001"},
+			wantErred:        true,
+			wantErrMsgSubStr: "number of max property history samples must be in the range [10, 1000]",
+		},
 		{
 			name:             "force delete wait time parse error",
 			flagSetName:      "forceDeleteWaitTimeParseError",
@@ -989,3 +1041,95 @@ func TestRateLimitOptions(t *testing.T) {
 		})
 	}
 }
+
+// TestHubTransportGatewayOptions tests the parsing and validation logic of the hub transport gateway options defined in HubTransportGatewayOptions.
+func TestHubTransportGatewayOptions(t *testing.T) {
+	testCases := []struct {
+		name             string
+		flagSetName      string
+		args             []string
+		wantGatewayOpts  HubTransportGatewayOptions
+		wantErred        bool
+		wantErrMsgSubStr string
+	}{
+		{
+			name:        "all default",
+			flagSetName: "allDefault",
+			args:        []string{},
+			wantGatewayOpts: HubTransportGatewayOptions{
+				EnableGateway:  false,
+				ListenAddress:  ":8090",
+				ResyncInterval: metav1.Duration{Duration: 30 * time.Second},
+			},
+		},
+		{
+			name:        "all specified",
+			flagSetName: "allSpecified",
+			args: []string{
+				"--enable-hub-transport-gateway=true",
+				"--hub-transport-gateway-listen-address=:9090",
+				"--hub-transport-gateway-cert-file=/etc/gateway/tls.crt",
+				"--hub-transport-gateway-key-file=/etc/gateway/tls.key",
+				"--hub-transport-gateway-client-ca-file=/etc/gateway/ca.crt",
+				"--hub-transport-gateway-resync-interval=1m",
+			},
+			wantGatewayOpts: HubTransportGatewayOptions{
+				EnableGateway:  true,
+				ListenAddress:  ":9090",
+				CertFile:       "/etc/gateway/tls.crt",
+				KeyFile:        "/etc/gateway/tls.key",
+				ClientCAFile:   "/etc/gateway/ca.crt",
+				ResyncInterval: metav1.Duration{Duration: time.Minute},
+			},
+		},
+		{
+			name:             "resync interval parse error",
+			flagSetName:      "resyncIntervalParseError",
+			args:             []string{"--hub-transport-gateway-resync-interval=abc"},
+			wantErred:        true,
+			wantErrMsgSubStr: "failed to parse duration",
+		},
+		{
+			name:             "resync interval out of range (too small)",
+			flagSetName:      "resyncIntervalOutOfRangeTooSmall",
+			args:             []string{"--hub-transport-gateway-resync-interval=500ms"},
+			wantErred:        true,
+			wantErrMsgSubStr: "duration must be in the range [1s, 10m]",
+		},
+		{
+			name:             "resync interval out of range (too large)",
+			flagSetName:      "resyncIntervalOutOfRangeTooLarge",
+			args:             []string{"--hub-transport-gateway-resync-interval=11m"},
+			wantErred:        true,
+			wantErrMsgSubStr: "duration must be in the range [1s, 10m]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			flags := flag.NewFlagSet(tc.flagSetName, flag.ContinueOnError)
+			gatewayOpts := HubTransportGatewayOptions{}
+			gatewayOpts.AddFlags(flags)
+
+			err := flags.Parse(tc.args)
+			if tc.wantErred {
+				if err == nil {
+					t.Fatalf("flag Parse() = nil, want erred")
+				}
+
+				if !strings.Contains(err.Error(), tc.wantErrMsgSubStr) {
+					t.Fatalf("flag Parse() error = %v, want error msg with sub-string %s", err, tc.wantErrMsgSubStr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("flag Parse() = %v, want nil", err)
+			}
+
+			if diff := cmp.Diff(gatewayOpts, tc.wantGatewayOpts); diff != "" {
+				t.Errorf("hub transport gateway options diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
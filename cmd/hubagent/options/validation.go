@@ -58,6 +58,16 @@ func (o *Options) Validate() field.ErrorList {
 		errs = append(errs, field.Invalid(newPath.Child("UseCertManager"), o.WebhookAndAdmissionPolicyOpts.UseCertManager, "If cert manager is used for securing webhook connections, the EnableWorkload option must be set to true, so that cert manager pods can run in the hub cluster."))
 	}
 
+	// Cross-field validation for hub transport gateway options.
+	if o.HubTransportGatewayOpts.EnableGateway {
+		if o.HubTransportGatewayOpts.CertFile == "" || o.HubTransportGatewayOpts.KeyFile == "" {
+			errs = append(errs, field.Required(newPath.Child("HubTransportGatewayOpts").Child("CertFile"), "The certificate and key files must be specified when the hub transport gateway is enabled"))
+		}
+		if o.HubTransportGatewayOpts.ClientCAFile == "" {
+			errs = append(errs, field.Required(newPath.Child("HubTransportGatewayOpts").Child("ClientCAFile"), "The client CA file must be specified when the hub transport gateway is enabled"))
+		}
+	}
+
 	if o.PlacementMgmtOpts.AllowedPropagatingAPIs != "" && o.PlacementMgmtOpts.SkippedPropagatingAPIs != "" {
 		errs = append(errs, field.Invalid(newPath.Child("AllowedPropagatingAPIs"), o.PlacementMgmtOpts.AllowedPropagatingAPIs, "AllowedPropagatingAPIs and SkippedPropagatingAPIs options are mutually exclusive"))
 	}
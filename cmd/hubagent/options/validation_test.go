@@ -139,6 +139,24 @@ func TestValidation(t *testing.T) {
 			}),
 			want: field.ErrorList{field.Invalid(newPath.Child("PlacementControllerWorkQueueRateLimiterOpts").Child("RateLimiterQPS"), 100, "the QPS for the placement controller set rate limiter must be less than its bucket size")},
 		},
+		"hub transport gateway enabled without TLS files": {
+			opt: newTestOptions(func(option *Options) {
+				option.HubTransportGatewayOpts.EnableGateway = true
+			}),
+			want: field.ErrorList{
+				field.Required(newPath.Child("HubTransportGatewayOpts").Child("CertFile"), "The certificate and key files must be specified when the hub transport gateway is enabled"),
+				field.Required(newPath.Child("HubTransportGatewayOpts").Child("ClientCAFile"), "The client CA file must be specified when the hub transport gateway is enabled"),
+			},
+		},
+		"hub transport gateway enabled with TLS files": {
+			opt: newTestOptions(func(option *Options) {
+				option.HubTransportGatewayOpts.EnableGateway = true
+				option.HubTransportGatewayOpts.CertFile = "/etc/gateway/tls.crt"
+				option.HubTransportGatewayOpts.KeyFile = "/etc/gateway/tls.key"
+				option.HubTransportGatewayOpts.ClientCAFile = "/etc/gateway/ca.crt"
+			}),
+			want: field.ErrorList{},
+		},
 	}
 
 	for name, tc := range testCases {
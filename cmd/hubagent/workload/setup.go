@@ -33,18 +33,24 @@ import (
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/cmd/hubagent/options"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/autoupdaterun"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/bindingwatcher"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/clusterfailover"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/clusterinventory/clusterprofile"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/clustermaintenance"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/clusterresourceplacementeviction"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/clusterresourceplacementstatuswatcher"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/fleetupdaterun"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/overrider"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/placement"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/placementwatcher"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/resourcechange"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/rollout"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/schedulingpolicysnapshot"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/tainteviction"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/updaterun"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/workgenerator"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/workloadsummary"
 	"github.com/kubefleet-dev/kubefleet/pkg/resourcewatcher"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
@@ -100,6 +106,7 @@ var (
 		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterStagedUpdateRunKind),
 		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterStagedUpdateStrategyKind),
 		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterApprovalRequestKind),
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterFleetUpdateRunKind),
 	}
 
 	stagedUpdateRunGVKs = []schema.GroupVersionKind{
@@ -116,6 +123,14 @@ var (
 		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterResourcePlacementEvictionKind),
 		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterResourcePlacementDisruptionBudgetKind),
 	}
+
+	clusterWorkloadSummaryGVKs = []schema.GroupVersionKind{
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterResourcePlacementWorkloadSummaryKind),
+	}
+
+	workloadSummaryGVKs = []schema.GroupVersionKind{
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ResourcePlacementWorkloadSummaryKind),
+	}
 )
 
 // SetupControllers set up the customized controllers we developed
@@ -298,6 +313,57 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 				klog.ErrorS(err, "Unable to set up cluster resource placement eviction controller")
 				return err
 			}
+
+			klog.Info("Setting up taint eviction controller")
+			if err := (&tainteviction.Reconciler{
+				Client: mgr.GetClient(),
+			}).SetupWithManager(mgr); err != nil {
+				klog.ErrorS(err, "Unable to set up taint eviction controller")
+				return err
+			}
+
+			klog.Info("Setting up cluster maintenance controller")
+			if err := (&clustermaintenance.Reconciler{
+				Client: mgr.GetClient(),
+			}).SetupWithManager(mgr); err != nil {
+				klog.ErrorS(err, "Unable to set up cluster maintenance controller")
+				return err
+			}
+		}
+
+		// Set up a controller to aggregate the back-reported workload statuses into workload summaries.
+		if opts.FeatureFlags.EnableWorkloadSummaryAPIs {
+			for _, gvk := range clusterWorkloadSummaryGVKs {
+				if err = utils.CheckCRDInstalled(discoverClient, gvk); err != nil {
+					klog.ErrorS(err, "Unable to find the required CRD", "GVK", gvk)
+					return err
+				}
+			}
+			klog.Info("Setting up clusterResourcePlacement workload summary controller")
+			if err := (&workloadsummary.Reconciler{
+				Client: mgr.GetClient(),
+				Scheme: mgr.GetScheme(),
+			}).SetupWithManagerForClusterResourcePlacement(mgr); err != nil {
+				klog.ErrorS(err, "Unable to set up clusterResourcePlacement workload summary controller")
+				return err
+			}
+
+			if opts.FeatureFlags.EnableResourcePlacementAPIs {
+				for _, gvk := range workloadSummaryGVKs {
+					if err = utils.CheckCRDInstalled(discoverClient, gvk); err != nil {
+						klog.ErrorS(err, "Unable to find the required CRD", "GVK", gvk)
+						return err
+					}
+				}
+				klog.Info("Setting up resourcePlacement workload summary controller")
+				if err := (&workloadsummary.Reconciler{
+					Client: mgr.GetClient(),
+					Scheme: mgr.GetScheme(),
+				}).SetupWithManagerForResourcePlacement(mgr); err != nil {
+					klog.ErrorS(err, "Unable to set up resourcePlacement workload summary controller")
+					return err
+				}
+			}
 		}
 
 		// Set up a controller to do staged update run, rolling out resources to clusters in a stage by stage manner.
@@ -314,6 +380,7 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 				InformerManager:          dynamicInformerManager,
 				ResourceSelectorResolver: resourceSelectorResolver,
 				ResourceSnapshotResolver: resourceSnapshotResolver,
+				UncachedReader:           mgr.GetAPIReader(),
 			}).SetupWithManagerForClusterStagedUpdateRun(mgr); err != nil {
 				klog.ErrorS(err, "Unable to set up clusterStagedUpdateRun controller")
 				return err
@@ -332,11 +399,43 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 					InformerManager:          dynamicInformerManager,
 					ResourceSelectorResolver: resourceSelectorResolver,
 					ResourceSnapshotResolver: resourceSnapshotResolver,
+					UncachedReader:           mgr.GetAPIReader(),
 				}).SetupWithManagerForStagedUpdateRun(mgr); err != nil {
 					klog.ErrorS(err, "Unable to set up stagedUpdateRun controller")
 					return err
 				}
 			}
+
+			// Set up a controller to update several placements together with a group of update runs.
+			klog.Info("Setting up clusterFleetUpdateRun controller")
+			if err = (&fleetupdaterun.Reconciler{
+				Client: mgr.GetClient(),
+				Scheme: mgr.GetScheme(),
+			}).SetupWithManager(mgr); err != nil {
+				klog.ErrorS(err, "Unable to set up clusterFleetUpdateRun controller")
+				return err
+			}
+
+			// Set up a controller to create the update runs automatically for new resource snapshots.
+			klog.Info("Setting up clusterResourcePlacement auto update run controller")
+			if err = (&autoupdaterun.Reconciler{
+				Client: mgr.GetClient(),
+				Scheme: mgr.GetScheme(),
+			}).SetupWithManagerForClusterResourcePlacement(mgr); err != nil {
+				klog.ErrorS(err, "Unable to set up clusterResourcePlacement auto update run controller")
+				return err
+			}
+
+			if opts.FeatureFlags.EnableResourcePlacementAPIs {
+				klog.Info("Setting up resourcePlacement auto update run controller")
+				if err = (&autoupdaterun.Reconciler{
+					Client: mgr.GetClient(),
+					Scheme: mgr.GetScheme(),
+				}).SetupWithManagerForResourcePlacement(mgr); err != nil {
+					klog.ErrorS(err, "Unable to set up resourcePlacement auto update run controller")
+					return err
+				}
+			}
 		}
 
 		// Set up the work generator
@@ -451,6 +550,19 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 			return err
 		}
 
+		klog.Info("Setting up the cluster failover controller")
+		if err := (&clusterfailover.Reconciler{
+			Client:                  mgr.GetClient(),
+			UnavailableThreshold:    opts.ClusterMgmtOpts.UnhealthyThreshold.Duration,
+			FailoverAfter:           opts.ClusterMgmtOpts.ClusterFailoverAfter.Duration,
+			MaxConcurrentFailovers:  opts.ClusterMgmtOpts.MaxConcurrentClusterFailovers,
+			RecoveryPeriod:          opts.ClusterMgmtOpts.ClusterFailoverRecoveryPeriod.Duration,
+			EnableResourcePlacement: opts.FeatureFlags.EnableResourcePlacementAPIs,
+		}).SetupWithManager(mgr); err != nil {
+			klog.ErrorS(err, "Unable to set up cluster failover controller")
+			return err
+		}
+
 		// Set up the controllers for overriding resources.
 		klog.Info("Setting up the clusterResourceOverride controller")
 		if err := (&overrider.ClusterResourceReconciler{
@@ -25,6 +25,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/textproto"
 	"os"
@@ -34,6 +35,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	utilrand "k8s.io/apimachinery/pkg/util/rand"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -42,9 +44,11 @@ import (
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
@@ -53,17 +57,39 @@ import (
 	"github.com/kubefleet-dev/kubefleet/cmd/memberagent/options"
 	imcv1beta1 "github.com/kubefleet-dev/kubefleet/pkg/controllers/internalmembercluster/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/workapplier"
+	"github.com/kubefleet-dev/kubefleet/pkg/hubtransport"
 	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
 	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/azure"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/composite"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/external"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/latency"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/nodes"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/pricingsheet"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/static"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/httpclient"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/parallelizer"
 	//+kubebuilder:scaffold:imports
 )
 
+const (
+	// hubStartProbeTimeout is the timeout of each attempt to reach the hub cluster before the hub
+	// cluster controller manager starts.
+	hubStartProbeTimeout = 10 * time.Second
+	// hubStartBackoffCap is the maximum delay between the attempts to reach the hub cluster before
+	// the hub cluster controller manager starts.
+	hubStartBackoffCap = time.Minute
+)
+
 const (
 	// The list of available property provider names.
-	azurePropertyProvider = "azure"
+	azurePropertyProvider     = "azure"
+	nodesPropertyProvider     = "nodes"
+	externalPropertyProvider  = "external"
+	compositePropertyProvider = "composite"
+	staticPropertyProvider    = "static"
+	pricingSheetProvider      = "pricingsheet"
+	latencyPropertyProvider   = "latency"
 )
 
 var (
@@ -99,19 +125,25 @@ func main() {
 	// Set up controller-runtime logger
 	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
 
-	hubURL := os.Getenv("HUB_SERVER_URL")
+	// With the gRPC hub transport, the member agent does not talk to the hub cluster's API server
+	// directly, and no Kubernetes client configuration is needed for the hub cluster.
+	var hubConfig *rest.Config
+	if opts.HubConnectivityOpts.HubTransport != options.HubTransportGRPC {
+		hubURL := os.Getenv("HUB_SERVER_URL")
 
-	if hubURL == "" {
-		klog.ErrorS(errors.New("hub server api cannot be empty"), "Failed to read URL for the hub cluster")
-		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
-	}
-	hubConfig, err := buildHubConfig(hubURL, opts.HubConnectivityOpts.UseCertificateAuth, opts.HubConnectivityOpts.UseInsecureTLSClient)
-	if err != nil {
-		klog.ErrorS(err, "Failed to build Kubernetes client configuration for the hub cluster")
-		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+		if hubURL == "" {
+			klog.ErrorS(errors.New("hub server api cannot be empty"), "Failed to read URL for the hub cluster")
+			klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+		}
+		var err error
+		hubConfig, err = buildHubConfig(hubURL, opts.HubConnectivityOpts.UseCertificateAuth, opts.HubConnectivityOpts.UseInsecureTLSClient)
+		if err != nil {
+			klog.ErrorS(err, "Failed to build Kubernetes client configuration for the hub cluster")
+			klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+		}
+		hubConfig.QPS = float32(opts.CtrlManagerOptions.HubManagerOpts.QPS)
+		hubConfig.Burst = opts.CtrlManagerOptions.HubManagerOpts.Burst
 	}
-	hubConfig.QPS = float32(opts.CtrlManagerOptions.HubManagerOpts.QPS)
-	hubConfig.Burst = opts.CtrlManagerOptions.HubManagerOpts.Burst
 
 	mcName := os.Getenv("MEMBER_CLUSTER_NAME")
 	if mcName == "" {
@@ -275,25 +307,137 @@ func buildHubConfig(hubURL string, useCertificateAuth bool, tlsClientInsecure bo
 	return hubConfig, nil
 }
 
-// Start the member controllers with the supplied config
-func Start(ctx context.Context, hubCfg, memberConfig *rest.Config, hubOpts, memberOpts ctrl.Options, globalOpts options.Options) error {
-	hubMgr, err := ctrl.NewManager(hubCfg, hubOpts)
+// buildPropertyProvider builds the property provider of the given name; it returns nil if the
+// name is none or not recognizable.
+func buildPropertyProvider(name string, opts options.PropertyProviderOptions) propertyprovider.PropertyProvider {
+	switch name {
+	case azurePropertyProvider:
+		klog.V(2).Info("setting up the Azure property provider")
+		klog.V(1).InfoS("Property Provider is azure, loading cloud config", "cloudConfigFile", opts.CloudConfigFilePath)
+		// TODO (britaniar): load cloud config for Azure property provider.
+		return azure.New(
+			&opts.Region,
+			opts.EnableAzProviderCostProperties,
+			opts.EnableAzProviderAvailableResourceProperties,
+			opts.EnableAzProviderNamespaceCollection)
+	case nodesPropertyProvider:
+		klog.V(2).Info("setting up the nodes property provider")
+		return nodes.New(opts.NodeLabelsToExport)
+	case externalPropertyProvider:
+		klog.V(2).InfoS("setting up the external property provider", "endpoint", opts.ExternalProviderEndpoint)
+		return external.New(opts.ExternalProviderEndpoint, opts.ExternalProviderTimeout)
+	case staticPropertyProvider:
+		klog.V(2).InfoS("setting up the static property provider", "configMap", opts.StaticPropertiesConfigMap)
+		return static.New(opts.StaticPropertiesConfigMap)
+	case pricingSheetProvider:
+		klog.V(2).InfoS("setting up the pricing sheet property provider", "sheetPath", opts.PricingSheetPath)
+		return pricingsheet.New(opts.PricingSheetPath)
+	case latencyPropertyProvider:
+		klog.V(2).InfoS("setting up the latency property provider", "peers", opts.LatencyProbePeers, "interval", opts.LatencyProbeInterval)
+		peers := make([]latency.Peer, 0, len(opts.LatencyProbePeers))
+		for _, peer := range opts.LatencyProbePeers {
+			peers = append(peers, latency.Peer{Name: peer.Name, Endpoint: peer.Endpoint})
+		}
+		return latency.New(peers, opts.LatencyProbeInterval)
+	default:
+		// Fall back to not using any property provider if the provided type is none or
+		// not recognizable.
+		klog.V(2).Info("no property provider is specified, or the given type is not recognizable; start with no property provider")
+		return nil
+	}
+}
+
+// memberAgentNamespace returns the namespace where the member agent runs, as set by the
+// POD_NAMESPACE environment variable; it defaults to the fleet system namespace.
+func memberAgentNamespace() string {
+	if namespace := os.Getenv("POD_NAMESPACE"); len(namespace) > 0 {
+		return namespace
+	}
+	return utils.FleetSystemNamespace
+}
+
+// buildHubTransportClient builds a client that reaches the hub cluster via the hub transport gateway.
+func buildHubTransportClient(hubConnectivityOpts options.HubConnectivityOptions) (*hubtransport.Client, error) {
+	keyFilePath := os.Getenv("IDENTITY_KEY")
+	certFilePath := os.Getenv("IDENTITY_CERT")
+	if keyFilePath == "" || certFilePath == "" {
+		err := errors.New("identity key and certificate file paths cannot be empty when the grpc hub transport is in use")
+		klog.ErrorS(err, "Failed to retrieve identity key and certificate")
+		return nil, err
+	}
+	tlsConfig, err := hubtransport.NewClientTLSConfig(certFilePath, keyFilePath, os.Getenv("CA_BUNDLE"))
 	if err != nil {
-		return fmt.Errorf("unable to start hub manager: %w", err)
+		klog.ErrorS(err, "Failed to build TLS configuration for the hub transport")
+		return nil, err
 	}
+	return hubtransport.NewClient(
+		hubConnectivityOpts.HubGatewayAddress,
+		tlsConfig,
+		scheme,
+	), nil
+}
+
+// Start the member controllers with the supplied config
+//
+// The hub cluster config is not used (and can be nil) if the gRPC hub transport is in use.
+func Start(ctx context.Context, hubCfg, memberConfig *rest.Config, hubOpts, memberOpts ctrl.Options, globalOpts options.Options) error {
+	// The context is cancelled when the hub cluster controller manager fails, so that the member
+	// cluster controller manager stops as well and the member agent gets restarted.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
 	memberMgr, err := ctrl.NewManager(memberConfig, memberOpts)
 	if err != nil {
 		return fmt.Errorf("unable to start member manager: %w", err)
 	}
 
-	if err := hubMgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
-		klog.ErrorS(err, "Failed to set up health check for hub manager")
-		return err
+	// Set up the access to the hub cluster.
+	//
+	// With the gRPC hub transport, the member agent runs no hub cluster controller manager; the
+	// transport client, which runs with the member cluster controller manager, serves as the hub
+	// cluster client and the source of Work and InternalMemberCluster object events. Events are
+	// recorded in the member cluster, as the transport does not carry them.
+	useHubTransport := globalOpts.HubConnectivityOpts.HubTransport == options.HubTransportGRPC
+	var hubMgr ctrl.Manager
+	var hubClient client.Client
+	var hubDiscoveryClient *discovery.DiscoveryClient
+	var hubTransportClient *hubtransport.Client
+	if useHubTransport {
+		klog.InfoS("Setting up the gRPC hub transport", "gatewayAddress", globalOpts.HubConnectivityOpts.HubGatewayAddress)
+		hubTransportClient, err = buildHubTransportClient(globalOpts.HubConnectivityOpts)
+		if err != nil {
+			return fmt.Errorf("unable to set up the hub transport client: %w", err)
+		}
+		if err := memberMgr.Add(hubTransportClient); err != nil {
+			klog.ErrorS(err, "Failed to add the hub transport client to the member manager")
+			return err
+		}
+		hubClient = hubTransportClient
+	} else {
+		hubMgr, err = ctrl.NewManager(hubCfg, hubOpts)
+		if err != nil {
+			return fmt.Errorf("unable to start hub manager: %w", err)
+		}
+
+		if err := hubMgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
+			klog.ErrorS(err, "Failed to set up health check for hub manager")
+			return err
+		}
+		if err := hubMgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
+			klog.ErrorS(err, "Failed to set up ready check for hub manager")
+			return err
+		}
+		hubClient = hubMgr.GetClient()
+
+		hubDiscoveryClient, err = discovery.NewDiscoveryClientForConfig(hubCfg)
+		if err != nil {
+			klog.ErrorS(err, "Failed to create hub discovery client")
+			return err
+		}
 	}
-	if err := hubMgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
-		klog.ErrorS(err, "Failed to set up ready check for hub manager")
-		return err
+	eventRecorderMgr := memberMgr
+	if hubMgr != nil {
+		eventRecorderMgr = hubMgr
 	}
 
 	if err := memberMgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -382,12 +526,16 @@ func Start(ctx context.Context, hubCfg, memberConfig *rest.Config, hubOpts, memb
 
 	workApplier := workapplier.NewReconciler(
 		"work-applier",
-		hubMgr.GetClient(),
+		hubClient,
 		targetNS,
 		spokeDynamicClient,
 		memberMgr.GetClient(),
 		restMapper,
-		hubMgr.GetEventRecorderFor("work_applier"),
+		// The member cluster config is used to build clients that impersonate the
+		// identities requested in apply strategies; the service accounts in the namespace
+		// of the member agent are never impersonated.
+		memberConfig, memberAgentNamespace(),
+		eventRecorderMgr.GetEventRecorderFor("work_applier"),
 		// The number of concurrent reconcilations. This is set to 5 to boost performance in
 		// resource processing.
 		5,
@@ -400,37 +548,79 @@ func Start(ctx context.Context, hubCfg, memberConfig *rest.Config, hubOpts, memb
 		&globalOpts.ApplierOpts.PriorityLinearEquationCoEffB,
 	)
 
-	if err = workApplier.SetupWithManager(hubMgr); err != nil {
+	if useHubTransport {
+		err = workApplier.SetupWithManagerAndEventSource(memberMgr, hubTransportClient.EventsFor(hubtransport.ObjectKindWork))
+	} else {
+		err = workApplier.SetupWithManager(hubMgr)
+	}
+	if err != nil {
 		klog.ErrorS(err, "Failed to create v1beta1 controller", "controller", "work")
 		return err
 	}
 
+	if globalOpts.OfflineOpts.EnableOfflineOperation {
+		// Set up the offline work enforcer, which keeps enforcing the locally cached Work objects
+		// when the hub cluster is not reachable. Note that it runs with the member cluster
+		// controller manager, as the hub cluster controller manager cannot function when the
+		// hub cluster is not reachable.
+		klog.InfoS("Setting up offline operation for the work applier", "workCacheDir", globalOpts.OfflineOpts.WorkCacheDir)
+		workCache, err := workapplier.NewLocalWorkCache(globalOpts.OfflineOpts.WorkCacheDir)
+		if err != nil {
+			klog.ErrorS(err, "Failed to set up the local work cache")
+			return err
+		}
+		hubProbeInterval := time.Second * time.Duration(globalOpts.OfflineOpts.HubProbeIntervalSeconds)
+		var probeHub workapplier.HubProbeFunc
+		if useHubTransport {
+			probeHub = func(_ context.Context) error {
+				if !hubTransportClient.IsConnected() {
+					return errors.New("the hub transport is not connected to the gateway")
+				}
+				return nil
+			}
+		} else {
+			probeHub = func(ctx context.Context) error {
+				return probeHubAPIServer(ctx, hubDiscoveryClient, hubProbeInterval)
+			}
+		}
+		offlineWorkEnforcer := workapplier.NewOfflineWorkEnforcer(
+			workApplier,
+			workCache,
+			probeHub,
+			hubProbeInterval,
+			time.Minute*time.Duration(globalOpts.OfflineOpts.MaxOfflineDurationMinutes),
+		)
+		if err := memberMgr.Add(offlineWorkEnforcer); err != nil {
+			klog.ErrorS(err, "Failed to set up the offline work enforcer")
+			return err
+		}
+	}
+
 	klog.Info("Setting up the internalMemberCluster v1beta1 controller")
 	// Set up a provider provider (if applicable).
+	//
+	// Note that the property provider, though initialized here, is not started until
+	// the specific instance wins the leader election.
 	var pp propertyprovider.PropertyProvider
-	switch {
-	case globalOpts.PropertyProviderOpts.Name == azurePropertyProvider:
-		klog.V(2).Info("setting up the Azure property provider")
-		// Note that the property provider, though initialized here, is not started until
-		// the specific instance wins the leader election.
-		klog.V(1).InfoS("Property Provider is azure, loading cloud config", "cloudConfigFile", globalOpts.PropertyProviderOpts.CloudConfigFilePath)
-		// TODO (britaniar): load cloud config for Azure property provider.
-		pp = azure.New(
-			&globalOpts.PropertyProviderOpts.Region,
-			globalOpts.PropertyProviderOpts.EnableAzProviderCostProperties,
-			globalOpts.PropertyProviderOpts.EnableAzProviderAvailableResourceProperties,
-			globalOpts.PropertyProviderOpts.EnableAzProviderNamespaceCollection)
-	default:
-		// Fall back to not using any property provider if the provided type is none or
-		// not recognizable.
-		klog.V(2).Info("no property provider is specified, or the given type is not recognizable; start with no property provider")
-		pp = nil
+	if globalOpts.PropertyProviderOpts.Name == compositePropertyProvider {
+		klog.V(2).InfoS("setting up the composite property provider", "providers", globalOpts.PropertyProviderOpts.CompositeProviders)
+		members := make([]composite.Member, 0, len(globalOpts.PropertyProviderOpts.CompositeProviders))
+		for _, name := range globalOpts.PropertyProviderOpts.CompositeProviders {
+			memberPP := buildPropertyProvider(name, globalOpts.PropertyProviderOpts)
+			if memberPP == nil {
+				return fmt.Errorf("property provider %q cannot be chained in the composite property provider", name)
+			}
+			members = append(members, composite.Member{Name: name, Provider: memberPP})
+		}
+		pp = composite.New(members...)
+	} else {
+		pp = buildPropertyProvider(globalOpts.PropertyProviderOpts.Name, globalOpts.PropertyProviderOpts)
 	}
 
 	// Set up the IMC controller.
 	imcReconciler, err := imcv1beta1.NewReconciler(
 		ctx,
-		hubMgr.GetClient(),
+		hubClient,
 		memberMgr.GetConfig(), memberMgr.GetClient(),
 		workApplier,
 		pp)
@@ -438,19 +628,30 @@ func Start(ctx context.Context, hubCfg, memberConfig *rest.Config, hubOpts, memb
 		klog.ErrorS(err, "Failed to create InternalMemberCluster v1beta1 reconciler")
 		return fmt.Errorf("failed to create InternalMemberCluster v1beta1 reconciler: %w", err)
 	}
-	if err := imcReconciler.SetupWithManager(hubMgr, "internalmembercluster-controller"); err != nil {
+	if useHubTransport {
+		err = imcReconciler.SetupWithManagerAndEventSource(memberMgr, "internalmembercluster-controller", hubTransportClient.EventsFor(hubtransport.ObjectKindInternalMemberCluster))
+	} else {
+		err = imcReconciler.SetupWithManager(hubMgr, "internalmembercluster-controller")
+	}
+	if err != nil {
 		klog.ErrorS(err, "Failed to set up InternalMemberCluster v1beta1 controller with the controller manager")
 		return fmt.Errorf("failed to set up InternalMemberCluster v1beta1 controller with the controller manager: %w", err)
 	}
 
-	klog.InfoS("starting hub manager")
-	go func() {
-		defer klog.InfoS("shutting down hub manager")
-		if err := hubMgr.Start(ctx); err != nil {
-			klog.ErrorS(err, "Failed to start controller manager for the hub cluster")
-			return
-		}
-	}()
+	hubMgrErr := make(chan error, 1)
+	if hubMgr != nil {
+		go func() {
+			defer klog.InfoS("shutting down hub manager")
+			probeHub := func(ctx context.Context) error {
+				return probeHubAPIServer(ctx, hubDiscoveryClient, hubStartProbeTimeout)
+			}
+			if err := runHubManager(ctx, hubMgr, probeHub, hubStartBackoff()); err != nil {
+				klog.ErrorS(err, "Failed to start controller manager for the hub cluster; stopping the member agent")
+				hubMgrErr <- err
+				cancel()
+			}
+		}()
+	}
 
 	klog.InfoS("starting member manager")
 	defer klog.InfoS("shutting down member manager")
@@ -459,5 +660,56 @@ func Start(ctx context.Context, hubCfg, memberConfig *rest.Config, hubOpts, memb
 		return fmt.Errorf("problem starting member manager: %w", err)
 	}
 
-	return nil
+	select {
+	case err := <-hubMgrErr:
+		return fmt.Errorf("problem starting hub manager: %w", err)
+	default:
+		return nil
+	}
+}
+
+// hubStartBackoff returns the backoff between the attempts to reach the hub cluster before the
+// hub cluster controller manager starts; it retries until the context is cancelled.
+func hubStartBackoff() wait.Backoff {
+	return wait.Backoff{
+		Duration: time.Second,
+		Factor:   2.0,
+		Jitter:   0.1,
+		Steps:    math.MaxInt32,
+		Cap:      hubStartBackoffCap,
+	}
+}
+
+// probeHubAPIServer checks if the hub cluster API server is reachable.
+func probeHubAPIServer(ctx context.Context, hubDiscoveryClient discovery.DiscoveryInterface, timeout time.Duration) error {
+	probeCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return hubDiscoveryClient.RESTClient().Get().AbsPath("/version").Do(probeCtx).Error()
+}
+
+// runHubManager starts the hub cluster controller manager once the hub cluster is reachable, and
+// blocks until the manager stops.
+//
+// A controller manager cannot be started again after it fails (e.g., when its caches fail to sync
+// as the hub cluster is down), so the member agent waits for the hub cluster, with backoff, before
+// starting it; the member cluster controller manager (and, if enabled, the offline work enforcer)
+// keeps running in the meantime. An error is returned if the manager fails to start nonetheless.
+func runHubManager(ctx context.Context, hubMgr manager.Runnable, probeHub workapplier.HubProbeFunc, backoff wait.Backoff) error {
+	err := wait.ExponentialBackoffWithContext(ctx, backoff, func(ctx context.Context) (bool, error) {
+		if err := probeHub(ctx); err != nil {
+			klog.ErrorS(err, "The hub cluster is not reachable; waiting before starting the hub manager")
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			// The member agent is shutting down.
+			return nil
+		}
+		return fmt.Errorf("failed to reach the hub cluster: %w", err)
+	}
+
+	klog.InfoS("starting hub manager")
+	return hubMgr.Start(ctx)
 }
@@ -1,9 +1,17 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
 )
 
@@ -143,3 +151,80 @@ func Test_buildHubConfig(t *testing.T) {
 		assert.NotNil(t, config.WrapTransport)
 	})
 }
+
+// fakeHubManager is a fake hub cluster controller manager that records whether it has been started.
+type fakeHubManager struct {
+	started  atomic.Bool
+	startErr error
+}
+
+func (m *fakeHubManager) Start(_ context.Context) error {
+	m.started.Store(true)
+	return m.startErr
+}
+
+func Test_runHubManager(t *testing.T) {
+	backoff := wait.Backoff{
+		Duration: time.Millisecond,
+		Factor:   1.0,
+		Steps:    1000,
+	}
+
+	// newHub returns a fake hub cluster API server that stays down for the first given number of requests.
+	newHub := func(t *testing.T, downRequests int32) (*httptest.Server, *atomic.Int32) {
+		var requests atomic.Int32
+		hub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			if requests.Add(1) <= downRequests {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"major":"1","minor":"30"}`))
+		}))
+		t.Cleanup(hub.Close)
+		return hub, &requests
+	}
+	probeHubAt := func(t *testing.T, host string) func(ctx context.Context) error {
+		hubDiscoveryClient, err := discovery.NewDiscoveryClientForConfig(&rest.Config{Host: host})
+		if err != nil {
+			t.Fatalf("NewDiscoveryClientForConfig() = %v, want no error", err)
+		}
+		return func(ctx context.Context) error {
+			return probeHubAPIServer(ctx, hubDiscoveryClient, time.Second)
+		}
+	}
+
+	t.Run("hub down at start - start the hub manager once the hub is up", func(t *testing.T) {
+		hub, requests := newHub(t, 3)
+		hubMgr := &fakeHubManager{}
+		err := runHubManager(context.Background(), hubMgr, probeHubAt(t, hub.URL), backoff)
+		assert.Nil(t, err)
+		assert.True(t, hubMgr.started.Load())
+		assert.Equal(t, int32(4), requests.Load())
+	})
+	t.Run("hub manager fails to start - error", func(t *testing.T) {
+		hub, _ := newHub(t, 0)
+		hubMgr := &fakeHubManager{startErr: errors.New("failed to wait for caches to sync")}
+		err := runHubManager(context.Background(), hubMgr, probeHubAt(t, hub.URL), backoff)
+		assert.NotNil(t, err)
+		assert.True(t, hubMgr.started.Load())
+	})
+	t.Run("hub stays down until shutdown - do not start the hub manager", func(t *testing.T) {
+		hub, _ := newHub(t, 0)
+		hub.Close()
+		hubMgr := &fakeHubManager{}
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		err := runHubManager(ctx, hubMgr, probeHubAt(t, hub.URL), backoff)
+		assert.Nil(t, err)
+		assert.False(t, hubMgr.started.Load())
+	})
+	t.Run("hub stays down past the backoff - error", func(t *testing.T) {
+		hub, _ := newHub(t, 0)
+		hub.Close()
+		hubMgr := &fakeHubManager{}
+		err := runHubManager(context.Background(), hubMgr, probeHubAt(t, hub.URL), wait.Backoff{Duration: time.Millisecond, Steps: 2})
+		assert.NotNil(t, err)
+		assert.False(t, hubMgr.started.Load())
+	})
+}
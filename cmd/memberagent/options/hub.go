@@ -18,6 +18,16 @@ package options
 
 import (
 	"flag"
+	"fmt"
+)
+
+const (
+	// HubTransportKubeAPI is the transport with which the KubeFleet member agent reaches the hub
+	// cluster via its Kubernetes API server directly.
+	HubTransportKubeAPI = "kube-api"
+	// HubTransportGRPC is the transport with which the KubeFleet member agent reaches the hub
+	// cluster via a single outbound gRPC stream to the hub transport gateway.
+	HubTransportGRPC = "grpc"
 )
 
 // HubConnectivityOptions is a set of options that control how the KubeFleet
@@ -41,6 +51,22 @@ type HubConnectivityOptions struct {
 	// variable; you can also give the member agent a file path to the CA data instead
 	// via the `HUB_CERTIFICATE_AUTHORITY` environment variable.
 	UseInsecureTLSClient bool
+
+	// The transport the member agent uses to reach the hub cluster.
+	//
+	// With the `kube-api` transport (the default), the member agent talks to the hub cluster's
+	// API server directly. With the `grpc` transport, the member agent opens a single outbound
+	// mTLS connection to the hub transport gateway, which streams Work and InternalMemberCluster
+	// objects to the member agent and accepts writes on its behalf; this is useful for member
+	// clusters behind NAT or strict egress firewalls. The `grpc` transport authenticates with
+	// the key and certificate specified via the `IDENTITY_KEY` and `IDENTITY_CERT` environment
+	// variables, and verifies the gateway with the CA bundle specified via the `CA_BUNDLE`
+	// environment variable (if any).
+	HubTransport string
+
+	// The address (host:port) of the hub transport gateway. Required when the `grpc` transport
+	// is in use.
+	HubGatewayAddress string
 }
 
 // AddFlags adds flags for HubConnectivityOptions to the specified FlagSet.
@@ -56,4 +82,36 @@ func (o *HubConnectivityOptions) AddFlags(flags *flag.FlagSet) {
 		"tls-insecure",
 		false,
 		"Use an insecure client or not when connecting to the hub cluster.")
+
+	flags.Var(
+		newHubTransportValue(HubTransportKubeAPI, &o.HubTransport),
+		"hub-transport",
+		"The transport the member agent uses to reach the hub cluster. Valid values are 'kube-api' and 'grpc'. Default is 'kube-api'.")
+
+	flags.StringVar(
+		&o.HubGatewayAddress,
+		"hub-gateway-address",
+		"",
+		"The address (host:port) of the hub transport gateway. Required when the 'grpc' hub transport is in use.")
+}
+
+type HubTransport string
+
+func (v *HubTransport) String() string {
+	return string(*v)
+}
+
+func (v *HubTransport) Set(s string) error {
+	switch s {
+	case HubTransportKubeAPI, HubTransportGRPC:
+		*v = HubTransport(s)
+		return nil
+	default:
+		return fmt.Errorf("hub transport is set to an invalid value (%s), must be one of [%s, %s]", s, HubTransportKubeAPI, HubTransportGRPC)
+	}
+}
+
+func newHubTransportValue(defaultValue string, p *string) *HubTransport {
+	*p = defaultValue
+	return (*HubTransport)(p)
 }
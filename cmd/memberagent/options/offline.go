@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+)
+
+// OfflineOperationOptions are options that control how the KubeFleet member agent operates
+// when the hub cluster is not reachable.
+type OfflineOperationOptions struct {
+	// Enable offline operation or not. When enabled, the KubeFleet member agent keeps the last known
+	// placements (Work objects) in a local cache, and keeps enforcing them (e.g., correcting drifts)
+	// when the hub cluster is not reachable; status updates are replayed to the hub cluster once
+	// the connectivity is restored.
+	EnableOfflineOperation bool
+
+	// The directory where the KubeFleet member agent keeps the local cache. To survive agent
+	// restarts, the directory should be backed by a persistent volume.
+	WorkCacheDir string
+
+	// The maximum duration in minutes for which the KubeFleet member agent keeps enforcing the
+	// cached placements when the hub cluster is not reachable; afterwards the cached placements are
+	// considered too stale to be enforced.
+	MaxOfflineDurationMinutes int
+
+	// The interval in seconds at which the KubeFleet member agent checks if the hub cluster is
+	// reachable.
+	HubProbeIntervalSeconds int
+}
+
+func (o *OfflineOperationOptions) AddFlags(flags *flag.FlagSet) {
+	flags.BoolVar(
+		&o.EnableOfflineOperation,
+		"enable-offline-operation",
+		false,
+		"Enable offline operation or not. When enabled, the KubeFleet member agent keeps the last known placements in a local cache and keeps enforcing them when the hub cluster is not reachable. Default is false.")
+
+	flags.StringVar(
+		&o.WorkCacheDir,
+		"offline-work-cache-dir",
+		"/var/lib/fleet/work-cache",
+		"The directory where the KubeFleet member agent keeps the local cache for offline operation. The directory should be backed by a persistent volume.")
+
+	flags.Var(
+		newMaxOfflineDurationMinutesValue(1440, &o.MaxOfflineDurationMinutes),
+		"offline-max-duration-minutes",
+		"The maximum duration in minutes for which the KubeFleet member agent keeps enforcing the cached placements when the hub cluster is not reachable. Default is 1440 minutes (1 day). The value must be in the range [1, 43200].")
+
+	flags.Var(
+		newHubProbeIntervalSecondsValue(15, &o.HubProbeIntervalSeconds),
+		"offline-hub-probe-interval-seconds",
+		"The interval in seconds at which the KubeFleet member agent checks if the hub cluster is reachable when offline operation is enabled. Default is 15 seconds. The value must be in the range [5, 300].")
+}
+
+type MaxOfflineDurationMinutes int
+
+func (v *MaxOfflineDurationMinutes) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *MaxOfflineDurationMinutes) Set(s string) error {
+	t, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse integer value: %w", err)
+	}
+
+	if t < 1 || t > 43200 {
+		return fmt.Errorf("max offline duration in minutes is set to an invalid value (%d), must be a value in the range [1, 43200]", t)
+	}
+	*v = MaxOfflineDurationMinutes(t)
+	return nil
+}
+
+func newMaxOfflineDurationMinutesValue(defaultValue int, p *int) *MaxOfflineDurationMinutes {
+	*p = defaultValue
+	return (*MaxOfflineDurationMinutes)(p)
+}
+
+type HubProbeIntervalSeconds int
+
+func (v *HubProbeIntervalSeconds) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *HubProbeIntervalSeconds) Set(s string) error {
+	t, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse integer value: %w", err)
+	}
+
+	if t < 5 || t > 300 {
+		return fmt.Errorf("hub probe interval in seconds is set to an invalid value (%d), must be a value in the range [5, 300]", t)
+	}
+	*v = HubProbeIntervalSeconds(t)
+	return nil
+}
+
+func newHubProbeIntervalSecondsValue(defaultValue int, p *int) *HubProbeIntervalSeconds {
+	*p = defaultValue
+	return (*HubProbeIntervalSeconds)(p)
+}
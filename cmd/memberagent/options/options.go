@@ -36,6 +36,10 @@ type Options struct {
 	// KubeFleet cluster property provider related options.
 	PropertyProviderOpts PropertyProviderOptions
 
+	// Options that control how the KubeFleet member agent operates when the hub cluster is not
+	// reachable.
+	OfflineOpts OfflineOperationOptions
+
 	// The fields below are added only for backwards compatibility reasons.
 	// Their values are never read.
 	UseV1Beta1APIs bool
@@ -50,6 +54,7 @@ func (o *Options) AddFlags(flags *flag.FlagSet) {
 	o.CtrlManagerOptions.AddFlags(flags)
 	o.ApplierOpts.AddFlags(flags)
 	o.PropertyProviderOpts.AddFlags(flags)
+	o.OfflineOpts.AddFlags(flags)
 
 	// The flags set up below are added only for backwards compatibility reasons.
 	// They are no-op flags and their values are never read.
@@ -25,6 +25,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // TestHubConnectivityOptions tests the parsing of the hub connectivity options defined in HubConnectivityOptions.
@@ -44,6 +45,7 @@ func TestHubConnectivityOptions(t *testing.T) {
 			wantHubConnectOpts: HubConnectivityOptions{
 				UseCertificateAuth:   false,
 				UseInsecureTLSClient: false,
+				HubTransport:         HubTransportKubeAPI,
 			},
 		},
 		{
@@ -52,12 +54,23 @@ func TestHubConnectivityOptions(t *testing.T) {
 			args: []string{
 				"--use-ca-auth=true",
 				"--tls-insecure=true",
+				"--hub-transport=grpc",
+				"--hub-gateway-address=gateway.example.com:8090",
 			},
 			wantHubConnectOpts: HubConnectivityOptions{
 				UseCertificateAuth:   true,
 				UseInsecureTLSClient: true,
+				HubTransport:         HubTransportGRPC,
+				HubGatewayAddress:    "gateway.example.com:8090",
 			},
 		},
+		{
+			name:             "invalid hub transport",
+			flagSetName:      "invalidHubTransport",
+			args:             []string{"--hub-transport=websocket"},
+			wantErred:        true,
+			wantErrMsgSubStr: "hub transport is set to an invalid value (websocket), must be one of [kube-api, grpc]",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -658,6 +671,12 @@ func TestPropertyProviderOptions(t *testing.T) {
 				EnableAzProviderCostProperties: true,
 				EnableAzProviderAvailableResourceProperties: true,
 				EnableAzProviderNamespaceCollection:         false,
+				NodeLabelsToExport:                          []string{"topology.kubernetes.io/zone", "kubernetes.io/arch", "kubernetes.io/os"},
+				ExternalProviderEndpoint:                    "unix:///var/run/kubefleet/property-provider.sock",
+				ExternalProviderTimeout:                     5 * time.Second,
+				StaticPropertiesConfigMap:                   types.NamespacedName{Namespace: "fleet-system", Name: "fleet-cluster-properties"},
+				PricingSheetPath:                            "/etc/kubefleet/pricing/pricing-sheet.yaml",
+				LatencyProbeInterval:                        30 * time.Second,
 			},
 		},
 		{
@@ -670,6 +689,14 @@ func TestPropertyProviderOptions(t *testing.T) {
 				"--use-cost-properties-in-azure-provider=false",
 				"--use-available-res-properties-in-azure-provider=false",
 				"--enable-namespace-collection-in-property-provider=true",
+				"--node-labels-to-export-in-nodes-provider=node.kubernetes.io/instance-type, example.com/pool",
+				"--external-property-provider-endpoint=localhost:50051",
+				"--external-property-provider-timeout=3s",
+				"--composite-property-providers=azure, nodes",
+				"--static-properties-config-map=kube-system/cluster-properties",
+				"--pricing-sheet-path=/custom/path/pricing.json",
+				"--latency-probe-peers=member-2=member-2.example.com:443, eastus=10.0.0.4:443",
+				"--latency-probe-interval=1m",
 			},
 			wantPropertyProvOpts: PropertyProviderOptions{
 				Region:                         "eastus",
@@ -678,7 +705,72 @@ func TestPropertyProviderOptions(t *testing.T) {
 				EnableAzProviderCostProperties: false,
 				EnableAzProviderAvailableResourceProperties: false,
 				EnableAzProviderNamespaceCollection:         true,
+				NodeLabelsToExport:                          []string{"node.kubernetes.io/instance-type", "example.com/pool"},
+				ExternalProviderEndpoint:                    "localhost:50051",
+				ExternalProviderTimeout:                     3 * time.Second,
+				CompositeProviders:                          []string{"azure", "nodes"},
+				StaticPropertiesConfigMap:                   types.NamespacedName{Namespace: "kube-system", Name: "cluster-properties"},
+				PricingSheetPath:                            "/custom/path/pricing.json",
+				LatencyProbePeers: []LatencyProbePeer{
+					{Name: "member-2", Endpoint: "member-2.example.com:443"},
+					{Name: "eastus", Endpoint: "10.0.0.4:443"},
+				},
+				LatencyProbeInterval: time.Minute,
+			},
+		},
+		{
+			name:        "invalid node label key",
+			flagSetName: "invalidNodeLabelKey",
+			args: []string{
+				"--node-labels-to-export-in-nodes-provider=kubernetes.io/arch,invalid key",
+			},
+			wantErred:        true,
+			wantErrMsgSubStr: "node label key \"invalid key\" is invalid",
+		},
+		{
+			name:        "external property provider timeout out of range",
+			flagSetName: "externalProviderTimeoutOutOfRange",
+			args: []string{
+				"--external-property-provider-timeout=10s",
+			},
+			wantErred:        true,
+			wantErrMsgSubStr: "must be a value in the range [1s, 9s]",
+		},
+		{
+			name:        "composite property provider chained more than once",
+			flagSetName: "compositeProviderChainedTwice",
+			args: []string{
+				"--composite-property-providers=azure,nodes,azure",
 			},
+			wantErred:        true,
+			wantErrMsgSubStr: "property provider azure is chained more than once",
+		},
+		{
+			name:        "static properties ConfigMap without namespace",
+			flagSetName: "staticPropertiesConfigMapWithoutNamespace",
+			args: []string{
+				"--static-properties-config-map=cluster-properties",
+			},
+			wantErred:        true,
+			wantErrMsgSubStr: "is not in the form of <namespace>/<name>",
+		},
+		{
+			name:        "latency probe peer without port",
+			flagSetName: "latencyProbePeerWithoutPort",
+			args: []string{
+				"--latency-probe-peers=member-2=member-2.example.com",
+			},
+			wantErred:        true,
+			wantErrMsgSubStr: "latency probe peer endpoint \"member-2.example.com\" is invalid",
+		},
+		{
+			name:        "latency probe interval out of range",
+			flagSetName: "latencyProbeIntervalOutOfRange",
+			args: []string{
+				"--latency-probe-interval=1s",
+			},
+			wantErred:        true,
+			wantErrMsgSubStr: "must be a value in the range [5s, 1h]",
 		},
 	}
 
@@ -710,3 +802,99 @@ func TestPropertyProviderOptions(t *testing.T) {
 		})
 	}
 }
+
+// TestOfflineOperationOptions tests the parsing of the offline operation options defined in OfflineOperationOptions.
+func TestOfflineOperationOptions(t *testing.T) {
+	testCases := []struct {
+		name             string
+		flagSetName      string
+		args             []string
+		wantOfflineOpts  OfflineOperationOptions
+		wantErred        bool
+		wantErrMsgSubStr string
+	}{
+		{
+			name:        "all default",
+			flagSetName: "allDefault",
+			args:        []string{},
+			wantOfflineOpts: OfflineOperationOptions{
+				EnableOfflineOperation:    false,
+				WorkCacheDir:              "/var/lib/fleet/work-cache",
+				MaxOfflineDurationMinutes: 1440,
+				HubProbeIntervalSeconds:   15,
+			},
+		},
+		{
+			name:        "all specified",
+			flagSetName: "allSpecified",
+			args: []string{
+				"--enable-offline-operation=true",
+				"--offline-work-cache-dir=/data/cache",
+				"--offline-max-duration-minutes=60",
+				"--offline-hub-probe-interval-seconds=30",
+			},
+			wantOfflineOpts: OfflineOperationOptions{
+				EnableOfflineOperation:    true,
+				WorkCacheDir:              "/data/cache",
+				MaxOfflineDurationMinutes: 60,
+				HubProbeIntervalSeconds:   30,
+			},
+		},
+		{
+			name:             "max offline duration out of range",
+			flagSetName:      "maxOfflineDurationOutOfRange",
+			args:             []string{"--offline-max-duration-minutes=0"},
+			wantErred:        true,
+			wantErrMsgSubStr: "max offline duration in minutes is set to an invalid value (0), must be a value in the range [1, 43200]",
+		},
+		{
+			name:             "max offline duration parse error",
+			flagSetName:      "maxOfflineDurationParseError",
+			args:             []string{"--offline-max-duration-minutes=abc"},
+			wantErred:        true,
+			wantErrMsgSubStr: "failed to parse integer value",
+		},
+		{
+			name:             "hub probe interval too small",
+			flagSetName:      "hubProbeIntervalTooSmall",
+			args:             []string{"--offline-hub-probe-interval-seconds=1"},
+			wantErred:        true,
+			wantErrMsgSubStr: "hub probe interval in seconds is set to an invalid value (1), must be a value in the range [5, 300]",
+		},
+		{
+			name:             "hub probe interval too large",
+			flagSetName:      "hubProbeIntervalTooLarge",
+			args:             []string{"--offline-hub-probe-interval-seconds=301"},
+			wantErred:        true,
+			wantErrMsgSubStr: "hub probe interval in seconds is set to an invalid value (301), must be a value in the range [5, 300]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			flags := flag.NewFlagSet(tc.flagSetName, flag.ContinueOnError)
+			offlineOpts := OfflineOperationOptions{}
+			offlineOpts.AddFlags(flags)
+
+			err := flags.Parse(tc.args)
+			if tc.wantErred {
+				if err == nil {
+					t.Fatalf("flag Parse() = nil, want erred")
+				}
+
+				if !strings.Contains(err.Error(), tc.wantErrMsgSubStr) {
+					t.Fatalf("flag Parse() error = %v, want error msg with sub-string %s", err, tc.wantErrMsgSubStr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("flag Parse() = %v, want nil", err)
+			}
+
+			if diff := cmp.Diff(offlineOpts, tc.wantOfflineOpts); diff != "" {
+				t.Errorf("offline operation options diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,14 @@ package options
 
 import (
 	"flag"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 type PropertyProviderOptions struct {
@@ -41,6 +49,46 @@ type PropertyProviderOptions struct {
 
 	// Enable support for namespace collection in the Azure property provider or not. This option applies only when the Azure property provider is in use.
 	EnableAzProviderNamespaceCollection bool
+
+	// The keys of the node labels whose values the nodes property provider exports as node counts.
+	// This option applies only when the nodes property provider is in use.
+	NodeLabelsToExport []string
+
+	// The gRPC endpoint of the external property provider, e.g., a Unix socket shared with a sidecar.
+	// This option applies only when the external property provider is in use.
+	ExternalProviderEndpoint string
+
+	// The maximum amount of time that a call to the external property provider may take.
+	// This option applies only when the external property provider is in use.
+	ExternalProviderTimeout time.Duration
+
+	// The property providers that the composite property provider chains, in the order of precedence.
+	// This option applies only when the composite property provider is in use.
+	CompositeProviders []string
+
+	// The ConfigMap on the member cluster where a cluster admin declares static properties.
+	// This option applies only when the static property provider is in use.
+	StaticPropertiesConfigMap types.NamespacedName
+
+	// The path to the pricing sheet file, which maps instance types to their hourly prices.
+	// This option applies only when the pricing sheet property provider is in use.
+	PricingSheetPath string
+
+	// The peers that the latency property provider measures the network latency to.
+	// This option applies only when the latency property provider is in use.
+	LatencyProbePeers []LatencyProbePeer
+
+	// How often the latency property provider measures the network latency to its peers.
+	// This option applies only when the latency property provider is in use.
+	LatencyProbeInterval time.Duration
+}
+
+// LatencyProbePeer is a peer that the latency property provider measures the network latency to.
+type LatencyProbePeer struct {
+	// The name of the peer, e.g., the name of another member cluster or a region.
+	Name string
+	// The TCP endpoint of the peer, in the form of host:port.
+	Endpoint string
 }
 
 func (o *PropertyProviderOptions) AddFlags(flags *flag.FlagSet) {
@@ -79,4 +127,222 @@ func (o *PropertyProviderOptions) AddFlags(flags *flag.FlagSet) {
 		"enable-namespace-collection-in-property-provider",
 		false,
 		"Enable support for namespace collection in the Azure property provider or not. This option applies only when the Azure property provider is in use.")
+
+	flags.Var(
+		newNodeLabelsToExportValue([]string{corev1.LabelTopologyZone, corev1.LabelArchStable, corev1.LabelOSStable}, &o.NodeLabelsToExport),
+		"node-labels-to-export-in-nodes-provider",
+		"A comma-separated list of the keys of the node labels whose values the nodes property provider exports as node counts. Default is topology.kubernetes.io/zone,kubernetes.io/arch,kubernetes.io/os. This option applies only when the nodes property provider is in use.")
+
+	flags.StringVar(
+		&o.ExternalProviderEndpoint,
+		"external-property-provider-endpoint",
+		"unix:///var/run/kubefleet/property-provider.sock",
+		"The gRPC endpoint of the external property provider, e.g., a Unix socket shared with a sidecar. This option applies only when the external property provider is in use.")
+
+	flags.Var(
+		newExternalProviderTimeoutValue(5*time.Second, &o.ExternalProviderTimeout),
+		"external-property-provider-timeout",
+		"The maximum amount of time that a call to the external property provider may take. Default is 5s. The value must be in the range [1s, 9s], as the KubeFleet member agent gives up on property collection after 10 seconds. This option applies only when the external property provider is in use.")
+
+	flags.Var(
+		newCompositeProvidersValue(nil, &o.CompositeProviders),
+		"composite-property-providers",
+		"A comma-separated list of the property providers that the composite property provider chains, e.g., azure,nodes. When more than one property provider reports the same property, the one that comes first in the list takes precedence. This option applies only when the composite property provider is in use.")
+
+	flags.Var(
+		newStaticPropertiesConfigMapValue(types.NamespacedName{Namespace: "fleet-system", Name: "fleet-cluster-properties"}, &o.StaticPropertiesConfigMap),
+		"static-properties-config-map",
+		"The ConfigMap on the member cluster where a cluster admin declares static properties, in the form of <namespace>/<name>. Default is fleet-system/fleet-cluster-properties. This option applies only when the static property provider is in use.")
+
+	flags.StringVar(
+		&o.PricingSheetPath,
+		"pricing-sheet-path",
+		"/etc/kubefleet/pricing/pricing-sheet.yaml",
+		"The path to the pricing sheet file, which maps instance types to their hourly prices in the form of YAML or JSON, e.g., a ConfigMap mounted into the KubeFleet member agent pod. The file is reloaded whenever it changes. This option applies only when the pricing sheet property provider is in use.")
+
+	flags.Var(
+		newLatencyProbePeersValue(nil, &o.LatencyProbePeers),
+		"latency-probe-peers",
+		"A comma-separated list of the peers that the latency property provider measures the network latency to, each in the form of <name>=<host>:<port>, e.g., member-2=member-2.example.com:443. The name of a peer is a part of the name of the reported latency property. This option applies only when the latency property provider is in use.")
+
+	flags.Var(
+		newLatencyProbeIntervalValue(30*time.Second, &o.LatencyProbeInterval),
+		"latency-probe-interval",
+		"How often the latency property provider measures the network latency to its peers. Default is 30s. The value must be in the range [5s, 1h]. This option applies only when the latency property provider is in use.")
+}
+
+type LatencyProbePeers []LatencyProbePeer
+
+func (v *LatencyProbePeers) String() string {
+	peers := make([]string, 0, len(*v))
+	for _, peer := range *v {
+		peers = append(peers, fmt.Sprintf("%s=%s", peer.Name, peer.Endpoint))
+	}
+	return strings.Join(peers, ",")
+}
+
+func (v *LatencyProbePeers) Set(s string) error {
+	peers := []LatencyProbePeer{}
+	seen := make(map[string]bool)
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if len(entry) == 0 {
+			continue
+		}
+		name, endpoint, found := strings.Cut(entry, "=")
+		if !found {
+			return fmt.Errorf("latency probe peer %q is not in the form of <name>=<host>:<port>", entry)
+		}
+		// The name of a peer is used as a segment of a property name, which must not have a prefix.
+		if errs := validation.IsQualifiedName(name); len(errs) > 0 || strings.Contains(name, "/") {
+			return fmt.Errorf("latency probe peer name %q is invalid: it must be 63 characters or less, begin and end with an alphanumeric character, and contain only dashes (-), underscores (_), dots (.), and alphanumerics", name)
+		}
+		if seen[name] {
+			return fmt.Errorf("latency probe peer %s is specified more than once", name)
+		}
+		if _, _, err := net.SplitHostPort(endpoint); err != nil {
+			return fmt.Errorf("latency probe peer endpoint %q is invalid: %w", endpoint, err)
+		}
+		seen[name] = true
+		peers = append(peers, LatencyProbePeer{Name: name, Endpoint: endpoint})
+	}
+	*v = peers
+	return nil
+}
+
+func newLatencyProbePeersValue(defaultValue []LatencyProbePeer, p *[]LatencyProbePeer) *LatencyProbePeers {
+	*p = defaultValue
+	return (*LatencyProbePeers)(p)
+}
+
+type LatencyProbeInterval time.Duration
+
+func (v *LatencyProbeInterval) String() string {
+	return time.Duration(*v).String()
+}
+
+func (v *LatencyProbeInterval) Set(s string) error {
+	t, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse duration value: %w", err)
+	}
+
+	if t < 5*time.Second || t > time.Hour {
+		return fmt.Errorf("latency probe interval is set to an invalid value (%s), must be a value in the range [5s, 1h]", t)
+	}
+	*v = LatencyProbeInterval(t)
+	return nil
+}
+
+func newLatencyProbeIntervalValue(defaultValue time.Duration, p *time.Duration) *LatencyProbeInterval {
+	*p = defaultValue
+	return (*LatencyProbeInterval)(p)
+}
+
+type StaticPropertiesConfigMap types.NamespacedName
+
+func (v *StaticPropertiesConfigMap) String() string {
+	return types.NamespacedName(*v).String()
+}
+
+func (v *StaticPropertiesConfigMap) Set(s string) error {
+	namespace, name, found := strings.Cut(s, "/")
+	if !found {
+		return fmt.Errorf("static properties ConfigMap %q is not in the form of <namespace>/<name>", s)
+	}
+	if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+		return fmt.Errorf("static properties ConfigMap namespace %q is invalid: %s", namespace, strings.Join(errs, "; "))
+	}
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return fmt.Errorf("static properties ConfigMap name %q is invalid: %s", name, strings.Join(errs, "; "))
+	}
+	*v = StaticPropertiesConfigMap{Namespace: namespace, Name: name}
+	return nil
+}
+
+func newStaticPropertiesConfigMapValue(defaultValue types.NamespacedName, p *types.NamespacedName) *StaticPropertiesConfigMap {
+	*p = defaultValue
+	return (*StaticPropertiesConfigMap)(p)
+}
+
+type CompositeProviders []string
+
+func (v *CompositeProviders) String() string {
+	return strings.Join(*v, ",")
+}
+
+func (v *CompositeProviders) Set(s string) error {
+	names := []string{}
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		switch {
+		case len(name) == 0:
+			return fmt.Errorf("composite property providers %q include an empty name", s)
+		case name == "composite":
+			return fmt.Errorf("the composite property provider cannot chain itself")
+		case seen[name]:
+			return fmt.Errorf("property provider %s is chained more than once", name)
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	*v = names
+	return nil
+}
+
+func newCompositeProvidersValue(defaultValue []string, p *[]string) *CompositeProviders {
+	*p = defaultValue
+	return (*CompositeProviders)(p)
+}
+
+type ExternalProviderTimeout time.Duration
+
+func (v *ExternalProviderTimeout) String() string {
+	return time.Duration(*v).String()
+}
+
+func (v *ExternalProviderTimeout) Set(s string) error {
+	t, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse duration value: %w", err)
+	}
+
+	if t < time.Second || t > 9*time.Second {
+		return fmt.Errorf("external property provider timeout is set to an invalid value (%s), must be a value in the range [1s, 9s]", t)
+	}
+	*v = ExternalProviderTimeout(t)
+	return nil
+}
+
+func newExternalProviderTimeoutValue(defaultValue time.Duration, p *time.Duration) *ExternalProviderTimeout {
+	*p = defaultValue
+	return (*ExternalProviderTimeout)(p)
+}
+
+type NodeLabelsToExport []string
+
+func (v *NodeLabelsToExport) String() string {
+	return strings.Join(*v, ",")
+}
+
+func (v *NodeLabelsToExport) Set(s string) error {
+	keys := []string{}
+	for _, key := range strings.Split(s, ",") {
+		key = strings.TrimSpace(key)
+		if len(key) == 0 {
+			continue
+		}
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("node label key %q is invalid: %s", key, strings.Join(errs, "; "))
+		}
+		keys = append(keys, key)
+	}
+	*v = keys
+	return nil
+}
+
+func newNodeLabelsToExportValue(defaultValue []string, p *[]string) *NodeLabelsToExport {
+	*p = defaultValue
+	return (*NodeLabelsToExport)(p)
 }
@@ -50,5 +50,25 @@ func (o *Options) Validate() field.ErrorList {
 		errs = append(errs, field.Invalid(newPath.Child("ApplierOpts").Child("RequeueRateLimiterExponentialBaseForFastBackoff"), o.ApplierOpts.RequeueRateLimiterExponentialBaseForFastBackoff, "The exponential base for the fast backoff stage must be greater than or equal to the exponential base for the slow backoff stage"))
 	}
 
+	// Cross-field validation for offline operation options.
+	if o.OfflineOpts.EnableOfflineOperation && len(o.OfflineOpts.WorkCacheDir) == 0 {
+		errs = append(errs, field.Required(newPath.Child("OfflineOpts").Child("WorkCacheDir"), "The work cache directory must be specified when offline operation is enabled"))
+	}
+
+	// Cross-field validation for property provider options.
+	if o.PropertyProviderOpts.Name == "composite" && len(o.PropertyProviderOpts.CompositeProviders) == 0 {
+		errs = append(errs, field.Required(newPath.Child("PropertyProviderOpts").Child("CompositeProviders"), "The property providers to chain must be specified when the composite property provider is in use"))
+	}
+
+	// Cross-field validation for hub connectivity options.
+	if o.HubConnectivityOpts.HubTransport == HubTransportGRPC {
+		if len(o.HubConnectivityOpts.HubGatewayAddress) == 0 {
+			errs = append(errs, field.Required(newPath.Child("HubConnectivityOpts").Child("HubGatewayAddress"), "The hub gateway address must be specified when the grpc hub transport is in use"))
+		}
+		if o.ApplierOpts.EnablePriorityQueue {
+			errs = append(errs, field.Invalid(newPath.Child("ApplierOpts").Child("EnablePriorityQueue"), o.ApplierOpts.EnablePriorityQueue, "The priority queue cannot be enabled when the grpc hub transport is in use"))
+		}
+	}
+
 	return errs
 }
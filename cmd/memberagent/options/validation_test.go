@@ -109,6 +109,61 @@ func TestValidate(t *testing.T) {
 				field.Invalid(newPath.Child("ApplierOpts").Child("RequeueRateLimiterExponentialBaseForFastBackoff"), 1.5, "The exponential base for the fast backoff stage must be greater than or equal to the exponential base for the slow backoff stage"),
 			},
 		},
+		"offline operation enabled with no work cache dir": {
+			opt: newTestOptions(func(option *Options) {
+				option.OfflineOpts.EnableOfflineOperation = true
+				option.OfflineOpts.WorkCacheDir = ""
+			}),
+			want: field.ErrorList{
+				field.Required(newPath.Child("OfflineOpts").Child("WorkCacheDir"), "The work cache directory must be specified when offline operation is enabled"),
+			},
+		},
+		"offline operation disabled with no work cache dir": {
+			opt: newTestOptions(func(option *Options) {
+				option.OfflineOpts.WorkCacheDir = ""
+			}),
+			want: field.ErrorList{},
+		},
+		"grpc hub transport with no gateway address": {
+			opt: newTestOptions(func(option *Options) {
+				option.HubConnectivityOpts.HubTransport = HubTransportGRPC
+			}),
+			want: field.ErrorList{
+				field.Required(newPath.Child("HubConnectivityOpts").Child("HubGatewayAddress"), "The hub gateway address must be specified when the grpc hub transport is in use"),
+			},
+		},
+		"grpc hub transport with priority queue": {
+			opt: newTestOptions(func(option *Options) {
+				option.HubConnectivityOpts.HubTransport = HubTransportGRPC
+				option.HubConnectivityOpts.HubGatewayAddress = "gateway.example.com:8090"
+				option.ApplierOpts.EnablePriorityQueue = true
+			}),
+			want: field.ErrorList{
+				field.Invalid(newPath.Child("ApplierOpts").Child("EnablePriorityQueue"), true, "The priority queue cannot be enabled when the grpc hub transport is in use"),
+			},
+		},
+		"grpc hub transport with gateway address": {
+			opt: newTestOptions(func(option *Options) {
+				option.HubConnectivityOpts.HubTransport = HubTransportGRPC
+				option.HubConnectivityOpts.HubGatewayAddress = "gateway.example.com:8090"
+			}),
+			want: field.ErrorList{},
+		},
+		"composite property provider with no chained property providers": {
+			opt: newTestOptions(func(option *Options) {
+				option.PropertyProviderOpts.Name = "composite"
+			}),
+			want: field.ErrorList{
+				field.Required(newPath.Child("PropertyProviderOpts").Child("CompositeProviders"), "The property providers to chain must be specified when the composite property provider is in use"),
+			},
+		},
+		"composite property provider with chained property providers": {
+			opt: newTestOptions(func(option *Options) {
+				option.PropertyProviderOpts.Name = "composite"
+				option.PropertyProviderOpts.CompositeProviders = []string{"azure", "nodes"}
+			}),
+			want: field.ErrorList{},
+		},
 		"multiple simultaneous violations": {
 			opt: newTestOptions(func(option *Options) {
 				option.CtrlManagerOptions.HubManagerOpts.QPS = 200
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command propertyprovider is a sample external property provider for Fleet.
+//
+// It runs as a sidecar of the KubeFleet member agent, and reports the number of ready nodes in
+// the cluster over a Unix socket shared with the member agent.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/external"
+	propertyproviderv1 "github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/external/proto/v1"
+)
+
+const (
+	// readyNodeCountProperty is the property that the sample provider reports.
+	readyNodeCountProperty = "example.com/ready-node-count"
+)
+
+// provider is the sample external property provider.
+type provider struct {
+	propertyproviderv1.UnimplementedPropertyProviderServer
+
+	client client.Client
+}
+
+// Collect implements the propertyproviderv1.PropertyProviderServer interface.
+func (p *provider) Collect(ctx context.Context, _ *propertyproviderv1.CollectRequest) (*propertyproviderv1.CollectResponse, error) {
+	nodeList := &corev1.NodeList{}
+	if err := p.client.List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	ready := 0
+	for _, node := range nodeList.Items {
+		for _, cond := range node.Status.Conditions {
+			if cond.Type == corev1.NodeReady && cond.Status == corev1.ConditionTrue {
+				ready++
+			}
+		}
+	}
+
+	return &propertyproviderv1.CollectResponse{
+		Properties: map[string]*propertyproviderv1.PropertyValue{
+			readyNodeCountProperty: {
+				Value:           fmt.Sprintf("%d", ready),
+				ObservationTime: timestamppb.Now(),
+			},
+		},
+	}, nil
+}
+
+func main() {
+	socket := flag.String("socket", "/var/run/kubefleet/property-provider.sock", "The path of the Unix socket to listen on.")
+	klog.InitFlags(nil)
+	flag.Parse()
+
+	c, err := client.New(ctrl.GetConfigOrDie(), client.Options{})
+	if err != nil {
+		klog.ErrorS(err, "Failed to create a client")
+		os.Exit(1)
+	}
+
+	// Remove the socket left behind by a previous run, if any.
+	if err := os.Remove(*socket); err != nil && !os.IsNotExist(err) {
+		klog.ErrorS(err, "Failed to remove the stale socket", "socket", *socket)
+		os.Exit(1)
+	}
+	lis, err := net.Listen("unix", *socket)
+	if err != nil {
+		klog.ErrorS(err, "Failed to listen on the socket", "socket", *socket)
+		os.Exit(1)
+	}
+
+	s := external.NewServer(&provider{client: c})
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		s.GracefulStop()
+	}()
+
+	klog.InfoS("Serving the sample external property provider", "socket", *socket)
+	if err := s.Serve(lis); err != nil {
+		klog.ErrorS(err, "Failed to serve the sample external property provider")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,355 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package autoupdaterun features a controller that creates staged update runs automatically whenever
+// a new resource snapshot is created for a placement with the External rollout strategy.
+package autoupdaterun
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// maxUpdateRunNameLength is the max length of the name of an automatically created updateRun.
+	maxUpdateRunNameLength = 63
+
+	// defaultHistoryLimit is the number of automatically created updateRuns kept once they are no longer in flight
+	// when the history limit is not specified.
+	defaultHistoryLimit = 5
+)
+
+// Reconciler reconciles a placement object with the External rollout strategy to create its updateRuns
+// automatically for new resource snapshots.
+type Reconciler struct {
+	Client client.Client
+	Scheme *runtime.Scheme
+}
+
+// Reconcile reconciles the placement object to create an updateRun for its latest resource snapshot if needed,
+// and cleans up the automatically created updateRuns beyond the history limit.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	placementKey := controller.GetObjectKeyFromRequest(req)
+	startTime := time.Now()
+	klog.V(2).InfoS("Reconciliation loop starts", "controller", "autoUpdateRun", "placement", placementKey)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Reconciliation loop ends", "controller", "autoUpdateRun", "placement", placementKey, "latency", latency)
+	}()
+
+	placementObj, err := controller.FetchPlacementFromNamespacedName(ctx, r.Client, req.NamespacedName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// The automatically created updateRuns are owned by the placement object and will be garbage collected.
+			klog.V(2).InfoS("Placement object is not found; skip", "placement", placementKey)
+			return ctrl.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to get the placement object", "placement", placementKey)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+	if placementObj.GetDeletionTimestamp() != nil {
+		klog.V(2).InfoS("Placement object is being deleted; skip", "placement", placementKey)
+		return ctrl.Result{}, nil
+	}
+	config := autoUpdateRunConfig(placementObj)
+	if config == nil {
+		klog.V(2).InfoS("Update runs are not created automatically for the placement; skip", "placement", placementKey)
+		return ctrl.Result{}, nil
+	}
+
+	updateRuns, err := r.listUpdateRuns(ctx, placementObj)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.createUpdateRunIfNeeded(ctx, placementObj, config, updateRuns); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, r.deleteExpiredUpdateRuns(ctx, placementObj, config, updateRuns)
+}
+
+// autoUpdateRunConfig returns the auto update run config of the placement, or nil if the updateRuns of the
+// placement are not created automatically.
+func autoUpdateRunConfig(placementObj placementv1beta1.PlacementObj) *placementv1beta1.AutoUpdateRunConfig {
+	strategy := placementObj.GetPlacementSpec().Strategy
+	if strategy.Type != placementv1beta1.ExternalRolloutStrategyType {
+		return nil
+	}
+	return strategy.AutoUpdateRun
+}
+
+// listUpdateRuns lists all the updateRuns of the placement.
+func (r *Reconciler) listUpdateRuns(ctx context.Context, placementObj placementv1beta1.PlacementObj) ([]placementv1beta1.UpdateRunObj, error) {
+	var updateRunList placementv1beta1.UpdateRunObjList
+	var listOptions []client.ListOption
+	if placementObj.GetNamespace() == "" {
+		updateRunList = &placementv1beta1.ClusterStagedUpdateRunList{}
+	} else {
+		updateRunList = &placementv1beta1.StagedUpdateRunList{}
+		listOptions = append(listOptions, client.InNamespace(placementObj.GetNamespace()))
+	}
+	if err := r.Client.List(ctx, updateRunList, listOptions...); err != nil {
+		klog.ErrorS(err, "Failed to list the updateRuns", "placement", klog.KObj(placementObj))
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	updateRuns := make([]placementv1beta1.UpdateRunObj, 0)
+	for _, updateRun := range updateRunList.GetUpdateRunObjs() {
+		if updateRun.GetUpdateRunSpec().PlacementName == placementObj.GetName() {
+			updateRuns = append(updateRuns, updateRun)
+		}
+	}
+	return updateRuns, nil
+}
+
+// createUpdateRunIfNeeded creates the updateRun for the latest resource snapshot of the placement, if there's none yet
+// and the concurrency policy allows it.
+func (r *Reconciler) createUpdateRunIfNeeded(
+	ctx context.Context,
+	placementObj placementv1beta1.PlacementObj,
+	config *placementv1beta1.AutoUpdateRunConfig,
+	updateRuns []placementv1beta1.UpdateRunObj,
+) error {
+	placementKObj := klog.KObj(placementObj)
+	placementKey := types.NamespacedName{Namespace: placementObj.GetNamespace(), Name: placementObj.GetName()}
+	latestResourceSnapshot, err := controller.FetchLatestMasterResourceSnapshot(ctx, r.Client, placementKey)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get the latest master resource snapshot", "placement", placementKObj)
+		return err
+	}
+	if latestResourceSnapshot == nil {
+		klog.V(2).InfoS("No resource snapshot is created for the placement yet", "placement", placementKObj)
+		return nil
+	}
+	resourceSnapshotIndex, ok := latestResourceSnapshot.GetLabels()[placementv1beta1.ResourceIndexLabel]
+	if !ok {
+		err := fmt.Errorf("resource snapshot %s does not have the resource index label", latestResourceSnapshot.GetName())
+		klog.ErrorS(err, "Failed to get the resource snapshot index", "placement", placementKObj)
+		return controller.NewUnexpectedBehaviorError(err)
+	}
+	for _, updateRun := range updateRuns {
+		if updateRun.GetUpdateRunSpec().ResourceSnapshotIndex == resourceSnapshotIndex {
+			klog.V(2).InfoS("An updateRun already exists for the latest resource snapshot", "placement", placementKObj, "updateRun", klog.KObj(updateRun), "resourceSnapshotIndex", resourceSnapshotIndex)
+			return nil
+		}
+	}
+
+	inFlight := false
+	for _, updateRun := range updateRuns {
+		if !isUpdateRunInFlight(updateRun) {
+			continue
+		}
+		inFlight = true
+		// Only the automatically created updateRuns are superseded; the ones created by users are left alone.
+		if config.ConcurrencyPolicy != placementv1beta1.AutoUpdateRunConcurrencyPolicySupersede ||
+			!metav1.IsControlledBy(updateRun, placementObj) || updateRun.GetUpdateRunSpec().State != placementv1beta1.StateRun {
+			continue
+		}
+		if err := r.stopUpdateRun(ctx, updateRun); err != nil {
+			return err
+		}
+	}
+	if inFlight {
+		klog.V(2).InfoS("Waiting for the updateRuns in flight before creating the updateRun for the latest resource snapshot",
+			"placement", placementKObj, "resourceSnapshotIndex", resourceSnapshotIndex, "concurrencyPolicy", config.ConcurrencyPolicy)
+		return nil
+	}
+
+	updateRun := buildUpdateRun(placementObj, config, resourceSnapshotIndex)
+	if err := controllerutil.SetControllerReference(placementObj, updateRun, r.Scheme); err != nil {
+		klog.ErrorS(err, "Failed to set the owner reference on the updateRun", "placement", placementKObj, "updateRun", klog.KObj(updateRun))
+		return controller.NewUnexpectedBehaviorError(err)
+	}
+	if err := r.Client.Create(ctx, updateRun); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			klog.V(2).InfoS("The updateRun for the latest resource snapshot already exists", "placement", placementKObj, "updateRun", klog.KObj(updateRun))
+			return nil
+		}
+		klog.ErrorS(err, "Failed to create the updateRun", "placement", placementKObj, "updateRun", klog.KObj(updateRun))
+		return controller.NewAPIServerError(false, err)
+	}
+	klog.V(2).InfoS("Created the updateRun for the latest resource snapshot", "placement", placementKObj, "updateRun", klog.KObj(updateRun), "resourceSnapshotIndex", resourceSnapshotIndex)
+	return nil
+}
+
+// stopUpdateRun sets the state of the updateRun to Stop.
+func (r *Reconciler) stopUpdateRun(ctx context.Context, updateRun placementv1beta1.UpdateRunObj) error {
+	patch := client.MergeFrom(updateRun.DeepCopyObject().(client.Object))
+	updateRunSpec := updateRun.GetUpdateRunSpec()
+	updateRunSpec.State = placementv1beta1.StateStop
+	if err := r.Client.Patch(ctx, updateRun, patch); err != nil {
+		klog.ErrorS(err, "Failed to stop the superseded updateRun", "updateRun", klog.KObj(updateRun))
+		return controller.NewAPIServerError(false, err)
+	}
+	klog.V(2).InfoS("Stopped the superseded updateRun", "updateRun", klog.KObj(updateRun))
+	return nil
+}
+
+// deleteExpiredUpdateRuns deletes the automatically created updateRuns that are no longer in flight beyond the
+// history limit, starting from the ones for the oldest resource snapshots.
+func (r *Reconciler) deleteExpiredUpdateRuns(
+	ctx context.Context,
+	placementObj placementv1beta1.PlacementObj,
+	config *placementv1beta1.AutoUpdateRunConfig,
+	updateRuns []placementv1beta1.UpdateRunObj,
+) error {
+	historyLimit := defaultHistoryLimit
+	if config.HistoryLimit != nil {
+		historyLimit = int(*config.HistoryLimit)
+	}
+	expired := expiredUpdateRuns(placementObj, updateRuns, historyLimit)
+	for _, updateRun := range expired {
+		if err := r.Client.Delete(ctx, updateRun); err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete the expired updateRun", "placement", klog.KObj(placementObj), "updateRun", klog.KObj(updateRun))
+			return controller.NewAPIServerError(false, err)
+		}
+		klog.V(2).InfoS("Deleted the expired updateRun", "placement", klog.KObj(placementObj), "updateRun", klog.KObj(updateRun))
+	}
+	return nil
+}
+
+// expiredUpdateRuns returns the automatically created updateRuns that are no longer in flight beyond the history limit.
+// The updateRuns for the newer resource snapshots are kept.
+func expiredUpdateRuns(placementObj placementv1beta1.PlacementObj, updateRuns []placementv1beta1.UpdateRunObj, historyLimit int) []placementv1beta1.UpdateRunObj {
+	candidates := make([]placementv1beta1.UpdateRunObj, 0)
+	for _, updateRun := range updateRuns {
+		if metav1.IsControlledBy(updateRun, placementObj) && updateRun.GetDeletionTimestamp() == nil && !isUpdateRunInFlight(updateRun) {
+			candidates = append(candidates, updateRun)
+		}
+	}
+	if len(candidates) <= historyLimit {
+		return nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return resourceSnapshotIndexOf(candidates[i]) > resourceSnapshotIndexOf(candidates[j])
+	})
+	return candidates[historyLimit:]
+}
+
+// resourceSnapshotIndexOf returns the resource snapshot index of the updateRun, or -1 if it's not a valid index.
+func resourceSnapshotIndexOf(updateRun placementv1beta1.UpdateRunObj) int {
+	index, err := strconv.Atoi(updateRun.GetUpdateRunSpec().ResourceSnapshotIndex)
+	if err != nil {
+		return -1
+	}
+	return index
+}
+
+// isUpdateRunInFlight tells whether the updateRun may still change the bindings of the placement, i.e., it has not
+// finished and it's either running or still stopping.
+// An updateRun that is waiting to be started, or that has stopped, is not in flight.
+func isUpdateRunInFlight(updateRun placementv1beta1.UpdateRunObj) bool {
+	generation := updateRun.GetGeneration()
+	conditions := updateRun.GetUpdateRunStatus().Conditions
+	if condition.IsConditionStatusFalse(meta.FindStatusCondition(conditions, string(placementv1beta1.StagedUpdateRunConditionInitialized)), generation) {
+		return false
+	}
+	succeededCond := meta.FindStatusCondition(conditions, string(placementv1beta1.StagedUpdateRunConditionSucceeded))
+	if succeededCond != nil && succeededCond.Status != metav1.ConditionUnknown {
+		return false
+	}
+	switch updateRun.GetUpdateRunSpec().State {
+	case placementv1beta1.StateRun:
+		return true
+	case placementv1beta1.StateStop:
+		progressingCond := meta.FindStatusCondition(conditions, string(placementv1beta1.StagedUpdateRunConditionProgressing))
+		stopped := condition.IsConditionStatusFalse(progressingCond, generation) && progressingCond.Reason == condition.UpdateRunStoppedReason
+		return !stopped
+	default:
+		return false
+	}
+}
+
+// buildUpdateRun builds the updateRun of the placement for the given resource snapshot index.
+func buildUpdateRun(placementObj placementv1beta1.PlacementObj, config *placementv1beta1.AutoUpdateRunConfig, resourceSnapshotIndex string) placementv1beta1.UpdateRunObj {
+	state := config.InitialState
+	if state == "" {
+		state = placementv1beta1.StateRun
+	}
+	objectMeta := metav1.ObjectMeta{
+		Name:      updateRunName(placementObj.GetName(), resourceSnapshotIndex),
+		Namespace: placementObj.GetNamespace(),
+	}
+	spec := placementv1beta1.UpdateRunSpec{
+		PlacementName:            placementObj.GetName(),
+		ResourceSnapshotIndex:    resourceSnapshotIndex,
+		StagedUpdateStrategyName: config.StagedUpdateStrategyName,
+		State:                    state,
+	}
+	if placementObj.GetNamespace() == "" {
+		return &placementv1beta1.ClusterStagedUpdateRun{ObjectMeta: objectMeta, Spec: spec}
+	}
+	return &placementv1beta1.StagedUpdateRun{ObjectMeta: objectMeta, Spec: spec}
+}
+
+// updateRunName returns the name of the updateRun of the placement for the given resource snapshot index.
+func updateRunName(placementName, resourceSnapshotIndex string) string {
+	suffix := "-auto-" + resourceSnapshotIndex
+	maxPrefixLength := maxUpdateRunNameLength - len(suffix)
+	if len(placementName) > maxPrefixLength {
+		placementName = strings.TrimRight(placementName[:maxPrefixLength], "-.")
+	}
+	return placementName + suffix
+}
+
+// enqueueForResourceSnapshot enqueues the placement that the resource snapshot belongs to.
+func enqueueForResourceSnapshot(_ context.Context, obj client.Object) []reconcile.Request {
+	placementName, ok := obj.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+	if !ok {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: placementName}}}
+}
+
+// SetupWithManagerForClusterResourcePlacement sets up the controller with the manager for
+// ClusterResourcePlacement objects.
+func (r *Reconciler) SetupWithManagerForClusterResourcePlacement(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("cluster-resource-placement-auto-update-run-controller").
+		For(&placementv1beta1.ClusterResourcePlacement{}).
+		Owns(&placementv1beta1.ClusterStagedUpdateRun{}).
+		Watches(&placementv1beta1.ClusterResourceSnapshot{}, handler.EnqueueRequestsFromMapFunc(enqueueForResourceSnapshot)).
+		Complete(r)
+}
+
+// SetupWithManagerForResourcePlacement sets up the controller with the manager for
+// ResourcePlacement objects.
+func (r *Reconciler) SetupWithManagerForResourcePlacement(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("resource-placement-auto-update-run-controller").
+		For(&placementv1beta1.ResourcePlacement{}).
+		Owns(&placementv1beta1.StagedUpdateRun{}).
+		Watches(&placementv1beta1.ResourceSnapshot{}, handler.EnqueueRequestsFromMapFunc(enqueueForResourceSnapshot)).
+		Complete(r)
+}
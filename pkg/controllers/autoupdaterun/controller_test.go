@@ -0,0 +1,382 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoupdaterun
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+const (
+	crpName      = "crp-1"
+	rpName       = "rp-1"
+	nsName       = "work"
+	strategyName = "strategy-1"
+)
+
+func crp(config *placementv1beta1.AutoUpdateRunConfig) *placementv1beta1.ClusterResourcePlacement {
+	return &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{Name: crpName, UID: "crp-uid"},
+		Spec: placementv1beta1.PlacementSpec{
+			Strategy: placementv1beta1.RolloutStrategy{
+				Type:          placementv1beta1.ExternalRolloutStrategyType,
+				AutoUpdateRun: config,
+			},
+		},
+	}
+}
+
+func masterResourceSnapshot(index string) *placementv1beta1.ClusterResourceSnapshot {
+	return &placementv1beta1.ClusterResourceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: crpName + "-" + index + "-snapshot",
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: crpName,
+				placementv1beta1.IsLatestSnapshotLabel:  "true",
+				placementv1beta1.ResourceIndexLabel:     index,
+			},
+			Annotations: map[string]string{
+				placementv1beta1.ResourceGroupHashAnnotation: "hash",
+			},
+		},
+	}
+}
+
+func updateRun(name, index string, state placementv1beta1.State, owned bool, conditions ...metav1.Condition) *placementv1beta1.ClusterStagedUpdateRun {
+	run := &placementv1beta1.ClusterStagedUpdateRun{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 1},
+		Spec: placementv1beta1.UpdateRunSpec{
+			PlacementName:            crpName,
+			ResourceSnapshotIndex:    index,
+			StagedUpdateStrategyName: strategyName,
+			State:                    state,
+		},
+		Status: placementv1beta1.UpdateRunStatus{Conditions: conditions},
+	}
+	if owned {
+		run.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: placementv1beta1.GroupVersion.String(),
+			Kind:       placementv1beta1.ClusterResourcePlacementKind,
+			Name:       crpName,
+			UID:        "crp-uid",
+			Controller: ptr.To(true),
+		}}
+	}
+	return run
+}
+
+func succeeded() metav1.Condition {
+	return metav1.Condition{Type: string(placementv1beta1.StagedUpdateRunConditionSucceeded), Status: metav1.ConditionTrue, ObservedGeneration: 1}
+}
+
+func stopped() metav1.Condition {
+	return metav1.Condition{
+		Type:               string(placementv1beta1.StagedUpdateRunConditionProgressing),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: 1,
+		Reason:             condition.UpdateRunStoppedReason,
+	}
+}
+
+// TestIsUpdateRunInFlight tests the isUpdateRunInFlight function.
+func TestIsUpdateRunInFlight(t *testing.T) {
+	testCases := []struct {
+		name      string
+		updateRun *placementv1beta1.ClusterStagedUpdateRun
+		want      bool
+	}{
+		{
+			name:      "running",
+			updateRun: updateRun("run", "1", placementv1beta1.StateRun, true),
+			want:      true,
+		},
+		{
+			name:      "waiting to be started",
+			updateRun: updateRun("run", "1", placementv1beta1.StateInitialize, true),
+		},
+		{
+			name:      "succeeded",
+			updateRun: updateRun("run", "1", placementv1beta1.StateRun, true, succeeded()),
+		},
+		{
+			name: "failed to initialize",
+			updateRun: updateRun("run", "1", placementv1beta1.StateRun, true, metav1.Condition{
+				Type: string(placementv1beta1.StagedUpdateRunConditionInitialized), Status: metav1.ConditionFalse, ObservedGeneration: 1,
+			}),
+		},
+		{
+			name:      "stopping",
+			updateRun: updateRun("run", "1", placementv1beta1.StateStop, true),
+			want:      true,
+		},
+		{
+			name:      "stopped",
+			updateRun: updateRun("run", "1", placementv1beta1.StateStop, true, stopped()),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isUpdateRunInFlight(tc.updateRun); got != tc.want {
+				t.Errorf("isUpdateRunInFlight() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestUpdateRunName tests the updateRunName function.
+func TestUpdateRunName(t *testing.T) {
+	testCases := []struct {
+		name          string
+		placementName string
+		want          string
+	}{
+		{
+			name:          "short placement name",
+			placementName: crpName,
+			want:          "crp-1-auto-12",
+		},
+		{
+			name:          "long placement name",
+			placementName: strings.Repeat("a", 54) + "-" + strings.Repeat("b", 20),
+			want:          strings.Repeat("a", 54) + "-auto-12",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := updateRunName(tc.placementName, "12"); got != tc.want {
+				t.Errorf("updateRunName() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestExpiredUpdateRuns tests the expiredUpdateRuns function.
+func TestExpiredUpdateRuns(t *testing.T) {
+	updateRuns := []placementv1beta1.UpdateRunObj{
+		updateRun("run-9", "9", placementv1beta1.StateRun, true, succeeded()),
+		updateRun("run-10", "10", placementv1beta1.StateRun, true, succeeded()),
+		updateRun("run-8", "8", placementv1beta1.StateStop, true, stopped()),
+		updateRun("run-7", "7", placementv1beta1.StateRun, false, succeeded()),
+		updateRun("run-11", "11", placementv1beta1.StateRun, true),
+	}
+	testCases := []struct {
+		name         string
+		historyLimit int
+		want         []string
+	}{
+		{
+			name:         "within the history limit",
+			historyLimit: 3,
+		},
+		{
+			name:         "beyond the history limit",
+			historyLimit: 1,
+			want:         []string{"run-9", "run-8"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, run := range expiredUpdateRuns(crp(nil), updateRuns, tc.historyLimit) {
+				got = append(got, run.GetName())
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("expiredUpdateRuns() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestEnqueueForResourceSnapshot tests the enqueueForResourceSnapshot function.
+func TestEnqueueForResourceSnapshot(t *testing.T) {
+	testCases := []struct {
+		name string
+		obj  client.Object
+		want []reconcile.Request
+	}{
+		{
+			name: "cluster resource snapshot",
+			obj:  masterResourceSnapshot("0"),
+			want: []reconcile.Request{{NamespacedName: types.NamespacedName{Name: crpName}}},
+		},
+		{
+			name: "resource snapshot",
+			obj: &placementv1beta1.ResourceSnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      rpName + "-0-snapshot",
+					Namespace: nsName,
+					Labels:    map[string]string{placementv1beta1.PlacementTrackingLabel: rpName},
+				},
+			},
+			want: []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: nsName, Name: rpName}}},
+		},
+		{
+			name: "no placement tracking label",
+			obj:  &placementv1beta1.ClusterResourceSnapshot{ObjectMeta: metav1.ObjectMeta{Name: "snapshot"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := enqueueForResourceSnapshot(context.Background(), tc.obj)
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("enqueueForResourceSnapshot() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestReconcile tests the Reconcile function.
+func TestReconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add scheme: %v", err)
+	}
+
+	type runSummary struct {
+		Name  string
+		Index string
+		State placementv1beta1.State
+	}
+	testCases := []struct {
+		name     string
+		objs     []client.Object
+		wantRuns []runSummary
+	}{
+		{
+			name: "auto update run is not configured",
+			objs: []client.Object{crp(nil), masterResourceSnapshot("0")},
+		},
+		{
+			name: "create the update run for the latest resource snapshot",
+			objs: []client.Object{
+				crp(&placementv1beta1.AutoUpdateRunConfig{StagedUpdateStrategyName: strategyName, InitialState: placementv1beta1.StateInitialize}),
+				masterResourceSnapshot("1"),
+				updateRun("manual", "0", placementv1beta1.StateRun, false, succeeded()),
+			},
+			wantRuns: []runSummary{
+				{Name: "crp-1-auto-1", Index: "1", State: placementv1beta1.StateInitialize},
+				{Name: "manual", Index: "0", State: placementv1beta1.StateRun},
+			},
+		},
+		{
+			name: "update run already exists for the latest resource snapshot",
+			objs: []client.Object{
+				crp(&placementv1beta1.AutoUpdateRunConfig{StagedUpdateStrategyName: strategyName}),
+				masterResourceSnapshot("1"),
+				updateRun("manual", "1", placementv1beta1.StateRun, false),
+			},
+			wantRuns: []runSummary{
+				{Name: "manual", Index: "1", State: placementv1beta1.StateRun},
+			},
+		},
+		{
+			name: "queue behind the update run in flight",
+			objs: []client.Object{
+				crp(&placementv1beta1.AutoUpdateRunConfig{StagedUpdateStrategyName: strategyName, ConcurrencyPolicy: placementv1beta1.AutoUpdateRunConcurrencyPolicyQueue}),
+				masterResourceSnapshot("1"),
+				updateRun("crp-1-auto-0", "0", placementv1beta1.StateRun, true),
+			},
+			wantRuns: []runSummary{
+				{Name: "crp-1-auto-0", Index: "0", State: placementv1beta1.StateRun},
+			},
+		},
+		{
+			name: "supersede the automatically created update run in flight",
+			objs: []client.Object{
+				crp(&placementv1beta1.AutoUpdateRunConfig{StagedUpdateStrategyName: strategyName, ConcurrencyPolicy: placementv1beta1.AutoUpdateRunConcurrencyPolicySupersede}),
+				masterResourceSnapshot("1"),
+				updateRun("crp-1-auto-0", "0", placementv1beta1.StateRun, true),
+			},
+			wantRuns: []runSummary{
+				{Name: "crp-1-auto-0", Index: "0", State: placementv1beta1.StateStop},
+			},
+		},
+		{
+			name: "do not supersede the update run created by users",
+			objs: []client.Object{
+				crp(&placementv1beta1.AutoUpdateRunConfig{StagedUpdateStrategyName: strategyName, ConcurrencyPolicy: placementv1beta1.AutoUpdateRunConcurrencyPolicySupersede}),
+				masterResourceSnapshot("1"),
+				updateRun("manual", "0", placementv1beta1.StateRun, false),
+			},
+			wantRuns: []runSummary{
+				{Name: "manual", Index: "0", State: placementv1beta1.StateRun},
+			},
+		},
+		{
+			name: "create the update run once the superseded one has stopped and prune the history",
+			objs: []client.Object{
+				crp(&placementv1beta1.AutoUpdateRunConfig{
+					StagedUpdateStrategyName: strategyName,
+					ConcurrencyPolicy:        placementv1beta1.AutoUpdateRunConcurrencyPolicySupersede,
+					HistoryLimit:             ptr.To(int32(1)),
+				}),
+				masterResourceSnapshot("2"),
+				updateRun("crp-1-auto-0", "0", placementv1beta1.StateRun, true, succeeded()),
+				updateRun("crp-1-auto-1", "1", placementv1beta1.StateStop, true, stopped()),
+			},
+			wantRuns: []runSummary{
+				{Name: "crp-1-auto-1", Index: "1", State: placementv1beta1.StateStop},
+				{Name: "crp-1-auto-2", Index: "2", State: placementv1beta1.StateRun},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.objs...).
+				Build()
+			r := &Reconciler{Client: fakeClient, Scheme: scheme}
+			if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: crpName}}); err != nil {
+				t.Fatalf("Reconcile() error = %v, want no error", err)
+			}
+
+			var runList placementv1beta1.ClusterStagedUpdateRunList
+			if err := fakeClient.List(context.Background(), &runList); err != nil {
+				t.Fatalf("List() error = %v, want no error", err)
+			}
+			var gotRuns []runSummary
+			for _, run := range runList.Items {
+				gotRuns = append(gotRuns, runSummary{Name: run.Name, Index: run.Spec.ResourceSnapshotIndex, State: run.Spec.State})
+			}
+			sort.Slice(gotRuns, func(i, j int) bool { return gotRuns[i].Name < gotRuns[j].Name })
+			if diff := cmp.Diff(gotRuns, tc.wantRuns); diff != "" {
+				t.Errorf("update runs mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
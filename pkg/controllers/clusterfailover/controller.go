@@ -0,0 +1,317 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clusterfailover features a controller that fails placements over from member clusters whose member agents
+// have stopped sending heartbeats.
+package clusterfailover
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// defaultFailoverAfter is how long to wait before failing a placement over when its failover policy does not
+	// specify it and the fleet-wide failover is disabled.
+	defaultFailoverAfter = 5 * time.Minute
+
+	// resyncPeriod is how often an unavailable member cluster is checked when nothing is scheduled to happen.
+	resyncPeriod = time.Minute
+
+	// Reasons of the FailedOver condition of member clusters.
+	reasonMemberClusterUnavailable = "MemberClusterUnavailable"
+	reasonMemberClusterRecovering  = "MemberClusterRecovering"
+)
+
+// Reconciler reconciles a MemberCluster object to fail the PickN placements on it over to other member clusters
+// once its member agent has stopped sending heartbeats for long enough.
+//
+// A member cluster that has been failed over carries the FailedOver condition, which keeps the scheduler from picking
+// it; the condition is removed once the member agent has kept sending heartbeats for the recovery period, which
+// protects the fleet from clusters that flap between available and unavailable.
+type Reconciler struct {
+	Client client.Client
+
+	// UnavailableThreshold is how long the member agent must have stopped sending heartbeats before the member cluster
+	// is considered unavailable.
+	UnavailableThreshold time.Duration
+
+	// FailoverAfter is the fleet-wide period after which placements without a failover policy are failed over from
+	// an unavailable member cluster; zero disables failover for such placements.
+	FailoverAfter time.Duration
+
+	// MaxConcurrentFailovers caps the number of member clusters being failed over at the same time.
+	MaxConcurrentFailovers int
+
+	// RecoveryPeriod is how long a failed-over member cluster must stay available before it is picked again.
+	RecoveryPeriod time.Duration
+
+	// EnableResourcePlacement indicates whether ResourcePlacements are failed over as well.
+	EnableResourcePlacement bool
+}
+
+// Reconcile fails the placements over from the member cluster if it is unavailable, or tracks its recovery from an
+// earlier failover.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	startTime := time.Now()
+	mcName := req.Name
+	klog.V(2).InfoS("Reconciliation loop starts", "controller", "clusterFailover", "memberCluster", mcName)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Reconciliation loop ends", "controller", "clusterFailover", "memberCluster", mcName, "latency", latency)
+	}()
+
+	var mc clusterv1beta1.MemberCluster
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: mcName}, &mc); err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(2).InfoS("Member cluster is not found; skip", "memberCluster", mcName)
+			return ctrl.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to get the member cluster", "memberCluster", mcName)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+	if mc.DeletionTimestamp != nil {
+		klog.V(2).InfoS("Member cluster is being deleted; skip", "memberCluster", mcName)
+		return ctrl.Result{}, nil
+	}
+	memberAgentStatus := mc.GetAgentStatus(clusterv1beta1.MemberAgent)
+	if memberAgentStatus == nil {
+		klog.V(2).InfoS("Member agent has not reported its status yet; skip", "memberCluster", mcName)
+		return ctrl.Result{}, nil
+	}
+
+	now := time.Now()
+	lastHeartbeat := memberAgentStatus.LastReceivedHeartbeat.Time
+	if sinceLastHeartbeat := now.Sub(lastHeartbeat); sinceLastHeartbeat <= r.UnavailableThreshold {
+		return r.trackRecovery(ctx, &mc, now)
+	}
+	return r.failover(ctx, &mc, lastHeartbeat, now)
+}
+
+// trackRecovery removes the FailedOver condition of an available member cluster once it has stayed available for the
+// recovery period.
+func (r *Reconciler) trackRecovery(ctx context.Context, mc *clusterv1beta1.MemberCluster, now time.Time) (ctrl.Result, error) {
+	mcKObj := klog.KObj(mc)
+	failedOverCond := mc.GetCondition(string(clusterv1beta1.ConditionTypeMemberClusterFailedOver))
+	switch {
+	case failedOverCond == nil:
+		// Check again once the member cluster would become unavailable, in case no more heartbeats arrive.
+		lastHeartbeat := mc.GetAgentStatus(clusterv1beta1.MemberAgent).LastReceivedHeartbeat.Time
+		return ctrl.Result{RequeueAfter: lastHeartbeat.Add(r.UnavailableThreshold).Sub(now) + time.Second}, nil
+	case failedOverCond.Status == metav1.ConditionTrue:
+		mc.SetConditions(metav1.Condition{
+			Type:               string(clusterv1beta1.ConditionTypeMemberClusterFailedOver),
+			Status:             metav1.ConditionFalse,
+			Reason:             reasonMemberClusterRecovering,
+			Message:            fmt.Sprintf("Member agent has resumed sending heartbeats; the cluster is picked for placements again after it stays available for %s", r.RecoveryPeriod),
+			ObservedGeneration: mc.Generation,
+		})
+		if err := r.updateMemberClusterStatus(ctx, mc); err != nil {
+			return ctrl.Result{}, err
+		}
+		klog.V(2).InfoS("Member cluster is recovering from a failover", "memberCluster", mcKObj)
+		return ctrl.Result{RequeueAfter: r.RecoveryPeriod}, nil
+	}
+
+	if recoveredAt := failedOverCond.LastTransitionTime.Add(r.RecoveryPeriod); now.Before(recoveredAt) {
+		return ctrl.Result{RequeueAfter: recoveredAt.Sub(now)}, nil
+	}
+	mc.RemoveCondition(string(clusterv1beta1.ConditionTypeMemberClusterFailedOver))
+	if err := r.updateMemberClusterStatus(ctx, mc); err != nil {
+		return ctrl.Result{}, err
+	}
+	klog.V(2).InfoS("Member cluster has recovered from a failover", "memberCluster", mcKObj)
+	return ctrl.Result{}, nil
+}
+
+// failover fails the PickN placements over from an unavailable member cluster once their failover periods have passed.
+func (r *Reconciler) failover(ctx context.Context, mc *clusterv1beta1.MemberCluster, lastHeartbeat, now time.Time) (ctrl.Result, error) {
+	mcKObj := klog.KObj(mc)
+	bindings, err := r.listBindingsOnCluster(ctx, mc.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	requeueAfter := resyncPeriod
+	dueBindings := make([]placementv1beta1.BindingObj, 0, len(bindings))
+	for _, binding := range bindings {
+		placementKey := types.NamespacedName{Namespace: binding.GetNamespace(), Name: binding.GetLabels()[placementv1beta1.PlacementTrackingLabel]}
+		placementObj, err := controller.FetchPlacementFromNamespacedName(ctx, r.Client, placementKey)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			klog.ErrorS(err, "Failed to get the placement", "placement", placementKey)
+			return ctrl.Result{}, controller.NewAPIServerError(true, err)
+		}
+		if placementObj.GetDeletionTimestamp() != nil {
+			continue
+		}
+		failoverAfter, enabled := r.failoverAfter(placementObj.GetPlacementSpec().Policy)
+		if !enabled {
+			continue
+		}
+		if failoverAt := lastHeartbeat.Add(failoverAfter); now.Before(failoverAt) {
+			requeueAfter = min(requeueAfter, failoverAt.Sub(now))
+			continue
+		}
+		dueBindings = append(dueBindings, binding)
+	}
+	if len(dueBindings) == 0 {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	failedOverCond := mc.GetCondition(string(clusterv1beta1.ConditionTypeMemberClusterFailedOver))
+	if failedOverCond == nil || failedOverCond.Status != metav1.ConditionTrue {
+		failingOver, err := r.countClustersFailingOver(ctx)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if failingOver >= r.MaxConcurrentFailovers {
+			klog.V(2).InfoS("Too many member clusters are being failed over; waiting", "memberCluster", mcKObj,
+				"clustersFailingOver", failingOver, "maxConcurrentFailovers", r.MaxConcurrentFailovers)
+			return ctrl.Result{RequeueAfter: resyncPeriod}, nil
+		}
+		// Mark the member cluster first so that the scheduler does not pick it again for the failed-over placements.
+		mc.SetConditions(metav1.Condition{
+			Type:               string(clusterv1beta1.ConditionTypeMemberClusterFailedOver),
+			Status:             metav1.ConditionTrue,
+			Reason:             reasonMemberClusterUnavailable,
+			Message:            fmt.Sprintf("Member agent has not sent heartbeats since %s; the placements are failed over to other clusters", lastHeartbeat.UTC().Format(time.RFC3339)),
+			ObservedGeneration: mc.Generation,
+		})
+		if err := r.updateMemberClusterStatus(ctx, mc); err != nil {
+			return ctrl.Result{}, err
+		}
+		klog.V(2).InfoS("Member cluster is unavailable; failing over the placements", "memberCluster", mcKObj, "lastHeartbeat", lastHeartbeat)
+	}
+
+	for _, binding := range dueBindings {
+		if err := r.deleteBinding(ctx, binding); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// failoverAfter returns how long to wait before failing a placement with the policy over, and whether the placement is
+// failed over at all.
+func (r *Reconciler) failoverAfter(policy *placementv1beta1.PlacementPolicy) (time.Duration, bool) {
+	if policy == nil || policy.PlacementType != placementv1beta1.PickNPlacementType {
+		// Only the placements of the PickN placement type can be moved to other clusters.
+		return 0, false
+	}
+	if policy.Failover == nil {
+		return r.FailoverAfter, r.FailoverAfter > 0
+	}
+	if policy.Failover.Type == placementv1beta1.DisabledFailoverType {
+		return 0, false
+	}
+	if policy.Failover.AfterSeconds != nil {
+		return time.Duration(*policy.Failover.AfterSeconds) * time.Second, true
+	}
+	if r.FailoverAfter > 0 {
+		return r.FailoverAfter, true
+	}
+	return defaultFailoverAfter, true
+}
+
+// listBindingsOnCluster lists the bindings that are scheduled or bound to the member cluster.
+func (r *Reconciler) listBindingsOnCluster(ctx context.Context, clusterName string) ([]placementv1beta1.BindingObj, error) {
+	bindingLists := []placementv1beta1.BindingObjList{&placementv1beta1.ClusterResourceBindingList{}}
+	if r.EnableResourcePlacement {
+		bindingLists = append(bindingLists, &placementv1beta1.ResourceBindingList{})
+	}
+	bindings := make([]placementv1beta1.BindingObj, 0)
+	for _, bindingList := range bindingLists {
+		if err := r.Client.List(ctx, bindingList); err != nil {
+			klog.ErrorS(err, "Failed to list the bindings", "memberCluster", clusterName)
+			return nil, controller.NewAPIServerError(true, err)
+		}
+		for _, binding := range bindingList.GetBindingObjs() {
+			spec := binding.GetBindingSpec()
+			if spec.TargetCluster != clusterName || binding.GetDeletionTimestamp() != nil ||
+				(spec.State != placementv1beta1.BindingStateScheduled && spec.State != placementv1beta1.BindingStateBound) {
+				continue
+			}
+			bindings = append(bindings, binding)
+		}
+	}
+	return bindings, nil
+}
+
+// countClustersFailingOver counts the member clusters that are being failed over.
+func (r *Reconciler) countClustersFailingOver(ctx context.Context) (int, error) {
+	var mcList clusterv1beta1.MemberClusterList
+	if err := r.Client.List(ctx, &mcList); err != nil {
+		klog.ErrorS(err, "Failed to list the member clusters")
+		return 0, controller.NewAPIServerError(true, err)
+	}
+	count := 0
+	for i := range mcList.Items {
+		if cond := mcList.Items[i].GetCondition(string(clusterv1beta1.ConditionTypeMemberClusterFailedOver)); cond != nil && cond.Status == metav1.ConditionTrue {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// deleteBinding deletes the binding so that the scheduler picks another member cluster for the placement; the
+// resources are cleaned up from the unavailable member cluster once its member agent comes back.
+func (r *Reconciler) deleteBinding(ctx context.Context, binding placementv1beta1.BindingObj) error {
+	bindingKObj := klog.KObj(binding)
+	deleteOptions := &client.DeleteOptions{
+		Preconditions: &metav1.Preconditions{
+			ResourceVersion: ptr.To(binding.GetResourceVersion()),
+		},
+	}
+	if err := r.Client.Delete(ctx, binding, deleteOptions); err != nil {
+		klog.ErrorS(err, "Failed to delete the binding to fail over the placement", "binding", bindingKObj)
+		return controller.NewDeleteIgnoreNotFoundError(err)
+	}
+	klog.V(2).InfoS("Deleted the binding to fail over the placement", "binding", bindingKObj, "memberCluster", binding.GetBindingSpec().TargetCluster)
+	return nil
+}
+
+// updateMemberClusterStatus updates the status of the member cluster.
+func (r *Reconciler) updateMemberClusterStatus(ctx context.Context, mc *clusterv1beta1.MemberCluster) error {
+	if err := r.Client.Status().Update(ctx, mc); err != nil {
+		klog.ErrorS(err, "Failed to update the member cluster status", "memberCluster", klog.KObj(mc))
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	return nil
+}
+
+// SetupWithManager sets up the controller with the manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("cluster-failover-controller").
+		For(&clusterv1beta1.MemberCluster{}).
+		Complete(r)
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterfailover
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	mcName      = "member-1"
+	crpName     = "crp-1"
+	bindingName = "crp-1-member-1"
+)
+
+func memberCluster(name string, lastHeartbeat time.Time, conditions ...metav1.Condition) *clusterv1beta1.MemberCluster {
+	return &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: clusterv1beta1.MemberClusterStatus{
+			Conditions: conditions,
+			AgentStatus: []clusterv1beta1.AgentStatus{
+				{
+					Type:                  clusterv1beta1.MemberAgent,
+					LastReceivedHeartbeat: metav1.NewTime(lastHeartbeat),
+				},
+			},
+		},
+	}
+}
+
+func failedOverCondition(status metav1.ConditionStatus, lastTransitionTime time.Time) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(clusterv1beta1.ConditionTypeMemberClusterFailedOver),
+		Status:             status,
+		Reason:             reasonMemberClusterUnavailable,
+		LastTransitionTime: metav1.NewTime(lastTransitionTime),
+	}
+}
+
+func clusterResourcePlacement(policy *placementv1beta1.PlacementPolicy) *placementv1beta1.ClusterResourcePlacement {
+	return &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{Name: crpName},
+		Spec:       placementv1beta1.PlacementSpec{Policy: policy},
+	}
+}
+
+func pickNPolicy(failover *placementv1beta1.FailoverPolicy) *placementv1beta1.PlacementPolicy {
+	return &placementv1beta1.PlacementPolicy{
+		PlacementType:    placementv1beta1.PickNPlacementType,
+		NumberOfClusters: ptr.To(int32(1)),
+		Failover:         failover,
+	}
+}
+
+func boundBinding() *placementv1beta1.ClusterResourceBinding {
+	return &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   bindingName,
+			Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         placementv1beta1.BindingStateBound,
+			TargetCluster: mcName,
+		},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name               string
+		objects            []client.Object
+		wantBindingDeleted bool
+		wantFailedOverCond *metav1.ConditionStatus
+		wantRequeue        bool
+	}{
+		{
+			name:        "available member cluster is checked again once it would become unavailable",
+			objects:     []client.Object{memberCluster(mcName, now), clusterResourcePlacement(pickNPolicy(nil)), boundBinding()},
+			wantRequeue: true,
+		},
+		{
+			name:               "PickN placement is failed over after the fleet-wide failover period",
+			objects:            []client.Object{memberCluster(mcName, now.Add(-time.Hour)), clusterResourcePlacement(pickNPolicy(nil)), boundBinding()},
+			wantBindingDeleted: true,
+			wantFailedOverCond: ptr.To(metav1.ConditionTrue),
+			wantRequeue:        true,
+		},
+		{
+			name: "PickN placement is not failed over before its own failover period",
+			objects: []client.Object{
+				memberCluster(mcName, now.Add(-time.Hour)),
+				clusterResourcePlacement(pickNPolicy(&placementv1beta1.FailoverPolicy{Type: placementv1beta1.AutomaticFailoverType, AfterSeconds: ptr.To(int32(7200))})),
+				boundBinding(),
+			},
+			wantRequeue: true,
+		},
+		{
+			name: "PickN placement with failover disabled is not failed over",
+			objects: []client.Object{
+				memberCluster(mcName, now.Add(-time.Hour)),
+				clusterResourcePlacement(pickNPolicy(&placementv1beta1.FailoverPolicy{Type: placementv1beta1.DisabledFailoverType})),
+				boundBinding(),
+			},
+			wantRequeue: true,
+		},
+		{
+			name: "PickAll placement is not failed over",
+			objects: []client.Object{
+				memberCluster(mcName, now.Add(-time.Hour)),
+				clusterResourcePlacement(&placementv1beta1.PlacementPolicy{PlacementType: placementv1beta1.PickAllPlacementType}),
+				boundBinding(),
+			},
+			wantRequeue: true,
+		},
+		{
+			name: "member cluster waits when too many member clusters are being failed over",
+			objects: []client.Object{
+				memberCluster(mcName, now.Add(-time.Hour)),
+				memberCluster("member-2", now.Add(-time.Hour), failedOverCondition(metav1.ConditionTrue, now)),
+				clusterResourcePlacement(pickNPolicy(nil)),
+				boundBinding(),
+			},
+			wantRequeue: true,
+		},
+		{
+			name:               "failed-over member cluster starts recovering once heartbeats resume",
+			objects:            []client.Object{memberCluster(mcName, now, failedOverCondition(metav1.ConditionTrue, now.Add(-time.Hour))), boundBinding()},
+			wantFailedOverCond: ptr.To(metav1.ConditionFalse),
+			wantRequeue:        true,
+		},
+		{
+			name:               "recovering member cluster keeps the condition during the recovery period",
+			objects:            []client.Object{memberCluster(mcName, now, failedOverCondition(metav1.ConditionFalse, now.Add(-time.Minute))), boundBinding()},
+			wantFailedOverCond: ptr.To(metav1.ConditionFalse),
+			wantRequeue:        true,
+		},
+		{
+			name:    "recovering member cluster has recovered after the recovery period",
+			objects: []client.Object{memberCluster(mcName, now, failedOverCondition(metav1.ConditionFalse, now.Add(-time.Hour))), boundBinding()},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add cluster scheme: %v", err)
+	}
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add placement scheme: %v", err)
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.objects...).
+				WithStatusSubresource(&clusterv1beta1.MemberCluster{}).
+				Build()
+			r := &Reconciler{
+				Client:                 fakeClient,
+				UnavailableThreshold:   time.Minute,
+				FailoverAfter:          10 * time.Minute,
+				MaxConcurrentFailovers: 1,
+				RecoveryPeriod:         5 * time.Minute,
+			}
+			ctx := context.Background()
+			got, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: mcName}})
+			if err != nil {
+				t.Fatalf("Reconcile() = %v, want nil", err)
+			}
+			if gotRequeue := got.RequeueAfter > 0; gotRequeue != tc.wantRequeue {
+				t.Errorf("Reconcile() requeueAfter = %v, want requeue %v", got.RequeueAfter, tc.wantRequeue)
+			}
+
+			var mc clusterv1beta1.MemberCluster
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: mcName}, &mc); err != nil {
+				t.Fatalf("Failed to get the member cluster: %v", err)
+			}
+			var gotFailedOverCond *metav1.ConditionStatus
+			if cond := mc.GetCondition(string(clusterv1beta1.ConditionTypeMemberClusterFailedOver)); cond != nil {
+				gotFailedOverCond = &cond.Status
+			}
+			if diff := cmp.Diff(gotFailedOverCond, tc.wantFailedOverCond); diff != "" {
+				t.Errorf("failedOver condition status mismatch (-got, +want):\n%s", diff)
+			}
+
+			var binding placementv1beta1.ClusterResourceBinding
+			err = fakeClient.Get(ctx, types.NamespacedName{Name: bindingName}, &binding)
+			if gotBindingDeleted := apierrors.IsNotFound(err); gotBindingDeleted != tc.wantBindingDeleted {
+				t.Errorf("binding deleted = %v, want %v", gotBindingDeleted, tc.wantBindingDeleted)
+			}
+		})
+	}
+}
+
+func TestFailoverAfter(t *testing.T) {
+	tests := []struct {
+		name         string
+		fleetDefault time.Duration
+		policy       *placementv1beta1.PlacementPolicy
+		wantAfter    time.Duration
+		wantEnabled  bool
+	}{
+		{
+			name:         "PickAll placement is never failed over",
+			fleetDefault: time.Minute,
+			policy:       &placementv1beta1.PlacementPolicy{PlacementType: placementv1beta1.PickAllPlacementType},
+		},
+		{
+			name:   "PickN placement without failover policy when the fleet-wide failover is disabled",
+			policy: pickNPolicy(nil),
+		},
+		{
+			name:         "PickN placement without failover policy uses the fleet-wide failover period",
+			fleetDefault: 10 * time.Minute,
+			policy:       pickNPolicy(nil),
+			wantAfter:    10 * time.Minute,
+			wantEnabled:  true,
+		},
+		{
+			name:         "PickN placement with its own failover period",
+			fleetDefault: 10 * time.Minute,
+			policy:       pickNPolicy(&placementv1beta1.FailoverPolicy{Type: placementv1beta1.AutomaticFailoverType, AfterSeconds: ptr.To(int32(120))}),
+			wantAfter:    2 * time.Minute,
+			wantEnabled:  true,
+		},
+		{
+			name:        "PickN placement with automatic failover when the fleet-wide failover is disabled",
+			policy:      pickNPolicy(&placementv1beta1.FailoverPolicy{Type: placementv1beta1.AutomaticFailoverType}),
+			wantAfter:   defaultFailoverAfter,
+			wantEnabled: true,
+		},
+		{
+			name:         "PickN placement with failover disabled",
+			fleetDefault: 10 * time.Minute,
+			policy:       pickNPolicy(&placementv1beta1.FailoverPolicy{Type: placementv1beta1.DisabledFailoverType}),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Reconciler{FailoverAfter: tc.fleetDefault}
+			gotAfter, gotEnabled := r.failoverAfter(tc.policy)
+			if gotAfter != tc.wantAfter || gotEnabled != tc.wantEnabled {
+				t.Errorf("failoverAfter() = (%v, %v), want (%v, %v)", gotAfter, gotEnabled, tc.wantAfter, tc.wantEnabled)
+			}
+		})
+	}
+}
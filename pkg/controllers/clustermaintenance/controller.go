@@ -0,0 +1,387 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clustermaintenance features a controller that puts member clusters in maintenance as their maintenance
+// windows ask: it stops scheduling placements to them and drains the placements already on them.
+package clustermaintenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	evictionutils "github.com/kubefleet-dev/kubefleet/pkg/utils/eviction"
+)
+
+const (
+	// evictionNameFormat is the format of the name of the evictions created to drain a member cluster, which is
+	// deterministic so that at most one eviction exists for a placement on a cluster.
+	evictionNameFormat = "maintenance-eviction-%s-%s"
+
+	// evictionRetryInterval is how long to wait before retrying an eviction that has not been executed,
+	// e.g., one that is blocked by a disruption budget.
+	evictionRetryInterval = time.Minute
+
+	// resyncPeriod is how often a member cluster in maintenance is reconciled to catch up with the placements and
+	// bindings on it.
+	resyncPeriod = time.Minute
+
+	// Reasons of the InMaintenance condition of member clusters.
+	reasonMaintenanceScheduled  = "MaintenanceScheduled"
+	reasonMaintenanceInProgress = "MaintenanceInProgress"
+	reasonMaintenanceEnded      = "MaintenanceEnded"
+
+	// Reasons of the Drained condition of member clusters.
+	reasonPlacementsDraining = "PlacementsDraining"
+	reasonPlacementsDrained  = "PlacementsDrained"
+)
+
+// Reconciler reconciles a MemberCluster object to put it in maintenance during its maintenance window.
+//
+// A member cluster in maintenance carries a NoSchedule maintenance taint, so that no placements are scheduled to it,
+// and the placements on it are drained with ClusterResourcePlacementEviction objects, which respect the disruption
+// budgets of the placements; the progress is reported with the InMaintenance and Drained conditions.
+type Reconciler struct {
+	Client client.Client
+}
+
+// Reconcile adds or removes the maintenance taint of the member cluster as its maintenance window asks, and drains
+// the placements from the member cluster while it is in maintenance; the evictions created to drain them are deleted
+// once the maintenance is removed or ends.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	startTime := time.Now()
+	mcName := req.Name
+	klog.V(2).InfoS("Reconciliation loop starts", "controller", "clusterMaintenance", "memberCluster", mcName)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Reconciliation loop ends", "controller", "clusterMaintenance", "memberCluster", mcName, "latency", latency)
+	}()
+
+	var mc clusterv1beta1.MemberCluster
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: mcName}, &mc); err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(2).InfoS("Member cluster is not found; skip", "memberCluster", mcName)
+			return ctrl.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to get the member cluster", "memberCluster", mcName)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+	if mc.DeletionTimestamp != nil {
+		klog.V(2).InfoS("Member cluster is being deleted; skip", "memberCluster", mcName)
+		return ctrl.Result{}, nil
+	}
+
+	now := time.Now()
+	maintenance := mc.Spec.Maintenance
+	oldConditions := make([]metav1.Condition, len(mc.Status.Conditions))
+	copy(oldConditions, mc.Status.Conditions)
+
+	var result ctrl.Result
+	switch {
+	case maintenance == nil:
+		if err := r.setMaintenanceTaint(ctx, &mc, false); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := r.deleteEvictions(ctx, mc.Name); err != nil {
+			return ctrl.Result{}, err
+		}
+		mc.RemoveCondition(string(clusterv1beta1.ConditionTypeMemberClusterInMaintenance))
+		mc.RemoveCondition(string(clusterv1beta1.ConditionTypeMemberClusterDrained))
+	case maintenance.EndTime != nil && !now.Before(maintenance.EndTime.Time):
+		if err := r.setMaintenanceTaint(ctx, &mc, false); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := r.deleteEvictions(ctx, mc.Name); err != nil {
+			return ctrl.Result{}, err
+		}
+		mc.SetConditions(metav1.Condition{
+			Type:               string(clusterv1beta1.ConditionTypeMemberClusterInMaintenance),
+			Status:             metav1.ConditionFalse,
+			Reason:             reasonMaintenanceEnded,
+			Message:            fmt.Sprintf("Maintenance ended at %s", maintenance.EndTime.UTC().Format(time.RFC3339)),
+			ObservedGeneration: mc.Generation,
+		})
+		mc.RemoveCondition(string(clusterv1beta1.ConditionTypeMemberClusterDrained))
+	case maintenance.StartTime != nil && now.Before(maintenance.StartTime.Time):
+		if err := r.setMaintenanceTaint(ctx, &mc, false); err != nil {
+			return ctrl.Result{}, err
+		}
+		mc.SetConditions(metav1.Condition{
+			Type:               string(clusterv1beta1.ConditionTypeMemberClusterInMaintenance),
+			Status:             metav1.ConditionFalse,
+			Reason:             reasonMaintenanceScheduled,
+			Message:            fmt.Sprintf("Maintenance is scheduled to start at %s", maintenance.StartTime.UTC().Format(time.RFC3339)),
+			ObservedGeneration: mc.Generation,
+		})
+		mc.RemoveCondition(string(clusterv1beta1.ConditionTypeMemberClusterDrained))
+		result.RequeueAfter = maintenance.StartTime.Sub(now)
+	default:
+		// Taint the member cluster first so that the scheduler does not pick it again for the drained placements.
+		if err := r.setMaintenanceTaint(ctx, &mc, true); err != nil {
+			return ctrl.Result{}, err
+		}
+		remaining, requeueAfter, err := r.drainPlacements(ctx, &mc, maintenance.DrainPolicy, now)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		setMaintenanceConditions(&mc, remaining)
+		if maintenance.EndTime != nil {
+			requeueAfter = min(requeueAfter, maintenance.EndTime.Sub(now))
+		}
+		result.RequeueAfter = requeueAfter
+	}
+
+	if !equality.Semantic.DeepEqual(oldConditions, mc.Status.Conditions) {
+		if err := r.Client.Status().Update(ctx, &mc); err != nil {
+			klog.ErrorS(err, "Failed to update the member cluster status", "memberCluster", mcName)
+			return ctrl.Result{}, controller.NewUpdateIgnoreConflictError(err)
+		}
+		klog.V(2).InfoS("Updated the maintenance conditions of the member cluster", "memberCluster", mcName)
+	}
+	return result, nil
+}
+
+// setMaintenanceTaint adds the maintenance taint to, or removes it from, the member cluster.
+func (r *Reconciler) setMaintenanceTaint(ctx context.Context, mc *clusterv1beta1.MemberCluster, tainted bool) error {
+	taints := make([]clusterv1beta1.Taint, 0, len(mc.Spec.Taints)+1)
+	found := false
+	for _, taint := range mc.Spec.Taints {
+		if taint.Key == clusterv1beta1.MaintenanceTaintKey {
+			found = true
+			if !tainted {
+				continue
+			}
+		}
+		taints = append(taints, taint)
+	}
+	if found == tainted {
+		return nil
+	}
+	if tainted {
+		taints = append(taints, clusterv1beta1.Taint{Key: clusterv1beta1.MaintenanceTaintKey, Effect: corev1.TaintEffectNoSchedule})
+	}
+	mc.Spec.Taints = taints
+	if err := r.Client.Update(ctx, mc); err != nil {
+		klog.ErrorS(err, "Failed to update the maintenance taint of the member cluster", "memberCluster", klog.KObj(mc), "tainted", tainted)
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Updated the maintenance taint of the member cluster", "memberCluster", klog.KObj(mc), "tainted", tainted)
+	return nil
+}
+
+// drainPlacements evicts the placements that the drain policy asks for from the member cluster.
+// It returns the number of such placements still on the member cluster, and how long to wait until the evictions
+// are checked again.
+func (r *Reconciler) drainPlacements(
+	ctx context.Context,
+	mc *clusterv1beta1.MemberCluster,
+	drainPolicy clusterv1beta1.MaintenanceDrainPolicy,
+	now time.Time,
+) (int, time.Duration, error) {
+	var bindingList placementv1beta1.ClusterResourceBindingList
+	if err := r.Client.List(ctx, &bindingList); err != nil {
+		klog.ErrorS(err, "Failed to list the cluster resource bindings", "memberCluster", mc.Name)
+		return 0, 0, controller.NewAPIServerError(true, err)
+	}
+
+	remaining := 0
+	requeueAfter := resyncPeriod
+	for i := range bindingList.Items {
+		binding := &bindingList.Items[i]
+		if binding.Spec.TargetCluster != mc.Name || binding.DeletionTimestamp != nil ||
+			(binding.Spec.State != placementv1beta1.BindingStateScheduled && binding.Spec.State != placementv1beta1.BindingStateBound) {
+			continue
+		}
+		crpName, ok := binding.Labels[placementv1beta1.PlacementTrackingLabel]
+		if !ok {
+			klog.V(2).InfoS("Binding does not have the placement tracking label; skip", "binding", klog.KObj(binding))
+			continue
+		}
+		var crp placementv1beta1.ClusterResourcePlacement
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: crpName}, &crp); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			klog.ErrorS(err, "Failed to get the cluster resource placement", "clusterResourcePlacement", crpName)
+			return 0, 0, controller.NewAPIServerError(true, err)
+		}
+		if crp.DeletionTimestamp != nil || !isDrained(crp.Spec.Policy, drainPolicy) {
+			continue
+		}
+
+		remaining++
+		retryAfter, err := r.ensureEviction(ctx, crpName, mc.Name, binding, now)
+		if err != nil {
+			return 0, 0, err
+		}
+		requeueAfter = min(requeueAfter, retryAfter)
+	}
+	return remaining, requeueAfter, nil
+}
+
+// isDrained returns whether a placement with the policy is drained from a member cluster in maintenance with the
+// drain policy.
+func isDrained(policy *placementv1beta1.PlacementPolicy, drainPolicy clusterv1beta1.MaintenanceDrainPolicy) bool {
+	placementType := placementv1beta1.PickAllPlacementType
+	if policy != nil {
+		placementType = policy.PlacementType
+	}
+	switch placementType {
+	case placementv1beta1.PickNPlacementType:
+		return true
+	case placementv1beta1.PickAllPlacementType:
+		return drainPolicy == clusterv1beta1.MaintenanceDrainPolicyAll
+	default:
+		// Placements of the PickFixed placement type cannot be evicted.
+		return false
+	}
+}
+
+// ensureEviction makes sure that an eviction is in progress for the placement on the member cluster.
+// An eviction that has finished without evicting the placement is deleted, so that it is created again after the
+// retry interval.
+// It returns how long to wait until the eviction is checked again.
+func (r *Reconciler) ensureEviction(
+	ctx context.Context,
+	crpName, clusterName string,
+	binding *placementv1beta1.ClusterResourceBinding,
+	now time.Time,
+) (time.Duration, error) {
+	evictionName := fmt.Sprintf(evictionNameFormat, crpName, clusterName)
+	if errs := validation.IsDNS1123Subdomain(evictionName); len(errs) != 0 {
+		err := fmt.Errorf("failed to format a qualified name for the eviction %s: %v", evictionName, errs)
+		klog.ErrorS(err, "Cannot drain the placement from the member cluster", "clusterResourcePlacement", crpName, "memberCluster", clusterName)
+		return resyncPeriod, nil
+	}
+	evictionKObj := klog.KRef("", evictionName)
+
+	var eviction placementv1beta1.ClusterResourcePlacementEviction
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: evictionName}, &eviction); err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to get the eviction", "clusterResourcePlacementEviction", evictionKObj)
+			return 0, controller.NewAPIServerError(true, err)
+		}
+		eviction = placementv1beta1.ClusterResourcePlacementEviction{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: evictionName,
+			},
+			Spec: placementv1beta1.PlacementEvictionSpec{
+				PlacementName: crpName,
+				ClusterName:   clusterName,
+			},
+		}
+		if err := r.Client.Create(ctx, &eviction); err != nil && !apierrors.IsAlreadyExists(err) {
+			klog.ErrorS(err, "Failed to create the eviction", "clusterResourcePlacementEviction", evictionKObj)
+			return 0, controller.NewAPIServerError(false, err)
+		}
+		klog.V(2).InfoS("Created the eviction to drain the placement from the member cluster in maintenance",
+			"clusterResourcePlacementEviction", evictionKObj, "clusterResourcePlacement", crpName, "memberCluster", clusterName)
+		return evictionRetryInterval, nil
+	}
+
+	if !evictionutils.IsEvictionInTerminalState(&eviction) {
+		klog.V(2).InfoS("Waiting for the eviction to finish", "clusterResourcePlacementEviction", evictionKObj)
+		return evictionRetryInterval, nil
+	}
+	// The eviction has finished but the binding is still there, either because the eviction did not go through,
+	// or because the eviction is left over from an earlier maintenance of the member cluster.
+	retryAt := eviction.CreationTimestamp.Add(evictionRetryInterval)
+	if now.Before(retryAt) && !eviction.CreationTimestamp.Before(&binding.CreationTimestamp) {
+		return retryAt.Sub(now), nil
+	}
+	if err := r.Client.Delete(ctx, &eviction); err != nil && !apierrors.IsNotFound(err) {
+		klog.ErrorS(err, "Failed to delete the finished eviction", "clusterResourcePlacementEviction", evictionKObj)
+		return 0, controller.NewAPIServerError(false, err)
+	}
+	klog.V(2).InfoS("Deleted the finished eviction to drain the placement again", "clusterResourcePlacementEviction", evictionKObj)
+	// The eviction is created again in the next reconciliation.
+	return time.Second, nil
+}
+
+// deleteEvictions deletes the evictions created to drain the member cluster, once it is no longer in maintenance.
+func (r *Reconciler) deleteEvictions(ctx context.Context, clusterName string) error {
+	var evictionList placementv1beta1.ClusterResourcePlacementEvictionList
+	if err := r.Client.List(ctx, &evictionList); err != nil {
+		klog.ErrorS(err, "Failed to list the evictions", "memberCluster", clusterName)
+		return controller.NewAPIServerError(true, err)
+	}
+	for i := range evictionList.Items {
+		eviction := &evictionList.Items[i]
+		if eviction.Spec.ClusterName != clusterName || eviction.Name != fmt.Sprintf(evictionNameFormat, eviction.Spec.PlacementName, clusterName) {
+			// The eviction is not created by this controller for the member cluster.
+			continue
+		}
+		if err := r.Client.Delete(ctx, eviction); err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete the eviction", "clusterResourcePlacementEviction", klog.KObj(eviction))
+			return controller.NewAPIServerError(false, err)
+		}
+		klog.V(2).InfoS("Deleted the eviction as the member cluster is no longer in maintenance", "clusterResourcePlacementEviction", klog.KObj(eviction), "memberCluster", clusterName)
+	}
+	return nil
+}
+
+// setMaintenanceConditions marks the member cluster as in maintenance, and reports whether the placements to drain
+// have all left it.
+func setMaintenanceConditions(mc *clusterv1beta1.MemberCluster, remaining int) {
+	message := "Member cluster is in maintenance; no placements are scheduled to it"
+	if reason := mc.Spec.Maintenance.Reason; reason != "" {
+		message = fmt.Sprintf("%s: %s", message, reason)
+	}
+	mc.SetConditions(metav1.Condition{
+		Type:               string(clusterv1beta1.ConditionTypeMemberClusterInMaintenance),
+		Status:             metav1.ConditionTrue,
+		Reason:             reasonMaintenanceInProgress,
+		Message:            message,
+		ObservedGeneration: mc.Generation,
+	})
+
+	drainedCond := metav1.Condition{
+		Type:               string(clusterv1beta1.ConditionTypeMemberClusterDrained),
+		Status:             metav1.ConditionTrue,
+		Reason:             reasonPlacementsDrained,
+		Message:            "All the placements to drain have left the member cluster",
+		ObservedGeneration: mc.Generation,
+	}
+	if remaining > 0 {
+		drainedCond.Status = metav1.ConditionFalse
+		drainedCond.Reason = reasonPlacementsDraining
+		drainedCond.Message = fmt.Sprintf("%d placement(s) to drain are still on the member cluster", remaining)
+	}
+	mc.SetConditions(drainedCond)
+}
+
+// SetupWithManager sets up the controller with the manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("cluster-maintenance-controller").
+		For(&clusterv1beta1.MemberCluster{}).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
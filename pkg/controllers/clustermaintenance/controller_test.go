@@ -0,0 +1,346 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustermaintenance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	mcName       = "member-1"
+	crpName      = "crp-1"
+	evictionName = "maintenance-eviction-crp-1-member-1"
+)
+
+var (
+	otherTaint       = clusterv1beta1.Taint{Key: "key1", Effect: corev1.TaintEffectNoSchedule}
+	maintenanceTaint = clusterv1beta1.Taint{Key: clusterv1beta1.MaintenanceTaintKey, Effect: corev1.TaintEffectNoSchedule}
+)
+
+func TestIsDrained(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      *placementv1beta1.PlacementPolicy
+		drainPolicy clusterv1beta1.MaintenanceDrainPolicy
+		want        bool
+	}{
+		{
+			name:        "PickN placement is drained with the PickN drain policy",
+			policy:      &placementv1beta1.PlacementPolicy{PlacementType: placementv1beta1.PickNPlacementType},
+			drainPolicy: clusterv1beta1.MaintenanceDrainPolicyPickN,
+			want:        true,
+		},
+		{
+			name:        "PickAll placement is not drained with the PickN drain policy",
+			policy:      &placementv1beta1.PlacementPolicy{PlacementType: placementv1beta1.PickAllPlacementType},
+			drainPolicy: clusterv1beta1.MaintenanceDrainPolicyPickN,
+		},
+		{
+			name:        "placement without a policy is drained with the All drain policy",
+			drainPolicy: clusterv1beta1.MaintenanceDrainPolicyAll,
+			want:        true,
+		},
+		{
+			name:        "PickFixed placement is never drained",
+			policy:      &placementv1beta1.PlacementPolicy{PlacementType: placementv1beta1.PickFixedPlacementType},
+			drainPolicy: clusterv1beta1.MaintenanceDrainPolicyAll,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isDrained(tc.policy, tc.drainPolicy); got != tc.want {
+				t.Errorf("isDrained() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func memberCluster(maintenance *clusterv1beta1.Maintenance, taints ...clusterv1beta1.Taint) *clusterv1beta1.MemberCluster {
+	return &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: mcName},
+		Spec: clusterv1beta1.MemberClusterSpec{
+			Taints:      taints,
+			Maintenance: maintenance,
+		},
+	}
+}
+
+func clusterResourcePlacement(placementType placementv1beta1.PlacementType) *placementv1beta1.ClusterResourcePlacement {
+	return &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{Name: crpName},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementType,
+			},
+		},
+	}
+}
+
+func binding() *placementv1beta1.ClusterResourceBinding {
+	return &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "binding-1",
+			Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         placementv1beta1.BindingStateBound,
+			TargetCluster: mcName,
+		},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	now := time.Now()
+	inMaintenanceCond := func(status metav1.ConditionStatus, reason string) metav1.Condition {
+		return metav1.Condition{Type: string(clusterv1beta1.ConditionTypeMemberClusterInMaintenance), Status: status, Reason: reason}
+	}
+	drainedCond := func(status metav1.ConditionStatus, reason string) metav1.Condition {
+		return metav1.Condition{Type: string(clusterv1beta1.ConditionTypeMemberClusterDrained), Status: status, Reason: reason}
+	}
+	inWindow := &clusterv1beta1.Maintenance{
+		StartTime:   &metav1.Time{Time: now.Add(-time.Hour)},
+		EndTime:     &metav1.Time{Time: now.Add(time.Hour)},
+		DrainPolicy: clusterv1beta1.MaintenanceDrainPolicyPickN,
+	}
+	maintenanceEnded := &clusterv1beta1.Maintenance{
+		StartTime: &metav1.Time{Time: now.Add(-2 * time.Hour)},
+		EndTime:   &metav1.Time{Time: now.Add(-time.Hour)},
+	}
+	inMaintenanceCluster := memberCluster(inWindow, otherTaint, maintenanceTaint)
+	inMaintenanceCluster.Status.Conditions = []metav1.Condition{
+		inMaintenanceCond(metav1.ConditionTrue, reasonMaintenanceInProgress),
+		drainedCond(metav1.ConditionTrue, reasonPlacementsDrained),
+	}
+
+	tests := []struct {
+		name           string
+		objects        []client.Object
+		wantTaints     []clusterv1beta1.Taint
+		wantConditions []metav1.Condition
+		wantEviction   bool
+		wantRequeue    bool
+	}{
+		{
+			name:       "member cluster without maintenance",
+			objects:    []client.Object{memberCluster(nil, otherTaint), clusterResourcePlacement(placementv1beta1.PickNPlacementType), binding()},
+			wantTaints: []clusterv1beta1.Taint{otherTaint},
+		},
+		{
+			name:       "maintenance removed from the member cluster",
+			objects:    []client.Object{memberCluster(nil, otherTaint, maintenanceTaint), clusterResourcePlacement(placementv1beta1.PickNPlacementType), binding()},
+			wantTaints: []clusterv1beta1.Taint{otherTaint},
+		},
+		{
+			name: "maintenance scheduled for later",
+			objects: []client.Object{
+				memberCluster(&clusterv1beta1.Maintenance{StartTime: &metav1.Time{Time: now.Add(time.Hour)}}, otherTaint),
+				clusterResourcePlacement(placementv1beta1.PickNPlacementType),
+				binding(),
+			},
+			wantTaints:     []clusterv1beta1.Taint{otherTaint},
+			wantConditions: []metav1.Condition{inMaintenanceCond(metav1.ConditionFalse, reasonMaintenanceScheduled)},
+			wantRequeue:    true,
+		},
+		{
+			name:           "PickN placement is drained from the member cluster in maintenance",
+			objects:        []client.Object{memberCluster(inWindow, otherTaint), clusterResourcePlacement(placementv1beta1.PickNPlacementType), binding()},
+			wantTaints:     []clusterv1beta1.Taint{otherTaint, maintenanceTaint},
+			wantConditions: []metav1.Condition{inMaintenanceCond(metav1.ConditionTrue, reasonMaintenanceInProgress), drainedCond(metav1.ConditionFalse, reasonPlacementsDraining)},
+			wantEviction:   true,
+			wantRequeue:    true,
+		},
+		{
+			name:           "PickAll placement is not drained with the PickN drain policy",
+			objects:        []client.Object{memberCluster(inWindow), clusterResourcePlacement(placementv1beta1.PickAllPlacementType), binding()},
+			wantTaints:     []clusterv1beta1.Taint{maintenanceTaint},
+			wantConditions: []metav1.Condition{inMaintenanceCond(metav1.ConditionTrue, reasonMaintenanceInProgress), drainedCond(metav1.ConditionTrue, reasonPlacementsDrained)},
+			wantRequeue:    true,
+		},
+		{
+			name: "PickAll placement is drained with the All drain policy",
+			objects: []client.Object{
+				memberCluster(&clusterv1beta1.Maintenance{DrainPolicy: clusterv1beta1.MaintenanceDrainPolicyAll}),
+				clusterResourcePlacement(placementv1beta1.PickAllPlacementType),
+				binding(),
+			},
+			wantTaints:     []clusterv1beta1.Taint{maintenanceTaint},
+			wantConditions: []metav1.Condition{inMaintenanceCond(metav1.ConditionTrue, reasonMaintenanceInProgress), drainedCond(metav1.ConditionFalse, reasonPlacementsDraining)},
+			wantEviction:   true,
+			wantRequeue:    true,
+		},
+		{
+			name: "PickFixed placement is never drained",
+			objects: []client.Object{
+				memberCluster(&clusterv1beta1.Maintenance{DrainPolicy: clusterv1beta1.MaintenanceDrainPolicyAll}),
+				clusterResourcePlacement(placementv1beta1.PickFixedPlacementType),
+				binding(),
+			},
+			wantTaints:     []clusterv1beta1.Taint{maintenanceTaint},
+			wantConditions: []metav1.Condition{inMaintenanceCond(metav1.ConditionTrue, reasonMaintenanceInProgress), drainedCond(metav1.ConditionTrue, reasonPlacementsDrained)},
+			wantRequeue:    true,
+		},
+		{
+			name:           "maintenance ends at its end time",
+			objects:        []client.Object{memberCluster(maintenanceEnded, otherTaint, maintenanceTaint), clusterResourcePlacement(placementv1beta1.PickNPlacementType), binding()},
+			wantTaints:     []clusterv1beta1.Taint{otherTaint},
+			wantConditions: []metav1.Condition{inMaintenanceCond(metav1.ConditionFalse, reasonMaintenanceEnded)},
+		},
+		{
+			name:           "drained member cluster stays in maintenance",
+			objects:        []client.Object{inMaintenanceCluster},
+			wantTaints:     []clusterv1beta1.Taint{otherTaint, maintenanceTaint},
+			wantConditions: []metav1.Condition{inMaintenanceCond(metav1.ConditionTrue, reasonMaintenanceInProgress), drainedCond(metav1.ConditionTrue, reasonPlacementsDrained)},
+			wantRequeue:    true,
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add cluster scheme: %v", err)
+	}
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add placement scheme: %v", err)
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.objects...).
+				WithStatusSubresource(&clusterv1beta1.MemberCluster{}).
+				Build()
+			r := &Reconciler{Client: fakeClient}
+			ctx := context.Background()
+			got, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: mcName}})
+			if err != nil {
+				t.Fatalf("Reconcile() = %v, want nil", err)
+			}
+			if gotRequeue := got.RequeueAfter > 0; gotRequeue != tc.wantRequeue {
+				t.Errorf("Reconcile() requeueAfter = %v, want requeue %v", got.RequeueAfter, tc.wantRequeue)
+			}
+
+			var mc clusterv1beta1.MemberCluster
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: mcName}, &mc); err != nil {
+				t.Fatalf("Failed to get the member cluster: %v", err)
+			}
+			if diff := cmp.Diff(mc.Spec.Taints, tc.wantTaints, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("member cluster taints mismatch (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(mc.Status.Conditions, tc.wantConditions, cmpopts.EquateEmpty(),
+				cmpopts.IgnoreFields(metav1.Condition{}, "Message", "LastTransitionTime", "ObservedGeneration")); diff != "" {
+				t.Errorf("member cluster conditions mismatch (-got, +want):\n%s", diff)
+			}
+
+			var eviction placementv1beta1.ClusterResourcePlacementEviction
+			err = fakeClient.Get(ctx, types.NamespacedName{Name: evictionName}, &eviction)
+			if tc.wantEviction {
+				if err != nil {
+					t.Fatalf("Failed to get the eviction: %v", err)
+				}
+				wantSpec := placementv1beta1.PlacementEvictionSpec{PlacementName: crpName, ClusterName: mcName}
+				if diff := cmp.Diff(eviction.Spec, wantSpec); diff != "" {
+					t.Errorf("eviction spec mismatch (-got, +want):\n%s", diff)
+				}
+				return
+			}
+			if !apierrors.IsNotFound(err) {
+				t.Errorf("Get() eviction = %v, want not found", err)
+			}
+		})
+	}
+}
+
+func TestReconcile_DeletesEvictions(t *testing.T) {
+	now := time.Now()
+	eviction := func(name, clusterName string) *placementv1beta1.ClusterResourcePlacementEviction {
+		return &placementv1beta1.ClusterResourcePlacementEviction{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       placementv1beta1.PlacementEvictionSpec{PlacementName: crpName, ClusterName: clusterName},
+		}
+	}
+	otherEvictions := []string{"user-eviction", "maintenance-eviction-crp-1-member-2"}
+
+	tests := []struct {
+		name        string
+		maintenance *clusterv1beta1.Maintenance
+	}{
+		{
+			name: "maintenance removed from the member cluster",
+		},
+		{
+			name: "maintenance ends at its end time",
+			maintenance: &clusterv1beta1.Maintenance{
+				StartTime: &metav1.Time{Time: now.Add(-2 * time.Hour)},
+				EndTime:   &metav1.Time{Time: now.Add(-time.Hour)},
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add cluster scheme: %v", err)
+	}
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add placement scheme: %v", err)
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					memberCluster(tc.maintenance, maintenanceTaint),
+					clusterResourcePlacement(placementv1beta1.PickNPlacementType),
+					binding(),
+					eviction(evictionName, mcName),
+					eviction(otherEvictions[0], mcName),
+					eviction(otherEvictions[1], "member-2"),
+				).
+				WithStatusSubresource(&clusterv1beta1.MemberCluster{}).
+				Build()
+			r := &Reconciler{Client: fakeClient}
+			ctx := context.Background()
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: mcName}}); err != nil {
+				t.Fatalf("Reconcile() = %v, want nil", err)
+			}
+
+			var got placementv1beta1.ClusterResourcePlacementEviction
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: evictionName}, &got); !apierrors.IsNotFound(err) {
+				t.Errorf("Get() eviction %s = %v, want not found", evictionName, err)
+			}
+			for _, name := range otherEvictions {
+				if err := fakeClient.Get(ctx, types.NamespacedName{Name: name}, &got); err != nil {
+					t.Errorf("Get() eviction %s = %v, want no error", name, err)
+				}
+			}
+		})
+	}
+}
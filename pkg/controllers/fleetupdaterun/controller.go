@@ -0,0 +1,283 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fleetupdaterun features a controller that creates the staged update runs of the placements in a
+// ClusterFleetUpdateRun and aggregates their status. The staged update runs coordinate with each other as a
+// group in the update run controller.
+package fleetupdaterun
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// Reconciler reconciles a ClusterFleetUpdateRun object.
+type Reconciler struct {
+	Client client.Client
+	Scheme *runtime.Scheme
+}
+
+// Reconcile creates the updateRuns of the placements in the ClusterFleetUpdateRun, keeps their states in sync with
+// the ClusterFleetUpdateRun, and aggregates their status.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	startTime := time.Now()
+	klog.V(2).InfoS("Reconciliation loop starts", "controller", "fleetUpdateRun", "fleetUpdateRun", req.Name)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Reconciliation loop ends", "controller", "fleetUpdateRun", "fleetUpdateRun", req.Name, "latency", latency)
+	}()
+
+	var fleetUpdateRun placementv1beta1.ClusterFleetUpdateRun
+	if err := r.Client.Get(ctx, req.NamespacedName, &fleetUpdateRun); err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(2).InfoS("FleetUpdateRun is not found; skip", "fleetUpdateRun", req.Name)
+			return ctrl.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to get the fleetUpdateRun", "fleetUpdateRun", req.Name)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+	if fleetUpdateRun.GetDeletionTimestamp() != nil {
+		// The updateRuns are owned by the fleetUpdateRun and will be garbage collected.
+		klog.V(2).InfoS("FleetUpdateRun is being deleted; skip", "fleetUpdateRun", req.Name)
+		return ctrl.Result{}, nil
+	}
+
+	updateRuns, syncErr := r.syncUpdateRuns(ctx, &fleetUpdateRun)
+	if syncErr != nil && !errors.Is(syncErr, controller.ErrUserError) {
+		return ctrl.Result{}, syncErr
+	}
+	return ctrl.Result{}, r.updateStatus(ctx, &fleetUpdateRun, updateRuns, syncErr)
+}
+
+// syncUpdateRuns creates the missing updateRuns of the placements and propagates the state of the fleetUpdateRun to them.
+// It returns the updateRuns in the order of the placements; it stops at the first updateRun that cannot be synced.
+func (r *Reconciler) syncUpdateRuns(ctx context.Context, fleetUpdateRun *placementv1beta1.ClusterFleetUpdateRun) ([]*placementv1beta1.ClusterStagedUpdateRun, error) {
+	fleetUpdateRunRef := klog.KObj(fleetUpdateRun)
+	updateRuns := make([]*placementv1beta1.ClusterStagedUpdateRun, 0, len(fleetUpdateRun.Spec.Placements))
+	for i := range fleetUpdateRun.Spec.Placements {
+		updateRun := &placementv1beta1.ClusterStagedUpdateRun{}
+		name := updateRunName(fleetUpdateRun.Name, i)
+		err := r.Client.Get(ctx, client.ObjectKey{Name: name}, updateRun)
+		switch {
+		case apierrors.IsNotFound(err):
+			updateRun = buildUpdateRun(fleetUpdateRun, i)
+			if err := controllerutil.SetControllerReference(fleetUpdateRun, updateRun, r.Scheme); err != nil {
+				klog.ErrorS(err, "Failed to set the owner reference on the updateRun", "fleetUpdateRun", fleetUpdateRunRef, "updateRun", klog.KObj(updateRun))
+				return updateRuns, controller.NewUnexpectedBehaviorError(err)
+			}
+			if err := r.Client.Create(ctx, updateRun); err != nil {
+				klog.ErrorS(err, "Failed to create the updateRun", "fleetUpdateRun", fleetUpdateRunRef, "updateRun", klog.KObj(updateRun))
+				return updateRuns, controller.NewAPIServerError(false, err)
+			}
+			klog.V(2).InfoS("Created the updateRun", "fleetUpdateRun", fleetUpdateRunRef, "updateRun", klog.KObj(updateRun))
+		case err != nil:
+			klog.ErrorS(err, "Failed to get the updateRun", "fleetUpdateRun", fleetUpdateRunRef, "updateRun", name)
+			return updateRuns, controller.NewAPIServerError(true, err)
+		case !metav1.IsControlledBy(updateRun, fleetUpdateRun):
+			conflictErr := controller.NewUserError(fmt.Errorf("updateRun `%s` already exists but is not created for the fleetUpdateRun", name))
+			klog.ErrorS(conflictErr, "Failed to sync the updateRun", "fleetUpdateRun", fleetUpdateRunRef)
+			return updateRuns, conflictErr
+		case updateRun.Spec.State != fleetUpdateRun.Spec.State:
+			patch := client.MergeFrom(updateRun.DeepCopy())
+			updateRun.Spec.State = fleetUpdateRun.Spec.State
+			if err := r.Client.Patch(ctx, updateRun, patch); err != nil {
+				klog.ErrorS(err, "Failed to update the state of the updateRun", "fleetUpdateRun", fleetUpdateRunRef, "updateRun", klog.KObj(updateRun), "state", fleetUpdateRun.Spec.State)
+				return updateRuns, controller.NewAPIServerError(false, err)
+			}
+			klog.V(2).InfoS("Updated the state of the updateRun", "fleetUpdateRun", fleetUpdateRunRef, "updateRun", klog.KObj(updateRun), "state", fleetUpdateRun.Spec.State)
+		}
+		updateRuns = append(updateRuns, updateRun)
+	}
+	return updateRuns, nil
+}
+
+// updateStatus updates the status of the fleetUpdateRun with the updateRuns of its placements.
+func (r *Reconciler) updateStatus(
+	ctx context.Context,
+	fleetUpdateRun *placementv1beta1.ClusterFleetUpdateRun,
+	updateRuns []*placementv1beta1.ClusterStagedUpdateRun,
+	syncErr error,
+) error {
+	oldStatus := fleetUpdateRun.Status.DeepCopy()
+	fleetUpdateRun.Status.UpdateRuns = make([]placementv1beta1.FleetUpdateRunMemberStatus, len(updateRuns))
+	for i, updateRun := range updateRuns {
+		fleetUpdateRun.Status.UpdateRuns[i] = placementv1beta1.FleetUpdateRunMemberStatus{
+			PlacementName: updateRun.Spec.PlacementName,
+			UpdateRunName: updateRun.Name,
+		}
+	}
+	setConditions(fleetUpdateRun, updateRuns, syncErr)
+	if reflect.DeepEqual(oldStatus, &fleetUpdateRun.Status) {
+		return nil
+	}
+	if err := r.Client.Status().Update(ctx, fleetUpdateRun); err != nil {
+		klog.ErrorS(err, "Failed to update the fleetUpdateRun status", "fleetUpdateRun", klog.KObj(fleetUpdateRun))
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Updated the fleetUpdateRun status", "fleetUpdateRun", klog.KObj(fleetUpdateRun))
+	return nil
+}
+
+// setConditions aggregates the conditions of the updateRuns into the conditions of the fleetUpdateRun.
+func setConditions(fleetUpdateRun *placementv1beta1.ClusterFleetUpdateRun, updateRuns []*placementv1beta1.ClusterStagedUpdateRun, syncErr error) {
+	generation := fleetUpdateRun.Generation
+	conditions := &fleetUpdateRun.Status.Conditions
+	initializedType := string(placementv1beta1.StagedUpdateRunConditionInitialized)
+	progressingType := string(placementv1beta1.StagedUpdateRunConditionProgressing)
+	succeededType := string(placementv1beta1.StagedUpdateRunConditionSucceeded)
+
+	if syncErr != nil {
+		meta.SetStatusCondition(conditions, metav1.Condition{
+			Type:               initializedType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: generation,
+			Reason:             condition.UpdateRunInitializeFailedReason,
+			Message:            syncErr.Error(),
+		})
+		meta.RemoveStatusCondition(conditions, progressingType)
+		meta.RemoveStatusCondition(conditions, succeededType)
+		return
+	}
+
+	// Aggregate the Initialized and Succeeded conditions: any updateRun that fails fails the fleetUpdateRun, and all
+	// the updateRuns need to be done for the fleetUpdateRun to be done.
+	for _, condType := range []string{initializedType, succeededType} {
+		var cond *metav1.Condition
+		allTrue := len(updateRuns) == len(fleetUpdateRun.Spec.Placements)
+		for _, updateRun := range updateRuns {
+			updateRunCond := meta.FindStatusCondition(updateRun.Status.Conditions, condType)
+			if updateRunCond != nil && updateRunCond.Status == metav1.ConditionFalse {
+				cond = &metav1.Condition{
+					Type:               condType,
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: generation,
+					Reason:             updateRunCond.Reason,
+					Message:            fmt.Sprintf("updateRun `%s`: %s", updateRun.Name, updateRunCond.Message),
+				}
+				break
+			}
+			if updateRunCond == nil || updateRunCond.Status != metav1.ConditionTrue {
+				allTrue = false
+			}
+		}
+		if cond == nil && allTrue {
+			cond = &metav1.Condition{
+				Type:               condType,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: generation,
+				Reason:             meta.FindStatusCondition(updateRuns[0].Status.Conditions, condType).Reason,
+				Message:            fmt.Sprintf("The %s condition of all the updateRuns is true", condType),
+			}
+		}
+		if cond == nil {
+			meta.RemoveStatusCondition(conditions, condType)
+			continue
+		}
+		meta.SetStatusCondition(conditions, *cond)
+	}
+
+	// The fleetUpdateRun is progressing as long as one of its updateRuns is.
+	if succeededCond := meta.FindStatusCondition(*conditions, succeededType); succeededCond != nil {
+		meta.SetStatusCondition(conditions, metav1.Condition{
+			Type:               progressingType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: generation,
+			Reason:             succeededCond.Reason,
+			Message:            succeededCond.Message,
+		})
+		return
+	}
+	var notProgressingCond *metav1.Condition
+	for _, updateRun := range updateRuns {
+		updateRunCond := meta.FindStatusCondition(updateRun.Status.Conditions, progressingType)
+		if updateRunCond == nil {
+			continue
+		}
+		if updateRunCond.Status == metav1.ConditionTrue {
+			meta.SetStatusCondition(conditions, metav1.Condition{
+				Type:               progressingType,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: generation,
+				Reason:             condition.UpdateRunProgressingReason,
+				Message:            fmt.Sprintf("updateRun `%s`: %s", updateRun.Name, updateRunCond.Message),
+			})
+			return
+		}
+		if notProgressingCond == nil {
+			notProgressingCond = &metav1.Condition{
+				Type:               progressingType,
+				Status:             updateRunCond.Status,
+				ObservedGeneration: generation,
+				Reason:             updateRunCond.Reason,
+				Message:            fmt.Sprintf("updateRun `%s`: %s", updateRun.Name, updateRunCond.Message),
+			}
+		}
+	}
+	if notProgressingCond == nil {
+		meta.RemoveStatusCondition(conditions, progressingType)
+		return
+	}
+	meta.SetStatusCondition(conditions, *notProgressingCond)
+}
+
+// buildUpdateRun builds the updateRun of the placement at the given index of the fleetUpdateRun.
+func buildUpdateRun(fleetUpdateRun *placementv1beta1.ClusterFleetUpdateRun, index int) *placementv1beta1.ClusterStagedUpdateRun {
+	placement := fleetUpdateRun.Spec.Placements[index]
+	return &placementv1beta1.ClusterStagedUpdateRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: updateRunName(fleetUpdateRun.Name, index),
+			Labels: map[string]string{
+				placementv1beta1.FleetUpdateRunLabel: fleetUpdateRun.Name,
+			},
+		},
+		Spec: placementv1beta1.UpdateRunSpec{
+			PlacementName:            placement.PlacementName,
+			ResourceSnapshotIndex:    placement.ResourceSnapshotIndex,
+			StagedUpdateStrategyName: fleetUpdateRun.Spec.StagedUpdateStrategyName,
+			State:                    fleetUpdateRun.Spec.State,
+		},
+	}
+}
+
+// updateRunName returns the name of the updateRun of the placement at the given index of the fleetUpdateRun.
+func updateRunName(fleetUpdateRunName string, index int) string {
+	return fmt.Sprintf("%s-%d", fleetUpdateRunName, index)
+}
+
+// SetupWithManager sets up the controller with the manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("cluster-fleet-update-run-controller").
+		For(&placementv1beta1.ClusterFleetUpdateRun{}).
+		Owns(&placementv1beta1.ClusterStagedUpdateRun{}).
+		Complete(r)
+}
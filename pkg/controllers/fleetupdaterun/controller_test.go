@@ -0,0 +1,312 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleetupdaterun
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	fleetUpdateRunName = "fur-1"
+	strategyName       = "strategy-1"
+)
+
+func fleetUpdateRun(state placementv1beta1.State, placementNames ...string) *placementv1beta1.ClusterFleetUpdateRun {
+	run := &placementv1beta1.ClusterFleetUpdateRun{
+		ObjectMeta: metav1.ObjectMeta{Name: fleetUpdateRunName, UID: "fur-uid", Generation: 1},
+		Spec: placementv1beta1.FleetUpdateRunSpec{
+			StagedUpdateStrategyName: strategyName,
+			State:                    state,
+		},
+	}
+	for _, name := range placementNames {
+		run.Spec.Placements = append(run.Spec.Placements, placementv1beta1.FleetUpdateRunPlacement{PlacementName: name, ResourceSnapshotIndex: "1"})
+	}
+	return run
+}
+
+func memberUpdateRun(index int, placementName string, state placementv1beta1.State, owned bool, conditions ...metav1.Condition) *placementv1beta1.ClusterStagedUpdateRun {
+	run := &placementv1beta1.ClusterStagedUpdateRun{
+		ObjectMeta: metav1.ObjectMeta{Name: updateRunName(fleetUpdateRunName, index)},
+		Spec: placementv1beta1.UpdateRunSpec{
+			PlacementName:            placementName,
+			ResourceSnapshotIndex:    "1",
+			StagedUpdateStrategyName: strategyName,
+			State:                    state,
+		},
+		Status: placementv1beta1.UpdateRunStatus{Conditions: conditions},
+	}
+	if owned {
+		run.Labels = map[string]string{placementv1beta1.FleetUpdateRunLabel: fleetUpdateRunName}
+		run.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: placementv1beta1.GroupVersion.String(),
+			Kind:       placementv1beta1.ClusterFleetUpdateRunKind,
+			Name:       fleetUpdateRunName,
+			UID:        "fur-uid",
+			Controller: ptr.To(true),
+		}}
+	}
+	return run
+}
+
+func updateRunCondition(condType placementv1beta1.StagedUpdateRunConditionType, status metav1.ConditionStatus, reason, message string) metav1.Condition {
+	return metav1.Condition{Type: string(condType), Status: status, Reason: reason, Message: message}
+}
+
+// TestSetConditions tests the setConditions function.
+func TestSetConditions(t *testing.T) {
+	initialized := updateRunCondition(placementv1beta1.StagedUpdateRunConditionInitialized, metav1.ConditionTrue, condition.UpdateRunInitializeSucceededReason, "initialized")
+	progressing := updateRunCondition(placementv1beta1.StagedUpdateRunConditionProgressing, metav1.ConditionTrue, condition.UpdateRunProgressingReason, "progressing")
+	waiting := updateRunCondition(placementv1beta1.StagedUpdateRunConditionProgressing, metav1.ConditionFalse, condition.UpdateRunWaitingReason, "waiting")
+	succeeded := updateRunCondition(placementv1beta1.StagedUpdateRunConditionSucceeded, metav1.ConditionTrue, condition.UpdateRunSucceededReason, "succeeded")
+	failed := updateRunCondition(placementv1beta1.StagedUpdateRunConditionSucceeded, metav1.ConditionFalse, condition.UpdateRunFailedReason, "failed")
+
+	testCases := []struct {
+		name           string
+		updateRuns     []*placementv1beta1.ClusterStagedUpdateRun
+		syncErr        error
+		wantConditions []metav1.Condition
+	}{
+		{
+			name:       "not initialized yet",
+			updateRuns: []*placementv1beta1.ClusterStagedUpdateRun{memberUpdateRun(0, "crp-a", placementv1beta1.StateRun, true, initialized, progressing)},
+			wantConditions: []metav1.Condition{
+				{
+					Type: string(placementv1beta1.StagedUpdateRunConditionProgressing), Status: metav1.ConditionTrue, ObservedGeneration: 1,
+					Reason: condition.UpdateRunProgressingReason, Message: "updateRun `fur-1-0`: progressing",
+				},
+			},
+		},
+		{
+			name:    "failed to sync the updateRuns",
+			syncErr: controller.NewUserError(errors.New("conflict")),
+			wantConditions: []metav1.Condition{
+				{
+					Type: string(placementv1beta1.StagedUpdateRunConditionInitialized), Status: metav1.ConditionFalse, ObservedGeneration: 1,
+					Reason: condition.UpdateRunInitializeFailedReason, Message: controller.NewUserError(errors.New("conflict")).Error(),
+				},
+			},
+		},
+		{
+			name: "progressing while another updateRun is waiting",
+			updateRuns: []*placementv1beta1.ClusterStagedUpdateRun{
+				memberUpdateRun(0, "crp-a", placementv1beta1.StateRun, true, initialized, waiting),
+				memberUpdateRun(1, "crp-b", placementv1beta1.StateRun, true, initialized, progressing),
+			},
+			wantConditions: []metav1.Condition{
+				{
+					Type: string(placementv1beta1.StagedUpdateRunConditionInitialized), Status: metav1.ConditionTrue, ObservedGeneration: 1,
+					Reason: condition.UpdateRunInitializeSucceededReason, Message: "The Initialized condition of all the updateRuns is true",
+				},
+				{
+					Type: string(placementv1beta1.StagedUpdateRunConditionProgressing), Status: metav1.ConditionTrue, ObservedGeneration: 1,
+					Reason: condition.UpdateRunProgressingReason, Message: "updateRun `fur-1-1`: progressing",
+				},
+			},
+		},
+		{
+			name: "waiting",
+			updateRuns: []*placementv1beta1.ClusterStagedUpdateRun{
+				memberUpdateRun(0, "crp-a", placementv1beta1.StateRun, true, initialized, waiting),
+				memberUpdateRun(1, "crp-b", placementv1beta1.StateRun, true, initialized),
+			},
+			wantConditions: []metav1.Condition{
+				{
+					Type: string(placementv1beta1.StagedUpdateRunConditionInitialized), Status: metav1.ConditionTrue, ObservedGeneration: 1,
+					Reason: condition.UpdateRunInitializeSucceededReason, Message: "The Initialized condition of all the updateRuns is true",
+				},
+				{
+					Type: string(placementv1beta1.StagedUpdateRunConditionProgressing), Status: metav1.ConditionFalse, ObservedGeneration: 1,
+					Reason: condition.UpdateRunWaitingReason, Message: "updateRun `fur-1-0`: waiting",
+				},
+			},
+		},
+		{
+			name: "one of the updateRuns has failed",
+			updateRuns: []*placementv1beta1.ClusterStagedUpdateRun{
+				memberUpdateRun(0, "crp-a", placementv1beta1.StateRun, true, initialized, progressing),
+				memberUpdateRun(1, "crp-b", placementv1beta1.StateRun, true, initialized, failed),
+			},
+			wantConditions: []metav1.Condition{
+				{
+					Type: string(placementv1beta1.StagedUpdateRunConditionInitialized), Status: metav1.ConditionTrue, ObservedGeneration: 1,
+					Reason: condition.UpdateRunInitializeSucceededReason, Message: "The Initialized condition of all the updateRuns is true",
+				},
+				{
+					Type: string(placementv1beta1.StagedUpdateRunConditionSucceeded), Status: metav1.ConditionFalse, ObservedGeneration: 1,
+					Reason: condition.UpdateRunFailedReason, Message: "updateRun `fur-1-1`: failed",
+				},
+				{
+					Type: string(placementv1beta1.StagedUpdateRunConditionProgressing), Status: metav1.ConditionFalse, ObservedGeneration: 1,
+					Reason: condition.UpdateRunFailedReason, Message: "updateRun `fur-1-1`: failed",
+				},
+			},
+		},
+		{
+			name: "all the updateRuns have succeeded",
+			updateRuns: []*placementv1beta1.ClusterStagedUpdateRun{
+				memberUpdateRun(0, "crp-a", placementv1beta1.StateRun, true, initialized, succeeded),
+				memberUpdateRun(1, "crp-b", placementv1beta1.StateRun, true, initialized, succeeded),
+			},
+			wantConditions: []metav1.Condition{
+				{
+					Type: string(placementv1beta1.StagedUpdateRunConditionInitialized), Status: metav1.ConditionTrue, ObservedGeneration: 1,
+					Reason: condition.UpdateRunInitializeSucceededReason, Message: "The Initialized condition of all the updateRuns is true",
+				},
+				{
+					Type: string(placementv1beta1.StagedUpdateRunConditionSucceeded), Status: metav1.ConditionTrue, ObservedGeneration: 1,
+					Reason: condition.UpdateRunSucceededReason, Message: "The Succeeded condition of all the updateRuns is true",
+				},
+				{
+					Type: string(placementv1beta1.StagedUpdateRunConditionProgressing), Status: metav1.ConditionFalse, ObservedGeneration: 1,
+					Reason: condition.UpdateRunSucceededReason, Message: "The Succeeded condition of all the updateRuns is true",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			run := fleetUpdateRun(placementv1beta1.StateRun, "crp-a", "crp-b")
+			setConditions(run, tc.updateRuns, tc.syncErr)
+			if diff := cmp.Diff(run.Status.Conditions, tc.wantConditions, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("setConditions() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestReconcile tests the Reconcile function.
+func TestReconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add scheme: %v", err)
+	}
+
+	type runSummary struct {
+		Name      string
+		Placement string
+		State     placementv1beta1.State
+		Label     string
+	}
+	testCases := []struct {
+		name            string
+		objs            []client.Object
+		wantRuns        []runSummary
+		wantMemberNames []string
+		wantInitFailed  bool
+	}{
+		{
+			name: "create the updateRuns of the placements",
+			objs: []client.Object{fleetUpdateRun(placementv1beta1.StateInitialize, "crp-a", "crp-b")},
+			wantRuns: []runSummary{
+				{Name: "fur-1-0", Placement: "crp-a", State: placementv1beta1.StateInitialize, Label: fleetUpdateRunName},
+				{Name: "fur-1-1", Placement: "crp-b", State: placementv1beta1.StateInitialize, Label: fleetUpdateRunName},
+			},
+			wantMemberNames: []string{"fur-1-0", "fur-1-1"},
+		},
+		{
+			name: "propagate the state to the updateRuns",
+			objs: []client.Object{
+				fleetUpdateRun(placementv1beta1.StateRun, "crp-a", "crp-b"),
+				memberUpdateRun(0, "crp-a", placementv1beta1.StateInitialize, true),
+				memberUpdateRun(1, "crp-b", placementv1beta1.StateRun, true),
+			},
+			wantRuns: []runSummary{
+				{Name: "fur-1-0", Placement: "crp-a", State: placementv1beta1.StateRun, Label: fleetUpdateRunName},
+				{Name: "fur-1-1", Placement: "crp-b", State: placementv1beta1.StateRun, Label: fleetUpdateRunName},
+			},
+			wantMemberNames: []string{"fur-1-0", "fur-1-1"},
+		},
+		{
+			name: "updateRun with the same name is not created for the fleetUpdateRun",
+			objs: []client.Object{
+				fleetUpdateRun(placementv1beta1.StateRun, "crp-a", "crp-b"),
+				memberUpdateRun(1, "crp-b", placementv1beta1.StateInitialize, false),
+			},
+			wantRuns: []runSummary{
+				{Name: "fur-1-0", Placement: "crp-a", State: placementv1beta1.StateRun, Label: fleetUpdateRunName},
+				{Name: "fur-1-1", Placement: "crp-b", State: placementv1beta1.StateInitialize},
+			},
+			wantMemberNames: []string{"fur-1-0"},
+			wantInitFailed:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.objs...).
+				WithStatusSubresource(&placementv1beta1.ClusterFleetUpdateRun{}).
+				Build()
+			r := &Reconciler{Client: fakeClient, Scheme: scheme}
+			if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: fleetUpdateRunName}}); err != nil {
+				t.Fatalf("Reconcile() error = %v, want no error", err)
+			}
+
+			var runList placementv1beta1.ClusterStagedUpdateRunList
+			if err := fakeClient.List(context.Background(), &runList); err != nil {
+				t.Fatalf("List() error = %v, want no error", err)
+			}
+			var gotRuns []runSummary
+			for _, run := range runList.Items {
+				gotRuns = append(gotRuns, runSummary{
+					Name:      run.Name,
+					Placement: run.Spec.PlacementName,
+					State:     run.Spec.State,
+					Label:     run.Labels[placementv1beta1.FleetUpdateRunLabel],
+				})
+			}
+			if diff := cmp.Diff(gotRuns, tc.wantRuns); diff != "" {
+				t.Errorf("update runs mismatch (-got, +want):\n%s", diff)
+			}
+
+			var gotFleetUpdateRun placementv1beta1.ClusterFleetUpdateRun
+			if err := fakeClient.Get(context.Background(), types.NamespacedName{Name: fleetUpdateRunName}, &gotFleetUpdateRun); err != nil {
+				t.Fatalf("Get() error = %v, want no error", err)
+			}
+			var gotMemberNames []string
+			for _, member := range gotFleetUpdateRun.Status.UpdateRuns {
+				gotMemberNames = append(gotMemberNames, member.UpdateRunName)
+			}
+			if diff := cmp.Diff(gotMemberNames, tc.wantMemberNames); diff != "" {
+				t.Errorf("status updateRuns mismatch (-got, +want):\n%s", diff)
+			}
+			initCond := gotFleetUpdateRun.GetCondition(string(placementv1beta1.StagedUpdateRunConditionInitialized))
+			if gotInitFailed := initCond != nil && initCond.Status == metav1.ConditionFalse; gotInitFailed != tc.wantInitFailed {
+				t.Errorf("Initialized condition = %v, want failed %t", initCond, tc.wantInitFailed)
+			}
+		})
+	}
+}
@@ -37,7 +37,10 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	sharedmetrics "github.com/kubefleet-dev/kubefleet/pkg/metrics/shared"
@@ -700,3 +703,13 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, name string) error {
 		For(&clusterv1beta1.InternalMemberCluster{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Complete(r)
 }
+
+// SetupWithManagerAndEventSource sets up the controller with a channel of InternalMemberCluster
+// object events rather than a watch on the hub cluster; this is used when the member agent reaches
+// the hub cluster via the pull-based hub transport.
+func (r *Reconciler) SetupWithManagerAndEventSource(mgr ctrl.Manager, name string, imcEvents <-chan event.GenericEvent) error {
+	r.recorder = mgr.GetEventRecorderFor("v1beta1InternalMemberClusterController")
+	return ctrl.NewControllerManagedBy(mgr).Named(name).
+		WatchesRawSource(source.Channel(imcEvents, &handler.EnqueueRequestForObject{})).
+		Complete(r)
+}
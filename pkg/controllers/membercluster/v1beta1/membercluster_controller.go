@@ -75,6 +75,10 @@ type Reconciler struct {
 	MaxConcurrentReconciles int
 	// the wait time in minutes before we force delete a member cluster.
 	ForceDeleteWaitTime time.Duration
+	// the window over which the property history of a member cluster is kept; zero disables the property history.
+	PropertyHistoryWindow time.Duration
+	// the max number of samples kept in the property history for each property.
+	PropertyHistoryMaxSamples int
 	// agents are used as hashset to query the expected agent type, so the value will be ignored.
 	agents map[clusterv1beta1.AgentType]bool
 }
@@ -121,8 +125,25 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 		return runtime.Result{}, err
 	}
 
+	// Keep the aggregates over the property history currently exposed, as copying the status from the
+	// InternalMemberCluster drops them.
+	currentAggregates := propertyHistoryAggregates(&mc)
+
 	// Copy status from InternalMemberCluster to MemberCluster.
 	r.syncInternalMemberClusterStatus(currentIMC, &mc)
+
+	// Record the properties in the property history and expose the aggregates, if enabled.
+	//
+	// A failure here does not block the status update, so that the heartbeats are still reflected;
+	// the aggregates currently exposed are kept as they are, and the error is returned after the
+	// status update for a retry.
+	var propertyHistoryErr error
+	if r.PropertyHistoryWindow > 0 {
+		if propertyHistoryErr = r.syncPropertyHistory(ctx, &mc); propertyHistoryErr != nil {
+			klog.ErrorS(propertyHistoryErr, "Failed to sync the property history", "memberCluster", mcObjRef)
+			setProperties(&mc, currentAggregates)
+		}
+	}
 	if err := r.updateMemberClusterStatus(ctx, &mc); err != nil {
 		if apierrors.IsConflict(err) {
 			klog.V(2).InfoS("Failed to update status due to conflicts", "memberCluster", mcObjRef)
@@ -132,7 +153,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 		return runtime.Result{}, client.IgnoreNotFound(err)
 	}
 
-	return runtime.Result{}, nil
+	return runtime.Result{}, propertyHistoryErr
 }
 
 // handleDelete handles the delete event of the member cluster, makes sure the agent has finished leaving the fleet first and
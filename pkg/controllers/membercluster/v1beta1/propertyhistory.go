@@ -0,0 +1,273 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// maxPropertyHistoryTotalSamples is the maximum number of samples kept in the property history of a member
+// cluster across all the properties, which keeps the size of the ClusterPropertyHistory object well below the
+// size limit of etcd objects (each sample takes about 60 bytes); when a member cluster reports many properties,
+// fewer samples are kept for each property.
+var maxPropertyHistoryTotalSamples = 10000
+
+// propertyHistoryPercentiles are the percentiles exposed as aggregates over the history window.
+var propertyHistoryPercentiles = map[string]float64{
+	propertyprovider.P50AggregateName: 50,
+	propertyprovider.P95AggregateName: 95,
+}
+
+// syncPropertyHistory records the latest properties observed for a member cluster in its property
+// history, and adds the aggregates over the history window to the properties of the member cluster.
+func (r *Reconciler) syncPropertyHistory(ctx context.Context, mc *clusterv1beta1.MemberCluster) error {
+	mcObjRef := klog.KObj(mc)
+	now := time.Now()
+	observed := observedPropertySamples(mc)
+
+	history := &clusterv1beta1.ClusterPropertyHistory{}
+	err := r.Client.Get(ctx, types.NamespacedName{Name: mc.Name}, history)
+	switch {
+	case apierrors.IsNotFound(err):
+		history = &clusterv1beta1.ClusterPropertyHistory{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            mc.Name,
+				OwnerReferences: []metav1.OwnerReference{*toOwnerReference(mc)},
+			},
+		}
+		recordPropertySamples(history, observed, now, r.PropertyHistoryWindow, r.PropertyHistoryMaxSamples)
+		if err := r.Client.Create(ctx, history); err != nil {
+			return controller.NewAPIServerError(false, fmt.Errorf("failed to create the property history %s: %w", klog.KObj(history), err))
+		}
+		klog.V(2).InfoS("Created the property history", "memberCluster", mcObjRef, "clusterPropertyHistory", klog.KObj(history))
+	case err != nil:
+		return controller.NewAPIServerError(true, fmt.Errorf("failed to get the property history %s: %w", mc.Name, err))
+	default:
+		if recordPropertySamples(history, observed, now, r.PropertyHistoryWindow, r.PropertyHistoryMaxSamples) {
+			if err := r.Client.Update(ctx, history); err != nil {
+				return controller.NewUpdateIgnoreConflictError(fmt.Errorf("failed to update the property history %s: %w", klog.KObj(history), err))
+			}
+			klog.V(4).InfoS("Updated the property history", "memberCluster", mcObjRef, "clusterPropertyHistory", klog.KObj(history))
+		}
+	}
+
+	addPropertyHistoryAggregates(mc, history)
+	return nil
+}
+
+// observedPropertySamples returns the latest properties observed for a member cluster, resource or
+// non-resource, whose values are quantities.
+func observedPropertySamples(mc *clusterv1beta1.MemberCluster) map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue {
+	samples := make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue)
+	for name, value := range mc.Status.Properties {
+		if strings.HasPrefix(string(name), propertyprovider.PropertyHistoryNamePrefix) {
+			// Do not record the aggregates themselves.
+			continue
+		}
+		if _, err := resource.ParseQuantity(value.Value); err != nil {
+			// Skip the properties that cannot be aggregated, e.g., the Kubernetes version.
+			continue
+		}
+		samples[name] = value
+	}
+
+	usage := mc.Status.ResourceUsage
+	if usage.ObservationTime.IsZero() {
+		return samples
+	}
+	capacities := map[string]corev1.ResourceList{
+		propertyprovider.TotalCapacityName:       usage.Capacity,
+		propertyprovider.AllocatableCapacityName: usage.Allocatable,
+		propertyprovider.AvailableCapacityName:   usage.Available,
+	}
+	for capacityName, resources := range capacities {
+		for resourceName, q := range resources {
+			// Skip the resources that cannot be referred to in a resource property name, as the
+			// format of such names is `[PREFIX]/[CAPACITY_TYPE]-[RESOURCE_NAME]`.
+			if strings.ContainsAny(string(resourceName), "-/") {
+				continue
+			}
+			name := fmt.Sprintf("%s%s-%s", propertyprovider.ResourcePropertyNamePrefix, capacityName, resourceName)
+			samples[clusterv1beta1.PropertyName(name)] = clusterv1beta1.PropertyValue{
+				Value:           q.String(),
+				ObservationTime: usage.ObservationTime,
+			}
+		}
+	}
+	return samples
+}
+
+// recordPropertySamples appends the newly observed property values to a property history, and
+// drops the samples that fall out of the history window or exceed the maximum number of samples
+// per property; it returns true if the property history has changed.
+//
+// The samples are spread evenly over the history window: a value is recorded only if it is observed
+// at least window/maxSamples after the last recorded sample, as the member agent refreshes the
+// observation time every time it collects the properties (e.g., with every heartbeat).
+func recordPropertySamples(
+	history *clusterv1beta1.ClusterPropertyHistory,
+	observed map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue,
+	now time.Time, window time.Duration, maxSamples int,
+) bool {
+	changed := false
+	if history.Properties == nil {
+		history.Properties = make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyHistory)
+	}
+
+	// Bound the total number of samples across all the properties.
+	propertyCount := len(history.Properties)
+	for name := range observed {
+		if _, ok := history.Properties[name]; !ok {
+			propertyCount++
+		}
+	}
+	if propertyCount > 0 {
+		maxSamples = max(min(maxSamples, maxPropertyHistoryTotalSamples/propertyCount), 1)
+	}
+	sampleInterval := window / time.Duration(maxSamples)
+
+	for name, value := range observed {
+		samples := history.Properties[name].Samples
+		if len(samples) > 0 && value.ObservationTime.Sub(samples[len(samples)-1].ObservationTime.Time) < sampleInterval {
+			continue
+		}
+		history.Properties[name] = clusterv1beta1.PropertyHistory{Samples: append(samples, value)}
+		changed = true
+	}
+
+	cutoff := now.Add(-window)
+	for name, h := range history.Properties {
+		samples := h.Samples
+		start := 0
+		for start < len(samples) && samples[start].ObservationTime.Time.Before(cutoff) {
+			start++
+		}
+		if len(samples)-start > maxSamples {
+			start = len(samples) - maxSamples
+		}
+		switch {
+		case start == 0:
+			continue
+		case start == len(samples):
+			// All the samples have fallen out of the window, e.g., the property is no longer reported.
+			delete(history.Properties, name)
+		default:
+			history.Properties[name] = clusterv1beta1.PropertyHistory{Samples: samples[start:]}
+		}
+		changed = true
+	}
+	return changed
+}
+
+// addPropertyHistoryAggregates adds the aggregates over the property history to the properties of
+// a member cluster.
+func addPropertyHistoryAggregates(mc *clusterv1beta1.MemberCluster, history *clusterv1beta1.ClusterPropertyHistory) {
+	aggregates := make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue)
+	for name, h := range history.Properties {
+		for aggregateName, value := range aggregatePropertySamples(h.Samples) {
+			pName := fmt.Sprintf(propertyprovider.PropertyHistoryAggregatePropertyTmpl, aggregateName, name)
+			aggregates[clusterv1beta1.PropertyName(pName)] = value
+		}
+	}
+	setProperties(mc, aggregates)
+}
+
+// propertyHistoryAggregates returns the aggregates over the property history among the properties
+// of a member cluster.
+func propertyHistoryAggregates(mc *clusterv1beta1.MemberCluster) map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue {
+	aggregates := make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue)
+	for name, value := range mc.Status.Properties {
+		if strings.HasPrefix(string(name), propertyprovider.PropertyHistoryNamePrefix) {
+			aggregates[name] = value
+		}
+	}
+	return aggregates
+}
+
+// setProperties sets the given properties among the properties of a member cluster.
+func setProperties(mc *clusterv1beta1.MemberCluster, toSet map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue) {
+	if len(toSet) == 0 {
+		return
+	}
+	// Make a copy of the properties, as the map might be shared with the InternalMemberCluster object.
+	properties := make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue, len(mc.Status.Properties)+len(toSet))
+	for name, value := range mc.Status.Properties {
+		properties[name] = value
+	}
+	for name, value := range toSet {
+		properties[name] = value
+	}
+	mc.Status.Properties = properties
+}
+
+// aggregatePropertySamples calculates the aggregates over the samples of a property, keyed by the
+// aggregate names; the value of each aggregate is one of the samples, observed at the time of the
+// latest sample.
+func aggregatePropertySamples(samples []clusterv1beta1.PropertyValue) map[string]clusterv1beta1.PropertyValue {
+	type sample struct {
+		q     resource.Quantity
+		value string
+	}
+	parsed := make([]sample, 0, len(samples))
+	var observationTime metav1.Time
+	for _, s := range samples {
+		q, err := resource.ParseQuantity(s.Value)
+		if err != nil {
+			continue
+		}
+		parsed = append(parsed, sample{q: q, value: s.Value})
+		if s.ObservationTime.After(observationTime.Time) {
+			observationTime = s.ObservationTime
+		}
+	}
+	if len(parsed) == 0 {
+		return nil
+	}
+	sort.SliceStable(parsed, func(i, j int) bool {
+		return parsed[i].q.Cmp(parsed[j].q) < 0
+	})
+
+	aggregates := map[string]clusterv1beta1.PropertyValue{
+		propertyprovider.MinAggregateName: {Value: parsed[0].value, ObservationTime: observationTime},
+		propertyprovider.MaxAggregateName: {Value: parsed[len(parsed)-1].value, ObservationTime: observationTime},
+	}
+	for aggregateName, p := range propertyHistoryPercentiles {
+		// Use the nearest-rank method, so that the percentile is always one of the samples.
+		rank := int(math.Ceil(p / 100 * float64(len(parsed))))
+		if rank < 1 {
+			rank = 1
+		}
+		aggregates[aggregateName] = clusterv1beta1.PropertyValue{Value: parsed[rank-1].value, ObservationTime: observationTime}
+	}
+	return aggregates
+}
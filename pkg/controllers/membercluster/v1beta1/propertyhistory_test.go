@@ -0,0 +1,455 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+)
+
+const (
+	nodeCountPropertyName = clusterv1beta1.PropertyName(propertyprovider.NodeCountProperty)
+)
+
+func propertySample(value string, observedAt time.Time) clusterv1beta1.PropertyValue {
+	return clusterv1beta1.PropertyValue{Value: value, ObservationTime: metav1.NewTime(observedAt)}
+}
+
+func aggregatePropertyName(aggregate string, name clusterv1beta1.PropertyName) clusterv1beta1.PropertyName {
+	return clusterv1beta1.PropertyName(propertyprovider.PropertyHistoryNamePrefix + aggregate + "/" + string(name))
+}
+
+func TestObservedPropertySamples(t *testing.T) {
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+	mc := &clusterv1beta1.MemberCluster{
+		Status: clusterv1beta1.MemberClusterStatus{
+			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				nodeCountPropertyName:                 {Value: "3", ObservationTime: now},
+				propertyprovider.K8sVersionProperty:   {Value: "v1.30.1", ObservationTime: now},
+				aggregatePropertyName("min", "count"): {Value: "1", ObservationTime: now},
+			},
+			ResourceUsage: clusterv1beta1.ResourceUsage{
+				Available: corev1.ResourceList{
+					corev1.ResourceCPU:              resource.MustParse("1500m"),
+					corev1.ResourceEphemeralStorage: resource.MustParse("10Gi"),
+					"nvidia.com/gpu":                resource.MustParse("2"),
+				},
+				ObservationTime: now,
+			},
+		},
+	}
+
+	want := map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+		nodeCountPropertyName:                         {Value: "3", ObservationTime: now},
+		propertyprovider.AvailableCPUCapacityProperty: {Value: "1500m", ObservationTime: now},
+	}
+	if diff := cmp.Diff(observedPropertySamples(mc), want); diff != "" {
+		t.Errorf("observedPropertySamples() diff (-got, +want):\n%s", diff)
+	}
+}
+
+func TestRecordPropertySamples(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	window := 10 * time.Minute
+
+	testCases := []struct {
+		name            string
+		history         map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyHistory
+		observed        map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue
+		maxSamples      int
+		maxTotalSamples int
+		want            map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyHistory
+		wantChanged     bool
+	}{
+		{
+			name: "new property",
+			observed: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				nodeCountPropertyName: propertySample("3", now),
+			},
+			maxSamples: 10,
+			want: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyHistory{
+				nodeCountPropertyName: {Samples: []clusterv1beta1.PropertyValue{propertySample("3", now)}},
+			},
+			wantChanged: true,
+		},
+		{
+			name: "same observation",
+			history: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyHistory{
+				nodeCountPropertyName: {Samples: []clusterv1beta1.PropertyValue{propertySample("3", now)}},
+			},
+			observed: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				nodeCountPropertyName: propertySample("3", now),
+			},
+			maxSamples: 10,
+			want: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyHistory{
+				nodeCountPropertyName: {Samples: []clusterv1beta1.PropertyValue{propertySample("3", now)}},
+			},
+		},
+		{
+			name: "samples out of the window",
+			history: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyHistory{
+				nodeCountPropertyName: {Samples: []clusterv1beta1.PropertyValue{
+					propertySample("1", now.Add(-20*time.Minute)),
+					propertySample("2", now.Add(-5*time.Minute)),
+				}},
+				"no-longer-reported": {Samples: []clusterv1beta1.PropertyValue{
+					propertySample("1", now.Add(-20*time.Minute)),
+				}},
+			},
+			observed: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				nodeCountPropertyName: propertySample("3", now),
+			},
+			maxSamples: 10,
+			want: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyHistory{
+				nodeCountPropertyName: {Samples: []clusterv1beta1.PropertyValue{
+					propertySample("2", now.Add(-5*time.Minute)),
+					propertySample("3", now),
+				}},
+			},
+			wantChanged: true,
+		},
+		{
+			name: "too many samples",
+			history: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyHistory{
+				nodeCountPropertyName: {Samples: []clusterv1beta1.PropertyValue{
+					propertySample("1", now.Add(-9*time.Minute)),
+					propertySample("2", now.Add(-6*time.Minute)),
+				}},
+			},
+			observed: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				nodeCountPropertyName: propertySample("3", now),
+			},
+			maxSamples: 2,
+			want: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyHistory{
+				nodeCountPropertyName: {Samples: []clusterv1beta1.PropertyValue{
+					propertySample("2", now.Add(-6*time.Minute)),
+					propertySample("3", now),
+				}},
+			},
+			wantChanged: true,
+		},
+		{
+			name: "observed within the sample interval",
+			history: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyHistory{
+				nodeCountPropertyName: {Samples: []clusterv1beta1.PropertyValue{
+					propertySample("2", now.Add(-30*time.Second)),
+				}},
+			},
+			observed: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				nodeCountPropertyName: propertySample("3", now),
+			},
+			// The sample interval is 10m/10 = 1m.
+			maxSamples: 10,
+			want: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyHistory{
+				nodeCountPropertyName: {Samples: []clusterv1beta1.PropertyValue{
+					propertySample("2", now.Add(-30*time.Second)),
+				}},
+			},
+		},
+		{
+			name: "total number of samples bounded across properties",
+			history: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyHistory{
+				nodeCountPropertyName: {Samples: []clusterv1beta1.PropertyValue{
+					propertySample("1", now.Add(-8*time.Minute)),
+					propertySample("2", now.Add(-4*time.Minute)),
+				}},
+			},
+			observed: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				nodeCountPropertyName:    propertySample("3", now),
+				"example.com/zone-count": propertySample("1", now),
+			},
+			// With a total of 4 samples across 2 properties, each property keeps at most 2 samples,
+			// i.e., one sample every 5 minutes.
+			maxSamples:      10,
+			maxTotalSamples: 4,
+			want: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyHistory{
+				nodeCountPropertyName: {Samples: []clusterv1beta1.PropertyValue{
+					propertySample("1", now.Add(-8*time.Minute)),
+					propertySample("2", now.Add(-4*time.Minute)),
+				}},
+				"example.com/zone-count": {Samples: []clusterv1beta1.PropertyValue{
+					propertySample("1", now),
+				}},
+			},
+			wantChanged: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.maxTotalSamples != 0 {
+				original := maxPropertyHistoryTotalSamples
+				maxPropertyHistoryTotalSamples = tc.maxTotalSamples
+				defer func() { maxPropertyHistoryTotalSamples = original }()
+			}
+			history := &clusterv1beta1.ClusterPropertyHistory{Properties: tc.history}
+			gotChanged := recordPropertySamples(history, tc.observed, now, window, tc.maxSamples)
+			if gotChanged != tc.wantChanged {
+				t.Errorf("recordPropertySamples() = %t, want %t", gotChanged, tc.wantChanged)
+			}
+			if diff := cmp.Diff(history.Properties, tc.want); diff != "" {
+				t.Errorf("recordPropertySamples() property history diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAggregatePropertySamples(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	testCases := []struct {
+		name    string
+		samples []clusterv1beta1.PropertyValue
+		want    map[string]clusterv1beta1.PropertyValue
+	}{
+		{
+			name: "no samples",
+		},
+		{
+			name: "single sample",
+			samples: []clusterv1beta1.PropertyValue{
+				propertySample("2", now),
+			},
+			want: map[string]clusterv1beta1.PropertyValue{
+				propertyprovider.MinAggregateName: propertySample("2", now),
+				propertyprovider.MaxAggregateName: propertySample("2", now),
+				propertyprovider.P50AggregateName: propertySample("2", now),
+				propertyprovider.P95AggregateName: propertySample("2", now),
+			},
+		},
+		{
+			name: "multiple samples",
+			samples: []clusterv1beta1.PropertyValue{
+				propertySample("1500m", now.Add(-4*time.Minute)),
+				propertySample("4", now.Add(-3*time.Minute)),
+				propertySample("500m", now.Add(-2*time.Minute)),
+				propertySample("2", now.Add(-time.Minute)),
+				propertySample("invalid", now),
+			},
+			want: map[string]clusterv1beta1.PropertyValue{
+				propertyprovider.MinAggregateName: propertySample("500m", now.Add(-time.Minute)),
+				propertyprovider.MaxAggregateName: propertySample("4", now.Add(-time.Minute)),
+				propertyprovider.P50AggregateName: propertySample("1500m", now.Add(-time.Minute)),
+				propertyprovider.P95AggregateName: propertySample("4", now.Add(-time.Minute)),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(aggregatePropertySamples(tc.samples), tc.want); diff != "" {
+				t.Errorf("aggregatePropertySamples() diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSyncPropertyHistory(t *testing.T) {
+	observedAt := time.Now().Truncate(time.Second)
+	mc := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "mc1", UID: "mc1-UID"},
+		Status: clusterv1beta1.MemberClusterStatus{
+			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				nodeCountPropertyName: propertySample("3", observedAt),
+			},
+		},
+	}
+	notFoundErr := apierrors.NewNotFound(schema.GroupResource{Group: clusterv1beta1.GroupVersion.Group, Resource: "clusterpropertyhistories"}, "mc1")
+
+	testCases := []struct {
+		name           string
+		client         client.Client
+		wantProperties map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue
+		wantErrMsg     string
+	}{
+		{
+			name: "property history gets created",
+			client: &test.MockClient{
+				MockGet:    test.NewMockGetFn(notFoundErr),
+				MockCreate: test.NewMockCreateFn(nil),
+			},
+			wantProperties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				nodeCountPropertyName:                               propertySample("3", observedAt),
+				aggregatePropertyName("min", nodeCountPropertyName): propertySample("3", observedAt),
+				aggregatePropertyName("max", nodeCountPropertyName): propertySample("3", observedAt),
+				aggregatePropertyName("p50", nodeCountPropertyName): propertySample("3", observedAt),
+				aggregatePropertyName("p95", nodeCountPropertyName): propertySample("3", observedAt),
+			},
+		},
+		{
+			name: "property history gets updated",
+			client: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					history := obj.(*clusterv1beta1.ClusterPropertyHistory)
+					history.Name = "mc1"
+					history.Properties = map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyHistory{
+						nodeCountPropertyName: {Samples: []clusterv1beta1.PropertyValue{propertySample("1", observedAt.Add(-time.Minute))}},
+					}
+					return nil
+				}),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			wantProperties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				nodeCountPropertyName:                               propertySample("3", observedAt),
+				aggregatePropertyName("min", nodeCountPropertyName): propertySample("1", observedAt),
+				aggregatePropertyName("max", nodeCountPropertyName): propertySample("3", observedAt),
+				aggregatePropertyName("p50", nodeCountPropertyName): propertySample("1", observedAt),
+				aggregatePropertyName("p95", nodeCountPropertyName): propertySample("3", observedAt),
+			},
+		},
+		{
+			name: "property history get error",
+			client: &test.MockClient{
+				MockGet: test.NewMockGetFn(errors.New("get error")),
+			},
+			wantErrMsg: "failed to get the property history",
+		},
+		{
+			name: "property history create error",
+			client: &test.MockClient{
+				MockGet:    test.NewMockGetFn(notFoundErr),
+				MockCreate: test.NewMockCreateFn(errors.New("create error")),
+			},
+			wantErrMsg: "failed to create the property history",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Reconciler{
+				Client:                    tc.client,
+				PropertyHistoryWindow:     time.Hour,
+				PropertyHistoryMaxSamples: 100,
+			}
+			gotMC := mc.DeepCopy()
+			err := r.syncPropertyHistory(context.Background(), gotMC)
+			if tc.wantErrMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrMsg) {
+					t.Fatalf("syncPropertyHistory() = %v, want error containing %s", err, tc.wantErrMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("syncPropertyHistory() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(gotMC.Status.Properties, tc.wantProperties); diff != "" {
+				t.Errorf("syncPropertyHistory() properties diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReconcileKeepsPropertyHistoryAggregatesOnFailure(t *testing.T) {
+	observedAt := time.Now().Truncate(time.Second)
+	currentAggregates := map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+		aggregatePropertyName("min", nodeCountPropertyName): propertySample("1", observedAt.Add(-time.Minute)),
+		aggregatePropertyName("max", nodeCountPropertyName): propertySample("2", observedAt.Add(-time.Minute)),
+	}
+	mc := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "mc1",
+			UID:        "mc1-UID",
+			Finalizers: []string{placementv1beta1.MemberClusterFinalizer},
+			Labels:     map[string]string{placementv1beta1.MemberNameLabel: "mc1"},
+		},
+		Spec: clusterv1beta1.MemberClusterSpec{HeartbeatPeriodSeconds: 60},
+		Status: clusterv1beta1.MemberClusterStatus{
+			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				nodeCountPropertyName: propertySample("2", observedAt.Add(-time.Minute)),
+			},
+		},
+	}
+	for name, value := range currentAggregates {
+		mc.Status.Properties[name] = value
+	}
+	imc := &clusterv1beta1.InternalMemberCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "mc1", Namespace: fmt.Sprintf(utils.NamespaceNameFormat, "mc1")},
+		Status: clusterv1beta1.InternalMemberClusterStatus{
+			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				nodeCountPropertyName: propertySample("3", observedAt),
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = rbacv1.AddToScheme(scheme)
+	_ = clusterv1beta1.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(mc, imc).
+		WithStatusSubresource(mc, imc).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if _, ok := obj.(*clusterv1beta1.ClusterPropertyHistory); ok {
+					return errors.New("get error")
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).
+		Build()
+	r := &Reconciler{
+		Client:                    fakeClient,
+		recorder:                  record.NewFakeRecorder(10),
+		PropertyHistoryWindow:     time.Hour,
+		PropertyHistoryMaxSamples: 100,
+	}
+	if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKey{Name: "mc1"}}); err == nil || !strings.Contains(err.Error(), "failed to get the property history") {
+		t.Fatalf("Reconcile() = %v, want error containing %s", err, "failed to get the property history")
+	}
+
+	gotMC := &clusterv1beta1.MemberCluster{}
+	if err := fakeClient.Get(context.Background(), client.ObjectKey{Name: "mc1"}, gotMC); err != nil {
+		t.Fatalf("Get() = %v, want no error", err)
+	}
+	wantProperties := map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+		nodeCountPropertyName: propertySample("3", observedAt),
+	}
+	for name, value := range currentAggregates {
+		wantProperties[name] = value
+	}
+	if diff := cmp.Diff(gotMC.Status.Properties, wantProperties); diff != "" {
+		t.Errorf("Reconcile() properties diff (-got, +want):\n%s", diff)
+	}
+	if _, ok := imc.Status.Properties[aggregatePropertyName("min", nodeCountPropertyName)]; ok {
+		t.Errorf("Reconcile() added the aggregates to the properties of the InternalMemberCluster")
+	}
+}
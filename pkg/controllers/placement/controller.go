@@ -297,7 +297,8 @@ func (r *Reconciler) handleUpdate(ctx context.Context, placementObj fleetv1beta1
 }
 
 // handleResourceSnapshotByStrategy handles resource snapshot resolution based on rollout strategy.
-// For External rollout strategy, it only fetches the existing snapshot (can be nil).
+// For External rollout strategy, it only fetches the existing snapshot (can be nil), unless the update runs
+// are created automatically, in which case the placement controller creates the resource snapshots.
 // For other strategies, it creates or gets a resource snapshot and may update selectedResourceIDs if requeue is needed.
 func (r *Reconciler) handleResourceSnapshotByStrategy(
 	ctx context.Context,
@@ -312,7 +313,9 @@ func (r *Reconciler) handleResourceSnapshotByStrategy(
 
 	// For External rollout strategy, the placement controller should not create new resource snapshots.
 	// The external controller (e.g., UpdateRun controller) is responsible for creating them.
-	if placementSpec.Strategy.Type == fleetv1beta1.ExternalRolloutStrategyType {
+	// When the update runs are created automatically, the new resource snapshots are what trigger them, so the
+	// placement controller keeps creating the snapshots as it does for the other strategies.
+	if placementSpec.Strategy.Type == fleetv1beta1.ExternalRolloutStrategyType && placementSpec.Strategy.AutoUpdateRun == nil {
 		// latestResourceSnapshot is nil for External strategy - the external controller will create it.
 		klog.V(2).InfoS("Using external rollout strategy, skipping resource snapshot creation", "placement", placementKObj)
 		return ctrl.Result{}, nil, selectedResourceIDs, nil
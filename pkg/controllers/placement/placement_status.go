@@ -373,6 +373,11 @@ func (r *Reconciler) setPerClusterPlacementStatus(
 			Reason:             condition.RolloutNotStartedYetReason,
 			Message:            "The rollout is being blocked by the rollout strategy",
 		}
+		if rolloutStartedCond.Reason == condition.RolloutWaitingForMaintenanceWindowReason {
+			// Surface why the cluster is waiting, so that users can tell it apart from the rollout strategy constraints.
+			cond.Reason = rolloutStartedCond.Reason
+			cond.Message = rolloutStartedCond.Message
+		}
 		meta.SetStatusCondition(&status.Conditions, cond)
 		res[condition.RolloutStartedCondition] = metav1.ConditionFalse
 		return res
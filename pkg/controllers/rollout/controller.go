@@ -29,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
@@ -39,15 +40,22 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	bindingutils "github.com/kubefleet-dev/kubefleet/pkg/utils/binding"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/defaulter"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/informer"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/maintenancewindow"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/overrider"
 )
 
+// maintenanceWindowRecheckInterval is how often the bindings held back by the maintenance windows of their clusters
+// are checked again at most; changes to the ClusterMaintenanceWindow objects trigger a recheck right away, but changes
+// to the labels of member clusters, which may select them into or out of the maintenance windows, do not.
+var maintenanceWindowRecheckInterval = 5 * time.Minute
+
 // Reconciler recomputes the cluster resource binding.
 type Reconciler struct {
 	client.Client
@@ -223,9 +231,34 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 	// We need to requeue the request regardless if the binding updates succeed or not
 	// to avoid the case that the rollout process stalling because the time based binding readiness does not trigger any event.
 	// Wait the time we need to wait for the first applied but not ready binding to be ready
+	// or for the first cluster held back by its maintenance windows to be updatable, whichever comes first.
+	if maintenanceWindowWaitTime, found := calculateMaintenanceWindowWaitTime(staleBoundBindings, time.Now()); found && (waitTime == 0 || maintenanceWindowWaitTime < waitTime) {
+		waitTime = maintenanceWindowWaitTime
+	}
 	return runtime.Result{Requeue: true, RequeueAfter: waitTime}, r.updateBindings(ctx, toBeUpdatedBindings)
 }
 
+// calculateMaintenanceWindowWaitTime returns how long to wait until the first binding held back by the maintenance
+// windows of its cluster may be updated, and whether any binding is held back at all.
+func calculateMaintenanceWindowWaitTime(staleBindings []toBeUpdatedBinding, now time.Time) (time.Duration, bool) {
+	found := false
+	var minWaitTime time.Duration
+	for _, binding := range staleBindings {
+		if binding.outsideMaintenanceWindow == nil {
+			continue
+		}
+		waitTime := maintenanceWindowRecheckInterval
+		if nextOpenTime := binding.outsideMaintenanceWindow.nextOpenTime; !nextOpenTime.IsZero() {
+			waitTime = min(max(nextOpenTime.Sub(now), time.Second), maintenanceWindowRecheckInterval)
+		}
+		if !found || waitTime < minWaitTime {
+			minWaitTime = waitTime
+			found = true
+		}
+	}
+	return minWaitTime, found
+}
+
 func (r *Reconciler) checkAndUpdateStaleBindingsStatus(ctx context.Context, bindings []placementv1beta1.BindingObj) error {
 	if len(bindings) == 0 {
 		return nil
@@ -309,6 +342,17 @@ func waitForResourcesToCleanUp(allBindings []placementv1beta1.BindingObj, placem
 type toBeUpdatedBinding struct {
 	currentBinding placementv1beta1.BindingObj
 	desiredBinding placementv1beta1.BindingObj // only valid for scheduled or bound binding
+	// outsideMaintenanceWindow is set when the binding is held back because its target cluster is outside its maintenance windows.
+	outsideMaintenanceWindow *maintenanceWindowHold
+	// rolloutStepHold is set to why the binding is held back by the rollout steps of the placement, if it is.
+	rolloutStepHold string
+}
+
+// maintenanceWindowHold describes why a binding is held back by the maintenance windows of its target cluster.
+type maintenanceWindowHold struct {
+	message string
+	// nextOpenTime is the zero time if it's unknown when the maintenance windows open.
+	nextOpenTime time.Time
 }
 
 func createUpdateInfo(binding placementv1beta1.BindingObj,
@@ -379,6 +423,15 @@ func (r *Reconciler) pickBindingsToRoll(
 	// resource/override snapshots, but might or might not have the refresh status information.
 	upToDateBoundBindings := make([]toBeUpdatedBinding, 0)
 
+	// Those are the bindings that need to be updated but are held back because their target clusters are outside
+	// their maintenance windows.
+	outsideMaintenanceWindowBindings := make([]toBeUpdatedBinding, 0)
+	maintenanceWindows, err := maintenancewindow.ListClusterMaintenanceWindows(ctx, r.Client)
+	if err != nil {
+		return nil, nil, nil, false, 0, err
+	}
+	now := time.Now()
+
 	// calculate the cutoff time for a binding to be applied before so that it can be considered ready
 	placementSpec := placementObj.GetPlacementSpec()
 	readyTimeCutOff := time.Now().Add(-time.Duration(*placementSpec.Strategy.RollingUpdate.UnavailablePeriodSeconds) * time.Second)
@@ -428,7 +481,16 @@ func (r *Reconciler) pickBindingsToRoll(
 			if err != nil {
 				return nil, nil, nil, false, minWaitTime, err
 			}
-			boundingCandidates = append(boundingCandidates, createUpdateInfo(binding, masterResourceSnapshot, cro, ro))
+			updateInfo := createUpdateInfo(binding, masterResourceSnapshot, cro, ro)
+			if updateInfo.outsideMaintenanceWindow, err = r.checkMaintenanceWindow(ctx, maintenanceWindows, bindingSpec.TargetCluster, now); err != nil {
+				return nil, nil, nil, false, minWaitTime, err
+			}
+			if updateInfo.outsideMaintenanceWindow != nil {
+				klog.V(2).InfoS("Found a scheduled binding whose cluster is outside its maintenance windows", "placement", placementKObj, "binding", bindingKObj)
+				outsideMaintenanceWindowBindings = append(outsideMaintenanceWindowBindings, updateInfo)
+				continue
+			}
+			boundingCandidates = append(boundingCandidates, updateInfo)
 		case placementv1beta1.BindingStateBound:
 			bindingFailed := false
 			schedulerTargetedBinds = append(schedulerTargetedBinds, binding)
@@ -460,7 +522,13 @@ func (r *Reconciler) pickBindingsToRoll(
 				// The binding needs update if it's not pointing to the latest resource binding or the overrides.
 				if bindingSpec.ResourceSnapshotName != masterResourceSnapshot.GetName() || !equality.Semantic.DeepEqual(bindingSpec.ClusterResourceOverrideSnapshots, cro) || !equality.Semantic.DeepEqual(bindingSpec.ResourceOverrideSnapshots, ro) {
 					updateInfo := createUpdateInfo(binding, masterResourceSnapshot, cro, ro)
-					if bindingFailed {
+					if updateInfo.outsideMaintenanceWindow, err = r.checkMaintenanceWindow(ctx, maintenanceWindows, bindingSpec.TargetCluster, now); err != nil {
+						return nil, nil, nil, false, 0, err
+					}
+					if updateInfo.outsideMaintenanceWindow != nil {
+						klog.V(2).InfoS("Found a bound binding whose cluster is outside its maintenance windows", "placement", placementKObj, "binding", bindingKObj)
+						outsideMaintenanceWindowBindings = append(outsideMaintenanceWindowBindings, updateInfo)
+					} else if bindingFailed {
 						// the binding has been applied but failed to apply, we can safely update it to latest resources without affecting max unavailable count
 						applyFailedUpdateCandidates = append(applyFailedUpdateCandidates, updateInfo)
 					} else {
@@ -487,16 +555,26 @@ func (r *Reconciler) pickBindingsToRoll(
 		"targetNumber", targetNumber, "readyBindingNumber", len(readyBindings), "canBeUnavailableBindingNumber", len(canBeUnavailableBindings),
 		"canBeReadyBindingNumber", len(canBeReadyBindings), "boundingCandidateNumber", len(boundingCandidates),
 		"removeCandidateNumber", len(removeCandidates), "updateCandidateNumber", len(updateCandidates), "applyFailedUpdateCandidateNumber",
-		len(applyFailedUpdateCandidates), "minWaitTime", minWaitTime)
+		len(applyFailedUpdateCandidates), "outsideMaintenanceWindowNumber", len(outsideMaintenanceWindowBindings), "minWaitTime", minWaitTime)
 
 	// the list of bindings that are to be updated by this rolling phase
 	toBeUpdatedBindingList := make([]toBeUpdatedBinding, 0)
-	if len(removeCandidates)+len(updateCandidates)+len(boundingCandidates)+len(applyFailedUpdateCandidates) == 0 {
+	if len(removeCandidates)+len(updateCandidates)+len(boundingCandidates)+len(applyFailedUpdateCandidates)+len(outsideMaintenanceWindowBindings) == 0 {
 		return toBeUpdatedBindingList, nil, upToDateBoundBindings, false, minWaitTime, nil
 	}
 
 	toBeUpdatedBindingList, staleUnselectedBinding := determineBindingsToUpdate(placementObj, removeCandidates, updateCandidates, boundingCandidates, applyFailedUpdateCandidates, targetNumber,
 		readyBindings, canBeReadyBindings, canBeUnavailableBindings)
+	if len(placementSpec.Strategy.RollingUpdate.Steps) > 0 {
+		var stepWaitTime time.Duration
+		toBeUpdatedBindingList, staleUnselectedBinding, stepWaitTime = limitBindingsToRolloutStep(placementObj, targetNumber,
+			toBeUpdatedBindingList, staleUnselectedBinding, upToDateBoundBindings, readyTimeCutOff, now)
+		if stepWaitTime > 0 && (minWaitTime == 0 || stepWaitTime < minWaitTime) {
+			minWaitTime = stepWaitTime
+		}
+	}
+	// The bindings held back by the maintenance windows are stale as well.
+	staleUnselectedBinding = append(staleUnselectedBinding, outsideMaintenanceWindowBindings...)
 
 	return toBeUpdatedBindingList, staleUnselectedBinding, upToDateBoundBindings, true, minWaitTime, nil
 }
@@ -725,6 +803,7 @@ func (r *Reconciler) SetupWithManagerForClusterResourcePlacement(mgr runtime.Man
 		}).
 		Watches(&placementv1beta1.ResourceOverride{}, resourceOverrideHandlerFuncs(true)).
 		Watches(&placementv1beta1.ClusterResourceBinding{}, bindingHandlerFuncs()).
+		Watches(&placementv1beta1.ClusterMaintenanceWindow{}, r.maintenanceWindowHandler(true)).
 		// Aside from resource snapshot and binding objects, the rollout
 		// controller also watches ClusterResourcePlacement objects,
 		// so that it can push apply strategy updates to all bindings right away.
@@ -745,6 +824,7 @@ func (r *Reconciler) SetupWithManagerForResourcePlacement(mgr runtime.Manager) e
 		// Namespace scoped rollout controller does not need to watch clusterResourceOverride, only resourceOverride.
 		Watches(&placementv1beta1.ResourceOverride{}, resourceOverrideHandlerFuncs(false)).
 		Watches(&placementv1beta1.ResourceBinding{}, bindingHandlerFuncs()).
+		Watches(&placementv1beta1.ClusterMaintenanceWindow{}, r.maintenanceWindowHandler(false)).
 		// Aside from resource snapshot and binding objects, the rollout
 		// controller also watches ResourcePlacement objects,
 		// so that it can push apply strategy updates to all bindings right away.
@@ -752,6 +832,63 @@ func (r *Reconciler) SetupWithManagerForResourcePlacement(mgr runtime.Manager) e
 		Complete(r)
 }
 
+// maintenanceWindowHandler returns the handler for ClusterMaintenanceWindow events, which enqueues the placements
+// with bindings on the member clusters selected by the maintenance window, so that the rollouts held back by it
+// are re-evaluated right away when it changes.
+// For update events, the member clusters selected by both the old and the new maintenance windows are considered.
+func (r *Reconciler) maintenanceWindowHandler(enqueueCRP bool) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, o client.Object) []reconcile.Request {
+		window, ok := o.(*placementv1beta1.ClusterMaintenanceWindow)
+		if !ok {
+			klog.ErrorS(controller.NewUnexpectedBehaviorError(fmt.Errorf("non ClusterMaintenanceWindow type resource: %+v", o)),
+				"Rollout controller received invalid ClusterMaintenanceWindow event", "object", klog.KObj(o))
+			return nil
+		}
+		windowRef := klog.KObj(window)
+		selector, err := metav1.LabelSelectorAsSelector(&window.Spec.ClusterSelector)
+		if err != nil {
+			// The rollouts on the clusters of an invalid maintenance window are rechecked periodically.
+			klog.V(2).InfoS("Ignoring a clusterMaintenanceWindow event with an invalid cluster selector", "clusterMaintenanceWindow", windowRef, "err", err)
+			return nil
+		}
+		var clusterList clusterv1beta1.MemberClusterList
+		if err := r.Client.List(ctx, &clusterList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			klog.ErrorS(err, "Failed to list the member clusters selected by the clusterMaintenanceWindow", "clusterMaintenanceWindow", windowRef)
+			return nil
+		}
+		clusterNames := sets.New[string]()
+		for i := range clusterList.Items {
+			clusterNames.Insert(clusterList.Items[i].Name)
+		}
+		if clusterNames.Len() == 0 {
+			return nil
+		}
+
+		var bindingList placementv1beta1.BindingObjList = &placementv1beta1.ClusterResourceBindingList{}
+		if !enqueueCRP {
+			bindingList = &placementv1beta1.ResourceBindingList{}
+		}
+		if err := r.Client.List(ctx, bindingList); err != nil {
+			klog.ErrorS(err, "Failed to list the bindings on the member clusters selected by the clusterMaintenanceWindow", "clusterMaintenanceWindow", windowRef)
+			return nil
+		}
+		placementKeys := sets.New[types.NamespacedName]()
+		for _, binding := range bindingList.GetBindingObjs() {
+			placementName := binding.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+			if len(placementName) == 0 || !clusterNames.Has(binding.GetBindingSpec().TargetCluster) {
+				continue
+			}
+			placementKeys.Insert(types.NamespacedName{Name: placementName, Namespace: binding.GetNamespace()})
+		}
+		klog.V(2).InfoS("Handling a clusterMaintenanceWindow event", "clusterMaintenanceWindow", windowRef, "numberOfPlacements", placementKeys.Len(), "enqueueCRP", enqueueCRP)
+		requests := make([]reconcile.Request, 0, placementKeys.Len())
+		for key := range placementKeys {
+			requests = append(requests, reconcile.Request{NamespacedName: key})
+		}
+		return requests
+	})
+}
+
 // resourceSnapshotObjHandlerFuncs returns the handler functions for resource snapshot obj events
 // (including cluster resource snapshots and resource snapshots).
 func resourceSnapshotObjHandlerFuncs() handler.Funcs {
@@ -1047,6 +1184,12 @@ func (r *Reconciler) updateStaleBindingsStatus(ctx context.Context, staleBinding
 			continue
 		}
 		errs.Go(func() error {
+			if binding.outsideMaintenanceWindow != nil {
+				return r.updateBindingStatusOutsideMaintenanceWindow(cctx, binding.currentBinding, binding.outsideMaintenanceWindow.message)
+			}
+			if binding.rolloutStepHold != "" {
+				return r.updateBindingStatusWaitingForRolloutStep(cctx, binding.currentBinding, binding.rolloutStepHold)
+			}
 			return r.updateBindingStatus(cctx, binding.currentBinding, false)
 		})
 	}
@@ -1088,6 +1231,37 @@ func (r *Reconciler) updateBindingStatus(ctx context.Context, binding placementv
 			Message:            "Detected the new changes on the resources and started the rollout process",
 		}
 	}
+	return r.setBindingRolloutStartedCondition(ctx, binding, cond)
+}
+
+// updateBindingStatusOutsideMaintenanceWindow updates the status of a BindingObj to indicate that its rollout
+// is held back because its target cluster is outside its maintenance windows.
+func (r *Reconciler) updateBindingStatusOutsideMaintenanceWindow(ctx context.Context, binding placementv1beta1.BindingObj, message string) error {
+	cond := metav1.Condition{
+		Type:               string(placementv1beta1.ResourceBindingRolloutStarted),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: binding.GetGeneration(),
+		Reason:             condition.RolloutWaitingForMaintenanceWindowReason,
+		Message:            fmt.Sprintf("The resources cannot be updated to the latest because %s", message),
+	}
+	return r.setBindingRolloutStartedCondition(ctx, binding, cond)
+}
+
+// updateBindingStatusWaitingForRolloutStep updates the status of a BindingObj to indicate that its rollout
+// is held back by the rollout steps of the placement.
+func (r *Reconciler) updateBindingStatusWaitingForRolloutStep(ctx context.Context, binding placementv1beta1.BindingObj, message string) error {
+	cond := metav1.Condition{
+		Type:               string(placementv1beta1.ResourceBindingRolloutStarted),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: binding.GetGeneration(),
+		Reason:             condition.RolloutWaitingForRolloutStepReason,
+		Message:            fmt.Sprintf("The resources cannot be updated to the latest because %s", message),
+	}
+	return r.setBindingRolloutStartedCondition(ctx, binding, cond)
+}
+
+// setBindingRolloutStartedCondition sets the RolloutStarted condition on a BindingObj and updates its status.
+func (r *Reconciler) setBindingRolloutStartedCondition(ctx context.Context, binding placementv1beta1.BindingObj, cond metav1.Condition) error {
 	binding.SetConditions(cond)
 	if err := r.Client.Status().Update(ctx, binding); err != nil {
 		klog.ErrorS(err, "Failed to update binding status", "binding", klog.KObj(binding), "condition", cond)
@@ -1097,6 +1271,24 @@ func (r *Reconciler) updateBindingStatus(ctx context.Context, binding placementv
 	return nil
 }
 
+// checkMaintenanceWindow checks whether the cluster is inside its maintenance windows at the given time.
+// It returns nil if the cluster can be updated, or why the cluster cannot be updated otherwise.
+func (r *Reconciler) checkMaintenanceWindow(
+	ctx context.Context,
+	maintenanceWindows []placementv1beta1.ClusterMaintenanceWindow,
+	clusterName string,
+	now time.Time,
+) (*maintenanceWindowHold, error) {
+	status, err := maintenancewindow.EvaluateCluster(ctx, r.Client, maintenanceWindows, clusterName, now)
+	if err != nil {
+		return nil, err
+	}
+	if status.Open {
+		return nil, nil
+	}
+	return &maintenanceWindowHold{message: status.Message(), nextOpenTime: status.NextOpenTime}, nil
+}
+
 // processApplyStrategyUpdates processes apply strategy updates on the placement end; specifically
 // it will push the update to all applicable bindings.
 func (r *Reconciler) processApplyStrategyUpdates(
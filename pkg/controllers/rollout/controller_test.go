@@ -34,6 +34,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
@@ -4205,3 +4206,138 @@ func TestProcessApplyStrategyUpdates(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculateMaintenanceWindowWaitTime(t *testing.T) {
+	now := time.Date(2026, 3, 4, 10, 30, 0, 0, time.UTC)
+	tests := map[string]struct {
+		staleBindings []toBeUpdatedBinding
+		wantWaitTime  time.Duration
+		wantFound     bool
+	}{
+		"no binding held back by maintenance windows": {
+			staleBindings: []toBeUpdatedBinding{{}, {}},
+		},
+		"the earliest next open time wins": {
+			staleBindings: []toBeUpdatedBinding{
+				{outsideMaintenanceWindow: &maintenanceWindowHold{nextOpenTime: now.Add(4 * time.Minute)}},
+				{},
+				{outsideMaintenanceWindow: &maintenanceWindowHold{nextOpenTime: now.Add(2 * time.Minute)}},
+			},
+			wantWaitTime: 2 * time.Minute,
+			wantFound:    true,
+		},
+		"next open time beyond the recheck interval": {
+			staleBindings: []toBeUpdatedBinding{
+				{outsideMaintenanceWindow: &maintenanceWindowHold{nextOpenTime: now.Add(2 * time.Hour)}},
+			},
+			wantWaitTime: maintenanceWindowRecheckInterval,
+			wantFound:    true,
+		},
+		"unknown next open time is rechecked periodically": {
+			staleBindings: []toBeUpdatedBinding{
+				{outsideMaintenanceWindow: &maintenanceWindowHold{}},
+				{outsideMaintenanceWindow: &maintenanceWindowHold{nextOpenTime: now.Add(time.Hour)}},
+			},
+			wantWaitTime: maintenanceWindowRecheckInterval,
+			wantFound:    true,
+		},
+		"next open time in the past": {
+			staleBindings: []toBeUpdatedBinding{
+				{outsideMaintenanceWindow: &maintenanceWindowHold{nextOpenTime: now.Add(-time.Minute)}},
+			},
+			wantWaitTime: time.Second,
+			wantFound:    true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotWaitTime, gotFound := calculateMaintenanceWindowWaitTime(tt.staleBindings, now)
+			if gotWaitTime != tt.wantWaitTime || gotFound != tt.wantFound {
+				t.Errorf("calculateMaintenanceWindowWaitTime() = (%v, %t), want (%v, %t)", gotWaitTime, gotFound, tt.wantWaitTime, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowHandler(t *testing.T) {
+	clusters := []client.Object{
+		&clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: cluster1, Labels: map[string]string{"env": "prod"}}},
+		&clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: cluster2, Labels: map[string]string{"env": "test"}}},
+		&clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: cluster3, Labels: map[string]string{"env": "prod"}}},
+	}
+	bindings := []client.Object{
+		&placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "crb-1", Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: "crp-1"}},
+			Spec:       placementv1beta1.ResourceBindingSpec{TargetCluster: cluster1},
+		},
+		&placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "crb-2", Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: "crp-1"}},
+			Spec:       placementv1beta1.ResourceBindingSpec{TargetCluster: cluster3},
+		},
+		&placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "crb-3", Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: "crp-2"}},
+			Spec:       placementv1beta1.ResourceBindingSpec{TargetCluster: cluster2},
+		},
+		&placementv1beta1.ResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "rb-1", Namespace: "test-namespace", Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: "rp-1"}},
+			Spec:       placementv1beta1.ResourceBindingSpec{TargetCluster: cluster1},
+		},
+	}
+
+	tests := map[string]struct {
+		selector        metav1.LabelSelector
+		enqueueCRP      bool
+		wantEnqueueKeys []reconcile.Request
+	}{
+		"cluster resource placements on the selected clusters": {
+			selector:   metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			enqueueCRP: true,
+			wantEnqueueKeys: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "crp-1"}},
+			},
+		},
+		"resource placements on the selected clusters": {
+			selector:   metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			enqueueCRP: false,
+			wantEnqueueKeys: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "rp-1", Namespace: "test-namespace"}},
+			},
+		},
+		"empty selector selects all the clusters": {
+			enqueueCRP: true,
+			wantEnqueueKeys: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "crp-1"}},
+				{NamespacedName: types.NamespacedName{Name: "crp-2"}},
+			},
+		},
+		"no cluster is selected": {
+			selector:   metav1.LabelSelector{MatchLabels: map[string]string{"env": "staging"}},
+			enqueueCRP: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(append(clusters, bindings...)...).
+				Build()
+			r := &Reconciler{Client: fakeClient}
+			window := &placementv1beta1.ClusterMaintenanceWindow{
+				ObjectMeta: metav1.ObjectMeta{Name: "window"},
+				Spec:       placementv1beta1.ClusterMaintenanceWindowSpec{ClusterSelector: tt.selector},
+			}
+			queue := &controllertest.Queue{TypedInterface: workqueue.NewTypedRateLimitingQueue[reconcile.Request](workqueue.DefaultTypedItemBasedRateLimiter[reconcile.Request]())}
+			r.maintenanceWindowHandler(tt.enqueueCRP).Create(context.Background(), event.CreateEvent{Object: window}, queue)
+
+			var gotEnqueueKeys []reconcile.Request
+			for queue.Len() > 0 {
+				item, _ := queue.Get()
+				gotEnqueueKeys = append(gotEnqueueKeys, item)
+			}
+			sortRequests := cmpopts.SortSlices(func(r1, r2 reconcile.Request) bool { return r1.String() < r2.String() })
+			if diff := cmp.Diff(tt.wantEnqueueKeys, gotEnqueueKeys, cmpopts.EquateEmpty(), sortRequests); diff != "" {
+				t.Errorf("maintenanceWindowHandler() enqueued keys mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	bindingutils "github.com/kubefleet-dev/kubefleet/pkg/utils/binding"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+// limitBindingsToRolloutStep caps the bindings to be updated to the latest resources at the current step of the
+// rollout steps of the placement. The bindings over the cap are moved to the stale bindings.
+// The next step only starts after all the bindings running the latest resources are ready and the pause of the
+// previous step has passed, so no binding is updated before then.
+// Bindings to be removed are never held back by the rollout steps.
+// It returns the bindings to be updated, the stale bindings and how long to wait until the pause of the previous step
+// ends, which is zero if the rollout is not paused.
+func limitBindingsToRolloutStep(
+	placementObj placementv1beta1.PlacementObj,
+	targetNumber int,
+	toBeUpdatedBindings, staleBindings, upToDateBoundBindings []toBeUpdatedBinding,
+	readyTimeCutOff, now time.Time,
+) ([]toBeUpdatedBinding, []toBeUpdatedBinding, time.Duration) {
+	rollingUpdate := placementObj.GetPlacementSpec().Strategy.RollingUpdate
+	steps := rollingUpdate.Steps
+	// The bindings that are bound to the latest resources are the clusters updated so far.
+	updatedNumber := len(upToDateBoundBindings)
+	if updatedNumber >= targetNumber {
+		return toBeUpdatedBindings, staleBindings, 0
+	}
+	stepIdx, stepClusters := currentRolloutStep(steps, targetNumber, updatedNumber)
+
+	allowedNumber := stepClusters - updatedNumber
+	holdMessage := fmt.Sprintf("rollout step %d of %d only updates %d of the %d clusters", stepIdx+1, len(steps)+1, stepClusters, targetNumber)
+	var waitTime time.Duration
+	if stepIdx > 0 {
+		// The current step only starts after all the clusters updated so far are ready and the previous step is not paused.
+		if lastReadyTime, allReady := lastBindingReadyTime(upToDateBoundBindings, readyTimeCutOff, time.Duration(*rollingUpdate.UnavailablePeriodSeconds)*time.Second); !allReady {
+			allowedNumber = 0
+			holdMessage = fmt.Sprintf("rollout step %d of %d waits for all the clusters updated so far (%d) to be available", stepIdx+1, len(steps)+1, updatedNumber)
+		} else if pauseEndTime := lastReadyTime.Add(pauseOfRolloutStep(steps[stepIdx-1])); now.Before(pauseEndTime) {
+			allowedNumber = 0
+			waitTime = pauseEndTime.Sub(now)
+			holdMessage = fmt.Sprintf("rollout step %d of %d is paused until %s", stepIdx+1, len(steps)+1, pauseEndTime.UTC().Format(time.RFC3339))
+		}
+	}
+	if stepIdx == len(steps) && allowedNumber > 0 {
+		// The last step updates all the remaining clusters, within the limits of the rest of the rollout strategy.
+		allowedNumber = len(toBeUpdatedBindings)
+	}
+	klog.V(2).InfoS("Calculated the number of bindings allowed to be updated by the rollout steps", "placement", klog.KObj(placementObj),
+		"step", stepIdx+1, "stepClusters", stepClusters, "updatedNumber", updatedNumber, "allowedNumber", allowedNumber, "waitTime", waitTime)
+
+	pickedBindings := make([]toBeUpdatedBinding, 0, len(toBeUpdatedBindings))
+	for _, binding := range toBeUpdatedBindings {
+		if binding.desiredBinding == nil {
+			// The binding is to be removed.
+			pickedBindings = append(pickedBindings, binding)
+			continue
+		}
+		if allowedNumber > 0 {
+			pickedBindings = append(pickedBindings, binding)
+			allowedNumber--
+			continue
+		}
+		binding.rolloutStepHold = holdMessage
+		staleBindings = append(staleBindings, binding)
+	}
+	return pickedBindings, staleBindings, waitTime
+}
+
+// currentRolloutStep returns the index of the current step of the rollout steps and the number of clusters that
+// run the latest resources at the end of it, given the number of clusters updated so far.
+// The index equals to the number of steps for the implicit last step which updates all the clusters.
+func currentRolloutStep(steps []placementv1beta1.RollingUpdateStep, targetNumber, updatedNumber int) (int, int) {
+	stepClusters := 0
+	for i := range steps {
+		// The validation webhook guarantees that the value is valid.
+		clusters, _ := intstr.GetScaledValueFromIntOrPercent(&steps[i].Clusters, targetNumber, true)
+		// A step never goes backwards or beyond the desired number of clusters.
+		stepClusters = max(stepClusters, min(clusters, targetNumber))
+		if updatedNumber < stepClusters {
+			return i, stepClusters
+		}
+	}
+	return len(steps), targetNumber
+}
+
+// pauseOfRolloutStep returns how long to wait after all the clusters of the step are ready.
+func pauseOfRolloutStep(step placementv1beta1.RollingUpdateStep) time.Duration {
+	if step.PauseSeconds == nil {
+		return 0
+	}
+	return time.Duration(*step.PauseSeconds) * time.Second
+}
+
+// lastBindingReadyTime returns when the last of the bindings became ready, and whether all of them are ready.
+// A binding that has failed is never considered ready.
+func lastBindingReadyTime(bindings []toBeUpdatedBinding, readyTimeCutOff time.Time, unavailablePeriod time.Duration) (time.Time, bool) {
+	var lastReadyTime time.Time
+	for _, binding := range bindings {
+		if _, ready := isBindingReady(binding.currentBinding, readyTimeCutOff); !ready || bindingutils.HasBindingFailed(binding.currentBinding) {
+			return time.Time{}, false
+		}
+		if readyTime := bindingReadyTime(binding.currentBinding, unavailablePeriod); readyTime.After(lastReadyTime) {
+			lastReadyTime = readyTime
+		}
+	}
+	return lastReadyTime, true
+}
+
+// bindingReadyTime returns when a ready binding became ready, following the same rules as isBindingReady.
+func bindingReadyTime(binding placementv1beta1.BindingObj, unavailablePeriod time.Duration) time.Time {
+	diffReportCondition := binding.GetCondition(string(placementv1beta1.ResourceBindingDiffReported))
+	if condition.IsConditionStatusTrue(diffReportCondition, binding.GetGeneration()) {
+		return diffReportCondition.LastTransitionTime.Time
+	}
+	availableCondition := binding.GetCondition(string(placementv1beta1.ResourceBindingAvailable))
+	if availableCondition == nil {
+		return time.Time{}
+	}
+	if availableCondition.Reason == condition.WorkNotAvailabilityTrackableReason || availableCondition.Reason == condition.WorkNotAllManifestsTrackableReason {
+		// The not trackable resources are considered available after the unavailable period.
+		return availableCondition.LastTransitionTime.Add(unavailablePeriod)
+	}
+	return availableCondition.LastTransitionTime.Time
+}
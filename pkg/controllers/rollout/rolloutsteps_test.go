@@ -0,0 +1,287 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+func TestCurrentRolloutStep(t *testing.T) {
+	steps := []placementv1beta1.RollingUpdateStep{
+		{Clusters: intstr.FromInt32(1)},
+		{Clusters: intstr.FromString("10%")},
+		{Clusters: intstr.FromString("50%")},
+	}
+	tests := []struct {
+		name             string
+		steps            []placementv1beta1.RollingUpdateStep
+		targetNumber     int
+		updatedNumber    int
+		wantStepIdx      int
+		wantStepClusters int
+	}{
+		{
+			name:             "first step",
+			steps:            steps,
+			targetNumber:     20,
+			updatedNumber:    0,
+			wantStepIdx:      0,
+			wantStepClusters: 1,
+		},
+		{
+			name:             "percentage step is rounded up",
+			steps:            steps,
+			targetNumber:     25,
+			updatedNumber:    1,
+			wantStepIdx:      1,
+			wantStepClusters: 3,
+		},
+		{
+			name:             "step with the same number of clusters as the previous one is skipped",
+			steps:            steps,
+			targetNumber:     10,
+			updatedNumber:    1,
+			wantStepIdx:      2,
+			wantStepClusters: 5,
+		},
+		{
+			name:             "last step updates all the clusters",
+			steps:            steps,
+			targetNumber:     10,
+			updatedNumber:    5,
+			wantStepIdx:      3,
+			wantStepClusters: 10,
+		},
+		{
+			name: "step never goes backwards",
+			steps: []placementv1beta1.RollingUpdateStep{
+				{Clusters: intstr.FromInt32(4)},
+				{Clusters: intstr.FromString("10%")},
+			},
+			targetNumber:     10,
+			updatedNumber:    4,
+			wantStepIdx:      2,
+			wantStepClusters: 10,
+		},
+		{
+			name: "step never goes beyond the target number",
+			steps: []placementv1beta1.RollingUpdateStep{
+				{Clusters: intstr.FromInt32(20)},
+			},
+			targetNumber:     3,
+			updatedNumber:    1,
+			wantStepIdx:      0,
+			wantStepClusters: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStepIdx, gotStepClusters := currentRolloutStep(tt.steps, tt.targetNumber, tt.updatedNumber)
+			if gotStepIdx != tt.wantStepIdx || gotStepClusters != tt.wantStepClusters {
+				t.Errorf("currentRolloutStep() = (%d, %d), want (%d, %d)", gotStepIdx, gotStepClusters, tt.wantStepIdx, tt.wantStepClusters)
+			}
+		})
+	}
+}
+
+func TestLimitBindingsToRolloutStep(t *testing.T) {
+	readyAt := func(cluster string, readyTime time.Time) toBeUpdatedBinding {
+		binding := generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, "snapshot-2", cluster)
+		binding.Status.Conditions[1].LastTransitionTime = metav1.NewTime(readyTime)
+		return toBeUpdatedBinding{currentBinding: binding}
+	}
+	notReady := func(cluster string) toBeUpdatedBinding {
+		return toBeUpdatedBinding{currentBinding: generateCanBeReadyClusterResourceBinding(placementv1beta1.BindingStateBound, "snapshot-2", cluster)}
+	}
+	failed := func(cluster string) toBeUpdatedBinding {
+		binding := generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, "snapshot-2", cluster)
+		// The binding was available before but has failed to apply since.
+		binding.Status.Conditions[0].Status = metav1.ConditionFalse
+		return toBeUpdatedBinding{currentBinding: binding}
+	}
+	toUpdate := func(clusters ...string) []toBeUpdatedBinding {
+		bindings := make([]toBeUpdatedBinding, 0, len(clusters))
+		for _, cluster := range clusters {
+			binding := generateClusterResourceBinding(placementv1beta1.BindingStateBound, "snapshot-1", cluster)
+			desiredBinding := binding.DeepCopy()
+			desiredBinding.Spec.ResourceSnapshotName = "snapshot-2"
+			bindings = append(bindings, toBeUpdatedBinding{currentBinding: binding, desiredBinding: desiredBinding})
+		}
+		return bindings
+	}
+	toRemove := toBeUpdatedBinding{currentBinding: generateClusterResourceBinding(placementv1beta1.BindingStateUnscheduled, "snapshot-1", "removed-cluster")}
+	pauseEndTime := now.Add(-time.Minute).Add(10 * time.Minute)
+
+	type bindingSummary struct {
+		Cluster string
+		Hold    string
+	}
+	summarize := func(bindings []toBeUpdatedBinding) []bindingSummary {
+		var summaries []bindingSummary
+		for _, binding := range bindings {
+			summaries = append(summaries, bindingSummary{Cluster: binding.currentBinding.GetBindingSpec().TargetCluster, Hold: binding.rolloutStepHold})
+		}
+		return summaries
+	}
+
+	tests := []struct {
+		name                  string
+		toBeUpdatedBindings   []toBeUpdatedBinding
+		staleBindings         []toBeUpdatedBinding
+		upToDateBoundBindings []toBeUpdatedBinding
+		wantToBeUpdated       []bindingSummary
+		wantStale             []bindingSummary
+		wantWaitTime          time.Duration
+	}{
+		{
+			name:                "first step only updates one cluster",
+			toBeUpdatedBindings: append([]toBeUpdatedBinding{toRemove}, toUpdate("cluster-1", "cluster-2", "cluster-3")...),
+			staleBindings:       toUpdate("cluster-4"),
+			wantToBeUpdated:     []bindingSummary{{Cluster: "removed-cluster"}, {Cluster: "cluster-1"}},
+			wantStale: []bindingSummary{
+				{Cluster: "cluster-4"},
+				{Cluster: "cluster-2", Hold: "rollout step 1 of 3 only updates 1 of the 10 clusters"},
+				{Cluster: "cluster-3", Hold: "rollout step 1 of 3 only updates 1 of the 10 clusters"},
+			},
+		},
+		{
+			name:                  "next step waits for the updated clusters to be available",
+			toBeUpdatedBindings:   toUpdate("cluster-2", "cluster-3"),
+			upToDateBoundBindings: []toBeUpdatedBinding{notReady("cluster-1")},
+			wantStale: []bindingSummary{
+				{Cluster: "cluster-2", Hold: "rollout step 2 of 3 waits for all the clusters updated so far (1) to be available"},
+				{Cluster: "cluster-3", Hold: "rollout step 2 of 3 waits for all the clusters updated so far (1) to be available"},
+			},
+		},
+		{
+			name:                  "next step does not start if an updated cluster failed",
+			toBeUpdatedBindings:   toUpdate("cluster-2"),
+			upToDateBoundBindings: []toBeUpdatedBinding{failed("cluster-1")},
+			wantStale: []bindingSummary{
+				{Cluster: "cluster-2", Hold: "rollout step 2 of 3 waits for all the clusters updated so far (1) to be available"},
+			},
+		},
+		{
+			name:                  "next step starts right away without a pause",
+			toBeUpdatedBindings:   toUpdate("cluster-2", "cluster-3", "cluster-4", "cluster-5", "cluster-6"),
+			upToDateBoundBindings: []toBeUpdatedBinding{readyAt("cluster-1", now)},
+			wantToBeUpdated: []bindingSummary{
+				{Cluster: "cluster-2"}, {Cluster: "cluster-3"}, {Cluster: "cluster-4"}, {Cluster: "cluster-5"},
+			},
+			wantStale: []bindingSummary{
+				{Cluster: "cluster-6", Hold: "rollout step 2 of 3 only updates 5 of the 10 clusters"},
+			},
+		},
+		{
+			name:                "last step is paused until the pause of the previous step ends",
+			toBeUpdatedBindings: toUpdate("cluster-6"),
+			upToDateBoundBindings: []toBeUpdatedBinding{
+				readyAt("cluster-1", now.Add(-time.Hour)), readyAt("cluster-2", now.Add(-time.Minute)), readyAt("cluster-3", now.Add(-time.Hour)),
+				readyAt("cluster-4", now.Add(-time.Hour)), readyAt("cluster-5", now.Add(-time.Hour)),
+			},
+			wantStale: []bindingSummary{
+				{Cluster: "cluster-6", Hold: fmt.Sprintf("rollout step 3 of 3 is paused until %s", pauseEndTime.UTC().Format(time.RFC3339))},
+			},
+			wantWaitTime: 9 * time.Minute,
+		},
+		{
+			name:                "last step updates all the remaining clusters after the pause",
+			toBeUpdatedBindings: toUpdate("cluster-6", "cluster-7", "cluster-8", "cluster-9", "cluster-10"),
+			upToDateBoundBindings: []toBeUpdatedBinding{
+				readyAt("cluster-1", now.Add(-time.Hour)), readyAt("cluster-2", now.Add(-time.Hour)), readyAt("cluster-3", now.Add(-time.Hour)),
+				readyAt("cluster-4", now.Add(-time.Hour)), readyAt("cluster-5", now.Add(-time.Hour)),
+			},
+			wantToBeUpdated: []bindingSummary{
+				{Cluster: "cluster-6"}, {Cluster: "cluster-7"}, {Cluster: "cluster-8"}, {Cluster: "cluster-9"}, {Cluster: "cluster-10"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rollingUpdate := generateDefaultRollingUpdateConfig()
+			rollingUpdate.Steps = []placementv1beta1.RollingUpdateStep{
+				{Clusters: intstr.FromInt32(1)},
+				{Clusters: intstr.FromString("50%"), PauseSeconds: ptr.To(600)},
+			}
+			crp := clusterResourcePlacementForTest("test",
+				createPlacementPolicyForTest(placementv1beta1.PickNPlacementType, 10),
+				createPlacementRolloutStrategyForTest(placementv1beta1.RollingUpdateRolloutStrategyType, rollingUpdate, nil))
+			readyTimeCutOff := now.Add(-time.Duration(*rollingUpdate.UnavailablePeriodSeconds) * time.Second)
+
+			gotToBeUpdated, gotStale, gotWaitTime := limitBindingsToRolloutStep(crp, 10, tt.toBeUpdatedBindings, tt.staleBindings, tt.upToDateBoundBindings, readyTimeCutOff, now)
+			if diff := cmp.Diff(summarize(gotToBeUpdated), tt.wantToBeUpdated); diff != "" {
+				t.Errorf("limitBindingsToRolloutStep() toBeUpdatedBindings mismatch (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(summarize(gotStale), tt.wantStale); diff != "" {
+				t.Errorf("limitBindingsToRolloutStep() staleBindings mismatch (-got, +want):\n%s", diff)
+			}
+			if gotWaitTime != tt.wantWaitTime {
+				t.Errorf("limitBindingsToRolloutStep() waitTime = %v, want %v", gotWaitTime, tt.wantWaitTime)
+			}
+		})
+	}
+}
+
+func TestBindingReadyTime(t *testing.T) {
+	transitionTime := metav1.NewTime(now.Add(-time.Minute))
+	tests := []struct {
+		name       string
+		conditions []metav1.Condition
+		want       time.Time
+	}{
+		{
+			name: "diff reported",
+			conditions: []metav1.Condition{
+				{Type: string(placementv1beta1.ResourceBindingDiffReported), Status: metav1.ConditionTrue, LastTransitionTime: transitionTime},
+			},
+			want: transitionTime.Time,
+		},
+		{
+			name: "available",
+			conditions: []metav1.Condition{
+				{Type: string(placementv1beta1.ResourceBindingAvailable), Status: metav1.ConditionTrue, LastTransitionTime: transitionTime, Reason: condition.WorkAllManifestsAvailableReason},
+			},
+			want: transitionTime.Time,
+		},
+		{
+			name: "not trackable resources are ready after the unavailable period",
+			conditions: []metav1.Condition{
+				{Type: string(placementv1beta1.ResourceBindingAvailable), Status: metav1.ConditionTrue, LastTransitionTime: transitionTime, Reason: condition.WorkNotAvailabilityTrackableReason},
+			},
+			want: transitionTime.Add(30 * time.Second),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			binding := generateClusterResourceBinding(placementv1beta1.BindingStateBound, "snapshot-1", "cluster-1")
+			binding.Status.Conditions = tt.conditions
+			if got := bindingReadyTime(binding, 30*time.Second); !got.Equal(tt.want) {
+				t.Errorf("bindingReadyTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
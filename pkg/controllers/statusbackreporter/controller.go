@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -27,12 +28,14 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	errorsutil "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 	parallelizerutil "github.com/kubefleet-dev/kubefleet/pkg/utils/parallelizer"
 )
@@ -77,6 +80,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	if _, ok := work.Labels[placementv1beta1.VerificationJobWorkLabel]; ok {
+		// Works that place the verification Jobs of staged update runs have no original resources on the hub cluster.
+		klog.V(2).InfoS("Skip status back-reporting to original resources for the verification job work", "work", workRef)
+		return ctrl.Result{}, nil
+	}
+
 	// Perform a sanity check; make sure that mirroring back to original resources can be done, i.e.,
 	// the scheduling policy is set to the PickFixed type with exactly one target cluster, or the PickN
 	// type with the number of clusters set to 1. The logic also checks if the report back strategy still
@@ -94,6 +103,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	// Prepare a map for quick lookup of whether a resource is enveloped.
 	isResEnvelopedByIdStr := prepareIsResEnvelopedMap(placementObj)
 
+	// With the Projected report back strategy, the status fields projected from each member cluster
+	// are aggregated into a per-cluster map on the original resources.
+	isProjected := isProjectedStatusBackReportingToOriginalResourcesOn(placementObj)
+	clusterName := strings.TrimPrefix(work.Namespace, utils.FleetMemberNamespacePrefix)
+	targetClusters := prepareTargetClusterSet(placementObj)
+
 	// Back-report statuses to original resources.
 
 	// Prepare a child context.
@@ -144,6 +159,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 		nsName := resIdentifier.Namespace
 		resName := resIdentifier.Name
+		if isProjected {
+			if err := r.backReportProjectedStatusToOriginalResource(ctx, gvr, nsName, resName, clusterName, manifestCond.BackReportedStatus, targetClusters); err != nil {
+				klog.ErrorS(err, "Failed to back-report projected status to the target resource", "work", workRef, "resourceIdentifier", resIdentifier)
+				errs[pieces] = err
+			}
+			return
+		}
 		unstructured, err := r.hubDynamicClient.Resource(gvr).Namespace(nsName).Get(ctx, resName, metav1.GetOptions{})
 		if err != nil {
 			wrappedErr := fmt.Errorf("failed to retrieve the target resource for status back-reporting: %w", err)
@@ -211,6 +233,12 @@ func (r *Reconciler) validatePlacementObjectForOriginalResourceStatusBackReporti
 		}
 	}
 
+	// Projected status back-reporting to original resources aggregates the statuses from all member
+	// clusters, and thus works with any scheduling policy.
+	if isProjectedStatusBackReportingToOriginalResourcesOn(placementObj) {
+		return placementObj, false, nil
+	}
+
 	// Validate the scheduling policy of the placement object.
 	schedulingPolicy := placementObj.GetPlacementSpec().Policy
 	switch {
@@ -255,6 +283,117 @@ func (r *Reconciler) validatePlacementObjectForOriginalResourceStatusBackReporti
 	return placementObj, false, nil
 }
 
+// isProjectedStatusBackReportingToOriginalResourcesOn returns whether the placement object uses the Projected
+// report back strategy with the OriginalResource destination.
+func isProjectedStatusBackReportingToOriginalResourcesOn(placementObj placementv1beta1.PlacementObj) bool {
+	reportBackStrategy := placementObj.GetPlacementSpec().Strategy.ReportBackStrategy
+	return reportBackStrategy != nil &&
+		reportBackStrategy.Type == placementv1beta1.ReportBackStrategyTypeProjected &&
+		reportBackStrategy.Destination != nil &&
+		*reportBackStrategy.Destination == placementv1beta1.ReportBackDestinationOriginalResource
+}
+
+// backReportProjectedStatusToOriginalResource merges the status fields projected from a member cluster into
+// the per-cluster map kept in the projected status annotation of the original resource.
+func (r *Reconciler) backReportProjectedStatusToOriginalResource(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	nsName, resName, clusterName string,
+	backReportedStatus *placementv1beta1.BackReportedStatus,
+	targetClusters map[string]bool,
+) error {
+	statusWrapper := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(backReportedStatus.ObservedStatus.Raw, &statusWrapper); err != nil {
+		return fmt.Errorf("failed to unmarshal back-reported status: %w", err)
+	}
+	projectedStatus, ok := statusWrapper[placementv1beta1.ProjectedStatusField]
+	if !ok {
+		// The member agent has not reported projected status yet (e.g., the report back strategy
+		// has just been switched from Mirror); skip the resource for now.
+		klog.V(2).InfoS("Skip status back-reporting for the resource; there is no projected status", "resource", klog.KRef(nsName, resName), "cluster", clusterName)
+		return nil
+	}
+
+	// Multiple Work objects (one per member cluster) might update the same original resource
+	// at the same time; retry on conflicts.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		unstructured, err := r.hubDynamicClient.Resource(gvr).Namespace(nsName).Get(ctx, resName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to retrieve the target resource for status back-reporting: %w", err)
+		}
+
+		annotations := unstructured.GetAnnotations()
+		currentValue := annotations[placementv1beta1.ProjectedStatusAnnotation]
+		newValue, err := mergeProjectedStatus(currentValue, clusterName, projectedStatus, targetClusters)
+		if err != nil {
+			return fmt.Errorf("failed to merge projected status: %w", err)
+		}
+		if newValue == currentValue {
+			// No change; skip the update.
+			return nil
+		}
+
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[placementv1beta1.ProjectedStatusAnnotation] = newValue
+		unstructured.SetAnnotations(annotations)
+		if _, err := r.hubDynamicClient.Resource(gvr).Namespace(nsName).Update(ctx, unstructured, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update projected status to the target resource: %w", err)
+		}
+		return nil
+	})
+}
+
+// mergeProjectedStatus merges the status fields projected from a member cluster into the per-cluster map
+// kept in the projected status annotation (as a JSON object keyed by member cluster name), and drops the
+// entries of member clusters that are no longer targeted by the placement (if the target clusters are known).
+func mergeProjectedStatus(annotationValue, clusterName string, projectedStatus json.RawMessage, targetClusters map[string]bool) (string, error) {
+	projectedStatusByCluster := parseProjectedStatusAnnotation(annotationValue)
+	if len(targetClusters) > 0 {
+		for name := range projectedStatusByCluster {
+			if !targetClusters[name] && name != clusterName {
+				delete(projectedStatusByCluster, name)
+			}
+		}
+	}
+	projectedStatusByCluster[clusterName] = projectedStatus
+
+	// Note that the JSON encoder sorts map keys, so that the result is deterministic.
+	data, err := json.Marshal(projectedStatusByCluster)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// parseProjectedStatusAnnotation parses the projected status annotation into the per-cluster map; a corrupted
+// annotation (e.g., one modified by hand) is parsed into an empty map, so that it is rebuilt from scratch.
+func parseProjectedStatusAnnotation(annotationValue string) map[string]json.RawMessage {
+	projectedStatusByCluster := make(map[string]json.RawMessage)
+	if len(annotationValue) == 0 {
+		return projectedStatusByCluster
+	}
+	if err := json.Unmarshal([]byte(annotationValue), &projectedStatusByCluster); err != nil {
+		klog.V(2).InfoS("The projected status annotation cannot be parsed; rebuilding it", "err", err)
+		return make(map[string]json.RawMessage)
+	}
+	return projectedStatusByCluster
+}
+
+// prepareTargetClusterSet prepares a set of the member clusters that are targeted by the placement object,
+// as reported in its status.
+func prepareTargetClusterSet(placementObj placementv1beta1.PlacementObj) map[string]bool {
+	targetClusters := make(map[string]bool)
+	perClusterStatuses := placementObj.GetPlacementStatus().PerClusterPlacementStatuses
+	for idx := range perClusterStatuses {
+		if len(perClusterStatuses[idx].ClusterName) > 0 {
+			targetClusters[perClusterStatuses[idx].ClusterName] = true
+		}
+	}
+	return targetClusters
+}
+
 // formatResourceIdentifier formats a ResourceIdentifier object to a string for keying purposes.
 //
 // The format in use is `[API-GROUP]/[API-VERSION]/[API-KIND]/[NAMESPACE]/[NAME]`, e.g., `/v1/Namespace//work`.
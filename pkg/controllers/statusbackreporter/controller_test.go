@@ -18,6 +18,7 @@ package statusbackreporter
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 	"os"
 	"strings"
@@ -26,6 +27,10 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/scheme"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -699,6 +704,64 @@ func TestValidatePlacementObjectForOriginalResourceStatusBackReporting(t *testin
 			},
 			wantShouldSkip: true,
 		},
+		{
+			name: "work associated with crp, with PickAll scheduling policy and Projected report back strategy",
+			work: &placementv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: crpWorkName1,
+					Labels: map[string]string{
+						placementv1beta1.PlacementTrackingLabel: crpName1,
+					},
+				},
+			},
+			placementObj: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: crpName1,
+				},
+				Spec: placementv1beta1.PlacementSpec{
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickAllPlacementType,
+					},
+					Strategy: placementv1beta1.RolloutStrategy{
+						ReportBackStrategy: &placementv1beta1.ReportBackStrategy{
+							Type:        placementv1beta1.ReportBackStrategyTypeProjected,
+							Destination: ptr.To(placementv1beta1.ReportBackDestinationOriginalResource),
+							StatusPaths: []string{".status.readyReplicas"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "work associated with crp, Projected report back strategy with WorkAPI destination",
+			work: &placementv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: crpWorkName1,
+					Labels: map[string]string{
+						placementv1beta1.PlacementTrackingLabel: crpName1,
+					},
+				},
+			},
+			placementObj: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: crpName1,
+				},
+				Spec: placementv1beta1.PlacementSpec{
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType:    placementv1beta1.PickNPlacementType,
+						NumberOfClusters: ptr.To(int32(1)),
+					},
+					Strategy: placementv1beta1.RolloutStrategy{
+						ReportBackStrategy: &placementv1beta1.ReportBackStrategy{
+							Type:        placementv1beta1.ReportBackStrategyTypeProjected,
+							Destination: ptr.To(placementv1beta1.ReportBackDestinationWorkAPI),
+							StatusPaths: []string{".status.readyReplicas"},
+						},
+					},
+				},
+			},
+			wantShouldSkip: true,
+		},
 		{
 			name: "work associated with crp, report back strategy destination not set to OriginalResource",
 			work: &placementv1beta1.Work{
@@ -758,3 +821,123 @@ func TestValidatePlacementObjectForOriginalResourceStatusBackReporting(t *testin
 		})
 	}
 }
+
+// TestMergeProjectedStatus tests the mergeProjectedStatus function.
+func TestMergeProjectedStatus(t *testing.T) {
+	testCases := []struct {
+		name            string
+		annotationValue string
+		clusterName     string
+		projectedStatus json.RawMessage
+		targetClusters  map[string]bool
+		want            string
+	}{
+		{
+			name:            "no existing annotation",
+			clusterName:     cluster1,
+			projectedStatus: json.RawMessage(`{".status.readyReplicas":3}`),
+			want:            `{"cluster-1":{".status.readyReplicas":3}}`,
+		},
+		{
+			name:            "add a new cluster",
+			annotationValue: `{"cluster-1":{".status.readyReplicas":3}}`,
+			clusterName:     cluster2,
+			projectedStatus: json.RawMessage(`{".status.readyReplicas":1}`),
+			targetClusters:  map[string]bool{cluster1: true, cluster2: true},
+			want:            `{"cluster-1":{".status.readyReplicas":3},"cluster-2":{".status.readyReplicas":1}}`,
+		},
+		{
+			name:            "overwrite an existing cluster",
+			annotationValue: `{"cluster-1":{".status.readyReplicas":3},"cluster-2":{".status.readyReplicas":1}}`,
+			clusterName:     cluster2,
+			projectedStatus: json.RawMessage(`{".status.readyReplicas":2}`),
+			want:            `{"cluster-1":{".status.readyReplicas":3},"cluster-2":{".status.readyReplicas":2}}`,
+		},
+		{
+			name:            "drop clusters that are no longer targeted",
+			annotationValue: `{"cluster-1":{".status.readyReplicas":3},"cluster-3":{".status.readyReplicas":1}}`,
+			clusterName:     cluster2,
+			projectedStatus: json.RawMessage(`{".status.readyReplicas":2}`),
+			targetClusters:  map[string]bool{cluster1: true},
+			want:            `{"cluster-1":{".status.readyReplicas":3},"cluster-2":{".status.readyReplicas":2}}`,
+		},
+		{
+			name:            "corrupted annotation",
+			annotationValue: `not-json`,
+			clusterName:     cluster1,
+			projectedStatus: json.RawMessage(`{}`),
+			want:            `{"cluster-1":{}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := mergeProjectedStatus(tc.annotationValue, tc.clusterName, tc.projectedStatus, tc.targetClusters)
+			if err != nil {
+				t.Fatalf("mergeProjectedStatus() = %v, want no error", err)
+			}
+			if got != tc.want {
+				t.Errorf("mergeProjectedStatus() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestBackReportProjectedStatusToOriginalResource tests the backReportProjectedStatusToOriginalResource method.
+func TestBackReportProjectedStatusToOriginalResource(t *testing.T) {
+	deployGVR := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	deploy := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name":      deployName,
+				"namespace": nsName,
+				"annotations": map[string]interface{}{
+					placementv1beta1.ProjectedStatusAnnotation: `{"cluster-1":{".status.readyReplicas":3}}`,
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		backReportedStatus string
+		wantAnnotation     string
+	}{
+		{
+			name:               "projected status reported",
+			backReportedStatus: `{"apiVersion":"apps/v1","kind":"Deployment","projectedStatus":{".status.readyReplicas":1}}`,
+			wantAnnotation:     `{"cluster-1":{".status.readyReplicas":3},"cluster-2":{".status.readyReplicas":1}}`,
+		},
+		{
+			name:               "no projected status reported",
+			backReportedStatus: `{"apiVersion":"apps/v1","kind":"Deployment","status":{"readyReplicas":1}}`,
+			wantAnnotation:     `{"cluster-1":{".status.readyReplicas":3}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			fakeDynamicClient := dynamicfake.NewSimpleDynamicClient(scheme.Scheme, deploy.DeepCopy())
+			r := NewReconciler(nil, fakeDynamicClient, nil)
+
+			backReportedStatus := &placementv1beta1.BackReportedStatus{
+				ObservedStatus: runtime.RawExtension{Raw: []byte(tc.backReportedStatus)},
+			}
+			targetClusters := map[string]bool{cluster1: true, cluster2: true}
+			if err := r.backReportProjectedStatusToOriginalResource(ctx, deployGVR, nsName, deployName, cluster2, backReportedStatus, targetClusters); err != nil {
+				t.Fatalf("backReportProjectedStatusToOriginalResource() = %v, want no error", err)
+			}
+
+			got, err := fakeDynamicClient.Resource(deployGVR).Namespace(nsName).Get(ctx, deployName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("failed to get the deployment: %v", err)
+			}
+			if diff := cmp.Diff(got.GetAnnotations()[placementv1beta1.ProjectedStatusAnnotation], tc.wantAnnotation); diff != "" {
+				t.Errorf("projected status annotation mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statusbackreporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	errorsutil "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// ProjectedStatusPruner reconciles a placement object (CRP or RP) to prune the projected status annotations on
+// its original resources in the hub cluster.
+//
+// The status back-reporter only prunes the entries of the member clusters that are no longer targeted when another
+// member cluster reports; the pruner drops the entries of the member clusters that no longer have a binding of the
+// placement whenever the placement is reconciled, e.g., when the last member cluster leaves.
+type ProjectedStatusPruner struct {
+	hubClient        client.Client
+	hubDynamicClient dynamic.Interface
+}
+
+// NewProjectedStatusPruner creates a new ProjectedStatusPruner.
+func NewProjectedStatusPruner(hubClient client.Client, hubDynamicClient dynamic.Interface) *ProjectedStatusPruner {
+	return &ProjectedStatusPruner{
+		hubClient:        hubClient,
+		hubDynamicClient: hubDynamicClient,
+	}
+}
+
+// Reconcile prunes the projected status annotations on the original resources selected by the placement object.
+func (r *ProjectedStatusPruner) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	placementRef := klog.KRef(req.Namespace, req.Name)
+	startTime := time.Now()
+	klog.V(2).InfoS("Reconciliation loop starts", "controller", "projectedStatusPruner", "placement", placementRef)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Reconciliation loop ends", "controller", "projectedStatusPruner", "placement", placementRef, "latency", latency)
+	}()
+
+	placementObj, err := controller.FetchPlacementFromNamespacedName(ctx, r.hubClient, req.NamespacedName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(2).InfoS("Placement object is not found; skip", "placement", placementRef)
+			return ctrl.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to retrieve the placement object", "placement", placementRef)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+	if placementObj.GetDeletionTimestamp() != nil || !isProjectedStatusBackReportingToOriginalResourcesOn(placementObj) {
+		return ctrl.Result{}, nil
+	}
+
+	bindings, err := controller.ListBindingsFromKey(ctx, r.hubClient, req.NamespacedName, true)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list the bindings of the placement object", "placement", placementRef)
+		return ctrl.Result{}, err
+	}
+	clustersWithBindings := make(map[string]bool, len(bindings))
+	for _, binding := range bindings {
+		clustersWithBindings[binding.GetBindingSpec().TargetCluster] = true
+	}
+
+	selectedResources := placementObj.GetPlacementStatus().SelectedResources
+	var errs []error
+	for idx := range selectedResources {
+		selectedRes := &selectedResources[idx]
+		if selectedRes.Envelope != nil {
+			// Statuses are not back-reported to enveloped resources.
+			continue
+		}
+		if err := r.pruneProjectedStatusOnOriginalResource(ctx, selectedRes, clustersWithBindings); err != nil {
+			klog.ErrorS(err, "Failed to prune the projected status annotation on the original resource", "placement", placementRef, "resourceIdentifier", formatResourceIdentifier(selectedRes))
+			errs = append(errs, err)
+		}
+	}
+	return ctrl.Result{}, errorsutil.NewAggregate(errs)
+}
+
+// pruneProjectedStatusOnOriginalResource drops the entries of the member clusters without bindings from the
+// projected status annotation on an original resource.
+func (r *ProjectedStatusPruner) pruneProjectedStatusOnOriginalResource(
+	ctx context.Context,
+	resIdentifier *placementv1beta1.ResourceIdentifier,
+	clustersWithBindings map[string]bool,
+) error {
+	mapping, err := r.hubClient.RESTMapper().RESTMapping(schema.GroupKind{Group: resIdentifier.Group, Kind: resIdentifier.Kind}, resIdentifier.Version)
+	if err != nil {
+		return fmt.Errorf("failed to find the resource of the original resource: %w", err)
+	}
+	resClient := r.hubDynamicClient.Resource(mapping.Resource).Namespace(resIdentifier.Namespace)
+
+	// The status back-reporter might update the same original resource at the same time; retry on conflicts.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		unstructured, err := resClient.Get(ctx, resIdentifier.Name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("failed to retrieve the original resource: %w", err)
+		}
+
+		annotations := unstructured.GetAnnotations()
+		currentValue, ok := annotations[placementv1beta1.ProjectedStatusAnnotation]
+		if !ok {
+			return nil
+		}
+		newValue, err := pruneProjectedStatus(currentValue, clustersWithBindings)
+		if err != nil {
+			return fmt.Errorf("failed to prune projected status: %w", err)
+		}
+		if newValue == currentValue {
+			// No change; skip the update.
+			return nil
+		}
+
+		if len(newValue) == 0 {
+			delete(annotations, placementv1beta1.ProjectedStatusAnnotation)
+		} else {
+			annotations[placementv1beta1.ProjectedStatusAnnotation] = newValue
+		}
+		unstructured.SetAnnotations(annotations)
+		if _, err := resClient.Update(ctx, unstructured, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update projected status to the original resource: %w", err)
+		}
+		klog.V(2).InfoS("Pruned the projected status annotation on the original resource", "resource", klog.KRef(resIdentifier.Namespace, resIdentifier.Name))
+		return nil
+	})
+}
+
+// pruneProjectedStatus drops the entries of the member clusters without bindings from the projected status
+// annotation; it returns an empty string if no entries are left.
+func pruneProjectedStatus(annotationValue string, clustersWithBindings map[string]bool) (string, error) {
+	projectedStatusByCluster := parseProjectedStatusAnnotation(annotationValue)
+	for name := range projectedStatusByCluster {
+		if !clustersWithBindings[name] {
+			delete(projectedStatusByCluster, name)
+		}
+	}
+	if len(projectedStatusByCluster) == 0 {
+		return "", nil
+	}
+
+	// Note that the JSON encoder sorts map keys, so that the result is deterministic.
+	data, err := json.Marshal(projectedStatusByCluster)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// SetupWithManager sets up the pruner with the manager; ClusterResourcePlacements are reconciled by name, and
+// ResourcePlacements by namespace and name.
+func (r *ProjectedStatusPruner) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("projected-status-pruner").
+		Watches(&placementv1beta1.ClusterResourcePlacement{}, &handler.EnqueueRequestForObject{}).
+		Watches(&placementv1beta1.ResourcePlacement{}, &handler.EnqueueRequestForObject{}).
+		Complete(r)
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statusbackreporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/scheme"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func TestPruneProjectedStatus(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		annotationValue      string
+		clustersWithBindings map[string]bool
+		want                 string
+	}{
+		{
+			name:                 "all clusters have bindings",
+			annotationValue:      `{"cluster-1":{".status.readyReplicas":3},"cluster-2":{".status.readyReplicas":1}}`,
+			clustersWithBindings: map[string]bool{cluster1: true, cluster2: true},
+			want:                 `{"cluster-1":{".status.readyReplicas":3},"cluster-2":{".status.readyReplicas":1}}`,
+		},
+		{
+			name:                 "drop clusters without bindings",
+			annotationValue:      `{"cluster-1":{".status.readyReplicas":3},"cluster-2":{".status.readyReplicas":1}}`,
+			clustersWithBindings: map[string]bool{cluster1: true},
+			want:                 `{"cluster-1":{".status.readyReplicas":3}}`,
+		},
+		{
+			name:            "no clusters have bindings",
+			annotationValue: `{"cluster-1":{".status.readyReplicas":3}}`,
+			want:            "",
+		},
+		{
+			name:                 "corrupted annotation",
+			annotationValue:      `not-json`,
+			clustersWithBindings: map[string]bool{cluster1: true},
+			want:                 "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := pruneProjectedStatus(tc.annotationValue, tc.clustersWithBindings)
+			if err != nil {
+				t.Fatalf("pruneProjectedStatus() = %v, want no error", err)
+			}
+			if got != tc.want {
+				t.Errorf("pruneProjectedStatus() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestProjectedStatusPrunerReconcile tests the Reconcile method of the ProjectedStatusPruner.
+func TestProjectedStatusPrunerReconcile(t *testing.T) {
+	deployGVR := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	newDeploy := func(annotationValue string) *unstructured.Unstructured {
+		deploy := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]interface{}{
+					"name":      deployName,
+					"namespace": nsName,
+				},
+			},
+		}
+		deploy.SetAnnotations(map[string]string{placementv1beta1.ProjectedStatusAnnotation: annotationValue})
+		return deploy
+	}
+	newCRP := func(strategyType placementv1beta1.ReportBackStrategyType) *placementv1beta1.ClusterResourcePlacement {
+		return &placementv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: crpName1,
+			},
+			Spec: placementv1beta1.PlacementSpec{
+				Strategy: placementv1beta1.RolloutStrategy{
+					ReportBackStrategy: &placementv1beta1.ReportBackStrategy{
+						Type:        strategyType,
+						Destination: ptr.To(placementv1beta1.ReportBackDestinationOriginalResource),
+					},
+				},
+			},
+			Status: placementv1beta1.PlacementStatus{
+				SelectedResources: []placementv1beta1.ResourceIdentifier{
+					{
+						Group:     "apps",
+						Version:   "v1",
+						Kind:      "Deployment",
+						Name:      deployName,
+						Namespace: nsName,
+					},
+				},
+			},
+		}
+	}
+	newBinding := func(clusterName string) *placementv1beta1.ClusterResourceBinding {
+		return &placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: crpName1 + "-" + clusterName,
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: crpName1,
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: clusterName,
+			},
+		}
+	}
+	restMapper := meta.NewDefaultRESTMapper(nil)
+	restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	reportedByBoth := `{"cluster-1":{".status.readyReplicas":3},"cluster-2":{".status.readyReplicas":1}}`
+
+	testCases := []struct {
+		name           string
+		crp            *placementv1beta1.ClusterResourcePlacement
+		bindings       []client.Object
+		wantAnnotation *string
+	}{
+		{
+			name:           "drop the cluster without a binding",
+			crp:            newCRP(placementv1beta1.ReportBackStrategyTypeProjected),
+			bindings:       []client.Object{newBinding(cluster1)},
+			wantAnnotation: ptr.To(`{"cluster-1":{".status.readyReplicas":3}}`),
+		},
+		{
+			name:           "all clusters have bindings",
+			crp:            newCRP(placementv1beta1.ReportBackStrategyTypeProjected),
+			bindings:       []client.Object{newBinding(cluster1), newBinding(cluster2)},
+			wantAnnotation: ptr.To(reportedByBoth),
+		},
+		{
+			name: "remove the annotation when no clusters have bindings",
+			crp:  newCRP(placementv1beta1.ReportBackStrategyTypeProjected),
+		},
+		{
+			name:           "projected status back-reporting is off",
+			crp:            newCRP(placementv1beta1.ReportBackStrategyTypeMirror),
+			bindings:       []client.Object{newBinding(cluster1)},
+			wantAnnotation: ptr.To(reportedByBoth),
+		},
+		{
+			name:           "placement not found",
+			bindings:       []client.Object{newBinding(cluster1)},
+			wantAnnotation: ptr.To(reportedByBoth),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			objs := tc.bindings
+			if tc.crp != nil {
+				objs = append(objs, tc.crp)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRESTMapper(restMapper).WithObjects(objs...).Build()
+			fakeDynamicClient := dynamicfake.NewSimpleDynamicClient(scheme.Scheme, newDeploy(reportedByBoth))
+			r := NewProjectedStatusPruner(fakeClient, fakeDynamicClient)
+
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: crpName1}}); err != nil {
+				t.Fatalf("Reconcile() = %v, want no error", err)
+			}
+
+			got, err := fakeDynamicClient.Resource(deployGVR).Namespace(nsName).Get(ctx, deployName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("failed to get the deployment: %v", err)
+			}
+			var gotAnnotation *string
+			if value, ok := got.GetAnnotations()[placementv1beta1.ProjectedStatusAnnotation]; ok {
+				gotAnnotation = &value
+			}
+			if diff := cmp.Diff(gotAnnotation, tc.wantAnnotation); diff != "" {
+				t.Errorf("projected status annotation mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
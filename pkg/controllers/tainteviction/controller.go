@@ -0,0 +1,361 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tainteviction features a controller that evicts placements from member clusters with NoExecute taints
+// once the taints are no longer tolerated by the placements.
+package tainteviction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	evictionutils "github.com/kubefleet-dev/kubefleet/pkg/utils/eviction"
+	tolerationutils "github.com/kubefleet-dev/kubefleet/pkg/utils/toleration"
+)
+
+const (
+	// evictionNameFormat is the format of the name of the evictions created for the NoExecute taints, which is
+	// deterministic so that at most one eviction exists for a placement on a cluster.
+	evictionNameFormat = "taint-eviction-%s-%s"
+
+	// evictionRetryInterval is how long to wait before retrying an eviction that has not been executed,
+	// e.g., one that is blocked by a disruption budget.
+	evictionRetryInterval = time.Minute
+
+	// resyncPeriod is how often a member cluster with NoExecute taints is reconciled to catch up with the
+	// placements and bindings on it.
+	resyncPeriod = time.Minute
+)
+
+// Reconciler reconciles a MemberCluster object to evict the placements that no longer tolerate its NoExecute taints.
+//
+// ClusterResourcePlacements are evicted with ClusterResourcePlacementEviction objects, so their disruption budgets
+// are respected; as there's no eviction API or disruption budget for ResourcePlacements, they are evicted by deleting
+// their bindings on the member cluster directly, the same way an executed eviction does.
+type Reconciler struct {
+	Client client.Client
+}
+
+// Reconcile records when the NoExecute taints of the member cluster are added, and evicts the placements that do not
+// tolerate the taints, or whose toleration seconds have passed, from the member cluster.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	startTime := time.Now()
+	mcName := req.Name
+	klog.V(2).InfoS("Reconciliation loop starts", "controller", "taintEviction", "memberCluster", mcName)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Reconciliation loop ends", "controller", "taintEviction", "memberCluster", mcName, "latency", latency)
+	}()
+
+	var mc clusterv1beta1.MemberCluster
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: mcName}, &mc); err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(2).InfoS("Member cluster is not found; skip", "memberCluster", mcName)
+			return ctrl.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to get the member cluster", "memberCluster", mcName)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+	if mc.DeletionTimestamp != nil {
+		klog.V(2).InfoS("Member cluster is being deleted; skip", "memberCluster", mcName)
+		return ctrl.Result{}, nil
+	}
+
+	hasNoExecuteTaints := false
+	missingTimeAdded := false
+	now := metav1.Now()
+	for i := range mc.Spec.Taints {
+		if mc.Spec.Taints[i].Effect != corev1.TaintEffectNoExecute {
+			continue
+		}
+		hasNoExecuteTaints = true
+		if mc.Spec.Taints[i].TimeAdded == nil {
+			mc.Spec.Taints[i].TimeAdded = &now
+			missingTimeAdded = true
+		}
+	}
+	if !hasNoExecuteTaints {
+		klog.V(2).InfoS("Member cluster has no NoExecute taints; skip", "memberCluster", mcName)
+		return ctrl.Result{}, nil
+	}
+	if missingTimeAdded {
+		// The update triggers another reconciliation, which evicts the placements as needed.
+		if err := r.Client.Update(ctx, &mc); err != nil {
+			klog.ErrorS(err, "Failed to record when the NoExecute taints are added", "memberCluster", mcName)
+			return ctrl.Result{}, controller.NewUpdateIgnoreConflictError(err)
+		}
+		klog.V(2).InfoS("Recorded when the NoExecute taints are added", "memberCluster", mcName)
+		return ctrl.Result{}, nil
+	}
+
+	requeueAfter, err := r.evictPlacements(ctx, &mc, now.Time)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: min(requeueAfter, resyncPeriod)}, nil
+}
+
+// evictPlacements evicts the placements that no longer tolerate the NoExecute taints of the member cluster.
+// It returns how long to wait until the next placement is to be evicted, or the eviction is to be retried.
+func (r *Reconciler) evictPlacements(ctx context.Context, mc *clusterv1beta1.MemberCluster, now time.Time) (time.Duration, error) {
+	var bindingList placementv1beta1.ClusterResourceBindingList
+	if err := r.Client.List(ctx, &bindingList); err != nil {
+		klog.ErrorS(err, "Failed to list the cluster resource bindings", "memberCluster", mc.Name)
+		return 0, controller.NewAPIServerError(true, err)
+	}
+
+	requeueAfter := resyncPeriod
+	for i := range bindingList.Items {
+		binding := &bindingList.Items[i]
+		if binding.Spec.TargetCluster != mc.Name || binding.DeletionTimestamp != nil ||
+			(binding.Spec.State != placementv1beta1.BindingStateScheduled && binding.Spec.State != placementv1beta1.BindingStateBound) {
+			continue
+		}
+		crpName, ok := binding.Labels[placementv1beta1.PlacementTrackingLabel]
+		if !ok {
+			klog.V(2).InfoS("Binding does not have the placement tracking label; skip", "binding", klog.KObj(binding))
+			continue
+		}
+		var crp placementv1beta1.ClusterResourcePlacement
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: crpName}, &crp); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			klog.ErrorS(err, "Failed to get the cluster resource placement", "clusterResourcePlacement", crpName)
+			return 0, controller.NewAPIServerError(true, err)
+		}
+		if crp.DeletionTimestamp != nil ||
+			(crp.Spec.Policy != nil && crp.Spec.Policy.PlacementType == placementv1beta1.PickFixedPlacementType) {
+			// Placements of the PickFixed placement type cannot be evicted.
+			continue
+		}
+
+		evictAt, evict := evictionTime(mc.Spec.Taints, crp.Spec.Tolerations())
+		if !evict {
+			continue
+		}
+		if now.Before(evictAt) {
+			klog.V(2).InfoS("Placement tolerates the NoExecute taints for now", "clusterResourcePlacement", crpName, "memberCluster", mc.Name, "evictAt", evictAt)
+			requeueAfter = min(requeueAfter, evictAt.Sub(now))
+			continue
+		}
+		retryAfter, err := r.ensureEviction(ctx, crpName, mc.Name, binding, now)
+		if err != nil {
+			return 0, err
+		}
+		requeueAfter = min(requeueAfter, retryAfter)
+	}
+
+	rpRequeueAfter, err := r.evictResourcePlacements(ctx, mc, now)
+	if err != nil {
+		return 0, err
+	}
+	return min(requeueAfter, rpRequeueAfter), nil
+}
+
+// evictResourcePlacements evicts the ResourcePlacements that no longer tolerate the NoExecute taints of the member
+// cluster by deleting their bindings on it; the scheduler does not pick the member cluster again for them, as the
+// taints are not tolerated.
+// It returns how long to wait until the next ResourcePlacement is to be evicted.
+func (r *Reconciler) evictResourcePlacements(ctx context.Context, mc *clusterv1beta1.MemberCluster, now time.Time) (time.Duration, error) {
+	var bindingList placementv1beta1.ResourceBindingList
+	if err := r.Client.List(ctx, &bindingList); err != nil {
+		klog.ErrorS(err, "Failed to list the resource bindings", "memberCluster", mc.Name)
+		return 0, controller.NewAPIServerError(true, err)
+	}
+
+	requeueAfter := resyncPeriod
+	for i := range bindingList.Items {
+		binding := &bindingList.Items[i]
+		if binding.Spec.TargetCluster != mc.Name || binding.DeletionTimestamp != nil ||
+			(binding.Spec.State != placementv1beta1.BindingStateScheduled && binding.Spec.State != placementv1beta1.BindingStateBound) {
+			continue
+		}
+		rpName, ok := binding.Labels[placementv1beta1.PlacementTrackingLabel]
+		if !ok {
+			klog.V(2).InfoS("Binding does not have the placement tracking label; skip", "binding", klog.KObj(binding))
+			continue
+		}
+		rpKey := types.NamespacedName{Namespace: binding.Namespace, Name: rpName}
+		var rp placementv1beta1.ResourcePlacement
+		if err := r.Client.Get(ctx, rpKey, &rp); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			klog.ErrorS(err, "Failed to get the resource placement", "resourcePlacement", rpKey)
+			return 0, controller.NewAPIServerError(true, err)
+		}
+		if rp.DeletionTimestamp != nil ||
+			(rp.Spec.Policy != nil && rp.Spec.Policy.PlacementType == placementv1beta1.PickFixedPlacementType) {
+			// Placements of the PickFixed placement type cannot be evicted.
+			continue
+		}
+
+		evictAt, evict := evictionTime(mc.Spec.Taints, rp.Spec.Tolerations())
+		if !evict {
+			continue
+		}
+		if now.Before(evictAt) {
+			klog.V(2).InfoS("Placement tolerates the NoExecute taints for now", "resourcePlacement", rpKey, "memberCluster", mc.Name, "evictAt", evictAt)
+			requeueAfter = min(requeueAfter, evictAt.Sub(now))
+			continue
+		}
+		deleteOptions := &client.DeleteOptions{
+			Preconditions: &metav1.Preconditions{
+				ResourceVersion: ptr.To(binding.ResourceVersion),
+			},
+		}
+		if err := r.Client.Delete(ctx, binding, deleteOptions); err != nil {
+			if apierrors.IsNotFound(err) || apierrors.IsConflict(err) {
+				// The binding has been changed since it was listed; it's checked again in the next reconciliation.
+				requeueAfter = min(requeueAfter, time.Second)
+				continue
+			}
+			klog.ErrorS(err, "Failed to delete the resource binding", "resourceBinding", klog.KObj(binding))
+			return 0, controller.NewAPIServerError(false, err)
+		}
+		klog.V(2).InfoS("Deleted the resource binding as the placement no longer tolerates the NoExecute taints",
+			"resourceBinding", klog.KObj(binding), "resourcePlacement", rpKey, "memberCluster", mc.Name)
+	}
+	return requeueAfter, nil
+}
+
+// ensureEviction makes sure that an eviction is in progress for the placement on the member cluster.
+// An eviction that has finished without evicting the placement is deleted, so that it is created again after the
+// retry interval.
+// It returns how long to wait until the eviction is checked again.
+func (r *Reconciler) ensureEviction(
+	ctx context.Context,
+	crpName, clusterName string,
+	binding *placementv1beta1.ClusterResourceBinding,
+	now time.Time,
+) (time.Duration, error) {
+	evictionName := fmt.Sprintf(evictionNameFormat, crpName, clusterName)
+	if errs := validation.IsDNS1123Subdomain(evictionName); len(errs) != 0 {
+		err := fmt.Errorf("failed to format a qualified name for the eviction %s: %v", evictionName, errs)
+		klog.ErrorS(err, "Cannot evict the placement from the member cluster", "clusterResourcePlacement", crpName, "memberCluster", clusterName)
+		return resyncPeriod, nil
+	}
+	evictionKObj := klog.KRef("", evictionName)
+
+	var eviction placementv1beta1.ClusterResourcePlacementEviction
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: evictionName}, &eviction); err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to get the eviction", "clusterResourcePlacementEviction", evictionKObj)
+			return 0, controller.NewAPIServerError(true, err)
+		}
+		eviction = placementv1beta1.ClusterResourcePlacementEviction{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: evictionName,
+			},
+			Spec: placementv1beta1.PlacementEvictionSpec{
+				PlacementName: crpName,
+				ClusterName:   clusterName,
+			},
+		}
+		if err := r.Client.Create(ctx, &eviction); err != nil && !apierrors.IsAlreadyExists(err) {
+			klog.ErrorS(err, "Failed to create the eviction", "clusterResourcePlacementEviction", evictionKObj)
+			return 0, controller.NewAPIServerError(false, err)
+		}
+		klog.V(2).InfoS("Created the eviction as the placement no longer tolerates the NoExecute taints",
+			"clusterResourcePlacementEviction", evictionKObj, "clusterResourcePlacement", crpName, "memberCluster", clusterName)
+		return evictionRetryInterval, nil
+	}
+
+	if !evictionutils.IsEvictionInTerminalState(&eviction) {
+		klog.V(2).InfoS("Waiting for the eviction to finish", "clusterResourcePlacementEviction", evictionKObj)
+		return evictionRetryInterval, nil
+	}
+	// The eviction has finished but the binding is still there, either because the eviction did not go through,
+	// or because the eviction is left over from an earlier eviction of the placement.
+	retryAt := eviction.CreationTimestamp.Add(evictionRetryInterval)
+	if now.Before(retryAt) && !eviction.CreationTimestamp.Before(&binding.CreationTimestamp) {
+		return retryAt.Sub(now), nil
+	}
+	if err := r.Client.Delete(ctx, &eviction); err != nil && !apierrors.IsNotFound(err) {
+		klog.ErrorS(err, "Failed to delete the finished eviction", "clusterResourcePlacementEviction", evictionKObj)
+		return 0, controller.NewAPIServerError(false, err)
+	}
+	klog.V(2).InfoS("Deleted the finished eviction to evict the placement again", "clusterResourcePlacementEviction", evictionKObj)
+	// The eviction is created again in the next reconciliation.
+	return time.Second, nil
+}
+
+// evictionTime returns when the placement with the tolerations is to be evicted from the member cluster with the
+// taints, and whether it is to be evicted at all.
+//
+// Like the NoExecute taints of Kubernetes nodes, a placement is evicted right away when any NoExecute taint is not
+// tolerated; a taint tolerated by a toleration without toleration seconds is tolerated forever; otherwise the
+// placement is evicted once the smallest toleration seconds have passed since the taint was added.
+func evictionTime(taints []clusterv1beta1.Taint, tolerations []placementv1beta1.Toleration) (time.Time, bool) {
+	var evictAt time.Time
+	evict := false
+	for _, taint := range taints {
+		if taint.Effect != corev1.TaintEffectNoExecute || taint.TimeAdded == nil {
+			continue
+		}
+		tolerated := false
+		toleratedForever := false
+		var tolerationSeconds int64
+		for _, toleration := range tolerations {
+			if !tolerationutils.ToleratesTaint(toleration, taint) {
+				continue
+			}
+			if toleration.TolerationSeconds == nil {
+				toleratedForever = true
+				break
+			}
+			seconds := max(*toleration.TolerationSeconds, 0)
+			if !tolerated || seconds < tolerationSeconds {
+				tolerationSeconds = seconds
+			}
+			tolerated = true
+		}
+		if toleratedForever {
+			continue
+		}
+		taintEvictAt := taint.TimeAdded.Add(time.Duration(tolerationSeconds) * time.Second)
+		if !evict || taintEvictAt.Before(evictAt) {
+			evictAt = taintEvictAt
+		}
+		evict = true
+	}
+	return evictAt, evict
+}
+
+// SetupWithManager sets up the controller with the manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("taint-eviction-controller").
+		For(&clusterv1beta1.MemberCluster{}).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
@@ -0,0 +1,379 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tainteviction
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	mcName       = "member-1"
+	crpName      = "crp-1"
+	evictionName = "taint-eviction-crp-1-member-1"
+)
+
+func TestEvictionTime(t *testing.T) {
+	timeAdded := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	noExecuteTaint := clusterv1beta1.Taint{Key: "key1", Value: "value1", Effect: corev1.TaintEffectNoExecute, TimeAdded: &metav1.Time{Time: timeAdded}}
+	tests := []struct {
+		name        string
+		taints      []clusterv1beta1.Taint
+		tolerations []placementv1beta1.Toleration
+		wantEvictAt time.Time
+		wantEvict   bool
+	}{
+		{
+			name:   "NoSchedule taint is never evicted",
+			taints: []clusterv1beta1.Taint{{Key: "key1", Effect: corev1.TaintEffectNoSchedule}},
+		},
+		{
+			name:        "NoExecute taint not tolerated is evicted right away",
+			taints:      []clusterv1beta1.Taint{noExecuteTaint},
+			wantEvictAt: timeAdded,
+			wantEvict:   true,
+		},
+		{
+			name:   "NoExecute taint tolerated without toleration seconds is tolerated forever",
+			taints: []clusterv1beta1.Taint{noExecuteTaint},
+			tolerations: []placementv1beta1.Toleration{
+				{Key: "key1", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: ptr.To(int64(60))},
+				{Key: "key1", Operator: corev1.TolerationOpExists},
+			},
+		},
+		{
+			name:   "NoExecute taint is evicted after the smallest toleration seconds",
+			taints: []clusterv1beta1.Taint{noExecuteTaint},
+			tolerations: []placementv1beta1.Toleration{
+				{Key: "key1", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: ptr.To(int64(600))},
+				{Key: "key1", Operator: corev1.TolerationOpEqual, Value: "value1", Effect: corev1.TaintEffectNoExecute, TolerationSeconds: ptr.To(int64(60))},
+			},
+			wantEvictAt: timeAdded.Add(time.Minute),
+			wantEvict:   true,
+		},
+		{
+			name:   "negative toleration seconds are treated as zero",
+			taints: []clusterv1beta1.Taint{noExecuteTaint},
+			tolerations: []placementv1beta1.Toleration{
+				{Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: ptr.To(int64(-60))},
+			},
+			wantEvictAt: timeAdded,
+			wantEvict:   true,
+		},
+		{
+			name: "earliest eviction time of multiple NoExecute taints",
+			taints: []clusterv1beta1.Taint{
+				noExecuteTaint,
+				{Key: "key2", Effect: corev1.TaintEffectNoExecute, TimeAdded: &metav1.Time{Time: timeAdded.Add(time.Minute)}},
+			},
+			tolerations: []placementv1beta1.Toleration{
+				{Key: "key1", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: ptr.To(int64(600))},
+				{Key: "key2", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: ptr.To(int64(60))},
+			},
+			wantEvictAt: timeAdded.Add(2 * time.Minute),
+			wantEvict:   true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gotEvictAt, gotEvict := evictionTime(tc.taints, tc.tolerations)
+			if gotEvict != tc.wantEvict || !gotEvictAt.Equal(tc.wantEvictAt) {
+				t.Errorf("evictionTime() = (%v, %v), want (%v, %v)", gotEvictAt, gotEvict, tc.wantEvictAt, tc.wantEvict)
+			}
+		})
+	}
+}
+
+func memberCluster(taints ...clusterv1beta1.Taint) *clusterv1beta1.MemberCluster {
+	return &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: mcName},
+		Spec:       clusterv1beta1.MemberClusterSpec{Taints: taints},
+	}
+}
+
+func clusterResourcePlacement(placementType placementv1beta1.PlacementType, tolerations ...placementv1beta1.Toleration) *placementv1beta1.ClusterResourcePlacement {
+	return &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{Name: crpName},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementType,
+				Tolerations:   tolerations,
+			},
+		},
+	}
+}
+
+func binding(state placementv1beta1.BindingState) *placementv1beta1.ClusterResourceBinding {
+	return &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "binding-1",
+			Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         state,
+			TargetCluster: mcName,
+		},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	now := time.Now()
+	noExecuteTaint := func(timeAdded time.Time) clusterv1beta1.Taint {
+		return clusterv1beta1.Taint{Key: "key1", Effect: corev1.TaintEffectNoExecute, TimeAdded: &metav1.Time{Time: timeAdded}}
+	}
+	finishedEviction := &placementv1beta1.ClusterResourcePlacementEviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              evictionName,
+			CreationTimestamp: metav1.NewTime(now.Add(-2 * evictionRetryInterval)),
+		},
+		Spec: placementv1beta1.PlacementEvictionSpec{PlacementName: crpName, ClusterName: mcName},
+		Status: placementv1beta1.PlacementEvictionStatus{
+			Conditions: []metav1.Condition{
+				{Type: string(placementv1beta1.PlacementEvictionConditionTypeValid), Status: metav1.ConditionTrue},
+				{Type: string(placementv1beta1.PlacementEvictionConditionTypeExecuted), Status: metav1.ConditionFalse},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		objects       []client.Object
+		wantEviction  bool
+		wantRequeue   bool
+		wantTimeAdded bool
+	}{
+		{
+			name:    "member cluster without NoExecute taints",
+			objects: []client.Object{memberCluster(clusterv1beta1.Taint{Key: "key1", Effect: corev1.TaintEffectNoSchedule}), clusterResourcePlacement(placementv1beta1.PickAllPlacementType), binding(placementv1beta1.BindingStateBound)},
+		},
+		{
+			name:          "time added is recorded for new NoExecute taints",
+			objects:       []client.Object{memberCluster(clusterv1beta1.Taint{Key: "key1", Effect: corev1.TaintEffectNoExecute}), clusterResourcePlacement(placementv1beta1.PickAllPlacementType), binding(placementv1beta1.BindingStateBound)},
+			wantTimeAdded: true,
+		},
+		{
+			name:         "placement not tolerating the taint is evicted",
+			objects:      []client.Object{memberCluster(noExecuteTaint(now)), clusterResourcePlacement(placementv1beta1.PickAllPlacementType), binding(placementv1beta1.BindingStateBound)},
+			wantEviction: true,
+			wantRequeue:  true,
+		},
+		{
+			name: "placement tolerating the taint for a period is not evicted yet",
+			objects: []client.Object{
+				memberCluster(noExecuteTaint(now)),
+				clusterResourcePlacement(placementv1beta1.PickNPlacementType, placementv1beta1.Toleration{Key: "key1", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: ptr.To(int64(3600))}),
+				binding(placementv1beta1.BindingStateScheduled),
+			},
+			wantRequeue: true,
+		},
+		{
+			name: "placement is evicted once the toleration seconds have passed",
+			objects: []client.Object{
+				memberCluster(noExecuteTaint(now.Add(-time.Hour))),
+				clusterResourcePlacement(placementv1beta1.PickNPlacementType, placementv1beta1.Toleration{Key: "key1", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: ptr.To(int64(60))}),
+				binding(placementv1beta1.BindingStateBound),
+			},
+			wantEviction: true,
+			wantRequeue:  true,
+		},
+		{
+			name:        "unscheduled binding is not evicted",
+			objects:     []client.Object{memberCluster(noExecuteTaint(now)), clusterResourcePlacement(placementv1beta1.PickAllPlacementType), binding(placementv1beta1.BindingStateUnscheduled)},
+			wantRequeue: true,
+		},
+		{
+			name:        "placement of the PickFixed placement type is not evicted",
+			objects:     []client.Object{memberCluster(noExecuteTaint(now)), clusterResourcePlacement(placementv1beta1.PickFixedPlacementType), binding(placementv1beta1.BindingStateBound)},
+			wantRequeue: true,
+		},
+		{
+			name:        "finished eviction that did not evict the placement is deleted to be retried",
+			objects:     []client.Object{memberCluster(noExecuteTaint(now)), clusterResourcePlacement(placementv1beta1.PickAllPlacementType), binding(placementv1beta1.BindingStateBound), finishedEviction},
+			wantRequeue: true,
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add cluster scheme: %v", err)
+	}
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add placement scheme: %v", err)
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.objects...).Build()
+			r := &Reconciler{Client: fakeClient}
+			ctx := context.Background()
+			got, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: mcName}})
+			if err != nil {
+				t.Fatalf("Reconcile() = %v, want nil", err)
+			}
+			if gotRequeue := got.RequeueAfter > 0; gotRequeue != tc.wantRequeue {
+				t.Errorf("Reconcile() requeueAfter = %v, want requeue %v", got.RequeueAfter, tc.wantRequeue)
+			}
+			if got.RequeueAfter > resyncPeriod {
+				t.Errorf("Reconcile() requeueAfter = %v, want no more than %v", got.RequeueAfter, resyncPeriod)
+			}
+
+			var mc clusterv1beta1.MemberCluster
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: mcName}, &mc); err != nil {
+				t.Fatalf("Failed to get the member cluster: %v", err)
+			}
+			if gotTimeAdded := mc.Spec.Taints[0].TimeAdded != nil; tc.wantTimeAdded && !gotTimeAdded {
+				t.Errorf("taint time added = nil, want it to be set")
+			}
+
+			var eviction placementv1beta1.ClusterResourcePlacementEviction
+			err = fakeClient.Get(ctx, types.NamespacedName{Name: evictionName}, &eviction)
+			if tc.wantEviction {
+				if err != nil {
+					t.Fatalf("Failed to get the eviction: %v", err)
+				}
+				wantSpec := placementv1beta1.PlacementEvictionSpec{PlacementName: crpName, ClusterName: mcName}
+				if diff := cmp.Diff(eviction.Spec, wantSpec); diff != "" {
+					t.Errorf("eviction spec mismatch (-got, +want):\n%s", diff)
+				}
+				return
+			}
+			if !apierrors.IsNotFound(err) {
+				t.Errorf("Get() eviction = %v, want not found", err)
+			}
+		})
+	}
+}
+
+func TestReconcile_ResourcePlacements(t *testing.T) {
+	now := time.Now()
+	noExecuteTaint := func(timeAdded time.Time) clusterv1beta1.Taint {
+		return clusterv1beta1.Taint{Key: "key1", Effect: corev1.TaintEffectNoExecute, TimeAdded: &metav1.Time{Time: timeAdded}}
+	}
+	resourcePlacement := func(placementType placementv1beta1.PlacementType, tolerations ...placementv1beta1.Toleration) *placementv1beta1.ResourcePlacement {
+		return &placementv1beta1.ResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: "rp-1", Namespace: "app"},
+			Spec: placementv1beta1.PlacementSpec{
+				Policy: &placementv1beta1.PlacementPolicy{
+					PlacementType: placementType,
+					Tolerations:   tolerations,
+				},
+			},
+		}
+	}
+	resourceBinding := func(state placementv1beta1.BindingState) *placementv1beta1.ResourceBinding {
+		return &placementv1beta1.ResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "binding-1",
+				Namespace: "app",
+				Labels:    map[string]string{placementv1beta1.PlacementTrackingLabel: "rp-1"},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				State:         state,
+				TargetCluster: mcName,
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		objects         []client.Object
+		wantBindingGone bool
+	}{
+		{
+			name:            "placement not tolerating the taint is evicted",
+			objects:         []client.Object{memberCluster(noExecuteTaint(now)), resourcePlacement(placementv1beta1.PickAllPlacementType), resourceBinding(placementv1beta1.BindingStateBound)},
+			wantBindingGone: true,
+		},
+		{
+			name: "placement tolerating the taint for a period is not evicted yet",
+			objects: []client.Object{
+				memberCluster(noExecuteTaint(now)),
+				resourcePlacement(placementv1beta1.PickNPlacementType, placementv1beta1.Toleration{Key: "key1", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: ptr.To(int64(3600))}),
+				resourceBinding(placementv1beta1.BindingStateScheduled),
+			},
+		},
+		{
+			name: "placement is evicted once the toleration seconds have passed",
+			objects: []client.Object{
+				memberCluster(noExecuteTaint(now.Add(-time.Hour))),
+				resourcePlacement(placementv1beta1.PickNPlacementType, placementv1beta1.Toleration{Key: "key1", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: ptr.To(int64(60))}),
+				resourceBinding(placementv1beta1.BindingStateBound),
+			},
+			wantBindingGone: true,
+		},
+		{
+			name:    "placement tolerating the taint forever is not evicted",
+			objects: []client.Object{memberCluster(noExecuteTaint(now)), resourcePlacement(placementv1beta1.PickAllPlacementType, placementv1beta1.Toleration{Key: "key1", Operator: corev1.TolerationOpExists}), resourceBinding(placementv1beta1.BindingStateBound)},
+		},
+		{
+			name:    "unscheduled binding is not evicted",
+			objects: []client.Object{memberCluster(noExecuteTaint(now)), resourcePlacement(placementv1beta1.PickAllPlacementType), resourceBinding(placementv1beta1.BindingStateUnscheduled)},
+		},
+		{
+			name:    "placement of the PickFixed placement type is not evicted",
+			objects: []client.Object{memberCluster(noExecuteTaint(now)), resourcePlacement(placementv1beta1.PickFixedPlacementType), resourceBinding(placementv1beta1.BindingStateBound)},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add cluster scheme: %v", err)
+	}
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add placement scheme: %v", err)
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.objects...).Build()
+			r := &Reconciler{Client: fakeClient}
+			ctx := context.Background()
+			got, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: mcName}})
+			if err != nil {
+				t.Fatalf("Reconcile() = %v, want nil", err)
+			}
+			if got.RequeueAfter <= 0 || got.RequeueAfter > resyncPeriod {
+				t.Errorf("Reconcile() requeueAfter = %v, want between 0 and %v", got.RequeueAfter, resyncPeriod)
+			}
+
+			var rb placementv1beta1.ResourceBinding
+			err = fakeClient.Get(ctx, types.NamespacedName{Namespace: "app", Name: "binding-1"}, &rb)
+			if tc.wantBindingGone {
+				if !apierrors.IsNotFound(err) {
+					t.Errorf("Get() resource binding = %v, want not found", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Get() resource binding = %v, want no error", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterun
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/metricanalysis"
+)
+
+const (
+	// defaultAnalysisInterval is the default time between two measurements of a metric.
+	defaultAnalysisInterval = time.Minute
+
+	// maxMeasurementsKept is the maximum number of measurements kept in the status of a metric.
+	maxMeasurementsKept = 10
+
+	// maxAnalysisQueryDuration is the longest time spent querying the metrics of an analysis task in a single
+	// reconciliation, as the queries block it; the metrics not measured in time are measured in the next one.
+	maxAnalysisQueryDuration = 15 * time.Second
+)
+
+// handleStageAnalysisTask handles the metric analysis task logic for after stage tasks.
+// It returns true if the analysis has succeeded, and the time to wait before taking the next measurement
+// if the analysis is still running. An errStagedUpdatedAborted error is returned if the analysis has failed.
+func (r *Reconciler) handleStageAnalysisTask(
+	ctx context.Context,
+	stageTaskStatus *placementv1beta1.StageTaskStatus,
+	task *placementv1beta1.StageTask,
+	updatingStageStatus *placementv1beta1.StageUpdatingStatus,
+	updateRun placementv1beta1.UpdateRunObj,
+) (bool, time.Duration, error) {
+	updateRunRef := klog.KObj(updateRun)
+	stageName := updatingStageStatus.StageName
+
+	analysisCond := meta.FindStatusCondition(stageTaskStatus.Conditions, string(placementv1beta1.StageTaskConditionAnalysisSucceeded))
+	if condition.IsConditionStatusTrue(analysisCond, updateRun.GetGeneration()) {
+		// The analysis has succeeded.
+		return true, 0, nil
+	}
+	if task.Analysis == nil {
+		// This should have been caught by the validation.
+		unexpectedErr := controller.NewUnexpectedBehaviorError(fmt.Errorf("the analysis task in stage `%s` has no analysis config", stageName))
+		klog.ErrorS(unexpectedErr, "Found an analysis task without analysis config", "stage", stageName, "updateRun", updateRunRef)
+		return false, -1, fmt.Errorf("%w: %s", errStagedUpdatedAborted, unexpectedErr.Error())
+	}
+
+	newMetricProvider := r.MetricProviderFactory
+	if newMetricProvider == nil {
+		newMetricProvider = metricanalysis.NewMetricProvider
+	}
+	provider, err := newMetricProvider(task.Analysis.Provider)
+	if err != nil {
+		userErr := controller.NewUserError(err)
+		klog.ErrorS(userErr, "Failed to set up the metric provider for the analysis task", "stage", stageName, "updateRun", updateRunRef)
+		return false, -1, fmt.Errorf("%w: %s", errStagedUpdatedAborted, userErr.Error())
+	}
+
+	now := time.Now()
+	if stageTaskStatus.AnalysisStatus == nil {
+		stageTaskStatus.AnalysisStatus = &placementv1beta1.AnalysisStatus{
+			StartTime: metav1.NewTime(now),
+		}
+	}
+	analysisStatus := stageTaskStatus.AnalysisStatus
+	replacer := newAnalysisQueryReplacer(updateRun, updatingStageStatus)
+
+	queryCtx, cancel := context.WithTimeout(ctx, maxAnalysisQueryDuration)
+	defer cancel()
+	waitTime := time.Duration(-1)
+	for i := range task.Analysis.Metrics {
+		metric := &task.Analysis.Metrics[i]
+		metricStatus := findOrAddMetricAnalysisStatus(analysisStatus, metric.Name)
+		if metricStatus.Phase != placementv1beta1.AnalysisPhaseRunning {
+			// The metric has completed; failed metrics are handled after all the metrics are processed.
+			continue
+		}
+
+		interval := defaultAnalysisInterval
+		if metric.Interval != nil && metric.Interval.Duration > 0 {
+			interval = metric.Interval.Duration
+		}
+		lastMeasuredAt := analysisStatus.StartTime.Time
+		if len(metricStatus.Measurements) > 0 {
+			lastMeasuredAt = metricStatus.Measurements[len(metricStatus.Measurements)-1].MeasuredAt.Time
+		}
+		if remaining := lastMeasuredAt.Add(interval).Sub(now); remaining > 0 {
+			klog.V(2).InfoS("The next measurement of the metric is not due yet", "metric", metric.Name, "remaining", remaining, "stage", stageName, "updateRun", updateRunRef)
+			waitTime = minPositiveDuration(waitTime, remaining)
+			continue
+		}
+
+		if queryCtx.Err() != nil {
+			klog.V(2).InfoS("Ran out of time to measure the metric in this reconciliation", "metric", metric.Name, "stage", stageName, "updateRun", updateRunRef)
+			waitTime = minPositiveDuration(waitTime, 0)
+			continue
+		}
+		measurement := takeMeasurement(queryCtx, provider, metric, replacer.Replace(metric.Query), now)
+		klog.V(2).InfoS("Took a measurement of the metric", "metric", metric.Name, "phase", measurement.Phase, "value", measurement.Value, "message", measurement.Message, "stage", stageName, "updateRun", updateRunRef)
+		recordMeasurement(metricStatus, metric, measurement)
+		if metricStatus.Phase == placementv1beta1.AnalysisPhaseRunning {
+			waitTime = minPositiveDuration(waitTime, interval)
+		}
+	}
+
+	for i := range analysisStatus.Metrics {
+		metricStatus := &analysisStatus.Metrics[i]
+		if metricStatus.Phase == placementv1beta1.AnalysisPhaseFailed {
+			failedErr := controller.NewUserError(fmt.Errorf("the analysis metric `%s` has failed %d measurements, exceeding the failure limit", metricStatus.Name, metricStatus.FailedCount))
+			klog.ErrorS(failedErr, "The analysis task has failed", "stage", stageName, "updateRun", updateRunRef)
+			markAfterStageAnalysisFailed(stageTaskStatus, updateRun.GetGeneration(), failedErr.Error())
+			return false, -1, fmt.Errorf("%w: %s", errStagedUpdatedAborted, failedErr.Error())
+		}
+	}
+
+	if allMetricsSucceeded(analysisStatus, task.Analysis) {
+		klog.V(2).InfoS("The analysis task has succeeded", "stage", stageName, "updateRun", updateRunRef)
+		markAfterStageAnalysisSucceeded(stageTaskStatus, updateRun.GetGeneration())
+		return true, 0, nil
+	}
+	return false, waitTime, nil
+}
+
+// takeMeasurement takes a measurement of the metric and evaluates it against the conditions of the metric.
+func takeMeasurement(
+	ctx context.Context,
+	provider metricanalysis.MetricProvider,
+	metric *placementv1beta1.AnalysisMetric,
+	query string,
+	now time.Time,
+) placementv1beta1.Measurement {
+	measurement := placementv1beta1.Measurement{
+		MeasuredAt: metav1.NewTime(now),
+	}
+	value, err := provider.Query(ctx, query)
+	if err != nil {
+		measurement.Phase = placementv1beta1.MeasurementPhaseError
+		measurement.Message = err.Error()
+		return measurement
+	}
+	measurement.Value = strconv.FormatFloat(value, 'g', -1, 64)
+
+	succeeded, err := metricanalysis.EvaluateCondition(metric.SuccessCondition, value)
+	if err != nil {
+		measurement.Phase = placementv1beta1.MeasurementPhaseError
+		measurement.Message = err.Error()
+		return measurement
+	}
+	if succeeded {
+		measurement.Phase = placementv1beta1.MeasurementPhaseSuccessful
+		return measurement
+	}
+	if metric.FailureCondition == "" {
+		measurement.Phase = placementv1beta1.MeasurementPhaseFailed
+		measurement.Message = fmt.Sprintf("the success condition `%s` is not met", metric.SuccessCondition)
+		return measurement
+	}
+	failed, err := metricanalysis.EvaluateCondition(metric.FailureCondition, value)
+	switch {
+	case err != nil:
+		measurement.Phase = placementv1beta1.MeasurementPhaseError
+		measurement.Message = err.Error()
+	case failed:
+		measurement.Phase = placementv1beta1.MeasurementPhaseFailed
+		measurement.Message = fmt.Sprintf("the failure condition `%s` is met", metric.FailureCondition)
+	default:
+		measurement.Phase = placementv1beta1.MeasurementPhaseInconclusive
+	}
+	return measurement
+}
+
+// recordMeasurement records a measurement in the status of the metric and updates the phase of the metric.
+func recordMeasurement(metricStatus *placementv1beta1.MetricAnalysisStatus, metric *placementv1beta1.AnalysisMetric, measurement placementv1beta1.Measurement) {
+	metricStatus.MeasurementCount++
+	switch measurement.Phase {
+	case placementv1beta1.MeasurementPhaseSuccessful:
+		metricStatus.SuccessfulCount++
+	case placementv1beta1.MeasurementPhaseInconclusive:
+		metricStatus.InconclusiveCount++
+	default:
+		// Measurements that cannot be taken count as failures.
+		metricStatus.FailedCount++
+	}
+	metricStatus.Measurements = append(metricStatus.Measurements, measurement)
+	if len(metricStatus.Measurements) > maxMeasurementsKept {
+		metricStatus.Measurements = metricStatus.Measurements[len(metricStatus.Measurements)-maxMeasurementsKept:]
+	}
+
+	count := max(metric.Count, 1)
+	switch {
+	case metricStatus.FailedCount > metric.FailureLimit:
+		metricStatus.Phase = placementv1beta1.AnalysisPhaseFailed
+	case metricStatus.MeasurementCount >= count:
+		metricStatus.Phase = placementv1beta1.AnalysisPhaseSuccessful
+	default:
+		metricStatus.Phase = placementv1beta1.AnalysisPhaseRunning
+	}
+}
+
+// findOrAddMetricAnalysisStatus returns the status of the metric with the given name, adding one if not found.
+func findOrAddMetricAnalysisStatus(analysisStatus *placementv1beta1.AnalysisStatus, name string) *placementv1beta1.MetricAnalysisStatus {
+	if metricStatus := findMetricAnalysisStatus(analysisStatus, name); metricStatus != nil {
+		return metricStatus
+	}
+	analysisStatus.Metrics = append(analysisStatus.Metrics, placementv1beta1.MetricAnalysisStatus{
+		Name:  name,
+		Phase: placementv1beta1.AnalysisPhaseRunning,
+	})
+	return &analysisStatus.Metrics[len(analysisStatus.Metrics)-1]
+}
+
+// findMetricAnalysisStatus returns the status of the metric with the given name, or nil if not found.
+func findMetricAnalysisStatus(analysisStatus *placementv1beta1.AnalysisStatus, name string) *placementv1beta1.MetricAnalysisStatus {
+	for i := range analysisStatus.Metrics {
+		if analysisStatus.Metrics[i].Name == name {
+			return &analysisStatus.Metrics[i]
+		}
+	}
+	return nil
+}
+
+// allMetricsSucceeded returns whether all the metrics in the analysis have succeeded.
+func allMetricsSucceeded(analysisStatus *placementv1beta1.AnalysisStatus, analysis *placementv1beta1.AnalysisConfig) bool {
+	for i := range analysis.Metrics {
+		metricStatus := findMetricAnalysisStatus(analysisStatus, analysis.Metrics[i].Name)
+		if metricStatus == nil || metricStatus.Phase != placementv1beta1.AnalysisPhaseSuccessful {
+			return false
+		}
+	}
+	return true
+}
+
+// newAnalysisQueryReplacer returns a replacer that fills in the placeholders in analysis queries.
+func newAnalysisQueryReplacer(updateRun placementv1beta1.UpdateRunObj, updatingStageStatus *placementv1beta1.StageUpdatingStatus) *strings.Replacer {
+	clusterNames := make([]string, 0, len(updatingStageStatus.Clusters))
+	for i := range updatingStageStatus.Clusters {
+		clusterNames = append(clusterNames, updatingStageStatus.Clusters[i].ClusterName)
+	}
+	return strings.NewReplacer(
+		"{{stage}}", updatingStageStatus.StageName,
+		"{{updateRun}}", updateRun.GetName(),
+		"{{placement}}", updateRun.GetUpdateRunSpec().PlacementName,
+		"{{clusters}}", strings.Join(clusterNames, "|"),
+	)
+}
+
+// minPositiveDuration returns the smaller of the two durations, treating negative durations as unset.
+func minPositiveDuration(a, b time.Duration) time.Duration {
+	if a < 0 || (b >= 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// markAfterStageAnalysisSucceeded marks the Analysis after stage task as succeeded in memory.
+func markAfterStageAnalysisSucceeded(afterStageTaskStatus *placementv1beta1.StageTaskStatus, generation int64) {
+	meta.SetStatusCondition(&afterStageTaskStatus.Conditions, metav1.Condition{
+		Type:               string(placementv1beta1.StageTaskConditionAnalysisSucceeded),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             condition.AfterStageTaskAnalysisSucceededReason,
+		Message:            "All the analysis metrics have succeeded",
+	})
+}
+
+// markAfterStageAnalysisFailed marks the Analysis after stage task as failed in memory.
+func markAfterStageAnalysisFailed(afterStageTaskStatus *placementv1beta1.StageTaskStatus, generation int64, message string) {
+	meta.SetStatusCondition(&afterStageTaskStatus.Conditions, metav1.Condition{
+		Type:               string(placementv1beta1.StageTaskConditionAnalysisSucceeded),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             condition.AfterStageTaskAnalysisFailedReason,
+		Message:            message,
+	})
+}
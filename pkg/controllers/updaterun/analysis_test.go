@@ -0,0 +1,285 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterun
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/metricanalysis"
+)
+
+func TestHandleStageAnalysisTask(t *testing.T) {
+	stageName := "stage-0"
+	query := `sum(rate(http_requests_total{cluster=~"{{clusters}}",code!~"5.."}[5m]))`
+	renderedQuery := `sum(rate(http_requests_total{cluster=~"cluster-1|cluster-2",code!~"5.."}[5m]))`
+	longAgo := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	analysisTask := func(count, failureLimit int32, failureCondition string) *placementv1beta1.StageTask {
+		return &placementv1beta1.StageTask{
+			Type: placementv1beta1.StageTaskTypeAnalysis,
+			Analysis: &placementv1beta1.AnalysisConfig{
+				Provider: placementv1beta1.MetricProviderConfig{
+					Type:    placementv1beta1.MetricProviderTypePrometheus,
+					Address: "http://prometheus:9090",
+				},
+				Metrics: []placementv1beta1.AnalysisMetric{
+					{
+						Name:             "success-rate",
+						Query:            query,
+						SuccessCondition: "result >= 0.99",
+						FailureCondition: failureCondition,
+						Interval:         &metav1.Duration{Duration: time.Minute},
+						Count:            count,
+						FailureLimit:     failureLimit,
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name                  string
+		task                  *placementv1beta1.StageTask
+		analysisStatus        *placementv1beta1.AnalysisStatus
+		ctxDone               bool
+		results               []metricanalysis.FakeResult
+		wantSucceeded         bool
+		wantWaitTime          time.Duration
+		wantErrAborted        bool
+		wantQueries           []string
+		wantMetricStatus      *placementv1beta1.MetricAnalysisStatus
+		wantAnalysisCondition *metav1.ConditionStatus
+	}{
+		{
+			name:         "first reconciliation waits for one interval",
+			task:         analysisTask(1, 0, ""),
+			wantWaitTime: time.Minute,
+			wantMetricStatus: &placementv1beta1.MetricAnalysisStatus{
+				Name:  "success-rate",
+				Phase: placementv1beta1.AnalysisPhaseRunning,
+			},
+		},
+		{
+			name:           "measurement out of the reconciliation deadline is taken in the next reconciliation",
+			task:           analysisTask(1, 0, ""),
+			analysisStatus: &placementv1beta1.AnalysisStatus{StartTime: longAgo},
+			ctxDone:        true,
+			results:        []metricanalysis.FakeResult{{Value: 0.995}},
+			wantWaitTime:   0,
+			wantMetricStatus: &placementv1beta1.MetricAnalysisStatus{
+				Name:  "success-rate",
+				Phase: placementv1beta1.AnalysisPhaseRunning,
+			},
+		},
+		{
+			name:           "successful measurement completes the analysis",
+			task:           analysisTask(1, 0, ""),
+			analysisStatus: &placementv1beta1.AnalysisStatus{StartTime: longAgo},
+			results:        []metricanalysis.FakeResult{{Value: 0.995}},
+			wantSucceeded:  true,
+			wantQueries:    []string{renderedQuery},
+			wantMetricStatus: &placementv1beta1.MetricAnalysisStatus{
+				Name:             "success-rate",
+				Phase:            placementv1beta1.AnalysisPhaseSuccessful,
+				MeasurementCount: 1,
+				SuccessfulCount:  1,
+				Measurements: []placementv1beta1.Measurement{
+					{Phase: placementv1beta1.MeasurementPhaseSuccessful, Value: "0.995"},
+				},
+			},
+			wantAnalysisCondition: ptr.To(metav1.ConditionTrue),
+		},
+		{
+			name:           "failed measurement within the failure limit keeps the analysis running",
+			task:           analysisTask(3, 1, ""),
+			analysisStatus: &placementv1beta1.AnalysisStatus{StartTime: longAgo},
+			results:        []metricanalysis.FakeResult{{Value: 0.9}},
+			wantWaitTime:   time.Minute,
+			wantQueries:    []string{renderedQuery},
+			wantMetricStatus: &placementv1beta1.MetricAnalysisStatus{
+				Name:             "success-rate",
+				Phase:            placementv1beta1.AnalysisPhaseRunning,
+				MeasurementCount: 1,
+				FailedCount:      1,
+				Measurements: []placementv1beta1.Measurement{
+					{Phase: placementv1beta1.MeasurementPhaseFailed, Value: "0.9", Message: "the success condition `result >= 0.99` is not met"},
+				},
+			},
+		},
+		{
+			name:           "inconclusive measurement does not count as a failure",
+			task:           analysisTask(2, 0, "result < 0.5"),
+			analysisStatus: &placementv1beta1.AnalysisStatus{StartTime: longAgo},
+			results:        []metricanalysis.FakeResult{{Value: 0.9}},
+			wantWaitTime:   time.Minute,
+			wantQueries:    []string{renderedQuery},
+			wantMetricStatus: &placementv1beta1.MetricAnalysisStatus{
+				Name:              "success-rate",
+				Phase:             placementv1beta1.AnalysisPhaseRunning,
+				MeasurementCount:  1,
+				InconclusiveCount: 1,
+				Measurements: []placementv1beta1.Measurement{
+					{Phase: placementv1beta1.MeasurementPhaseInconclusive, Value: "0.9"},
+				},
+			},
+		},
+		{
+			name:           "query error exceeding the failure limit fails the analysis",
+			task:           analysisTask(1, 0, ""),
+			analysisStatus: &placementv1beta1.AnalysisStatus{StartTime: longAgo},
+			results:        []metricanalysis.FakeResult{{Err: errors.New("connection refused")}},
+			wantWaitTime:   -1,
+			wantErrAborted: true,
+			wantQueries:    []string{renderedQuery},
+			wantMetricStatus: &placementv1beta1.MetricAnalysisStatus{
+				Name:             "success-rate",
+				Phase:            placementv1beta1.AnalysisPhaseFailed,
+				MeasurementCount: 1,
+				FailedCount:      1,
+				Measurements: []placementv1beta1.Measurement{
+					{Phase: placementv1beta1.MeasurementPhaseError, Message: "connection refused"},
+				},
+			},
+			wantAnalysisCondition: ptr.To(metav1.ConditionFalse),
+		},
+		{
+			name: "measurement not due yet",
+			task: analysisTask(2, 0, ""),
+			analysisStatus: &placementv1beta1.AnalysisStatus{
+				StartTime: longAgo,
+				Metrics: []placementv1beta1.MetricAnalysisStatus{
+					{
+						Name:             "success-rate",
+						Phase:            placementv1beta1.AnalysisPhaseRunning,
+						MeasurementCount: 1,
+						SuccessfulCount:  1,
+						Measurements: []placementv1beta1.Measurement{
+							{Phase: placementv1beta1.MeasurementPhaseSuccessful, Value: "1", MeasuredAt: metav1.NewTime(time.Now().Add(-30 * time.Second))},
+						},
+					},
+				},
+			},
+			wantWaitTime: 30 * time.Second,
+			wantMetricStatus: &placementv1beta1.MetricAnalysisStatus{
+				Name:             "success-rate",
+				Phase:            placementv1beta1.AnalysisPhaseRunning,
+				MeasurementCount: 1,
+				SuccessfulCount:  1,
+				Measurements: []placementv1beta1.Measurement{
+					{Phase: placementv1beta1.MeasurementPhaseSuccessful, Value: "1"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &metricanalysis.FakeMetricProvider{
+				Results: map[string][]metricanalysis.FakeResult{renderedQuery: tt.results},
+			}
+			r := &Reconciler{
+				MetricProviderFactory: func(_ placementv1beta1.MetricProviderConfig) (metricanalysis.MetricProvider, error) {
+					return provider, nil
+				},
+			}
+			updateRun := &placementv1beta1.ClusterStagedUpdateRun{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-update-run", Generation: 1},
+				Spec:       placementv1beta1.UpdateRunSpec{PlacementName: "test-placement"},
+			}
+			stageStatus := &placementv1beta1.StageUpdatingStatus{
+				StageName: stageName,
+				Clusters: []placementv1beta1.ClusterUpdatingStatus{
+					{ClusterName: "cluster-1"},
+					{ClusterName: "cluster-2"},
+				},
+			}
+			taskStatus := &placementv1beta1.StageTaskStatus{
+				Type:           placementv1beta1.StageTaskTypeAnalysis,
+				AnalysisStatus: tt.analysisStatus,
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.ctxDone {
+				cancel()
+			}
+			succeeded, waitTime, err := r.handleStageAnalysisTask(ctx, taskStatus, tt.task, stageStatus, updateRun)
+			if gotAborted := errors.Is(err, errStagedUpdatedAborted); gotAborted != tt.wantErrAborted {
+				t.Fatalf("handleStageAnalysisTask() error = %v, want aborted %t", err, tt.wantErrAborted)
+			}
+			if !tt.wantErrAborted && err != nil {
+				t.Fatalf("handleStageAnalysisTask() error = %v, want no error", err)
+			}
+			if succeeded != tt.wantSucceeded {
+				t.Errorf("handleStageAnalysisTask() succeeded = %t, want %t", succeeded, tt.wantSucceeded)
+			}
+			// Allow some slack for the time elapsed during the test.
+			if diff := waitTime - tt.wantWaitTime; diff > 0 || diff < -5*time.Second {
+				t.Errorf("handleStageAnalysisTask() waitTime = %v, want %v", waitTime, tt.wantWaitTime)
+			}
+			if diff := cmp.Diff(provider.Queries, tt.wantQueries, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("queries mismatch (-got, +want):\n%s", diff)
+			}
+
+			if taskStatus.AnalysisStatus == nil || len(taskStatus.AnalysisStatus.Metrics) != 1 {
+				t.Fatalf("analysis status = %v, want one metric status", taskStatus.AnalysisStatus)
+			}
+			if diff := cmp.Diff(&taskStatus.AnalysisStatus.Metrics[0], tt.wantMetricStatus, cmpopts.IgnoreFields(placementv1beta1.Measurement{}, "MeasuredAt")); diff != "" {
+				t.Errorf("metric status mismatch (-got, +want):\n%s", diff)
+			}
+
+			cond := meta.FindStatusCondition(taskStatus.Conditions, string(placementv1beta1.StageTaskConditionAnalysisSucceeded))
+			switch {
+			case tt.wantAnalysisCondition == nil && cond != nil:
+				t.Errorf("AnalysisSucceeded condition = %v, want nil", cond)
+			case tt.wantAnalysisCondition != nil && (cond == nil || cond.Status != *tt.wantAnalysisCondition):
+				t.Errorf("AnalysisSucceeded condition = %v, want status %s", cond, *tt.wantAnalysisCondition)
+			}
+		})
+	}
+}
+
+func TestRecordMeasurementKeepsRecentMeasurements(t *testing.T) {
+	metric := &placementv1beta1.AnalysisMetric{Name: "success-rate", Count: 20, FailureLimit: 20}
+	metricStatus := &placementv1beta1.MetricAnalysisStatus{Name: "success-rate", Phase: placementv1beta1.AnalysisPhaseRunning}
+	for i := 0; i < 15; i++ {
+		recordMeasurement(metricStatus, metric, placementv1beta1.Measurement{
+			Phase: placementv1beta1.MeasurementPhaseSuccessful,
+			Value: fmt.Sprintf("%d", i),
+		})
+	}
+	if metricStatus.MeasurementCount != 15 || metricStatus.SuccessfulCount != 15 {
+		t.Errorf("recordMeasurement() counts = (%d, %d), want (15, 15)", metricStatus.MeasurementCount, metricStatus.SuccessfulCount)
+	}
+	if len(metricStatus.Measurements) != maxMeasurementsKept || metricStatus.Measurements[0].Value != "5" {
+		t.Errorf("recordMeasurement() kept measurements = %v, want the last %d", metricStatus.Measurements, maxMeasurementsKept)
+	}
+	if metricStatus.Phase != placementv1beta1.AnalysisPhaseRunning {
+		t.Errorf("recordMeasurement() phase = %s, want %s", metricStatus.Phase, placementv1beta1.AnalysisPhaseRunning)
+	}
+}
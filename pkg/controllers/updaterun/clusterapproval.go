@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterun
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// listClusterApprovalRequests lists the per-cluster approval requests of a stage in the update run, keyed by
+// their target clusters. The approval requests are either created by the controller for the clusters that
+// require an approval, or created manually to promote or skip a cluster.
+func (r *Reconciler) listClusterApprovalRequests(ctx context.Context, updateRun placementv1beta1.UpdateRunObj, stageName string) (map[string]placementv1beta1.ApprovalRequestObj, error) {
+	var approvalRequestList placementv1beta1.ApprovalRequestObjList
+	if updateRun.GetNamespace() == "" {
+		approvalRequestList = &placementv1beta1.ClusterApprovalRequestList{}
+	} else {
+		approvalRequestList = &placementv1beta1.ApprovalRequestList{}
+	}
+	if err := r.Client.List(ctx, approvalRequestList, client.InNamespace(updateRun.GetNamespace()), client.MatchingLabels{
+		placementv1beta1.TargetUpdateRunLabel:         updateRun.GetName(),
+		placementv1beta1.TargetUpdatingStageNameLabel: stageName,
+		placementv1beta1.TaskTypeLabel:                placementv1beta1.ClusterApprovalTaskLabelValue,
+	}); err != nil {
+		klog.ErrorS(err, "Failed to list the per-cluster approval requests", "stage", stageName, "updateRun", klog.KObj(updateRun))
+		return nil, controller.NewAPIServerError(true, err)
+	}
+
+	approvalRequests := make(map[string]placementv1beta1.ApprovalRequestObj)
+	for _, approvalRequest := range approvalRequestList.GetApprovalRequestObjs() {
+		spec := approvalRequest.GetApprovalRequestSpec()
+		if spec.TargetUpdateRun != updateRun.GetName() || spec.TargetStage != stageName || spec.TargetCluster == "" {
+			klog.V(2).InfoS("Ignoring the approval request not targeting a cluster in the stage", "approvalRequest", klog.KObj(approvalRequest), "stage", stageName, "updateRun", klog.KObj(updateRun))
+			continue
+		}
+		// Prefer the approval request created by the controller if there are several for the same cluster.
+		if _, found := approvalRequests[spec.TargetCluster]; found &&
+			approvalRequest.GetName() != fmt.Sprintf(placementv1beta1.ClusterApprovalTaskNameFmt, updateRun.GetName(), stageName, spec.TargetCluster) {
+			continue
+		}
+		approvalRequests[spec.TargetCluster] = approvalRequest
+	}
+	return approvalRequests, nil
+}
+
+// isClusterApprovalGiven checks if the cluster has been approved, promoted or skipped, in which case it's processed
+// regardless of the maxConcurrency of the stage.
+func isClusterApprovalGiven(clusterStatus *placementv1beta1.ClusterUpdatingStatus, approvalRequest placementv1beta1.ApprovalRequestObj) bool {
+	if clusterStatus.ApprovalState == placementv1beta1.ClusterApprovalStateApproved || clusterStatus.ApprovalState == placementv1beta1.ClusterApprovalStateSkipped {
+		return true
+	}
+	if approvalRequest == nil {
+		return false
+	}
+	conditions := approvalRequest.GetApprovalRequestStatus().Conditions
+	return condition.IsConditionStatusTrue(meta.FindStatusCondition(conditions, string(placementv1beta1.ApprovalRequestConditionApproved)), approvalRequest.GetGeneration()) ||
+		condition.IsConditionStatusTrue(meta.FindStatusCondition(conditions, string(placementv1beta1.ApprovalRequestConditionSkipped)), approvalRequest.GetGeneration())
+}
+
+// checkClusterApproval checks the per-cluster approval of a cluster that has not started updating and returns
+// its approval state:
+//   - Pending: the cluster has to wait for its approval; the approval request is created if it does not exist.
+//   - Skipped: the cluster is skipped and marked as succeeded without being updated.
+//   - Approved or empty: the cluster can be updated.
+func (r *Reconciler) checkClusterApproval(
+	ctx context.Context,
+	updateRun placementv1beta1.UpdateRunObj,
+	stageName string,
+	clusterStatus *placementv1beta1.ClusterUpdatingStatus,
+	approvalRequest placementv1beta1.ApprovalRequestObj,
+) (placementv1beta1.ClusterApprovalState, error) {
+	updateRunRef := klog.KObj(updateRun)
+	if clusterStatus.ApprovalState == placementv1beta1.ClusterApprovalStateApproved {
+		return clusterStatus.ApprovalState, nil
+	}
+
+	if approvalRequest != nil {
+		requestRef := klog.KObj(approvalRequest)
+		clusterStatus.ApprovalRequestName = approvalRequest.GetName()
+		conditions := approvalRequest.GetApprovalRequestStatus().Conditions
+		approvalAccepted := condition.IsConditionStatusTrue(meta.FindStatusCondition(conditions, string(placementv1beta1.ApprovalRequestConditionApprovalAccepted)), approvalRequest.GetGeneration())
+		skipped := condition.IsConditionStatusTrue(meta.FindStatusCondition(conditions, string(placementv1beta1.ApprovalRequestConditionSkipped)), approvalRequest.GetGeneration())
+		approved := condition.IsConditionStatusTrue(meta.FindStatusCondition(conditions, string(placementv1beta1.ApprovalRequestConditionApproved)), approvalRequest.GetGeneration())
+		switch {
+		case skipped:
+			klog.V(2).InfoS("The cluster has been skipped", "approvalRequest", requestRef, "cluster", clusterStatus.ClusterName, "stage", stageName, "updateRun", updateRunRef)
+			clusterStatus.ApprovalState = placementv1beta1.ClusterApprovalStateSkipped
+		case approved || approvalAccepted:
+			// Approved state should not change once the approval is accepted.
+			klog.V(2).InfoS("The cluster has been approved", "approvalRequest", requestRef, "cluster", clusterStatus.ClusterName, "stage", stageName, "updateRun", updateRunRef)
+			clusterStatus.ApprovalState = placementv1beta1.ClusterApprovalStateApproved
+		default:
+			klog.V(2).InfoS("The approval request of the cluster has not been approved yet", "approvalRequest", requestRef, "cluster", clusterStatus.ClusterName, "stage", stageName, "updateRun", updateRunRef)
+			if clusterStatus.ApprovalState == placementv1beta1.ClusterApprovalStatePending {
+				markClusterUpdatingWaitingForApproval(clusterStatus, updateRun.GetGeneration(), approvalRequest.GetName())
+			}
+			return clusterStatus.ApprovalState, nil
+		}
+		if !approvalAccepted {
+			if err := r.updateApprovalRequestAccepted(ctx, approvalRequest); err != nil {
+				klog.ErrorS(err, "Failed to accept the approval request of the cluster", "approvalRequest", requestRef, "cluster", clusterStatus.ClusterName, "stage", stageName, "updateRun", updateRunRef)
+				return "", err
+			}
+		}
+		if clusterStatus.ApprovalState == placementv1beta1.ClusterApprovalStateSkipped {
+			markClusterUpdatingSkipped(clusterStatus, updateRun.GetGeneration(), approvalRequest.GetName())
+		}
+		return clusterStatus.ApprovalState, nil
+	}
+
+	if clusterStatus.ApprovalState != placementv1beta1.ClusterApprovalStatePending {
+		// The cluster does not require an approval.
+		return clusterStatus.ApprovalState, nil
+	}
+	clusterStatus.ApprovalRequestName = fmt.Sprintf(placementv1beta1.ClusterApprovalTaskNameFmt, updateRun.GetName(), stageName, clusterStatus.ClusterName)
+	approvalRequest = buildClusterApprovalRequestObject(types.NamespacedName{Name: clusterStatus.ApprovalRequestName, Namespace: updateRun.GetNamespace()}, stageName, updateRun.GetName(), clusterStatus.ClusterName)
+	if err := r.Client.Create(ctx, approvalRequest); err != nil && !apierrors.IsAlreadyExists(err) {
+		klog.ErrorS(err, "Failed to create the approval request of the cluster", "approvalRequest", klog.KObj(approvalRequest), "cluster", clusterStatus.ClusterName, "stage", stageName, "updateRun", updateRunRef)
+		return "", controller.NewAPIServerError(false, err)
+	}
+	klog.V(2).InfoS("The approval request of the cluster has been created", "approvalRequest", klog.KObj(approvalRequest), "cluster", clusterStatus.ClusterName, "stage", stageName, "updateRun", updateRunRef)
+	markClusterUpdatingWaitingForApproval(clusterStatus, updateRun.GetGeneration(), approvalRequest.GetName())
+	return clusterStatus.ApprovalState, nil
+}
+
+// buildClusterApprovalRequestObject creates a per-cluster approval request object.
+// It returns a ClusterApprovalRequest if namespace is empty, otherwise returns an ApprovalRequest.
+func buildClusterApprovalRequestObject(namespacedName types.NamespacedName, stageName, updateRunName, clusterName string) placementv1beta1.ApprovalRequestObj {
+	approvalRequest := buildApprovalRequestObject(namespacedName, stageName, updateRunName, placementv1beta1.ClusterApprovalTaskLabelValue)
+	approvalRequest.GetApprovalRequestSpec().TargetCluster = clusterName
+	return approvalRequest
+}
+
+// markClusterUpdatingWaitingForApproval marks the cluster updating status as waiting for its approval in memory.
+func markClusterUpdatingWaitingForApproval(clusterUpdatingStatus *placementv1beta1.ClusterUpdatingStatus, generation int64, approvalRequestName string) {
+	meta.SetStatusCondition(&clusterUpdatingStatus.Conditions, metav1.Condition{
+		Type:               string(placementv1beta1.ClusterUpdatingConditionStarted),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             condition.ClusterUpdatingWaitingForApprovalReason,
+		Message:            fmt.Sprintf("Cluster update is waiting for the approval request `%s` to be approved", approvalRequestName),
+	})
+}
+
+// markClusterUpdatingSkipped marks the cluster updating status as skipped in memory.
+// The cluster is marked as succeeded so that the stage can proceed without updating it.
+func markClusterUpdatingSkipped(clusterUpdatingStatus *placementv1beta1.ClusterUpdatingStatus, generation int64, approvalRequestName string) {
+	meta.SetStatusCondition(&clusterUpdatingStatus.Conditions, metav1.Condition{
+		Type:               string(placementv1beta1.ClusterUpdatingConditionSucceeded),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             condition.ClusterUpdatingSkippedReason,
+		Message:            fmt.Sprintf("Cluster update is skipped by the approval request `%s`", approvalRequestName),
+	})
+}
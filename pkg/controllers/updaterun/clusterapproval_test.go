@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterun
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+// TestExecuteUpdatingStage_ClusterApproval tests the per-cluster approvals in the executeUpdatingStage function.
+func TestExecuteUpdatingStage_ClusterApproval(t *testing.T) {
+	const (
+		updateRunName = "test-update-run"
+		stageName     = "test-stage"
+	)
+	requestName := func(cluster string) string {
+		return fmt.Sprintf(placementv1beta1.ClusterApprovalTaskNameFmt, updateRunName, stageName, cluster)
+	}
+	approvalRequest := func(cluster string, condType placementv1beta1.ApprovalRequestConditionType) *placementv1beta1.ClusterApprovalRequest {
+		request := buildClusterApprovalRequestObject(types.NamespacedName{Name: requestName(cluster)}, stageName, updateRunName, cluster).(*placementv1beta1.ClusterApprovalRequest)
+		request.Generation = 1
+		if condType != "" {
+			request.Status.Conditions = []metav1.Condition{{Type: string(condType), Status: metav1.ConditionTrue, ObservedGeneration: 1, Reason: "Test"}}
+		}
+		return request
+	}
+	pendingCluster := func(cluster string) placementv1beta1.ClusterUpdatingStatus {
+		return placementv1beta1.ClusterUpdatingStatus{ClusterName: cluster, ApprovalState: placementv1beta1.ClusterApprovalStatePending, ApprovalRequestName: requestName(cluster)}
+	}
+
+	type clusterSummary struct {
+		Name          string
+		ApprovalState placementv1beta1.ClusterApprovalState
+		Started       bool
+		Skipped       bool
+		WaitingReason string
+	}
+	tests := []struct {
+		name             string
+		clusters         []placementv1beta1.ClusterUpdatingStatus
+		approvalRequests []client.Object
+		wantClusters     []clusterSummary
+		wantRequests     []string
+	}{
+		{
+			name:     "pending cluster waits for its approval and holds the slot",
+			clusters: []placementv1beta1.ClusterUpdatingStatus{pendingCluster("cluster-1"), {ClusterName: "cluster-2"}},
+			wantClusters: []clusterSummary{
+				{Name: "cluster-1", ApprovalState: placementv1beta1.ClusterApprovalStatePending, WaitingReason: condition.ClusterUpdatingWaitingForApprovalReason},
+				{Name: "cluster-2"},
+			},
+			wantRequests: []string{requestName("cluster-1")},
+		},
+		{
+			name:             "approved cluster is updated",
+			clusters:         []placementv1beta1.ClusterUpdatingStatus{pendingCluster("cluster-1"), {ClusterName: "cluster-2"}},
+			approvalRequests: []client.Object{approvalRequest("cluster-1", placementv1beta1.ApprovalRequestConditionApproved)},
+			wantClusters: []clusterSummary{
+				{Name: "cluster-1", ApprovalState: placementv1beta1.ClusterApprovalStateApproved, Started: true},
+				{Name: "cluster-2"},
+			},
+			wantRequests: []string{requestName("cluster-1")},
+		},
+		{
+			name:             "skipped cluster is not updated and frees the slot",
+			clusters:         []placementv1beta1.ClusterUpdatingStatus{pendingCluster("cluster-1"), {ClusterName: "cluster-2"}},
+			approvalRequests: []client.Object{approvalRequest("cluster-1", placementv1beta1.ApprovalRequestConditionSkipped)},
+			wantClusters: []clusterSummary{
+				{Name: "cluster-1", ApprovalState: placementv1beta1.ClusterApprovalStateSkipped, Skipped: true},
+				{Name: "cluster-2", Started: true},
+			},
+			wantRequests: []string{requestName("cluster-1")},
+		},
+		{
+			name:             "promoted cluster is updated beyond the max concurrency",
+			clusters:         []placementv1beta1.ClusterUpdatingStatus{{ClusterName: "cluster-1"}, {ClusterName: "cluster-2"}, {ClusterName: "cluster-3"}},
+			approvalRequests: []client.Object{approvalRequest("cluster-3", placementv1beta1.ApprovalRequestConditionApproved)},
+			wantClusters: []clusterSummary{
+				{Name: "cluster-1", Started: true},
+				{Name: "cluster-2"},
+				{Name: "cluster-3", ApprovalState: placementv1beta1.ClusterApprovalStateApproved, Started: true},
+			},
+			wantRequests: []string{requestName("cluster-3")},
+		},
+		{
+			name:             "unapproved request does not promote the cluster",
+			clusters:         []placementv1beta1.ClusterUpdatingStatus{{ClusterName: "cluster-1"}, {ClusterName: "cluster-2"}},
+			approvalRequests: []client.Object{approvalRequest("cluster-2", "")},
+			wantClusters: []clusterSummary{
+				{Name: "cluster-1", Started: true},
+				{Name: "cluster-2"},
+			},
+			wantRequests: []string{requestName("cluster-2")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			_ = placementv1beta1.AddToScheme(scheme)
+			objs := append([]client.Object{}, tt.approvalRequests...)
+			var bindings []placementv1beta1.BindingObj
+			for _, cluster := range tt.clusters {
+				binding := &placementv1beta1.ClusterResourceBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: "binding-" + cluster.ClusterName, Generation: 1},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						TargetCluster:        cluster.ClusterName,
+						ResourceSnapshotName: "test-placement-0-snapshot",
+						State:                placementv1beta1.BindingStateScheduled,
+					},
+				}
+				bindings = append(bindings, binding)
+				objs = append(objs, binding)
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(objs...).
+				WithStatusSubresource(&placementv1beta1.ClusterResourceBinding{}, &placementv1beta1.ClusterApprovalRequest{}).
+				Build()
+			r := &Reconciler{Client: fakeClient}
+			updateRun := &placementv1beta1.ClusterStagedUpdateRun{
+				ObjectMeta: metav1.ObjectMeta{Name: updateRunName, Generation: 1},
+				Spec: placementv1beta1.UpdateRunSpec{
+					PlacementName:         "test-placement",
+					ResourceSnapshotIndex: "1",
+					State:                 placementv1beta1.StateRun,
+				},
+				Status: placementv1beta1.UpdateRunStatus{
+					ResourceSnapshotIndexUsed: "1",
+					StagesStatus:              []placementv1beta1.StageUpdatingStatus{{StageName: stageName, Clusters: tt.clusters}},
+					UpdateStrategySnapshot: &placementv1beta1.UpdateStrategySpec{
+						Stages: []placementv1beta1.StageConfig{{Name: stageName}},
+					},
+				},
+			}
+
+			if _, err := r.executeUpdatingStage(ctx, updateRun, 0, bindings, 1, 0, nil); err != nil {
+				t.Fatalf("executeUpdatingStage() error = %v, want no error", err)
+			}
+
+			var gotClusters []clusterSummary
+			for _, cluster := range updateRun.Status.StagesStatus[0].Clusters {
+				summary := clusterSummary{
+					Name:          cluster.ClusterName,
+					ApprovalState: cluster.ApprovalState,
+					Started:       meta.IsStatusConditionTrue(cluster.Conditions, string(placementv1beta1.ClusterUpdatingConditionStarted)),
+				}
+				if succeededCond := meta.FindStatusCondition(cluster.Conditions, string(placementv1beta1.ClusterUpdatingConditionSucceeded)); succeededCond != nil {
+					summary.Skipped = succeededCond.Reason == condition.ClusterUpdatingSkippedReason
+				}
+				if startedCond := meta.FindStatusCondition(cluster.Conditions, string(placementv1beta1.ClusterUpdatingConditionStarted)); startedCond != nil && startedCond.Status == metav1.ConditionFalse {
+					summary.WaitingReason = startedCond.Reason
+				}
+				gotClusters = append(gotClusters, summary)
+			}
+			if diff := cmp.Diff(gotClusters, tt.wantClusters); diff != "" {
+				t.Errorf("executeUpdatingStage() clusters mismatch (-got, +want):\n%s", diff)
+			}
+
+			var requestList placementv1beta1.ClusterApprovalRequestList
+			if err := fakeClient.List(ctx, &requestList); err != nil {
+				t.Fatalf("List() error = %v, want no error", err)
+			}
+			var gotRequests []string
+			for _, request := range requestList.Items {
+				gotRequests = append(gotRequests, request.Name)
+				if request.Spec.TargetCluster == "" {
+					t.Errorf("approval request %s has no target cluster", request.Name)
+				}
+			}
+			sort.Strings(gotRequests)
+			if diff := cmp.Diff(gotRequests, tt.wantRequests); diff != "" {
+				t.Errorf("approval requests mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -43,6 +44,7 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/informer"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/metricanalysis"
 )
 
 var (
@@ -66,6 +68,14 @@ type Reconciler struct {
 
 	// ResourceSnapshotResolver gets or creates resource snapshots.
 	ResourceSnapshotResolver controller.ResourceSnapshotResolver
+
+	// MetricProviderFactory builds the metric providers for Analysis stage tasks.
+	// If not set, the default factory that supports the Prometheus HTTP API is used.
+	MetricProviderFactory metricanalysis.MetricProviderFactory
+
+	// UncachedReader reads the Secrets that hold the HTTP headers of Webhook stage tasks, so that Secrets
+	// are not cached. If not set, the client is used.
+	UncachedReader client.Reader
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtime.Result, error) {
@@ -143,6 +153,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 		finishedCond := meta.FindStatusCondition(updateRunStatus.Conditions, string(placementv1beta1.StagedUpdateRunConditionSucceeded))
 		if condition.IsConditionStatusTrue(finishedCond, updateRun.GetGeneration()) || condition.IsConditionStatusFalse(finishedCond, updateRun.GetGeneration()) {
 			klog.V(2).InfoS("The updateRun is finished", "finishedSuccessfully", finishedCond.Status, "updateRun", runObjRef)
+			if condition.IsConditionStatusFalse(finishedCond, updateRun.GetGeneration()) {
+				// Retry creating the rollback updateRun in case the previous attempt failed.
+				return runtime.Result{}, r.rollbackIfNeeded(ctx, updateRun)
+			}
 			return runtime.Result{}, nil
 		}
 		// Validate the updateRun status to ensure the update can be continued and get the updating stage index and cluster indices.
@@ -150,7 +164,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 			klog.ErrorS(reconcileErr, "Failed to validate the updateRun", "updateRun", runObjRef)
 			// errStagedUpdatedAborted cannot be retried.
 			if errors.Is(reconcileErr, errStagedUpdatedAborted) {
-				return runtime.Result{}, r.recordUpdateRunFailed(ctx, updateRun, reconcileErr.Error())
+				return runtime.Result{}, r.recordUpdateRunFailedAndRollback(ctx, updateRun, reconcileErr.Error())
 			}
 			return runtime.Result{}, reconcileErr
 		}
@@ -174,7 +188,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 		finished, waitTime, reconcileErr = r.execute(ctx, updateRun, updatingStageIndex, toBeUpdatedBindings, toBeDeletedBindings)
 		if errors.Is(reconcileErr, errStagedUpdatedAborted) {
 			// errStagedUpdatedAborted cannot be retried.
-			return runtime.Result{}, r.recordUpdateRunFailed(ctx, updateRun, reconcileErr.Error())
+			return runtime.Result{}, r.recordUpdateRunFailedAndRollback(ctx, updateRun, reconcileErr.Error())
 		}
 
 		if finished {
@@ -244,6 +258,12 @@ func (r *Reconciler) handleDelete(ctx context.Context, updateRun placementv1beta
 	}
 	klog.V(2).InfoS("Deleted all approvalRequests associated with the updateRun", "updateRun", runObjRef)
 
+	// Delete all the Works that place the verification Jobs of the updateRun.
+	if err := r.deleteVerificationJobWorks(ctx, updateRun, ""); err != nil {
+		klog.ErrorS(err, "Failed to delete all associated verification job works", "updateRun", runObjRef)
+		return false, 0, err
+	}
+
 	// Delete the update run metrics.
 	deleteUpdateRunMetrics(updateRun)
 
@@ -280,7 +300,7 @@ func (r *Reconciler) recordUpdateRunSucceeded(ctx context.Context, updateRun pla
 		Status:             metav1.ConditionTrue,
 		ObservedGeneration: updateRun.GetGeneration(),
 		Reason:             condition.UpdateRunSucceededReason,
-		Message:            "All stages are completed successfully",
+		Message:            updateRunSucceededMessage(updateRunStatus),
 	})
 	if updateErr := r.Client.Status().Update(ctx, updateRun); updateErr != nil {
 		klog.ErrorS(updateErr, "Failed to update the updateRun status as succeeded", "updateRun", klog.KObj(updateRun))
@@ -290,6 +310,19 @@ func (r *Reconciler) recordUpdateRunSucceeded(ctx context.Context, updateRun pla
 	return nil
 }
 
+// updateRunSucceededMessage returns the message of the succeeded condition of an updateRun, which summarizes
+// the failed clusters tolerated by the stages if there are any.
+func updateRunSucceededMessage(updateRunStatus *placementv1beta1.UpdateRunStatus) string {
+	var failedClusters []string
+	for i := range updateRunStatus.StagesStatus {
+		failedClusters = append(failedClusters, updateRunStatus.StagesStatus[i].FailedClusters...)
+	}
+	if len(failedClusters) == 0 {
+		return "All stages are completed successfully"
+	}
+	return fmt.Sprintf("All stages are completed with %d failed cluster(s) tolerated: %s", len(failedClusters), strings.Join(failedClusters, ", "))
+}
+
 // recordUpdateRunFailed records the failed condition in the updateRun status.
 func (r *Reconciler) recordUpdateRunFailed(ctx context.Context, updateRun placementv1beta1.UpdateRunObj, message string) error {
 	updateRunStatus := updateRun.GetUpdateRunStatus()
@@ -424,7 +457,10 @@ func handleApprovalRequestUpdate(oldObj, newObj client.Object, q workqueue.Typed
 	approvedInOld := condition.IsConditionStatusTrue(meta.FindStatusCondition(oldAppReq.GetApprovalRequestStatus().Conditions, string(placementv1beta1.ApprovalRequestConditionApproved)), oldAppReq.GetGeneration())
 	approvedInNew := condition.IsConditionStatusTrue(meta.FindStatusCondition(newAppReq.GetApprovalRequestStatus().Conditions, string(placementv1beta1.ApprovalRequestConditionApproved)), newAppReq.GetGeneration())
 
-	if approvedInOld == approvedInNew {
+	skippedInOld := condition.IsConditionStatusTrue(meta.FindStatusCondition(oldAppReq.GetApprovalRequestStatus().Conditions, string(placementv1beta1.ApprovalRequestConditionSkipped)), oldAppReq.GetGeneration())
+	skippedInNew := condition.IsConditionStatusTrue(meta.FindStatusCondition(newAppReq.GetApprovalRequestStatus().Conditions, string(placementv1beta1.ApprovalRequestConditionSkipped)), newAppReq.GetGeneration())
+
+	if approvedInOld == approvedInNew && skippedInOld == skippedInNew {
 		klog.V(2).InfoS("The approval status is not changed, ignore queueing", "approvalRequestObj", klog.KObj(newAppReq))
 		return
 	}
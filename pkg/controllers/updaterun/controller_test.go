@@ -1371,3 +1371,37 @@ func TestUpdateAllStatusConditionsGeneration(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateRunSucceededMessage(t *testing.T) {
+	tests := []struct {
+		name         string
+		stagesStatus []placementv1beta1.StageUpdatingStatus
+		wantMessage  string
+	}{
+		{
+			name: "no failed clusters",
+			stagesStatus: []placementv1beta1.StageUpdatingStatus{
+				{StageName: "stage-1"},
+				{StageName: "stage-2"},
+			},
+			wantMessage: "All stages are completed successfully",
+		},
+		{
+			name: "failed clusters tolerated in multiple stages",
+			stagesStatus: []placementv1beta1.StageUpdatingStatus{
+				{StageName: "stage-1", FailedClusters: []string{"cluster-1"}},
+				{StageName: "stage-2", FailedClusters: []string{"cluster-3", "cluster-4"}},
+			},
+			wantMessage: "All stages are completed with 3 failed cluster(s) tolerated: cluster-1, cluster-3, cluster-4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := updateRunSucceededMessage(&placementv1beta1.UpdateRunStatus{StagesStatus: tt.stagesStatus})
+			if diff := cmp.Diff(got, tt.wantMessage); diff != "" {
+				t.Errorf("updateRunSucceededMessage() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
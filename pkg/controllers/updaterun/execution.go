@@ -18,8 +18,10 @@ package updaterun
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -37,6 +39,7 @@ import (
 	bindingutils "github.com/kubefleet-dev/kubefleet/pkg/utils/binding"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/maintenancewindow"
 )
 
 var (
@@ -83,20 +86,45 @@ func (r *Reconciler) execute(
 			// No need to wait to get to the next stage.
 			return false, 0, nil
 		}
-		approved, err := r.checkBeforeStageTasksStatus(ctx, updatingStageIndex, updateRun)
+		// The updateRuns created for a ClusterFleetUpdateRun start each stage together.
+		group, err := r.fetchFleetUpdateRunGroup(ctx, updateRun)
+		if err != nil {
+			return false, 0, abortOnUserError(err)
+		}
+		if group != nil && updatingStageStatus.StartTime == nil {
+			waitMessage, err := group.checkBeforeStage(updatingStageIndex, updatingStageStatus.StageName)
+			if err != nil {
+				return false, 0, abortOnUserError(err)
+			}
+			if waitMessage != "" {
+				klog.V(2).InfoS("The stage is waiting for the other updateRuns in the fleetUpdateRun", "stage", updatingStageStatus.StageName, "reason", waitMessage, "updateRun", klog.KObj(updateRun))
+				markStageUpdatingWaiting(updatingStageStatus, updateRun.GetGeneration(), waitMessage)
+				markUpdateRunWaiting(updateRun, waitMessage)
+				return false, stageUpdatingWaitTime, nil
+			}
+		}
+		approved, beforeStageWaitTime, err := r.checkBeforeStageTasksStatus(ctx, updatingStageIndex, updateRun)
 		if err != nil {
 			return false, 0, err
 		}
 		if !approved {
 			markStageUpdatingWaiting(updatingStageStatus, updateRun.GetGeneration(), "Not all before-stage tasks are completed, waiting for approval")
 			markUpdateRunWaiting(updateRun, fmt.Sprintf(condition.UpdateRunWaitingMessageFmt, "before-stage", updatingStageStatus.StageName))
-			return false, stageUpdatingWaitTime, nil
+			return false, minPositiveDuration(stageUpdatingWaitTime, beforeStageWaitTime), nil
 		}
 		maxConcurrency, err := calculateMaxConcurrencyValue(updateRunStatus, updatingStageIndex)
 		if err != nil {
 			return false, 0, fmt.Errorf("%w: %s", errStagedUpdatedAborted, err.Error())
 		}
-		waitTime, err = r.executeUpdatingStage(ctx, updateRun, updatingStageIndex, toBeUpdatedBindings, maxConcurrency)
+		maxFailedClusters, err := calculateMaxFailedClustersValue(updateRunStatus, updatingStageIndex)
+		if err != nil {
+			return false, 0, fmt.Errorf("%w: %s", errStagedUpdatedAborted, err.Error())
+		}
+		waitTime, err = r.executeUpdatingStage(ctx, updateRun, updatingStageIndex, toBeUpdatedBindings, maxConcurrency, maxFailedClusters, group)
+		if err == nil {
+			// Abort the updateRun if it's stuck and its rollback policy asks to roll back stuck updateRuns.
+			err = checkUpdateRunStuckForRollback(updateRun)
+		}
 		// The execution has not finished yet.
 		return false, waitTime, err
 	}
@@ -107,32 +135,49 @@ func (r *Reconciler) execute(
 
 // checkBeforeStageTasksStatus checks if the before stage tasks have finished.
 // It returns if the before stage tasks have finished or error if the before stage tasks failed.
-func (r *Reconciler) checkBeforeStageTasksStatus(ctx context.Context, updatingStageIndex int, updateRun placementv1beta1.UpdateRunObj) (bool, error) {
+// It also returns the time to wait before rechecking the tasks that need to be checked again later, or -1 if none.
+func (r *Reconciler) checkBeforeStageTasksStatus(ctx context.Context, updatingStageIndex int, updateRun placementv1beta1.UpdateRunObj) (bool, time.Duration, error) {
 	updateRunRef := klog.KObj(updateRun)
 	updateRunStatus := updateRun.GetUpdateRunStatus()
 	updatingStage := &updateRunStatus.UpdateStrategySnapshot.Stages[updatingStageIndex]
 	if updatingStage.BeforeStageTasks == nil {
 		klog.V(2).InfoS("There is no before stage task for this stage", "stage", updatingStage.Name, "updateRun", updateRunRef)
-		return true, nil
+		return true, 0, nil
 	}
 
 	updatingStageStatus := &updateRunStatus.StagesStatus[updatingStageIndex]
+	passed := true
+	beforeStageWaitTime := time.Duration(-1)
 	for i, task := range updatingStage.BeforeStageTasks {
 		switch task.Type {
 		case placementv1beta1.StageTaskTypeApproval:
 			approved, err := r.handleStageApprovalTask(ctx, &updatingStageStatus.BeforeStageTaskStatus[i], updatingStage, updateRun, placementv1beta1.BeforeStageTaskLabelValue)
 			if err != nil {
-				return false, err
+				return false, -1, err
+			}
+			if !approved {
+				passed = false
+			}
+		case placementv1beta1.StageTaskTypeWebhook:
+			approved, waitTime, err := r.handleStageWebhookTask(ctx, &updatingStageStatus.BeforeStageTaskStatus[i], &updatingStage.BeforeStageTasks[i], updatingStageStatus, updateRun, placementv1beta1.BeforeStageTaskLabelValue)
+			if err != nil {
+				return false, -1, err
+			}
+			if !approved {
+				passed = false
+				beforeStageWaitTime = minPositiveDuration(beforeStageWaitTime, waitTime)
 			}
-			return approved, nil // Ideally there should be only one approval task in before stage tasks.
 		default:
-			// Approval is the only supported before stage task.
+			// Approval and Webhook are the only supported before stage tasks.
 			unexpectedErr := controller.NewUnexpectedBehaviorError(fmt.Errorf("found unsupported task type in before stage tasks: %s", task.Type))
 			klog.ErrorS(unexpectedErr, "Task type is not supported in before stage tasks", "stage", updatingStage.Name, "updateRun", updateRunRef, "taskType", task.Type)
-			return false, fmt.Errorf("%w: %s", errStagedUpdatedAborted, unexpectedErr.Error())
+			return false, -1, fmt.Errorf("%w: %s", errStagedUpdatedAborted, unexpectedErr.Error())
 		}
 	}
-	return true, nil
+	if passed {
+		beforeStageWaitTime = 0
+	}
+	return passed, beforeStageWaitTime, nil
 }
 
 // executeUpdatingStage executes a single updating stage by updating the bindings.
@@ -141,7 +186,8 @@ func (r *Reconciler) executeUpdatingStage(
 	updateRun placementv1beta1.UpdateRunObj,
 	updatingStageIndex int,
 	toBeUpdatedBindings []placementv1beta1.BindingObj,
-	maxConcurrency int,
+	maxConcurrency, maxFailedClusters int,
+	group *fleetUpdateRunGroup,
 ) (time.Duration, error) {
 	updateRunStatus := updateRun.GetUpdateRunStatus()
 	updateRunSpec := updateRun.GetUpdateRunSpec()
@@ -157,12 +203,29 @@ func (r *Reconciler) executeUpdatingStage(
 		toBeUpdatedBindingsMap[bindingSpec.TargetCluster] = binding
 	}
 
+	maintenanceWindows, err := maintenancewindow.ListClusterMaintenanceWindows(ctx, r.Client)
+	if err != nil {
+		return 0, err
+	}
+	stageMaintenanceWindow := updateRunStatus.UpdateStrategySnapshot.Stages[updatingStageIndex].MaintenanceWindow
+	now := time.Now()
+	var clustersWaitingForPreviousPlacements map[string]string
+	if group != nil {
+		clustersWaitingForPreviousPlacements = group.clustersWaitingForPreviousPlacements(updatingStageIndex)
+	}
+	clusterApprovalRequests, err := r.listClusterApprovalRequests(ctx, updateRun, updatingStageStatus.StageName)
+	if err != nil {
+		return 0, err
+	}
+
 	finishedClusterCount := 0
 	clusterUpdatingCount := 0
 	var stuckClusterNames []string
 	var clusterUpdateErrors []error
 	// Go through each cluster in the stage and check if it's updating/succeeded/failed.
-	for i := 0; i < len(updatingStageStatus.Clusters) && clusterUpdatingCount < maxConcurrency; i++ {
+	// At most maxConcurrency clusters are processed, except for the clusters that have been manually approved,
+	// promoted or skipped.
+	for i := 0; i < len(updatingStageStatus.Clusters); i++ {
 		clusterStatus := &updatingStageStatus.Clusters[i]
 		clusterUpdateSucceededCond := meta.FindStatusCondition(clusterStatus.Conditions, string(placementv1beta1.ClusterUpdatingConditionSucceeded))
 		if condition.IsConditionStatusTrue(clusterUpdateSucceededCond, updateRun.GetGeneration()) {
@@ -170,31 +233,79 @@ func (r *Reconciler) executeUpdatingStage(
 			finishedClusterCount++
 			continue
 		}
-		clusterUpdatingCount++
 		if condition.IsConditionStatusFalse(clusterUpdateSucceededCond, updateRun.GetGeneration()) {
+			if tolerateClusterFailure(updatingStageStatus, clusterStatus.ClusterName, maxFailedClusters) {
+				// The failure of the cluster is tolerated, the cluster is skipped and counted as finished.
+				finishedClusterCount++
+				continue
+			}
 			// The cluster is marked as failed to update, this cluster is counted as updating cluster since it's not finished to avoid processing more clusters than maxConcurrency in this round.
+			clusterUpdatingCount++
 			failedErr := fmt.Errorf("the cluster `%s` in the stage %s has failed", clusterStatus.ClusterName, updatingStageStatus.StageName)
 			klog.ErrorS(failedErr, "The cluster has failed to be updated", "updateRun", updateRunRef)
 			clusterUpdateErrors = append(clusterUpdateErrors, fmt.Errorf("%w: %s", errStagedUpdatedAborted, failedErr.Error()))
 			continue
 		}
-		// The cluster needs to be processed.
 		clusterStartedCond := meta.FindStatusCondition(clusterStatus.Conditions, string(placementv1beta1.ClusterUpdatingConditionStarted))
+		if !condition.IsConditionStatusTrue(clusterStartedCond, updateRun.GetGeneration()) {
+			approvalRequest := clusterApprovalRequests[clusterStatus.ClusterName]
+			if clusterUpdatingCount >= maxConcurrency && !isClusterApprovalGiven(clusterStatus, approvalRequest) {
+				continue
+			}
+			approvalState, err := r.checkClusterApproval(ctx, updateRun, updatingStageStatus.StageName, clusterStatus, approvalRequest)
+			if err != nil {
+				clusterUpdatingCount++
+				clusterUpdateErrors = append(clusterUpdateErrors, err)
+				continue
+			}
+			switch approvalState {
+			case placementv1beta1.ClusterApprovalStateSkipped:
+				// The skipped cluster is counted as finished without being updated.
+				finishedClusterCount++
+				continue
+			case placementv1beta1.ClusterApprovalStatePending:
+				// The cluster waits for its approval in its turn.
+				clusterUpdatingCount++
+				continue
+			}
+		}
+		// The cluster needs to be processed.
 		binding, exists := toBeUpdatedBindingsMap[clusterStatus.ClusterName]
 		if !exists || binding == nil {
+			clusterUpdatingCount++
 			missingBindingErr := controller.NewUnexpectedBehaviorError(fmt.Errorf("the binding for cluster `%s` in stage `%s` is not found in the toBeUpdatedBindings map", clusterStatus.ClusterName, updatingStageStatus.StageName))
 			klog.ErrorS(missingBindingErr, "Cannot find the binding for the cluster in the updating stage", "cluster", clusterStatus.ClusterName, "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
 			clusterUpdateErrors = append(clusterUpdateErrors, fmt.Errorf("%w: %s", errStagedUpdatedAborted, missingBindingErr.Error()))
 			continue
 		}
+		clusterSnapshotName := clusterResourceSnapshotName(updateRunSpec.PlacementName, resourceSnapshotName, clusterStatus)
 		if !condition.IsConditionStatusTrue(clusterStartedCond, updateRun.GetGeneration()) {
 			// The cluster has not started updating yet.
-			if !isBindingSyncedWithClusterStatus(resourceSnapshotName, updateRun, binding, clusterStatus) {
+			if !isBindingSyncedWithClusterStatus(clusterSnapshotName, updateRun, binding, clusterStatus) {
+				// Only start updating the cluster after the previous placements in the fleetUpdateRun have updated it.
+				if previousUpdateRun, found := clustersWaitingForPreviousPlacements[clusterStatus.ClusterName]; found {
+					klog.V(2).InfoS("The cluster is waiting for the updateRun of a previous placement in the fleetUpdateRun", "cluster", clusterStatus.ClusterName, "stage", updatingStageStatus.StageName, "previousUpdateRun", previousUpdateRun, "updateRun", updateRunRef)
+					continue
+				}
+				// Only start updating the cluster inside its maintenance windows and the ones of its stage.
+				windowStatus, err := maintenancewindow.EvaluateCluster(ctx, r.Client, maintenanceWindows, clusterStatus.ClusterName, now, stageMaintenanceWindow)
+				if err != nil {
+					clusterUpdateErrors = append(clusterUpdateErrors, err)
+					continue
+				}
+				if !windowStatus.Open {
+					klog.V(2).InfoS("The cluster is outside its maintenance windows, waiting", "cluster", clusterStatus.ClusterName, "stage", updatingStageStatus.StageName, "nextOpenTime", windowStatus.NextOpenTime, "updateRun", updateRunRef)
+					markClusterUpdatingWaitingForMaintenanceWindow(clusterStatus, updateRun.GetGeneration(), windowStatus.Message())
+					continue
+				}
+				// The cluster is only counted as updating once it can start updating, so that the clusters waiting for
+				// the previous placements or their maintenance windows do not hold back the other clusters in the stage.
+				clusterUpdatingCount++
 				klog.V(2).InfoS("Found the first cluster that needs to be updated", "cluster", clusterStatus.ClusterName, "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
 				// The binding is not up-to-date with the cluster status.
 				bindingSpec := binding.GetBindingSpec()
 				bindingSpec.State = placementv1beta1.BindingStateBound
-				bindingSpec.ResourceSnapshotName = resourceSnapshotName
+				bindingSpec.ResourceSnapshotName = clusterSnapshotName
 				bindingSpec.ResourceOverrideSnapshots = clusterStatus.ResourceOverrideSnapshots
 				bindingSpec.ClusterResourceOverrideSnapshots = clusterStatus.ClusterResourceOverrideSnapshots
 				bindingSpec.ApplyStrategy = updateRunStatus.ApplyStrategy
@@ -209,6 +320,7 @@ func (r *Reconciler) executeUpdatingStage(
 					continue
 				}
 			} else {
+				clusterUpdatingCount++
 				klog.V(2).InfoS("Found the first binding that is updating but the cluster status has not been updated", "cluster", clusterStatus.ClusterName, "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
 				bindingSpec := binding.GetBindingSpec()
 				if bindingSpec.State != placementv1beta1.BindingStateBound {
@@ -242,8 +354,9 @@ func (r *Reconciler) executeUpdatingStage(
 			continue
 		}
 
+		clusterUpdatingCount++
 		// Now the cluster has to be updating, the binding should point to the right resource snapshot and the binding should be bound.
-		inSync := isBindingSyncedWithClusterStatus(resourceSnapshotName, updateRun, binding, clusterStatus)
+		inSync := isBindingSyncedWithClusterStatus(clusterSnapshotName, updateRun, binding, clusterStatus)
 		rolloutStarted := condition.IsConditionStatusTrue(meta.FindStatusCondition(binding.GetBindingStatus().Conditions, string(placementv1beta1.ResourceBindingRolloutStarted)), binding.GetGeneration())
 		bindingSpec := binding.GetBindingSpec()
 		if !inSync || !rolloutStarted || bindingSpec.State != placementv1beta1.BindingStateBound {
@@ -256,11 +369,27 @@ func (r *Reconciler) executeUpdatingStage(
 				"bindingSpecInSync", inSync, "bindingState", bindingSpec.State,
 				"bindingRolloutStarted", rolloutStarted, "binding", klog.KObj(binding), "updateRun", updateRunRef)
 			markClusterUpdatingFailed(clusterStatus, updateRun.GetGeneration(), preemptedErr.Error())
+			if tolerateClusterFailure(updatingStageStatus, clusterStatus.ClusterName, maxFailedClusters) {
+				klog.V(2).InfoS("Tolerated the failure of the preempted cluster", "cluster", clusterStatus.ClusterName, "stage", updatingStageStatus.StageName, "maxFailedClusters", maxFailedClusters, "updateRun", updateRunRef)
+				finishedClusterCount++
+				clusterUpdatingCount--
+				continue
+			}
 			clusterUpdateErrors = append(clusterUpdateErrors, fmt.Errorf("%w: %w", errStagedUpdatedAborted, preemptedErr))
 			continue
 		}
 
 		finished, updateErr := checkClusterUpdateResult(binding, clusterStatus, updatingStageStatus, updateRun)
+		timeElapsed := time.Since(clusterStartedCond.LastTransitionTime.Time)
+		if updateErr != nil && timeElapsed > updateRunStuckThreshold && tolerateClusterFailure(updatingStageStatus, clusterStatus.ClusterName, maxFailedClusters) {
+			// The cluster has kept failing for longer than the stuck threshold, give up on it as the failure is tolerated.
+			klog.V(2).InfoS("Tolerated the failure of the cluster that keeps failing to be updated", "time elapsed", timeElapsed, "threshold", updateRunStuckThreshold,
+				"cluster", clusterStatus.ClusterName, "stage", updatingStageStatus.StageName, "maxFailedClusters", maxFailedClusters, "updateRun", updateRunRef)
+			markClusterUpdatingFailed(clusterStatus, updateRun.GetGeneration(), updateErr.Error())
+			finishedClusterCount++
+			clusterUpdatingCount--
+			continue
+		}
 		if updateErr != nil {
 			clusterUpdateErrors = append(clusterUpdateErrors, updateErr)
 		}
@@ -270,7 +399,6 @@ func (r *Reconciler) executeUpdatingStage(
 			clusterUpdatingCount--
 		} else {
 			// If cluster update has been running for more than "updateRunStuckThreshold", mark the update run as stuck.
-			timeElapsed := time.Since(clusterStartedCond.LastTransitionTime.Time)
 			if timeElapsed > updateRunStuckThreshold {
 				klog.V(2).InfoS("Time waiting for cluster update to finish passes threshold, mark the update run as stuck", "time elapsed", timeElapsed, "threshold", updateRunStuckThreshold, "cluster", clusterStatus.ClusterName, "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
 				stuckClusterNames = append(stuckClusterNames, clusterStatus.ClusterName)
@@ -288,6 +416,13 @@ func (r *Reconciler) executeUpdatingStage(
 	}
 
 	if finishedClusterCount == len(updatingStageStatus.Clusters) {
+		if group != nil {
+			// The shared after-stage tasks only start after all the updateRuns in the fleetUpdateRun have updated the clusters in the stage.
+			if blockingUpdateRun := group.updateRunBlockingAfterStageTasks(updatingStageIndex); blockingUpdateRun != "" {
+				klog.V(2).InfoS("Waiting for the updateRun of a previous placement in the fleetUpdateRun to finish the stage", "stage", updatingStageStatus.StageName, "previousUpdateRun", blockingUpdateRun, "updateRun", updateRunRef)
+				return clusterUpdatingWaitTime, nil
+			}
+		}
 		// Only record the metric once when transitioning from clusters updating to waiting/succeeded.
 		// Record only when the stage reason is still "Started", meaning clusters just finished and we haven't yet
 		// transitioned to waiting for after-stage tasks. On subsequent reconciles, the reason will be "Waiting",
@@ -423,7 +558,7 @@ func (r *Reconciler) checkAfterStageTasksStatus(ctx context.Context, updatingSta
 			if waitTime > 0 {
 				klog.V(2).InfoS("The after stage task still need to wait", "waitStartTime", waitStartTime, "waitTime", task.WaitTime, "stage", updatingStage.Name, "updateRun", updateRunRef)
 				passed = false
-				afterStageWaitTime = waitTime
+				afterStageWaitTime = minPositiveDuration(afterStageWaitTime, waitTime)
 			} else {
 				markAfterStageWaitTimeElapsed(&updatingStageStatus.AfterStageTaskStatus[i], updateRun.GetGeneration())
 				klog.V(2).InfoS("The after stage wait task has completed", "stage", updatingStage.Name, "updateRun", updateRunRef)
@@ -436,6 +571,33 @@ func (r *Reconciler) checkAfterStageTasksStatus(ctx context.Context, updatingSta
 			if !approved {
 				passed = false
 			}
+		case placementv1beta1.StageTaskTypeAnalysis:
+			succeeded, waitTime, err := r.handleStageAnalysisTask(ctx, &updatingStageStatus.AfterStageTaskStatus[i], &updatingStage.AfterStageTasks[i], updatingStageStatus, updateRun)
+			if err != nil {
+				return false, -1, err
+			}
+			if !succeeded {
+				passed = false
+				afterStageWaitTime = minPositiveDuration(afterStageWaitTime, waitTime)
+			}
+		case placementv1beta1.StageTaskTypeWebhook:
+			approved, waitTime, err := r.handleStageWebhookTask(ctx, &updatingStageStatus.AfterStageTaskStatus[i], &updatingStage.AfterStageTasks[i], updatingStageStatus, updateRun, placementv1beta1.AfterStageTaskLabelValue)
+			if err != nil {
+				return false, -1, err
+			}
+			if !approved {
+				passed = false
+				afterStageWaitTime = minPositiveDuration(afterStageWaitTime, waitTime)
+			}
+		case placementv1beta1.StageTaskTypeVerificationJob:
+			succeeded, waitTime, err := r.handleStageVerificationJobTask(ctx, &updatingStageStatus.AfterStageTaskStatus[i], &updatingStage.AfterStageTasks[i], updatingStageStatus, updateRun)
+			if err != nil {
+				return false, -1, err
+			}
+			if !succeeded {
+				passed = false
+				afterStageWaitTime = minPositiveDuration(afterStageWaitTime, waitTime)
+			}
 		}
 	}
 	if passed {
@@ -570,6 +732,40 @@ func calculateMaxConcurrencyValue(status *placementv1beta1.UpdateRunStatus, stag
 	return maxConcurrencyValue, nil
 }
 
+// abortOnUserError aborts the updateRun if the error is a user error, which cannot be fixed by retrying.
+func abortOnUserError(err error) error {
+	if errors.Is(err, controller.ErrUserError) {
+		return fmt.Errorf("%w: %s", errStagedUpdatedAborted, err.Error())
+	}
+	return err
+}
+
+// calculateMaxFailedClustersValue calculates the actual max failed clusters value for a stage.
+// It converts the IntOrString maxFailedClusters (which can be an integer or percentage) to an integer value
+// based on the total number of clusters in the stage. The value is rounded down and defaults to 0.
+func calculateMaxFailedClustersValue(status *placementv1beta1.UpdateRunStatus, stageIndex int) (int, error) {
+	specifiedMaxFailedClusters := status.UpdateStrategySnapshot.Stages[stageIndex].MaxFailedClusters
+	if specifiedMaxFailedClusters == nil {
+		return 0, nil
+	}
+	clusterCount := len(status.StagesStatus[stageIndex].Clusters)
+	// Round down the maxFailedClusters so that the limit is never more lenient than specified.
+	return intstr.GetScaledValueFromIntOrPercent(specifiedMaxFailedClusters, clusterCount, false)
+}
+
+// tolerateClusterFailure checks if the failure of a cluster in a stage is tolerated under the maxFailedClusters
+// limit of the stage. A newly tolerated cluster is recorded in the failed clusters of the stage status.
+func tolerateClusterFailure(stageStatus *placementv1beta1.StageUpdatingStatus, clusterName string, maxFailedClusters int) bool {
+	if slices.Contains(stageStatus.FailedClusters, clusterName) {
+		return true
+	}
+	if len(stageStatus.FailedClusters) >= maxFailedClusters {
+		return false
+	}
+	stageStatus.FailedClusters = append(stageStatus.FailedClusters, clusterName)
+	return true
+}
+
 // aggregateUpdateRunStatus aggregates the status of the update run based on the cluster update status.
 // It marks the update run as stuck if any clusters are stuck, or as progressing if some clusters have finished updating.
 func aggregateUpdateRunStatus(updateRun placementv1beta1.UpdateRunObj, stageName string, stuckClusterNames []string) {
@@ -836,6 +1032,18 @@ func markClusterUpdatingStarted(clusterUpdatingStatus *placementv1beta1.ClusterU
 	})
 }
 
+// markClusterUpdatingWaitingForMaintenanceWindow marks the cluster updating status as not started because the cluster
+// is outside its maintenance windows in memory.
+func markClusterUpdatingWaitingForMaintenanceWindow(clusterUpdatingStatus *placementv1beta1.ClusterUpdatingStatus, generation int64, message string) {
+	meta.SetStatusCondition(&clusterUpdatingStatus.Conditions, metav1.Condition{
+		Type:               string(placementv1beta1.ClusterUpdatingConditionStarted),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             condition.ClusterUpdatingWaitingForMaintenanceWindowReason,
+		Message:            fmt.Sprintf("Cluster update is waiting: %s", message),
+	})
+}
+
 // markClusterUpdatingSucceeded marks the cluster updating status as succeeded in memory.
 func markClusterUpdatingSucceeded(clusterUpdatingStatus *placementv1beta1.ClusterUpdatingStatus, generation int64) {
 	meta.SetStatusCondition(&clusterUpdatingStatus.Conditions, metav1.Condition{
@@ -35,6 +35,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 )
@@ -865,7 +866,7 @@ func TestExecuteUpdatingStage_Error(t *testing.T) {
 			}
 
 			// Execute the stage.
-			waitTime, gotErr := r.executeUpdatingStage(ctx, tt.updateRun, 0, tt.bindings, 1)
+			waitTime, gotErr := r.executeUpdatingStage(ctx, tt.updateRun, 0, tt.bindings, 1, 0, nil)
 
 			// Verify error expectation.
 			if (tt.wantErr != nil) != (gotErr != nil) {
@@ -1009,6 +1010,402 @@ func TestCalculateMaxConcurrencyValue(t *testing.T) {
 	}
 }
 
+func TestCalculateMaxFailedClustersValue(t *testing.T) {
+	tests := []struct {
+		name              string
+		maxFailedClusters *intstr.IntOrString
+		clusterCount      int
+		wantValue         int
+		wantErr           bool
+	}{
+		{
+			name:         "not specified",
+			clusterCount: 10,
+			wantValue:    0,
+		},
+		{
+			name:              "integer value",
+			maxFailedClusters: &intstr.IntOrString{Type: intstr.Int, IntVal: 2},
+			clusterCount:      10,
+			wantValue:         2,
+		},
+		{
+			name:              "percentage value - 15% rounds down",
+			maxFailedClusters: &intstr.IntOrString{Type: intstr.String, StrVal: "15%"},
+			clusterCount:      10,
+			wantValue:         1,
+		},
+		{
+			name:              "percentage value - 10% with 5 clusters rounds down to 0",
+			maxFailedClusters: &intstr.IntOrString{Type: intstr.String, StrVal: "10%"},
+			clusterCount:      5,
+			wantValue:         0,
+		},
+		{
+			name:              "value as string without percentage",
+			maxFailedClusters: &intstr.IntOrString{Type: intstr.String, StrVal: "5"},
+			clusterCount:      10,
+			wantErr:           true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := &placementv1beta1.UpdateRunStatus{
+				StagesStatus: []placementv1beta1.StageUpdatingStatus{
+					{
+						StageName: "test-stage",
+						Clusters:  make([]placementv1beta1.ClusterUpdatingStatus, tt.clusterCount),
+					},
+				},
+				UpdateStrategySnapshot: &placementv1beta1.UpdateStrategySpec{
+					Stages: []placementv1beta1.StageConfig{
+						{
+							Name:              "test-stage",
+							MaxFailedClusters: tt.maxFailedClusters,
+						},
+					},
+				},
+			}
+
+			gotValue, gotErr := calculateMaxFailedClustersValue(status, 0)
+
+			if (gotErr != nil) != tt.wantErr {
+				t.Fatalf("calculateMaxFailedClustersValue() error = %v, wantErr %v", gotErr, tt.wantErr)
+			}
+
+			if gotValue != tt.wantValue {
+				t.Fatalf("calculateMaxFailedClustersValue() = %v, want %v", gotValue, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestExecuteUpdatingStage_MaxFailedClusters(t *testing.T) {
+	startedCond := func(startTime time.Time) metav1.Condition {
+		return metav1.Condition{
+			Type:               string(placementv1beta1.ClusterUpdatingConditionStarted),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 1,
+			Reason:             condition.ClusterUpdatingStartedReason,
+			LastTransitionTime: metav1.NewTime(startTime),
+		}
+	}
+	failedCond := metav1.Condition{
+		Type:               string(placementv1beta1.ClusterUpdatingConditionSucceeded),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: 1,
+		Reason:             condition.ClusterUpdatingFailedReason,
+	}
+	binding := func(cluster, resourceSnapshotName string, conds ...metav1.Condition) placementv1beta1.BindingObj {
+		return &placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "binding-" + cluster,
+				Generation: 1,
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster:        cluster,
+				ResourceSnapshotName: resourceSnapshotName,
+				State:                placementv1beta1.BindingStateBound,
+			},
+			Status: placementv1beta1.ResourceBindingStatus{
+				Conditions: append([]metav1.Condition{
+					{
+						Type:               string(placementv1beta1.ResourceBindingRolloutStarted),
+						Status:             metav1.ConditionTrue,
+						ObservedGeneration: 1,
+						Reason:             condition.RolloutStartedReason,
+					},
+				}, conds...),
+			},
+		}
+	}
+	applyFailedCond := metav1.Condition{
+		Type:               string(placementv1beta1.ResourceBindingApplied),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: 1,
+		Reason:             condition.ApplyFailedReason,
+	}
+
+	tests := []struct {
+		name               string
+		clusters           []placementv1beta1.ClusterUpdatingStatus
+		failedClusters     []string
+		bindings           []placementv1beta1.BindingObj
+		maxFailedClusters  int
+		wantFailedClusters []string
+		wantErr            error
+		wantAbortErr       bool
+		wantWaitTime       time.Duration
+	}{
+		{
+			name: "failed cluster is tolerated and skipped",
+			clusters: []placementv1beta1.ClusterUpdatingStatus{
+				{ClusterName: "cluster-1", Conditions: []metav1.Condition{startedCond(time.Now()), failedCond}},
+				{ClusterName: "cluster-2", Conditions: []metav1.Condition{startedCond(time.Now())}},
+			},
+			bindings: []placementv1beta1.BindingObj{
+				binding("cluster-2", "test-placement-1-snapshot"),
+			},
+			maxFailedClusters:  1,
+			wantFailedClusters: []string{"cluster-1"},
+			wantWaitTime:       clusterUpdatingWaitTime,
+		},
+		{
+			name: "failed clusters beyond the limit abort the stage",
+			clusters: []placementv1beta1.ClusterUpdatingStatus{
+				{ClusterName: "cluster-1", Conditions: []metav1.Condition{startedCond(time.Now()), failedCond}},
+				{ClusterName: "cluster-2", Conditions: []metav1.Condition{startedCond(time.Now()), failedCond}},
+			},
+			maxFailedClusters:  1,
+			wantFailedClusters: []string{"cluster-1"},
+			wantErr:            errors.New("the cluster `cluster-2` in the stage test-stage has failed"),
+			wantAbortErr:       true,
+		},
+		{
+			name: "previously tolerated failed cluster stays tolerated",
+			clusters: []placementv1beta1.ClusterUpdatingStatus{
+				{ClusterName: "cluster-1", Conditions: []metav1.Condition{startedCond(time.Now()), failedCond}},
+				{ClusterName: "cluster-2", Conditions: []metav1.Condition{startedCond(time.Now())}},
+			},
+			failedClusters: []string{"cluster-1"},
+			bindings: []placementv1beta1.BindingObj{
+				binding("cluster-2", "test-placement-1-snapshot"),
+			},
+			maxFailedClusters:  1,
+			wantFailedClusters: []string{"cluster-1"},
+			wantWaitTime:       clusterUpdatingWaitTime,
+		},
+		{
+			name: "preempted cluster is tolerated",
+			clusters: []placementv1beta1.ClusterUpdatingStatus{
+				{ClusterName: "cluster-1", Conditions: []metav1.Condition{startedCond(time.Now())}},
+				{ClusterName: "cluster-2", Conditions: []metav1.Condition{startedCond(time.Now())}},
+			},
+			bindings: []placementv1beta1.BindingObj{
+				binding("cluster-1", "wrong-snapshot"),
+				binding("cluster-2", "test-placement-1-snapshot"),
+			},
+			maxFailedClusters:  1,
+			wantFailedClusters: []string{"cluster-1"},
+			wantWaitTime:       clusterUpdatingWaitTime,
+		},
+		{
+			name: "cluster failing for longer than the stuck threshold is tolerated",
+			clusters: []placementv1beta1.ClusterUpdatingStatus{
+				{ClusterName: "cluster-1", Conditions: []metav1.Condition{startedCond(time.Now().Add(-2 * updateRunStuckThreshold))}},
+				{ClusterName: "cluster-2", Conditions: []metav1.Condition{startedCond(time.Now())}},
+			},
+			bindings: []placementv1beta1.BindingObj{
+				binding("cluster-1", "test-placement-1-snapshot", applyFailedCond),
+				binding("cluster-2", "test-placement-1-snapshot"),
+			},
+			maxFailedClusters:  1,
+			wantFailedClusters: []string{"cluster-1"},
+			wantWaitTime:       clusterUpdatingWaitTime,
+		},
+		{
+			name: "cluster failing within the stuck threshold keeps retrying",
+			clusters: []placementv1beta1.ClusterUpdatingStatus{
+				{ClusterName: "cluster-1", Conditions: []metav1.Condition{startedCond(time.Now())}},
+			},
+			bindings: []placementv1beta1.BindingObj{
+				binding("cluster-1", "test-placement-1-snapshot", applyFailedCond),
+			},
+			maxFailedClusters: 1,
+			wantErr:           errors.New("cluster updating encountered an error at stage"),
+		},
+		{
+			name: "cluster failing for longer than the stuck threshold without tolerance keeps retrying",
+			clusters: []placementv1beta1.ClusterUpdatingStatus{
+				{ClusterName: "cluster-1", Conditions: []metav1.Condition{startedCond(time.Now().Add(-2 * updateRunStuckThreshold))}},
+			},
+			bindings: []placementv1beta1.BindingObj{
+				binding("cluster-1", "test-placement-1-snapshot", applyFailedCond),
+			},
+			maxFailedClusters: 0,
+			wantErr:           errors.New("cluster updating encountered an error at stage"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			_ = placementv1beta1.AddToScheme(scheme)
+			objs := make([]client.Object, len(tt.bindings))
+			for i := range tt.bindings {
+				objs[i] = tt.bindings[i]
+			}
+			r := &Reconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+			}
+			updateRun := &placementv1beta1.ClusterStagedUpdateRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-update-run",
+					Generation: 1,
+				},
+				Spec: placementv1beta1.UpdateRunSpec{
+					PlacementName:         "test-placement",
+					ResourceSnapshotIndex: "1",
+					State:                 placementv1beta1.StateRun,
+				},
+				Status: placementv1beta1.UpdateRunStatus{
+					ResourceSnapshotIndexUsed: "1",
+					StagesStatus: []placementv1beta1.StageUpdatingStatus{
+						{
+							StageName:      "test-stage",
+							Clusters:       tt.clusters,
+							FailedClusters: tt.failedClusters,
+						},
+					},
+					UpdateStrategySnapshot: &placementv1beta1.UpdateStrategySpec{
+						Stages: []placementv1beta1.StageConfig{
+							{
+								Name: "test-stage",
+							},
+						},
+					},
+				},
+			}
+
+			waitTime, gotErr := r.executeUpdatingStage(ctx, updateRun, 0, tt.bindings, 2, tt.maxFailedClusters, nil)
+			if (tt.wantErr != nil) != (gotErr != nil) {
+				t.Fatalf("executeUpdatingStage() want error: %v, got error: %v", tt.wantErr, gotErr)
+			}
+			if tt.wantErr != nil {
+				if errors.Is(gotErr, errStagedUpdatedAborted) != tt.wantAbortErr {
+					t.Fatalf("executeUpdatingStage() want abort error: %v, got error: %v", tt.wantAbortErr, gotErr)
+				}
+				if !strings.Contains(gotErr.Error(), tt.wantErr.Error()) {
+					t.Fatalf("executeUpdatingStage() want error: %v, got error: %v", tt.wantErr, gotErr)
+				}
+			}
+			if waitTime != tt.wantWaitTime {
+				t.Errorf("executeUpdatingStage() want waitTime: %v, got waitTime: %v", tt.wantWaitTime, waitTime)
+			}
+			if diff := cmp.Diff(updateRun.Status.StagesStatus[0].FailedClusters, tt.wantFailedClusters); diff != "" {
+				t.Errorf("executeUpdatingStage() failed clusters mismatch (-got, +want):\n%s", diff)
+			}
+			for _, clusterName := range tt.wantFailedClusters {
+				for _, clusterStatus := range updateRun.Status.StagesStatus[0].Clusters {
+					if clusterStatus.ClusterName == clusterName && !condition.IsConditionStatusFalse(meta.FindStatusCondition(clusterStatus.Conditions, string(placementv1beta1.ClusterUpdatingConditionSucceeded)), 1) {
+						t.Errorf("executeUpdatingStage() tolerated cluster %s is not marked as failed", clusterName)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestExecuteUpdatingStage_WaitingClustersNotCounted(t *testing.T) {
+	binding := func(cluster string) *placementv1beta1.ClusterResourceBinding {
+		return &placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "binding-" + cluster,
+				Generation: 1,
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster:        cluster,
+				ResourceSnapshotName: "test-placement-0-snapshot",
+				State:                placementv1beta1.BindingStateScheduled,
+			},
+		}
+	}
+	alwaysBlackedOut := &placementv1beta1.ClusterMaintenanceWindow{
+		ObjectMeta: metav1.ObjectMeta{Name: "freeze"},
+		Spec: placementv1beta1.ClusterMaintenanceWindowSpec{
+			ClusterSelector: metav1.LabelSelector{MatchLabels: map[string]string{"freeze": "true"}},
+			MaintenanceWindowPolicy: placementv1beta1.MaintenanceWindowPolicy{
+				Blackouts: []placementv1beta1.RecurringTimeWindow{{Schedule: "* * * * *", Duration: metav1.Duration{Duration: time.Hour}}},
+			},
+		},
+	}
+	frozenCluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-1", Labels: map[string]string{"freeze": "true"}},
+	}
+
+	tests := []struct {
+		name string
+		objs []client.Object
+		// group is the fleetUpdateRun group, in which the updateRun under test is the last member.
+		group *fleetUpdateRunGroup
+	}{
+		{
+			name: "cluster waiting for the previous placement does not take up the concurrency",
+			group: &fleetUpdateRunGroup{
+				name:     "fur",
+				position: 1,
+				members: []placementv1beta1.UpdateRunObj{
+					groupMember("run-0", groupStage("test-stage", true, false, groupCluster("cluster-1", false))),
+				},
+			},
+		},
+		{
+			name: "cluster outside its maintenance windows does not take up the concurrency",
+			objs: []client.Object{alwaysBlackedOut, frozenCluster},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			_ = placementv1beta1.AddToScheme(scheme)
+			_ = clusterv1beta1.AddToScheme(scheme)
+			bindings := []placementv1beta1.BindingObj{binding("cluster-1"), binding("cluster-2")}
+			objs := append([]client.Object{bindings[0], bindings[1]}, tt.objs...)
+			r := &Reconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).WithStatusSubresource(bindings[0], bindings[1]).Build(),
+			}
+			updateRun := &placementv1beta1.ClusterStagedUpdateRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-update-run",
+					Generation: 1,
+				},
+				Spec: placementv1beta1.UpdateRunSpec{
+					PlacementName:         "test-placement",
+					ResourceSnapshotIndex: "1",
+					State:                 placementv1beta1.StateRun,
+				},
+				Status: placementv1beta1.UpdateRunStatus{
+					ResourceSnapshotIndexUsed: "1",
+					StagesStatus: []placementv1beta1.StageUpdatingStatus{
+						{
+							StageName: "test-stage",
+							Clusters: []placementv1beta1.ClusterUpdatingStatus{
+								{ClusterName: "cluster-1"},
+								{ClusterName: "cluster-2"},
+							},
+						},
+					},
+					UpdateStrategySnapshot: &placementv1beta1.UpdateStrategySpec{
+						Stages: []placementv1beta1.StageConfig{
+							{
+								Name: "test-stage",
+							},
+						},
+					},
+				},
+			}
+			if tt.group != nil {
+				tt.group.members = append(tt.group.members, updateRun)
+			}
+
+			if _, err := r.executeUpdatingStage(ctx, updateRun, 0, bindings, 1, 0, tt.group); err != nil {
+				t.Fatalf("executeUpdatingStage() got error: %v, want no error", err)
+			}
+			clusters := updateRun.Status.StagesStatus[0].Clusters
+			if condition.IsConditionStatusTrue(meta.FindStatusCondition(clusters[0].Conditions, string(placementv1beta1.ClusterUpdatingConditionStarted)), 1) {
+				t.Errorf("executeUpdatingStage() started updating the waiting cluster %s", clusters[0].ClusterName)
+			}
+			if !condition.IsConditionStatusTrue(meta.FindStatusCondition(clusters[1].Conditions, string(placementv1beta1.ClusterUpdatingConditionStarted)), 1) {
+				t.Errorf("executeUpdatingStage() did not start updating the cluster %s behind the waiting cluster", clusters[1].ClusterName)
+			}
+		})
+	}
+}
+
 func TestCheckBeforeStageTasksStatus_NegativeCases(t *testing.T) {
 	stageName := "stage-0"
 	testUpdateRunName = "test-update-run"
@@ -1251,7 +1648,7 @@ func TestCheckBeforeStageTasksStatus_NegativeCases(t *testing.T) {
 				Client: fakeClient,
 			}
 			ctx := context.Background()
-			_, gotErr := r.checkBeforeStageTasksStatus(ctx, tt.stageIndex, tt.updateRun)
+			_, _, gotErr := r.checkBeforeStageTasksStatus(ctx, tt.stageIndex, tt.updateRun)
 			if gotErr == nil {
 				t.Fatalf("checkBeforeStageTasksStatus() want error but got nil")
 			}
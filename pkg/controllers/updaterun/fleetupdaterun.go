@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterun
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// fleetUpdateRunGroup is the group of the updateRuns created for the placements of a ClusterFleetUpdateRun.
+type fleetUpdateRunGroup struct {
+	// name is the name of the ClusterFleetUpdateRun.
+	name string
+	// position is the index of the updateRun being reconciled in the group.
+	position int
+	// members are the updateRuns of the group in the order of the placements of the ClusterFleetUpdateRun.
+	// The updateRuns that have not been created yet are nil.
+	members []placementv1beta1.UpdateRunObj
+}
+
+// fleetUpdateRunNameOf returns the name of the ClusterFleetUpdateRun that the updateRun is created for,
+// or an empty string if the updateRun is not created for a ClusterFleetUpdateRun.
+func fleetUpdateRunNameOf(updateRun placementv1beta1.UpdateRunObj) string {
+	if updateRun.GetNamespace() != "" {
+		return ""
+	}
+	owner := metav1.GetControllerOf(updateRun)
+	if owner == nil || owner.Kind != placementv1beta1.ClusterFleetUpdateRunKind || owner.APIVersion != placementv1beta1.GroupVersion.String() {
+		return ""
+	}
+	return owner.Name
+}
+
+// fetchFleetUpdateRunGroup fetches the group of the updateRuns that the updateRun belongs to.
+// It returns nil if the updateRun is not created for a ClusterFleetUpdateRun.
+func (r *Reconciler) fetchFleetUpdateRunGroup(ctx context.Context, updateRun placementv1beta1.UpdateRunObj) (*fleetUpdateRunGroup, error) {
+	name := fleetUpdateRunNameOf(updateRun)
+	if name == "" {
+		return nil, nil
+	}
+	updateRunRef := klog.KObj(updateRun)
+	var fleetUpdateRun placementv1beta1.ClusterFleetUpdateRun
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: name}, &fleetUpdateRun); err != nil {
+		klog.ErrorS(err, "Failed to get the fleetUpdateRun of the updateRun", "fleetUpdateRun", name, "updateRun", updateRunRef)
+		if apierrors.IsNotFound(err) {
+			return nil, controller.NewUserError(fmt.Errorf("the fleetUpdateRun `%s` of the updateRun is not found", name))
+		}
+		return nil, controller.NewAPIServerError(true, err)
+	}
+
+	var updateRunList placementv1beta1.ClusterStagedUpdateRunList
+	if err := r.Client.List(ctx, &updateRunList, client.MatchingLabels{placementv1beta1.FleetUpdateRunLabel: name}); err != nil {
+		klog.ErrorS(err, "Failed to list the updateRuns of the fleetUpdateRun", "fleetUpdateRun", name, "updateRun", updateRunRef)
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	updateRunsByPlacement := make(map[string]placementv1beta1.UpdateRunObj, len(updateRunList.Items))
+	for i := range updateRunList.Items {
+		if metav1.IsControlledBy(&updateRunList.Items[i], &fleetUpdateRun) {
+			updateRunsByPlacement[updateRunList.Items[i].Spec.PlacementName] = &updateRunList.Items[i]
+		}
+	}
+
+	group := &fleetUpdateRunGroup{
+		name:     name,
+		position: -1,
+		members:  make([]placementv1beta1.UpdateRunObj, len(fleetUpdateRun.Spec.Placements)),
+	}
+	for i, placement := range fleetUpdateRun.Spec.Placements {
+		group.members[i] = updateRunsByPlacement[placement.PlacementName]
+		if placement.PlacementName == updateRun.GetUpdateRunSpec().PlacementName {
+			group.position = i
+			// Always use the updateRun being reconciled, which may have changed in memory.
+			group.members[i] = updateRun
+		}
+	}
+	if group.position == -1 {
+		return nil, controller.NewUserError(fmt.Errorf("the placement `%s` of the updateRun is not in the fleetUpdateRun `%s`", updateRun.GetUpdateRunSpec().PlacementName, name))
+	}
+	return group, nil
+}
+
+// removeSharedStageTasks removes the stage tasks that are run by the other updateRuns of the group from the
+// update strategy snapshot: only the first updateRun runs the before-stage tasks and only the last updateRun
+// runs the after-stage tasks.
+func (g *fleetUpdateRunGroup) removeSharedStageTasks(updateStrategy *placementv1beta1.UpdateStrategySpec) {
+	for i := range updateStrategy.Stages {
+		if g.position != 0 {
+			updateStrategy.Stages[i].BeforeStageTasks = nil
+		}
+		if g.position != len(g.members)-1 {
+			updateStrategy.Stages[i].AfterStageTasks = nil
+		}
+	}
+}
+
+// checkBeforeStage checks if the updateRun can start a stage together with the other updateRuns of the group.
+// A stage can be started after all the updateRuns have finished the previous stage, and after the updateRun of
+// the previous placement has started the stage.
+// It returns a non-empty message if the updateRun has to wait, or a user error if the group has failed.
+func (g *fleetUpdateRunGroup) checkBeforeStage(stageIndex int, stageName string) (string, error) {
+	for i, member := range g.members {
+		if i == g.position {
+			continue
+		}
+		if member == nil {
+			return fmt.Sprintf("waiting for the updateRun of placement index %d in fleetUpdateRun `%s` to be created", i, g.name), nil
+		}
+		memberStatus := member.GetUpdateRunStatus()
+		if meta.IsStatusConditionFalse(memberStatus.Conditions, string(placementv1beta1.StagedUpdateRunConditionInitialized)) ||
+			meta.IsStatusConditionFalse(memberStatus.Conditions, string(placementv1beta1.StagedUpdateRunConditionSucceeded)) {
+			return "", controller.NewUserError(fmt.Errorf("the updateRun `%s` in the same fleetUpdateRun `%s` has failed", member.GetName(), g.name))
+		}
+		if !meta.IsStatusConditionTrue(memberStatus.Conditions, string(placementv1beta1.StagedUpdateRunConditionInitialized)) {
+			return fmt.Sprintf("waiting for the updateRun `%s` in fleetUpdateRun `%s` to be initialized", member.GetName(), g.name), nil
+		}
+		if stageIndex >= len(memberStatus.StagesStatus) || memberStatus.StagesStatus[stageIndex].StageName != stageName {
+			return "", controller.NewUserError(fmt.Errorf("the updateRun `%s` in the same fleetUpdateRun `%s` does not have stage `%s` at index %d", member.GetName(), g.name, stageName, stageIndex))
+		}
+		if stageIndex > 0 && !meta.IsStatusConditionTrue(memberStatus.StagesStatus[stageIndex-1].Conditions, string(placementv1beta1.StageUpdatingConditionSucceeded)) {
+			return fmt.Sprintf("waiting for the updateRun `%s` in fleetUpdateRun `%s` to finish stage `%s`", member.GetName(), g.name, memberStatus.StagesStatus[stageIndex-1].StageName), nil
+		}
+		if i == g.position-1 && memberStatus.StagesStatus[stageIndex].StartTime == nil {
+			return fmt.Sprintf("waiting for the updateRun `%s` in fleetUpdateRun `%s` to start stage `%s`", member.GetName(), g.name, stageName), nil
+		}
+	}
+	return "", nil
+}
+
+// clustersWaitingForPreviousPlacements returns the clusters in a stage that the updateRun cannot start updating yet,
+// mapped to the updateRun of a previous placement that has not finished updating the cluster.
+func (g *fleetUpdateRunGroup) clustersWaitingForPreviousPlacements(stageIndex int) map[string]string {
+	waitingClusters := make(map[string]string)
+	for i := 0; i < g.position; i++ {
+		member := g.members[i]
+		if member == nil || stageIndex >= len(member.GetUpdateRunStatus().StagesStatus) {
+			continue
+		}
+		stageStatus := &member.GetUpdateRunStatus().StagesStatus[stageIndex]
+		for j := range stageStatus.Clusters {
+			clusterName := stageStatus.Clusters[j].ClusterName
+			if meta.IsStatusConditionTrue(stageStatus.Clusters[j].Conditions, string(placementv1beta1.ClusterUpdatingConditionSucceeded)) ||
+				slices.Contains(stageStatus.FailedClusters, clusterName) {
+				continue
+			}
+			if _, found := waitingClusters[clusterName]; !found {
+				waitingClusters[clusterName] = member.GetName()
+			}
+		}
+	}
+	return waitingClusters
+}
+
+// updateRunBlockingAfterStageTasks returns the name of an updateRun of a previous placement that has not finished
+// a stage yet, which the shared after-stage tasks of the stage have to wait for. It returns an empty string if the
+// updateRun being reconciled does not run the after-stage tasks or if there's nothing to wait for.
+func (g *fleetUpdateRunGroup) updateRunBlockingAfterStageTasks(stageIndex int) string {
+	if g.position != len(g.members)-1 {
+		return ""
+	}
+	for i := 0; i < g.position; i++ {
+		member := g.members[i]
+		if member == nil {
+			return fmt.Sprintf("placement index %d", i)
+		}
+		stagesStatus := member.GetUpdateRunStatus().StagesStatus
+		if stageIndex >= len(stagesStatus) || !meta.IsStatusConditionTrue(stagesStatus[stageIndex].Conditions, string(placementv1beta1.StageUpdatingConditionSucceeded)) {
+			return member.GetName()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,317 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterun
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// groupMember builds an initialized updateRun of a fleetUpdateRun group with the given stages status.
+func groupMember(name string, stagesStatus ...placementv1beta1.StageUpdatingStatus) *placementv1beta1.ClusterStagedUpdateRun {
+	return &placementv1beta1.ClusterStagedUpdateRun{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: placementv1beta1.UpdateRunStatus{
+			Conditions: []metav1.Condition{
+				{Type: string(placementv1beta1.StagedUpdateRunConditionInitialized), Status: metav1.ConditionTrue},
+			},
+			StagesStatus: stagesStatus,
+		},
+	}
+}
+
+// groupStage builds the status of a stage; a started stage has a start time and a succeeded stage has the Succeeded condition.
+func groupStage(name string, started, succeeded bool, clusters ...placementv1beta1.ClusterUpdatingStatus) placementv1beta1.StageUpdatingStatus {
+	stage := placementv1beta1.StageUpdatingStatus{StageName: name, Clusters: clusters}
+	if started {
+		stage.StartTime = ptr.To(metav1.Now())
+	}
+	if succeeded {
+		stage.Conditions = []metav1.Condition{{Type: string(placementv1beta1.StageUpdatingConditionSucceeded), Status: metav1.ConditionTrue}}
+	}
+	return stage
+}
+
+func groupCluster(name string, succeeded bool) placementv1beta1.ClusterUpdatingStatus {
+	cluster := placementv1beta1.ClusterUpdatingStatus{ClusterName: name}
+	if succeeded {
+		cluster.Conditions = []metav1.Condition{{Type: string(placementv1beta1.ClusterUpdatingConditionSucceeded), Status: metav1.ConditionTrue}}
+	}
+	return cluster
+}
+
+// TestFleetUpdateRunNameOf tests the fleetUpdateRunNameOf function.
+func TestFleetUpdateRunNameOf(t *testing.T) {
+	ownerRef := func(kind string) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{
+			APIVersion: placementv1beta1.GroupVersion.String(),
+			Kind:       kind,
+			Name:       "owner",
+			Controller: ptr.To(true),
+		}}
+	}
+	testCases := []struct {
+		name      string
+		updateRun placementv1beta1.UpdateRunObj
+		want      string
+	}{
+		{
+			name:      "no owner",
+			updateRun: &placementv1beta1.ClusterStagedUpdateRun{},
+		},
+		{
+			name:      "owned by a fleetUpdateRun",
+			updateRun: &placementv1beta1.ClusterStagedUpdateRun{ObjectMeta: metav1.ObjectMeta{OwnerReferences: ownerRef(placementv1beta1.ClusterFleetUpdateRunKind)}},
+			want:      "owner",
+		},
+		{
+			name:      "owned by a placement",
+			updateRun: &placementv1beta1.ClusterStagedUpdateRun{ObjectMeta: metav1.ObjectMeta{OwnerReferences: ownerRef(placementv1beta1.ClusterResourcePlacementKind)}},
+		},
+		{
+			name:      "namespaced updateRun",
+			updateRun: &placementv1beta1.StagedUpdateRun{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", OwnerReferences: ownerRef(placementv1beta1.ClusterFleetUpdateRunKind)}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := fleetUpdateRunNameOf(tc.updateRun); got != tc.want {
+				t.Errorf("fleetUpdateRunNameOf() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestRemoveSharedStageTasks tests the removeSharedStageTasks method.
+func TestRemoveSharedStageTasks(t *testing.T) {
+	strategy := func() *placementv1beta1.UpdateStrategySpec {
+		return &placementv1beta1.UpdateStrategySpec{
+			Stages: []placementv1beta1.StageConfig{
+				{
+					Name:             "stage-1",
+					BeforeStageTasks: []placementv1beta1.StageTask{{Type: placementv1beta1.StageTaskTypeApproval}},
+					AfterStageTasks:  []placementv1beta1.StageTask{{Type: placementv1beta1.StageTaskTypeApproval}},
+				},
+			},
+		}
+	}
+	testCases := []struct {
+		name       string
+		position   int
+		wantBefore bool
+		wantAfter  bool
+	}{
+		{
+			name:       "first updateRun",
+			position:   0,
+			wantBefore: true,
+		},
+		{
+			name:     "middle updateRun",
+			position: 1,
+		},
+		{
+			name:      "last updateRun",
+			position:  2,
+			wantAfter: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			group := &fleetUpdateRunGroup{position: tc.position, members: make([]placementv1beta1.UpdateRunObj, 3)}
+			got := strategy()
+			group.removeSharedStageTasks(got)
+			if gotBefore := got.Stages[0].BeforeStageTasks != nil; gotBefore != tc.wantBefore {
+				t.Errorf("before-stage tasks kept = %t, want %t", gotBefore, tc.wantBefore)
+			}
+			if gotAfter := got.Stages[0].AfterStageTasks != nil; gotAfter != tc.wantAfter {
+				t.Errorf("after-stage tasks kept = %t, want %t", gotAfter, tc.wantAfter)
+			}
+		})
+	}
+}
+
+// TestCheckBeforeStage tests the checkBeforeStage method.
+func TestCheckBeforeStage(t *testing.T) {
+	failedMember := groupMember("run-0")
+	failedMember.Status.Conditions = append(failedMember.Status.Conditions, metav1.Condition{
+		Type: string(placementv1beta1.StagedUpdateRunConditionSucceeded), Status: metav1.ConditionFalse,
+	})
+	uninitializedMember := groupMember("run-0")
+	uninitializedMember.Status.Conditions = nil
+
+	testCases := []struct {
+		name       string
+		members    []placementv1beta1.UpdateRunObj
+		position   int
+		stageIndex int
+		wantWait   string
+		wantErr    bool
+	}{
+		{
+			name:       "previous updateRun is not created",
+			members:    []placementv1beta1.UpdateRunObj{nil, groupMember("run-1")},
+			position:   1,
+			stageIndex: 0,
+			wantWait:   "waiting for the updateRun of placement index 0 in fleetUpdateRun `fur` to be created",
+		},
+		{
+			name:       "previous updateRun has failed",
+			members:    []placementv1beta1.UpdateRunObj{failedMember, groupMember("run-1")},
+			position:   1,
+			stageIndex: 0,
+			wantErr:    true,
+		},
+		{
+			name:       "previous updateRun is not initialized",
+			members:    []placementv1beta1.UpdateRunObj{uninitializedMember, groupMember("run-1")},
+			position:   1,
+			stageIndex: 0,
+			wantWait:   "waiting for the updateRun `run-0` in fleetUpdateRun `fur` to be initialized",
+		},
+		{
+			name:       "stages do not match",
+			members:    []placementv1beta1.UpdateRunObj{groupMember("run-0", groupStage("other", false, false)), groupMember("run-1")},
+			position:   1,
+			stageIndex: 0,
+			wantErr:    true,
+		},
+		{
+			name:       "previous updateRun has not started the stage",
+			members:    []placementv1beta1.UpdateRunObj{groupMember("run-0", groupStage("stage-1", false, false)), groupMember("run-1")},
+			position:   1,
+			stageIndex: 0,
+			wantWait:   "waiting for the updateRun `run-0` in fleetUpdateRun `fur` to start stage `stage-1`",
+		},
+		{
+			name:       "previous updateRun has started the stage",
+			members:    []placementv1beta1.UpdateRunObj{groupMember("run-0", groupStage("stage-1", true, false)), groupMember("run-1")},
+			position:   1,
+			stageIndex: 0,
+		},
+		{
+			name: "next updateRun has not finished the previous stage",
+			members: []placementv1beta1.UpdateRunObj{
+				groupMember("run-0"),
+				groupMember("run-1", groupStage("stage-1", true, false), groupStage("stage-2", false, false)),
+			},
+			position:   0,
+			stageIndex: 1,
+			wantWait:   "waiting for the updateRun `run-1` in fleetUpdateRun `fur` to finish stage `stage-1`",
+		},
+		{
+			name: "all updateRuns have finished the previous stage",
+			members: []placementv1beta1.UpdateRunObj{
+				groupMember("run-0"),
+				groupMember("run-1", groupStage("stage-1", true, true), groupStage("stage-2", false, false)),
+			},
+			position:   0,
+			stageIndex: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			group := &fleetUpdateRunGroup{name: "fur", position: tc.position, members: tc.members}
+			stageName := "stage-1"
+			if tc.stageIndex == 1 {
+				stageName = "stage-2"
+			}
+			gotWait, err := group.checkBeforeStage(tc.stageIndex, stageName)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("checkBeforeStage() error = %v, want error %t", err, tc.wantErr)
+			}
+			if err != nil && !errors.Is(err, controller.ErrUserError) {
+				t.Errorf("checkBeforeStage() error = %v, want a user error", err)
+			}
+			if gotWait != tc.wantWait {
+				t.Errorf("checkBeforeStage() = %q, want %q", gotWait, tc.wantWait)
+			}
+		})
+	}
+}
+
+// TestClustersWaitingForPreviousPlacements tests the clustersWaitingForPreviousPlacements method.
+func TestClustersWaitingForPreviousPlacements(t *testing.T) {
+	toleratedStage := groupStage("stage-1", true, false, groupCluster("member-3", false))
+	toleratedStage.FailedClusters = []string{"member-3"}
+	group := &fleetUpdateRunGroup{
+		name:     "fur",
+		position: 2,
+		members: []placementv1beta1.UpdateRunObj{
+			groupMember("run-0", groupStage("stage-1", true, false, groupCluster("member-1", true), groupCluster("member-2", false))),
+			groupMember("run-1", toleratedStage),
+			groupMember("run-2", groupStage("stage-1", true, false, groupCluster("member-2", false))),
+			groupMember("run-3", groupStage("stage-1", false, false, groupCluster("member-4", false))),
+		},
+	}
+	want := map[string]string{"member-2": "run-0"}
+	if diff := cmp.Diff(group.clustersWaitingForPreviousPlacements(0), want); diff != "" {
+		t.Errorf("clustersWaitingForPreviousPlacements() mismatch (-got, +want):\n%s", diff)
+	}
+}
+
+// TestUpdateRunBlockingAfterStageTasks tests the updateRunBlockingAfterStageTasks method.
+func TestUpdateRunBlockingAfterStageTasks(t *testing.T) {
+	testCases := []struct {
+		name     string
+		members  []placementv1beta1.UpdateRunObj
+		position int
+		want     string
+	}{
+		{
+			name:     "not the last updateRun",
+			members:  []placementv1beta1.UpdateRunObj{groupMember("run-0"), groupMember("run-1")},
+			position: 0,
+		},
+		{
+			name:     "previous updateRun is not created",
+			members:  []placementv1beta1.UpdateRunObj{nil, groupMember("run-1")},
+			position: 1,
+			want:     "placement index 0",
+		},
+		{
+			name:     "previous updateRun has not finished the stage",
+			members:  []placementv1beta1.UpdateRunObj{groupMember("run-0", groupStage("stage-1", true, false)), groupMember("run-1")},
+			position: 1,
+			want:     "run-0",
+		},
+		{
+			name:     "previous updateRun has finished the stage",
+			members:  []placementv1beta1.UpdateRunObj{groupMember("run-0", groupStage("stage-1", true, true)), groupMember("run-1")},
+			position: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			group := &fleetUpdateRunGroup{name: "fur", position: tc.position, members: tc.members}
+			if got := group.updateRunBlockingAfterStageTasks(0); got != tc.want {
+				t.Errorf("updateRunBlockingAfterStageTasks() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
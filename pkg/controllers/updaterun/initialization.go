@@ -27,6 +27,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -37,6 +38,8 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/defaulter"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/maintenancewindow"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/metricanalysis"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/overrider"
 )
 
@@ -62,6 +65,18 @@ func (r *Reconciler) initialize(
 		return nil, nil, err
 	}
 
+	// A rollback updateRun computes its stages from the failed updateRun it rolls back.
+	if isRollbackUpdateRun(updateRun) {
+		if err := r.generateRollbackStages(ctx, scheduledBindings, updateRun); err != nil {
+			return nil, nil, err
+		}
+		if err := r.recordRollbackResourceSnapshotIndex(ctx, placement, updateRun); err != nil {
+			return nil, nil, err
+		}
+		// A rollback updateRun never deletes any binding.
+		return scheduledBindings, nil, r.recordInitializationSucceeded(ctx, updateRun)
+	}
+
 	// Compute the stages based on the UpdateStrategy.
 	if err := r.generateStagesByStrategy(ctx, scheduledBindings, toBeDeletedBindings, updateRun); err != nil {
 		return nil, nil, err
@@ -70,6 +85,8 @@ func (r *Reconciler) initialize(
 	if err := r.recordOverrideSnapshots(ctx, placement, updateRun); err != nil {
 		return nil, nil, err
 	}
+	// Record what each cluster uses now so that it can be rolled back if the updateRun fails.
+	recordClusterPreviousStates(scheduledBindings, updateRun)
 
 	return scheduledBindings, toBeDeletedBindings, r.recordInitializationSucceeded(ctx, updateRun)
 }
@@ -285,6 +302,18 @@ func (r *Reconciler) generateStagesByStrategy(
 	// Remove waitTime from the updateRun status for BeforeStageTask and AfterStageTask for type Approval.
 	removeWaitTimeFromUpdateRunStatus(updateRun)
 
+	// The stage tasks are shared by the updateRuns created for a ClusterFleetUpdateRun.
+	group, err := r.fetchFleetUpdateRunGroup(ctx, updateRun)
+	if err != nil {
+		if errors.Is(err, controller.ErrUserError) {
+			return fmt.Errorf("%w: %s", errValidationFailed, err.Error())
+		}
+		return err
+	}
+	if group != nil {
+		group.removeSharedStageTasks(updateRunStatus.UpdateStrategySnapshot)
+	}
+
 	// Compute the update stages.
 	if err := r.computeRunStageStatus(ctx, scheduledBindings, updateRun); err != nil {
 		return err
@@ -346,6 +375,12 @@ func (r *Reconciler) computeRunStageStatus(
 			invalidAfterStageErr := controller.NewUserError(fmt.Errorf("the after stage tasks are invalid, updateStrategy: `%s`, stage: %s, err: %s", strategyKey, stage.Name, err.Error()))
 			return fmt.Errorf("%w: %s", errValidationFailed, invalidAfterStageErr.Error())
 		}
+		if err := maintenancewindow.Validate(stage.MaintenanceWindow); err != nil {
+			klog.ErrorS(err, "Failed to validate the maintenance window", "updateStrategy", strategyKey, "stageName", stage.Name, "updateRun", updateRunRef)
+			// no more retries here.
+			invalidMaintenanceWindowErr := controller.NewUserError(fmt.Errorf("the maintenance window is invalid, updateStrategy: `%s`, stage: %s, err: %s", strategyKey, stage.Name, err.Error()))
+			return fmt.Errorf("%w: %s", errValidationFailed, invalidMaintenanceWindowErr.Error())
+		}
 
 		curStageUpdatingStatus := placementv1beta1.StageUpdatingStatus{StageName: stage.Name}
 		var curStageClusters []clusterv1beta1.MemberCluster
@@ -407,11 +442,26 @@ func (r *Reconciler) computeRunStageStatus(
 			})
 		}
 
+		// The clusters matching the cluster approval selector require their own approvals.
+		var clusterApprovalSelector labels.Selector
+		if stage.ClusterApprovalSelector != nil {
+			if clusterApprovalSelector, err = metav1.LabelSelectorAsSelector(stage.ClusterApprovalSelector); err != nil {
+				klog.ErrorS(err, "Failed to convert cluster approval selector", "updateStrategy", strategyKey, "stageName", stage.Name, "clusterApprovalSelector", stage.ClusterApprovalSelector, "updateRun", updateRunRef)
+				// no more retries here.
+				invalidSelectorErr := controller.NewUserError(fmt.Errorf("the stage cluster approval selector is invalid, updateStrategy: `%s`, stage: %s, err: %s", strategyKey, stage.Name, err.Error()))
+				return fmt.Errorf("%w: %s", errValidationFailed, invalidSelectorErr.Error())
+			}
+		}
+
 		// Record the clusters in the stage.
 		curStageUpdatingStatus.Clusters = make([]placementv1beta1.ClusterUpdatingStatus, len(curStageClusters))
 		for i, cluster := range curStageClusters {
 			klog.V(2).InfoS("Adding a cluster to the stage", "cluster", cluster.Name, "updateStrategy", strategyKey, "stageName", stage.Name, "updateRun", updateRunRef)
 			curStageUpdatingStatus.Clusters[i].ClusterName = cluster.Name
+			if clusterApprovalSelector != nil && clusterApprovalSelector.Matches(labels.Set(cluster.Labels)) {
+				curStageUpdatingStatus.Clusters[i].ApprovalState = placementv1beta1.ClusterApprovalStatePending
+				curStageUpdatingStatus.Clusters[i].ApprovalRequestName = fmt.Sprintf(placementv1beta1.ClusterApprovalTaskNameFmt, updateRun.GetName(), stage.Name, cluster.Name)
+			}
 		}
 
 		// Create the before stage tasks.
@@ -455,15 +505,27 @@ func (r *Reconciler) computeRunStageStatus(
 // validateBeforeStageTask validates the beforeStageTasks in the stage defined in the UpdateStrategy.
 // The error returned from this function is not retriable.
 func validateBeforeStageTask(tasks []placementv1beta1.StageTask) error {
-	if len(tasks) > 1 {
-		return fmt.Errorf("beforeStageTasks can have at most one task")
+	if len(tasks) > 2 {
+		return fmt.Errorf("beforeStageTasks can have at most two tasks")
 	}
+	seenTypes := make(map[placementv1beta1.StageTaskType]bool, len(tasks))
 	for i, task := range tasks {
-		if task.Type != placementv1beta1.StageTaskTypeApproval {
-			return fmt.Errorf("task %d of type %s is not allowed in beforeStageTasks, allowed type: Approval", i, task.Type)
+		if seenTypes[task.Type] {
+			return fmt.Errorf("beforeStageTasks cannot have two tasks of the same type: %s", task.Type)
+		}
+		seenTypes[task.Type] = true
+		switch task.Type {
+		case placementv1beta1.StageTaskTypeApproval, placementv1beta1.StageTaskTypeWebhook:
+		default:
+			return fmt.Errorf("task %d of type %s is not allowed in beforeStageTasks, allowed types: Approval, Webhook", i, task.Type)
 		}
 		if task.WaitTime != nil {
-			return fmt.Errorf("task %d of type Approval cannot have wait duration set", i)
+			return fmt.Errorf("task %d of type %s cannot have wait duration set", i, task.Type)
+		}
+		if task.Type == placementv1beta1.StageTaskTypeWebhook {
+			if err := validateWebhookConfig(task.Webhook); err != nil {
+				return fmt.Errorf("task %d of type Webhook is invalid: %w", i, err)
+			}
 		}
 	}
 	return nil
@@ -472,17 +534,55 @@ func validateBeforeStageTask(tasks []placementv1beta1.StageTask) error {
 // validateAfterStageTask validates the afterStageTasks in the stage defined in the UpdateStrategy.
 // The error returned from this function is not retriable.
 func validateAfterStageTask(tasks []placementv1beta1.StageTask) error {
-	if len(tasks) == 2 && tasks[0].Type == tasks[1].Type {
-		return fmt.Errorf("afterStageTasks cannot have two tasks of the same type: %s", tasks[0].Type)
+	seenTypes := make(map[placementv1beta1.StageTaskType]bool, len(tasks))
+	for _, task := range tasks {
+		if seenTypes[task.Type] {
+			return fmt.Errorf("afterStageTasks cannot have two tasks of the same type: %s", task.Type)
+		}
+		seenTypes[task.Type] = true
 	}
 	for i, task := range tasks {
-		if task.Type == placementv1beta1.StageTaskTypeTimedWait {
+		switch task.Type {
+		case placementv1beta1.StageTaskTypeTimedWait:
 			if task.WaitTime == nil {
 				return fmt.Errorf("task %d of type TimedWait has wait duration set to nil", i)
 			}
 			if task.WaitTime.Duration <= 0 {
 				return fmt.Errorf("task %d of type TimedWait has wait duration <= 0", i)
 			}
+		case placementv1beta1.StageTaskTypeAnalysis:
+			if err := validateAnalysisConfig(task.Analysis); err != nil {
+				return fmt.Errorf("task %d of type Analysis is invalid: %w", i, err)
+			}
+		case placementv1beta1.StageTaskTypeWebhook:
+			if err := validateWebhookConfig(task.Webhook); err != nil {
+				return fmt.Errorf("task %d of type Webhook is invalid: %w", i, err)
+			}
+		case placementv1beta1.StageTaskTypeVerificationJob:
+			if err := validateVerificationJobConfig(task.VerificationJob); err != nil {
+				return fmt.Errorf("task %d of type VerificationJob is invalid: %w", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateAnalysisConfig validates the analysis config of an Analysis stage task.
+func validateAnalysisConfig(analysis *placementv1beta1.AnalysisConfig) error {
+	if analysis == nil {
+		return fmt.Errorf("analysis config is not set")
+	}
+	if len(analysis.Metrics) == 0 {
+		return fmt.Errorf("no metric is specified")
+	}
+	for _, metric := range analysis.Metrics {
+		if err := metricanalysis.ValidateCondition(metric.SuccessCondition); err != nil {
+			return fmt.Errorf("metric %s has an invalid success condition: %w", metric.Name, err)
+		}
+		if metric.FailureCondition != "" {
+			if err := metricanalysis.ValidateCondition(metric.FailureCondition); err != nil {
+				return fmt.Errorf("metric %s has an invalid failure condition: %w", metric.Name, err)
+			}
 		}
 	}
 	return nil
@@ -44,17 +44,56 @@ func TestValidateBeforeStageTask(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "invalid BeforeTasks, greater than 1 task",
+			name: "valid BeforeTasks, with Approval and Webhook",
 			task: []placementv1beta1.StageTask{
 				{
 					Type: placementv1beta1.StageTaskTypeApproval,
 				},
+				{
+					Type:    placementv1beta1.StageTaskTypeWebhook,
+					Webhook: &placementv1beta1.WebhookConfig{URL: "https://change-management.example.com/approve"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid BeforeTasks, greater than 2 tasks",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeApproval,
+				},
+				{
+					Type: placementv1beta1.StageTaskTypeApproval,
+				},
+				{
+					Type: placementv1beta1.StageTaskTypeApproval,
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "beforeStageTasks can have at most two tasks",
+		},
+		{
+			name: "invalid BeforeTasks, with two tasks of the same type",
+			task: []placementv1beta1.StageTask{
 				{
 					Type: placementv1beta1.StageTaskTypeApproval,
 				},
+				{
+					Type: placementv1beta1.StageTaskTypeApproval,
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "beforeStageTasks cannot have two tasks of the same type: Approval",
+		},
+		{
+			name: "invalid BeforeTasks, with Webhook without webhook config",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeWebhook,
+				},
 			},
 			wantErr:    true,
-			wantErrMsg: "beforeStageTasks can have at most one task",
+			wantErrMsg: "task 0 of type Webhook is invalid: webhook config is not set",
 		},
 		{
 			name: "invalid BeforeTasks, with invalid task type",
@@ -65,7 +104,7 @@ func TestValidateBeforeStageTask(t *testing.T) {
 				},
 			},
 			wantErr:    true,
-			wantErrMsg: fmt.Sprintf("task %d of type %s is not allowed in beforeStageTasks, allowed type: Approval", 0, placementv1beta1.StageTaskTypeTimedWait),
+			wantErrMsg: fmt.Sprintf("task %d of type %s is not allowed in beforeStageTasks, allowed types: Approval, Webhook", 0, placementv1beta1.StageTaskTypeTimedWait),
 		},
 		{
 			name: "invalid BeforeTasks, with duration for Approval",
@@ -150,6 +189,88 @@ func TestValidateAfterStageTask(t *testing.T) {
 			wantErr: true,
 			errMsg:  "task 0 of type TimedWait has wait duration <= 0",
 		},
+		{
+			name: "valid AfterTasks, with Analysis",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeApproval,
+				},
+				{
+					Type:     placementv1beta1.StageTaskTypeTimedWait,
+					WaitTime: ptr.To(metav1.Duration{Duration: 5 * time.Minute}),
+				},
+				{
+					Type: placementv1beta1.StageTaskTypeAnalysis,
+					Analysis: &placementv1beta1.AnalysisConfig{
+						Metrics: []placementv1beta1.AnalysisMetric{
+							{Name: "success-rate", Query: "up", SuccessCondition: "result >= 0.99", FailureCondition: "result < 0.9"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid AfterTasks, with nil analysis for Analysis",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeAnalysis,
+				},
+			},
+			wantErr: true,
+			errMsg:  "task 0 of type Analysis is invalid: analysis config is not set",
+		},
+		{
+			name: "invalid AfterTasks, with invalid success condition for Analysis",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeAnalysis,
+					Analysis: &placementv1beta1.AnalysisConfig{
+						Metrics: []placementv1beta1.AnalysisMetric{
+							{Name: "success-rate", Query: "up", SuccessCondition: "result ~ 1"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  `task 0 of type Analysis is invalid: metric success-rate has an invalid success condition: invalid condition "result ~ 1": the comparison "result ~ 1" has no supported operator, want one of <=, >=, ==, !=, <, >`,
+		},
+		{
+			name: "invalid AfterTasks, with Webhook timeout too long",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeWebhook,
+					Webhook: &placementv1beta1.WebhookConfig{
+						URL:     "https://change-management.example.com/approve",
+						Timeout: &metav1.Duration{Duration: time.Minute},
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  "task 0 of type Webhook is invalid: webhook timeout 1m0s must be positive and at most 10s",
+		},
+		{
+			name: "valid AfterTasks, with VerificationJob",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeVerificationJob,
+					VerificationJob: &placementv1beta1.VerificationJobConfig{
+						JobTemplateRef: placementv1beta1.VerificationJobTemplateReference{Name: "smoke-test"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid AfterTasks, with nil verification job for VerificationJob",
+			task: []placementv1beta1.StageTask{
+				{
+					Type: placementv1beta1.StageTaskTypeVerificationJob,
+				},
+			},
+			wantErr: true,
+			errMsg:  "task 0 of type VerificationJob is invalid: verification job config is not set",
+		},
 	}
 
 	for _, tt := range tests {
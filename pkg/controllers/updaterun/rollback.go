@@ -0,0 +1,395 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterun
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	runtime "sigs.k8s.io/controller-runtime"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// rollbackUpdateRunNameSuffix is the suffix of the name of a rollback updateRun.
+	rollbackUpdateRunNameSuffix = "-rollback"
+	// maxUpdateRunNameLength is the max length of the name of an updateRun.
+	maxUpdateRunNameLength = 63
+)
+
+// isRollbackUpdateRun returns true if the updateRun is created to roll back another failed updateRun.
+func isRollbackUpdateRun(updateRun placementv1beta1.UpdateRunObj) bool {
+	return updateRun.GetLabels()[placementv1beta1.RolledBackUpdateRunLabel] != ""
+}
+
+// getRollbackPolicy returns the rollback policy of the updateRun.
+// The rollback policy in the updateRun spec takes precedence over the one in the update strategy snapshot.
+func getRollbackPolicy(updateRun placementv1beta1.UpdateRunObj) *placementv1beta1.RollbackPolicy {
+	if policy := updateRun.GetUpdateRunSpec().RollbackPolicy; policy != nil {
+		return policy
+	}
+	if snapshot := updateRun.GetUpdateRunStatus().UpdateStrategySnapshot; snapshot != nil {
+		return snapshot.RollbackPolicy
+	}
+	return nil
+}
+
+// isAutomaticRollbackEnabled returns true if a failed updateRun should be rolled back automatically.
+// A rollback updateRun itself is never rolled back.
+func isAutomaticRollbackEnabled(updateRun placementv1beta1.UpdateRunObj) bool {
+	policy := getRollbackPolicy(updateRun)
+	return policy != nil && policy.Type == placementv1beta1.RollbackPolicyTypeAutomatic && !isRollbackUpdateRun(updateRun)
+}
+
+// checkUpdateRunStuckForRollback returns an errStagedUpdatedAborted error if the updateRun is stuck and
+// its rollback policy asks to roll back stuck updateRuns.
+func checkUpdateRunStuckForRollback(updateRun placementv1beta1.UpdateRunObj) error {
+	if !isAutomaticRollbackEnabled(updateRun) || !getRollbackPolicy(updateRun).RollbackOnStuck {
+		return nil
+	}
+	progressingCond := meta.FindStatusCondition(updateRun.GetUpdateRunStatus().Conditions, string(placementv1beta1.StagedUpdateRunConditionProgressing))
+	if progressingCond == nil || progressingCond.Status != metav1.ConditionFalse || progressingCond.Reason != condition.UpdateRunStuckReason {
+		return nil
+	}
+	klog.V(2).InfoS("The updateRun is stuck, aborting it to roll back", "updateRun", klog.KObj(updateRun))
+	return fmt.Errorf("%w: %s", errStagedUpdatedAborted, progressingCond.Message)
+}
+
+// recordUpdateRunFailedAndRollback records the failed condition in the updateRun status, and then creates
+// the rollback updateRun if the rollback policy of the updateRun is Automatic.
+func (r *Reconciler) recordUpdateRunFailedAndRollback(ctx context.Context, updateRun placementv1beta1.UpdateRunObj, message string) error {
+	if err := r.recordUpdateRunFailed(ctx, updateRun, message); err != nil {
+		return err
+	}
+	return r.rollbackIfNeeded(ctx, updateRun)
+}
+
+// rollbackIfNeeded creates the rollback updateRun for a failed updateRun if its rollback policy is Automatic
+// and the rollback updateRun has not been created yet.
+func (r *Reconciler) rollbackIfNeeded(ctx context.Context, updateRun placementv1beta1.UpdateRunObj) error {
+	updateRunRef := klog.KObj(updateRun)
+	updateRunStatus := updateRun.GetUpdateRunStatus()
+	if !isAutomaticRollbackEnabled(updateRun) || updateRunStatus.RollbackUpdateRunName != "" {
+		return nil
+	}
+	rollbackRun := buildRollbackUpdateRun(updateRun)
+	if rollbackRun == nil {
+		klog.V(2).InfoS("No cluster needs to be rolled back for the failed updateRun", "updateRun", updateRunRef)
+		return nil
+	}
+	if err := r.Client.Create(ctx, rollbackRun); err != nil && !apierrors.IsAlreadyExists(err) {
+		klog.ErrorS(err, "Failed to create the rollback updateRun", "rollbackUpdateRun", klog.KObj(rollbackRun), "updateRun", updateRunRef)
+		return controller.NewAPIServerError(false, err)
+	}
+	klog.V(2).InfoS("Created the rollback updateRun", "rollbackUpdateRun", klog.KObj(rollbackRun),
+		"resourceSnapshotIndex", rollbackRun.GetUpdateRunSpec().ResourceSnapshotIndex, "updateRun", updateRunRef)
+	updateRunStatus.RollbackUpdateRunName = rollbackRun.GetName()
+	return r.recordUpdateRunStatus(ctx, updateRun)
+}
+
+// buildRollbackUpdateRun builds the updateRun that rolls back the clusters which the failed updateRun has started to update.
+// It returns nil if there is no cluster to roll back.
+func buildRollbackUpdateRun(updateRun placementv1beta1.UpdateRunObj) placementv1beta1.UpdateRunObj {
+	resourceSnapshotIndex, found := pickRollbackResourceSnapshotIndex(updateRun)
+	if !found {
+		return nil
+	}
+	updateRunSpec := updateRun.GetUpdateRunSpec()
+	objectMeta := metav1.ObjectMeta{
+		Name:      rollbackUpdateRunName(updateRun.GetName()),
+		Namespace: updateRun.GetNamespace(),
+		Labels: map[string]string{
+			placementv1beta1.RolledBackUpdateRunLabel: updateRun.GetName(),
+		},
+	}
+	spec := placementv1beta1.UpdateRunSpec{
+		PlacementName:            updateRunSpec.PlacementName,
+		ResourceSnapshotIndex:    resourceSnapshotIndex,
+		StagedUpdateStrategyName: updateRunSpec.StagedUpdateStrategyName,
+		State:                    placementv1beta1.StateRun,
+		RollbackPolicy:           &placementv1beta1.RollbackPolicy{Type: placementv1beta1.RollbackPolicyTypeManual},
+	}
+	if updateRun.GetNamespace() == "" {
+		return &placementv1beta1.ClusterStagedUpdateRun{ObjectMeta: objectMeta, Spec: spec}
+	}
+	return &placementv1beta1.StagedUpdateRun{ObjectMeta: objectMeta, Spec: spec}
+}
+
+// rollbackUpdateRunName returns the name of the rollback updateRun of the given updateRun.
+func rollbackUpdateRunName(updateRunName string) string {
+	maxPrefixLength := maxUpdateRunNameLength - len(rollbackUpdateRunNameSuffix)
+	if len(updateRunName) > maxPrefixLength {
+		updateRunName = strings.TrimRight(updateRunName[:maxPrefixLength], "-.")
+	}
+	return updateRunName + rollbackUpdateRunNameSuffix
+}
+
+// pickRollbackResourceSnapshotIndex picks the resource snapshot index of the rollback updateRun.
+// It's the previous resource snapshot index shared by most of the clusters to roll back; when there's a tie,
+// the larger index wins. Note that the rollback updateRun still rolls each cluster back to its own previous
+// resource snapshot.
+func pickRollbackResourceSnapshotIndex(updateRun placementv1beta1.UpdateRunObj) (string, bool) {
+	counts := make(map[string]int)
+	for _, stageStatus := range updateRun.GetUpdateRunStatus().StagesStatus {
+		for i := range stageStatus.Clusters {
+			if index, ok := clusterRollbackResourceSnapshotIndex(&stageStatus.Clusters[i], updateRun); ok {
+				counts[index]++
+			}
+		}
+	}
+	picked, pickedIndexNum, pickedCount := "", -1, 0
+	for index, count := range counts {
+		// The index is validated when it's recorded.
+		indexNum, _ := strconv.Atoi(index)
+		if count > pickedCount || (count == pickedCount && indexNum > pickedIndexNum) {
+			picked, pickedIndexNum, pickedCount = index, indexNum, count
+		}
+	}
+	return picked, pickedCount > 0
+}
+
+// clusterRollbackResourceSnapshotIndex returns the resource snapshot index that a cluster should be rolled back to.
+// A cluster needs to be rolled back only if the updateRun has started to update it and it used a different
+// resource snapshot before.
+func clusterRollbackResourceSnapshotIndex(clusterStatus *placementv1beta1.ClusterUpdatingStatus, updateRun placementv1beta1.UpdateRunObj) (string, bool) {
+	if clusterStatus.PreviousState == nil {
+		return "", false
+	}
+	// The generation is not checked as the failed updateRun is not reconciled anymore.
+	if !meta.IsStatusConditionTrue(clusterStatus.Conditions, string(placementv1beta1.ClusterUpdatingConditionStarted)) {
+		return "", false
+	}
+	if clusterStatus.PreviousState.ResourceSnapshotIndex == updateRun.GetUpdateRunStatus().ResourceSnapshotIndexUsed {
+		return "", false
+	}
+	return clusterStatus.PreviousState.ResourceSnapshotIndex, true
+}
+
+// clusterResourceSnapshotName returns the name of the master resource snapshot that a cluster is updated to.
+// Rollback updateRuns roll each cluster back to its own previous resource snapshot, which may differ from the
+// resource snapshot of the updateRun.
+func clusterResourceSnapshotName(placementName, resourceSnapshotName string, clusterStatus *placementv1beta1.ClusterUpdatingStatus) string {
+	if clusterStatus.ResourceSnapshotIndex == "" {
+		return resourceSnapshotName
+	}
+	// The index is validated when it's recorded.
+	index, _ := strconv.Atoi(clusterStatus.ResourceSnapshotIndex)
+	return fmt.Sprintf(placementv1beta1.ResourceSnapshotNameFmt, placementName, index)
+}
+
+// recordClusterPreviousStates records the resource snapshot and override snapshots that each cluster uses
+// before the updateRun starts, so that the cluster can be rolled back if the updateRun fails.
+func recordClusterPreviousStates(scheduledBindings []placementv1beta1.BindingObj, updateRun placementv1beta1.UpdateRunObj) {
+	placementName := updateRun.GetUpdateRunSpec().PlacementName
+	bindingsMap := make(map[string]placementv1beta1.BindingObj, len(scheduledBindings))
+	for _, binding := range scheduledBindings {
+		bindingsMap[binding.GetBindingSpec().TargetCluster] = binding
+	}
+	updateRunStatus := updateRun.GetUpdateRunStatus()
+	for i := range updateRunStatus.StagesStatus {
+		for j := range updateRunStatus.StagesStatus[i].Clusters {
+			clusterStatus := &updateRunStatus.StagesStatus[i].Clusters[j]
+			binding, ok := bindingsMap[clusterStatus.ClusterName]
+			if !ok {
+				continue
+			}
+			bindingSpec := binding.GetBindingSpec()
+			if bindingSpec.State != placementv1beta1.BindingStateBound {
+				// The resources have not been placed on the cluster yet.
+				continue
+			}
+			index, ok := extractResourceSnapshotIndex(placementName, bindingSpec.ResourceSnapshotName)
+			if !ok {
+				klog.V(2).InfoS("Cannot find the resource snapshot index the cluster uses, the cluster cannot be rolled back",
+					"cluster", clusterStatus.ClusterName, "resourceSnapshot", bindingSpec.ResourceSnapshotName, "updateRun", klog.KObj(updateRun))
+				continue
+			}
+			clusterStatus.PreviousState = &placementv1beta1.ClusterPreviousState{
+				ResourceSnapshotIndex:            index,
+				ResourceOverrideSnapshots:        bindingSpec.ResourceOverrideSnapshots,
+				ClusterResourceOverrideSnapshots: bindingSpec.ClusterResourceOverrideSnapshots,
+			}
+		}
+	}
+}
+
+// extractResourceSnapshotIndex extracts the resource snapshot index from the name of a master resource snapshot,
+// which follows placementv1beta1.ResourceSnapshotNameFmt.
+func extractResourceSnapshotIndex(placementName, resourceSnapshotName string) (string, bool) {
+	index, found := strings.CutPrefix(resourceSnapshotName, placementName+"-")
+	if !found {
+		return "", false
+	}
+	index, found = strings.CutSuffix(index, "-snapshot")
+	if !found {
+		return "", false
+	}
+	if indexNum, err := strconv.Atoi(index); err != nil || indexNum < 0 {
+		return "", false
+	}
+	return index, true
+}
+
+// generateRollbackStages computes the stages of a rollback updateRun from the failed updateRun it rolls back.
+// The stages are in the reverse order of the failed updateRun, and each of them only contains the clusters that
+// the failed updateRun has started to update; each cluster is rolled back to the resource snapshot it used before.
+func (r *Reconciler) generateRollbackStages(ctx context.Context, scheduledBindings []placementv1beta1.BindingObj, updateRun placementv1beta1.UpdateRunObj) error {
+	updateRunRef := klog.KObj(updateRun)
+	updateRunSpec := updateRun.GetUpdateRunSpec()
+	rolledBackKey := types.NamespacedName{Name: updateRun.GetLabels()[placementv1beta1.RolledBackUpdateRunLabel], Namespace: updateRun.GetNamespace()}
+
+	rolledBackRun, err := controller.FetchUpdateRunFromRequest(ctx, r.Client, runtime.Request{NamespacedName: rolledBackKey})
+	if err != nil {
+		klog.ErrorS(err, "Failed to get the updateRun to roll back", "rolledBackUpdateRun", rolledBackKey, "updateRun", updateRunRef)
+		if apierrors.IsNotFound(err) {
+			notFoundErr := controller.NewUserError(fmt.Errorf("the updateRun to roll back not found: `%s`", rolledBackKey))
+			return fmt.Errorf("%w: %s", errValidationFailed, notFoundErr.Error())
+		}
+		return controller.NewAPIServerError(true, err)
+	}
+	if rolledBackRun.GetUpdateRunSpec().PlacementName != updateRunSpec.PlacementName {
+		mismatchErr := controller.NewUserError(fmt.Errorf("the updateRun to roll back `%s` belongs to a different placement `%s`", rolledBackKey, rolledBackRun.GetUpdateRunSpec().PlacementName))
+		klog.ErrorS(mismatchErr, "Failed to validate the updateRun to roll back", "updateRun", updateRunRef)
+		return fmt.Errorf("%w: %s", errValidationFailed, mismatchErr.Error())
+	}
+
+	scheduledClusters := make(map[string]struct{}, len(scheduledBindings))
+	for _, binding := range scheduledBindings {
+		scheduledClusters[binding.GetBindingSpec().TargetCluster] = struct{}{}
+	}
+	rolledBackStatus := rolledBackRun.GetUpdateRunStatus()
+	// missingSnapshotIndices caches whether the resource snapshots with an index no longer exist.
+	missingSnapshotIndices := make(map[string]bool)
+	var unrecoverableClusters []string
+	var stageConfigs []placementv1beta1.StageConfig
+	var stagesStatus []placementv1beta1.StageUpdatingStatus
+	for i := len(rolledBackStatus.StagesStatus) - 1; i >= 0; i-- {
+		rolledBackStage := &rolledBackStatus.StagesStatus[i]
+		var clusters []placementv1beta1.ClusterUpdatingStatus
+		for j := len(rolledBackStage.Clusters) - 1; j >= 0; j-- {
+			clusterStatus := &rolledBackStage.Clusters[j]
+			index, ok := clusterRollbackResourceSnapshotIndex(clusterStatus, rolledBackRun)
+			if !ok {
+				continue
+			}
+			if _, ok := scheduledClusters[clusterStatus.ClusterName]; !ok {
+				klog.V(2).InfoS("The cluster is no longer scheduled, skip rolling it back", "cluster", clusterStatus.ClusterName, "rolledBackUpdateRun", rolledBackKey, "updateRun", updateRunRef)
+				continue
+			}
+			missing, checked := missingSnapshotIndices[index]
+			if !checked {
+				snapshotList, err := controller.ListAllResourceSnapshotWithAnIndex(ctx, r.Client, index, updateRunSpec.PlacementName, updateRun.GetNamespace())
+				if err != nil {
+					klog.ErrorS(err, "Failed to list the resource snapshots to roll back to", "resourceSnapshotIndex", index, "updateRun", updateRunRef)
+					return controller.NewAPIServerError(true, err)
+				}
+				missing = len(snapshotList.GetResourceSnapshotObjs()) == 0
+				missingSnapshotIndices[index] = missing
+			}
+			if missing {
+				unrecoverableClusters = append(unrecoverableClusters, clusterStatus.ClusterName)
+				continue
+			}
+			clusters = append(clusters, placementv1beta1.ClusterUpdatingStatus{
+				ClusterName:                      clusterStatus.ClusterName,
+				ResourceSnapshotIndex:            index,
+				ResourceOverrideSnapshots:        clusterStatus.PreviousState.ResourceOverrideSnapshots,
+				ClusterResourceOverrideSnapshots: clusterStatus.PreviousState.ClusterResourceOverrideSnapshots,
+			})
+		}
+		if len(clusters) == 0 {
+			continue
+		}
+		stageConfig := placementv1beta1.StageConfig{Name: rolledBackStage.StageName}
+		if rolledBackStatus.UpdateStrategySnapshot != nil && i < len(rolledBackStatus.UpdateStrategySnapshot.Stages) {
+			stageConfig.MaxConcurrency = rolledBackStatus.UpdateStrategySnapshot.Stages[i].MaxConcurrency
+			stageConfig.MaxFailedClusters = rolledBackStatus.UpdateStrategySnapshot.Stages[i].MaxFailedClusters
+		}
+		stageConfigs = append(stageConfigs, stageConfig)
+		stagesStatus = append(stagesStatus, placementv1beta1.StageUpdatingStatus{StageName: rolledBackStage.StageName, Clusters: clusters})
+	}
+	if len(unrecoverableClusters) > 0 {
+		slices.Sort(unrecoverableClusters)
+		missingErr := controller.NewUserError(fmt.Errorf("cannot roll back clusters %v of the updateRun `%s` as the resource snapshots they used before no longer exist", unrecoverableClusters, rolledBackKey))
+		klog.ErrorS(missingErr, "Failed to compute the rollback stages", "updateRun", updateRunRef)
+		return fmt.Errorf("%w: %s", errValidationFailed, missingErr.Error())
+	}
+	if len(stagesStatus) == 0 {
+		noClusterErr := controller.NewUserError(fmt.Errorf("no cluster of the updateRun `%s` needs to be rolled back", rolledBackKey))
+		klog.ErrorS(noClusterErr, "Failed to compute the rollback stages", "updateRun", updateRunRef)
+		return fmt.Errorf("%w: %s", errValidationFailed, noClusterErr.Error())
+	}
+
+	updateRunStatus := updateRun.GetUpdateRunStatus()
+	updateRunStatus.RolledBackUpdateRunName = rolledBackKey.Name
+	updateRunStatus.UpdateStrategySnapshot = &placementv1beta1.UpdateStrategySpec{Stages: stageConfigs}
+	updateRunStatus.StagesStatus = stagesStatus
+	// A rollback updateRun never deletes any binding.
+	updateRunStatus.DeletionStageStatus = &placementv1beta1.StageUpdatingStatus{
+		StageName: placementv1beta1.UpdateRunDeleteStageName,
+		Clusters:  []placementv1beta1.ClusterUpdatingStatus{},
+	}
+	return nil
+}
+
+// recordRollbackResourceSnapshotIndex validates the resource snapshot that the rollback updateRun rolls back to
+// and records its index in the updateRun status.
+func (r *Reconciler) recordRollbackResourceSnapshotIndex(ctx context.Context, placement placementv1beta1.PlacementObj, updateRun placementv1beta1.UpdateRunObj) error {
+	if updateRun.GetUpdateRunSpec().ResourceSnapshotIndex == "" {
+		missingErr := controller.NewUserError(fmt.Errorf("the resource snapshot index to roll back to is not specified"))
+		klog.ErrorS(missingErr, "Failed to validate the rollback updateRun", "updateRun", klog.KObj(updateRun))
+		return fmt.Errorf("%w: %s", errValidationFailed, missingErr.Error())
+	}
+	if _, err := r.getResourceSnapshotObjs(ctx, placement, updateRun); err != nil {
+		return err
+	}
+	updateRunStatus := updateRun.GetUpdateRunStatus()
+	updateRunStatus.ResourceSnapshotIndexUsed = updateRun.GetUpdateRunSpec().ResourceSnapshotIndex
+	return nil
+}
+
+// recomputeRollbackStageStatus recomputes the stages of a rollback updateRun during validation.
+// Unlike regular updateRuns, the stages are not derived from an update strategy, so we keep the existing stages
+// and only drop the clusters that are no longer scheduled; validateUpdateStagesStatus then detects the change.
+func recomputeRollbackStageStatus(scheduledBindings []placementv1beta1.BindingObj, updateRun placementv1beta1.UpdateRunObj) {
+	scheduledClusters := make(map[string]struct{}, len(scheduledBindings))
+	for _, binding := range scheduledBindings {
+		scheduledClusters[binding.GetBindingSpec().TargetCluster] = struct{}{}
+	}
+	updateRunStatus := updateRun.GetUpdateRunStatus()
+	stagesStatus := make([]placementv1beta1.StageUpdatingStatus, len(updateRunStatus.StagesStatus))
+	for i, stageStatus := range updateRunStatus.StagesStatus {
+		stagesStatus[i].StageName = stageStatus.StageName
+		for _, clusterStatus := range stageStatus.Clusters {
+			if _, ok := scheduledClusters[clusterStatus.ClusterName]; ok {
+				stagesStatus[i].Clusters = append(stagesStatus[i].Clusters, placementv1beta1.ClusterUpdatingStatus{ClusterName: clusterStatus.ClusterName})
+			}
+		}
+	}
+	updateRunStatus.StagesStatus = stagesStatus
+}
@@ -0,0 +1,520 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterun
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+// clusterStatusForRollback returns the updating status of a cluster with the given previous resource snapshot index.
+func clusterStatusForRollback(name, previousIndex string, started bool) placementv1beta1.ClusterUpdatingStatus {
+	status := placementv1beta1.ClusterUpdatingStatus{ClusterName: name}
+	if previousIndex != "" {
+		status.PreviousState = &placementv1beta1.ClusterPreviousState{
+			ResourceSnapshotIndex:            previousIndex,
+			ClusterResourceOverrideSnapshots: []string{name + "-cro-snapshot"},
+		}
+	}
+	if started {
+		status.Conditions = []metav1.Condition{
+			{
+				Type:               string(placementv1beta1.ClusterUpdatingConditionStarted),
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: 1,
+				Reason:             condition.ClusterUpdatingStartedReason,
+			},
+		}
+	}
+	return status
+}
+
+func TestBuildRollbackUpdateRun(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		stages    []placementv1beta1.StageUpdatingStatus
+		want      placementv1beta1.UpdateRunObj
+	}{
+		{
+			name: "no cluster has started updating",
+			stages: []placementv1beta1.StageUpdatingStatus{
+				{
+					StageName: "stage-1",
+					Clusters: []placementv1beta1.ClusterUpdatingStatus{
+						clusterStatusForRollback("cluster-1", "1", false),
+					},
+				},
+			},
+		},
+		{
+			name: "started clusters have no previous state or already used the new resource snapshot",
+			stages: []placementv1beta1.StageUpdatingStatus{
+				{
+					StageName: "stage-1",
+					Clusters: []placementv1beta1.ClusterUpdatingStatus{
+						clusterStatusForRollback("cluster-1", "", true),
+						clusterStatusForRollback("cluster-2", "2", true),
+					},
+				},
+			},
+		},
+		{
+			name: "picks the resource snapshot index used by most clusters",
+			stages: []placementv1beta1.StageUpdatingStatus{
+				{
+					StageName: "stage-1",
+					Clusters: []placementv1beta1.ClusterUpdatingStatus{
+						clusterStatusForRollback("cluster-1", "0", true),
+						clusterStatusForRollback("cluster-2", "1", true),
+					},
+				},
+				{
+					StageName: "stage-2",
+					Clusters: []placementv1beta1.ClusterUpdatingStatus{
+						clusterStatusForRollback("cluster-3", "0", true),
+						clusterStatusForRollback("cluster-4", "1", false),
+					},
+				},
+			},
+			want: &placementv1beta1.ClusterStagedUpdateRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "test-run-rollback",
+					Labels: map[string]string{placementv1beta1.RolledBackUpdateRunLabel: "test-run"},
+				},
+				Spec: placementv1beta1.UpdateRunSpec{
+					PlacementName:            "test-placement",
+					ResourceSnapshotIndex:    "0",
+					StagedUpdateStrategyName: "test-strategy",
+					State:                    placementv1beta1.StateRun,
+					RollbackPolicy:           &placementv1beta1.RollbackPolicy{Type: placementv1beta1.RollbackPolicyTypeManual},
+				},
+			},
+		},
+		{
+			name:      "picks the larger resource snapshot index on a tie for a namespaced updateRun",
+			namespace: "test-ns",
+			stages: []placementv1beta1.StageUpdatingStatus{
+				{
+					StageName: "stage-1",
+					Clusters: []placementv1beta1.ClusterUpdatingStatus{
+						clusterStatusForRollback("cluster-1", "0", true),
+						clusterStatusForRollback("cluster-2", "1", true),
+					},
+				},
+			},
+			want: &placementv1beta1.StagedUpdateRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-run-rollback",
+					Namespace: "test-ns",
+					Labels:    map[string]string{placementv1beta1.RolledBackUpdateRunLabel: "test-run"},
+				},
+				Spec: placementv1beta1.UpdateRunSpec{
+					PlacementName:            "test-placement",
+					ResourceSnapshotIndex:    "1",
+					StagedUpdateStrategyName: "test-strategy",
+					State:                    placementv1beta1.StateRun,
+					RollbackPolicy:           &placementv1beta1.RollbackPolicy{Type: placementv1beta1.RollbackPolicyTypeManual},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := metav1.ObjectMeta{Name: "test-run", Namespace: tt.namespace, Generation: 1}
+			spec := placementv1beta1.UpdateRunSpec{
+				PlacementName:            "test-placement",
+				StagedUpdateStrategyName: "test-strategy",
+			}
+			status := placementv1beta1.UpdateRunStatus{ResourceSnapshotIndexUsed: "2", StagesStatus: tt.stages}
+			var updateRun placementv1beta1.UpdateRunObj
+			if tt.namespace == "" {
+				updateRun = &placementv1beta1.ClusterStagedUpdateRun{ObjectMeta: meta, Spec: spec, Status: status}
+			} else {
+				updateRun = &placementv1beta1.StagedUpdateRun{ObjectMeta: meta, Spec: spec, Status: status}
+			}
+			got := buildRollbackUpdateRun(updateRun)
+			if tt.want == nil {
+				if got != nil {
+					t.Fatalf("buildRollbackUpdateRun() = %v, want nil", got)
+				}
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("buildRollbackUpdateRun() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestClusterResourceSnapshotName(t *testing.T) {
+	tests := []struct {
+		name          string
+		clusterStatus placementv1beta1.ClusterUpdatingStatus
+		want          string
+	}{
+		{
+			name:          "the cluster uses the resource snapshot of the updateRun",
+			clusterStatus: placementv1beta1.ClusterUpdatingStatus{ClusterName: "cluster-1"},
+			want:          "test-placement-2-snapshot",
+		},
+		{
+			name:          "the cluster is rolled back to its own resource snapshot",
+			clusterStatus: placementv1beta1.ClusterUpdatingStatus{ClusterName: "cluster-1", ResourceSnapshotIndex: "0"},
+			want:          "test-placement-0-snapshot",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := clusterResourceSnapshotName("test-placement", "test-placement-2-snapshot", &tt.clusterStatus); got != tt.want {
+				t.Errorf("clusterResourceSnapshotName() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRollbackUpdateRunName(t *testing.T) {
+	tests := []struct {
+		name          string
+		updateRunName string
+		want          string
+	}{
+		{
+			name:          "short name",
+			updateRunName: "release-v2",
+			want:          "release-v2-rollback",
+		},
+		{
+			name:          "long name is truncated",
+			updateRunName: strings.Repeat("a", 53) + "-" + strings.Repeat("b", 9),
+			want:          strings.Repeat("a", 53) + "-rollback",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rollbackUpdateRunName(tt.updateRunName)
+			if got != tt.want {
+				t.Errorf("rollbackUpdateRunName() = %s, want %s", got, tt.want)
+			}
+			if len(got) > maxUpdateRunNameLength {
+				t.Errorf("rollbackUpdateRunName() returned a name of length %d, want at most %d", len(got), maxUpdateRunNameLength)
+			}
+		})
+	}
+}
+
+func TestRecordClusterPreviousStates(t *testing.T) {
+	bindings := []placementv1beta1.BindingObj{
+		&placementv1beta1.ClusterResourceBinding{
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster:                    "cluster-1",
+				State:                            placementv1beta1.BindingStateBound,
+				ResourceSnapshotName:             "test-placement-3-snapshot",
+				ClusterResourceOverrideSnapshots: []string{"cro-1"},
+			},
+		},
+		&placementv1beta1.ClusterResourceBinding{
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster:        "cluster-2",
+				State:                placementv1beta1.BindingStateScheduled,
+				ResourceSnapshotName: "test-placement-3-snapshot",
+			},
+		},
+		&placementv1beta1.ClusterResourceBinding{
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster:        "cluster-3",
+				State:                placementv1beta1.BindingStateBound,
+				ResourceSnapshotName: "another-placement-3-snapshot",
+			},
+		},
+	}
+	updateRun := &placementv1beta1.ClusterStagedUpdateRun{
+		Spec: placementv1beta1.UpdateRunSpec{PlacementName: "test-placement"},
+		Status: placementv1beta1.UpdateRunStatus{
+			StagesStatus: []placementv1beta1.StageUpdatingStatus{
+				{
+					StageName: "stage-1",
+					Clusters: []placementv1beta1.ClusterUpdatingStatus{
+						{ClusterName: "cluster-1"},
+						{ClusterName: "cluster-2"},
+						{ClusterName: "cluster-3"},
+					},
+				},
+			},
+		},
+	}
+	recordClusterPreviousStates(bindings, updateRun)
+	want := []placementv1beta1.ClusterUpdatingStatus{
+		{
+			ClusterName: "cluster-1",
+			PreviousState: &placementv1beta1.ClusterPreviousState{
+				ResourceSnapshotIndex:            "3",
+				ClusterResourceOverrideSnapshots: []string{"cro-1"},
+			},
+		},
+		{ClusterName: "cluster-2"},
+		{ClusterName: "cluster-3"},
+	}
+	if diff := cmp.Diff(updateRun.Status.StagesStatus[0].Clusters, want); diff != "" {
+		t.Errorf("recordClusterPreviousStates() mismatch (-got, +want):\n%s", diff)
+	}
+}
+
+func TestGenerateRollbackStages(t *testing.T) {
+	rolledBackRun := &placementv1beta1.ClusterStagedUpdateRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-run", Generation: 1},
+		Spec:       placementv1beta1.UpdateRunSpec{PlacementName: "test-placement"},
+		Status: placementv1beta1.UpdateRunStatus{
+			ResourceSnapshotIndexUsed: "2",
+			UpdateStrategySnapshot: &placementv1beta1.UpdateStrategySpec{
+				Stages: []placementv1beta1.StageConfig{
+					{Name: "canary"},
+					{Name: "prod", MaxConcurrency: ptr.To(intstr.FromInt32(2))},
+					{Name: "empty"},
+				},
+			},
+			StagesStatus: []placementv1beta1.StageUpdatingStatus{
+				{
+					StageName: "canary",
+					Clusters: []placementv1beta1.ClusterUpdatingStatus{
+						clusterStatusForRollback("cluster-1", "1", true),
+					},
+				},
+				{
+					StageName: "prod",
+					Clusters: []placementv1beta1.ClusterUpdatingStatus{
+						clusterStatusForRollback("cluster-2", "1", true),
+						clusterStatusForRollback("cluster-3", "1", true),
+						clusterStatusForRollback("cluster-4", "0", true),
+						clusterStatusForRollback("cluster-5", "1", false),
+					},
+				},
+				{
+					StageName: "empty",
+				},
+			},
+		},
+	}
+	scheduledBindings := []placementv1beta1.BindingObj{}
+	for _, cluster := range []string{"cluster-1", "cluster-2", "cluster-3", "cluster-4", "cluster-5"} {
+		scheduledBindings = append(scheduledBindings, &placementv1beta1.ClusterResourceBinding{
+			Spec: placementv1beta1.ResourceBindingSpec{TargetCluster: cluster},
+		})
+	}
+
+	tests := []struct {
+		name             string
+		rolledBackName   string
+		placementName    string
+		snapshotIndices  []string
+		wantStatus       placementv1beta1.UpdateRunStatus
+		wantErr          error
+		wantErrMsgSubStr string
+	}{
+		{
+			name:            "computes the stages in reverse order",
+			rolledBackName:  "test-run",
+			placementName:   "test-placement",
+			snapshotIndices: []string{"0", "1", "2"},
+			wantStatus: placementv1beta1.UpdateRunStatus{
+				RolledBackUpdateRunName: "test-run",
+				UpdateStrategySnapshot: &placementv1beta1.UpdateStrategySpec{
+					Stages: []placementv1beta1.StageConfig{
+						{Name: "prod", MaxConcurrency: ptr.To(intstr.FromInt32(2))},
+						{Name: "canary"},
+					},
+				},
+				StagesStatus: []placementv1beta1.StageUpdatingStatus{
+					{
+						StageName: "prod",
+						Clusters: []placementv1beta1.ClusterUpdatingStatus{
+							{ClusterName: "cluster-4", ResourceSnapshotIndex: "0", ClusterResourceOverrideSnapshots: []string{"cluster-4-cro-snapshot"}},
+							{ClusterName: "cluster-3", ResourceSnapshotIndex: "1", ClusterResourceOverrideSnapshots: []string{"cluster-3-cro-snapshot"}},
+							{ClusterName: "cluster-2", ResourceSnapshotIndex: "1", ClusterResourceOverrideSnapshots: []string{"cluster-2-cro-snapshot"}},
+						},
+					},
+					{
+						StageName: "canary",
+						Clusters: []placementv1beta1.ClusterUpdatingStatus{
+							{ClusterName: "cluster-1", ResourceSnapshotIndex: "1", ClusterResourceOverrideSnapshots: []string{"cluster-1-cro-snapshot"}},
+						},
+					},
+				},
+				DeletionStageStatus: &placementv1beta1.StageUpdatingStatus{
+					StageName: placementv1beta1.UpdateRunDeleteStageName,
+					Clusters:  []placementv1beta1.ClusterUpdatingStatus{},
+				},
+			},
+		},
+		{
+			name:             "the resource snapshot that a cluster used before no longer exists",
+			rolledBackName:   "test-run",
+			placementName:    "test-placement",
+			snapshotIndices:  []string{"1", "2"},
+			wantErr:          errValidationFailed,
+			wantErrMsgSubStr: "cannot roll back clusters [cluster-4]",
+		},
+		{
+			name:             "the updateRun to roll back is not found",
+			rolledBackName:   "missing-run",
+			placementName:    "test-placement",
+			wantErr:          errValidationFailed,
+			wantErrMsgSubStr: "the updateRun to roll back not found",
+		},
+		{
+			name:             "the updateRun to roll back belongs to another placement",
+			rolledBackName:   "test-run",
+			placementName:    "another-placement",
+			wantErr:          errValidationFailed,
+			wantErrMsgSubStr: "belongs to a different placement",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = placementv1beta1.AddToScheme(scheme)
+			objs := []client.Object{rolledBackRun.DeepCopy()}
+			for _, index := range tt.snapshotIndices {
+				objs = append(objs, &placementv1beta1.ClusterResourceSnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-placement-" + index + "-snapshot",
+						Labels: map[string]string{
+							placementv1beta1.PlacementTrackingLabel: "test-placement",
+							placementv1beta1.ResourceIndexLabel:     index,
+						},
+					},
+				})
+			}
+			r := &Reconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()}
+			updateRun := &placementv1beta1.ClusterStagedUpdateRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-run-rollback",
+					Generation: 1,
+					Labels:     map[string]string{placementv1beta1.RolledBackUpdateRunLabel: tt.rolledBackName},
+				},
+				Spec: placementv1beta1.UpdateRunSpec{PlacementName: tt.placementName, ResourceSnapshotIndex: "1"},
+			}
+			err := r.generateRollbackStages(context.Background(), scheduledBindings, updateRun)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("generateRollbackStages() error = %v, want %v", err, tt.wantErr)
+				}
+				if !strings.Contains(err.Error(), tt.wantErrMsgSubStr) {
+					t.Fatalf("generateRollbackStages() error = %v, want error containing %s", err, tt.wantErrMsgSubStr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("generateRollbackStages() error = %v, want no error", err)
+			}
+			if diff := cmp.Diff(updateRun.Status, tt.wantStatus); diff != "" {
+				t.Errorf("generateRollbackStages() status mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckUpdateRunStuckForRollback(t *testing.T) {
+	stuckCond := metav1.Condition{
+		Type:    string(placementv1beta1.StagedUpdateRunConditionProgressing),
+		Status:  metav1.ConditionFalse,
+		Reason:  condition.UpdateRunStuckReason,
+		Message: "the updateRun is stuck",
+	}
+	tests := []struct {
+		name           string
+		labels         map[string]string
+		policy         *placementv1beta1.RollbackPolicy
+		strategyPolicy *placementv1beta1.RollbackPolicy
+		conditions     []metav1.Condition
+		wantErr        bool
+	}{
+		{
+			name:       "no rollback policy",
+			conditions: []metav1.Condition{stuckCond},
+		},
+		{
+			name:       "automatic rollback without rollbackOnStuck",
+			policy:     &placementv1beta1.RollbackPolicy{Type: placementv1beta1.RollbackPolicyTypeAutomatic},
+			conditions: []metav1.Condition{stuckCond},
+		},
+		{
+			name:       "automatic rollback on stuck but the updateRun is progressing",
+			policy:     &placementv1beta1.RollbackPolicy{Type: placementv1beta1.RollbackPolicyTypeAutomatic, RollbackOnStuck: true},
+			conditions: []metav1.Condition{{Type: string(placementv1beta1.StagedUpdateRunConditionProgressing), Status: metav1.ConditionTrue, Reason: condition.UpdateRunProgressingReason}},
+		},
+		{
+			name:       "automatic rollback on stuck and the updateRun is stuck",
+			policy:     &placementv1beta1.RollbackPolicy{Type: placementv1beta1.RollbackPolicyTypeAutomatic, RollbackOnStuck: true},
+			conditions: []metav1.Condition{stuckCond},
+			wantErr:    true,
+		},
+		{
+			name:           "automatic rollback on stuck from the strategy",
+			strategyPolicy: &placementv1beta1.RollbackPolicy{Type: placementv1beta1.RollbackPolicyTypeAutomatic, RollbackOnStuck: true},
+			conditions:     []metav1.Condition{stuckCond},
+			wantErr:        true,
+		},
+		{
+			name:           "the updateRun policy takes precedence over the strategy",
+			policy:         &placementv1beta1.RollbackPolicy{Type: placementv1beta1.RollbackPolicyTypeManual},
+			strategyPolicy: &placementv1beta1.RollbackPolicy{Type: placementv1beta1.RollbackPolicyTypeAutomatic, RollbackOnStuck: true},
+			conditions:     []metav1.Condition{stuckCond},
+		},
+		{
+			name:       "a rollback updateRun is never rolled back",
+			labels:     map[string]string{placementv1beta1.RolledBackUpdateRunLabel: "test-run"},
+			policy:     &placementv1beta1.RollbackPolicy{Type: placementv1beta1.RollbackPolicyTypeAutomatic, RollbackOnStuck: true},
+			conditions: []metav1.Condition{stuckCond},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updateRun := &placementv1beta1.ClusterStagedUpdateRun{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-run", Labels: tt.labels},
+				Spec:       placementv1beta1.UpdateRunSpec{RollbackPolicy: tt.policy},
+				Status: placementv1beta1.UpdateRunStatus{
+					UpdateStrategySnapshot: &placementv1beta1.UpdateStrategySpec{RollbackPolicy: tt.strategyPolicy},
+					Conditions:             tt.conditions,
+				},
+			}
+			err := checkUpdateRunStuckForRollback(updateRun)
+			if tt.wantErr {
+				if !errors.Is(err, errStagedUpdatedAborted) {
+					t.Fatalf("checkUpdateRunStuckForRollback() error = %v, want %v", err, errStagedUpdatedAborted)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("checkUpdateRunStuckForRollback() error = %v, want no error", err)
+			}
+		})
+	}
+}
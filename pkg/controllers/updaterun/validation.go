@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/klog/v2"
@@ -82,6 +83,11 @@ func (r *Reconciler) validate(
 		return -1, nil, nil, err
 	}
 
+	if isRollbackUpdateRun(updateRun) {
+		// A rollback updateRun never deletes any binding.
+		toBeDeletedBindings = nil
+	}
+
 	// Validate the stages and return the updating stage index.
 	updatingStageIndex, err := r.validateStagesStatus(ctx, scheduledBindings, toBeDeletedBindings, updateRun, updateRunCopy)
 	if err != nil {
@@ -111,7 +117,9 @@ func (r *Reconciler) validateStagesStatus(
 		klog.ErrorS(unexpectedErr, "Failed to find the updateStrategySnapshot in the updateRun", "updateRun", updateRunRef)
 		return -1, fmt.Errorf("%w: %s", errStagedUpdatedAborted, unexpectedErr.Error())
 	}
-	if err := r.computeRunStageStatus(ctx, scheduledBindings, updateRunCopy); err != nil {
+	if isRollbackUpdateRun(updateRun) {
+		recomputeRollbackStageStatus(scheduledBindings, updateRunCopy)
+	} else if err := r.computeRunStageStatus(ctx, scheduledBindings, updateRunCopy); err != nil {
 		return -1, err
 	}
 
@@ -198,8 +206,11 @@ func validateClusterUpdatingStatus(
 			klog.ErrorS(unexpectedErr, "The finished stage is after the updating stage", "updateRun", klog.KObj(updateRun))
 			return -1, -1, fmt.Errorf("%w: %s", errStagedUpdatedAborted, unexpectedErr.Error())
 		}
-		// Make sure that all the clusters are updated.
+		// Make sure that all the clusters are updated, except for the failed clusters that are tolerated.
 		for curCluster := range stageStatus.Clusters {
+			if slices.Contains(stageStatus.FailedClusters, stageStatus.Clusters[curCluster].ClusterName) {
+				continue
+			}
 			// Check if the cluster is still updating.
 			if !condition.IsConditionStatusTrue(meta.FindStatusCondition(
 				stageStatus.Clusters[curCluster].Conditions,
@@ -242,6 +253,10 @@ func validateClusterUpdatingStatus(
 		// Collect the updating clusters.
 		updatingClusterCount := 0
 		for j := range stageStatus.Clusters {
+			if slices.Contains(stageStatus.FailedClusters, stageStatus.Clusters[j].ClusterName) {
+				// The failed clusters that are tolerated are skipped in execution.
+				continue
+			}
 			clusterStartedCond := meta.FindStatusCondition(stageStatus.Clusters[j].Conditions, string(placementv1beta1.ClusterUpdatingConditionStarted))
 			clusterFinishedCond := meta.FindStatusCondition(stageStatus.Clusters[j].Conditions, string(placementv1beta1.ClusterUpdatingConditionSucceeded))
 			// cluster is updating if it has started but not yet finished, we also consider failed clusters as updating clusters in execution.
@@ -243,6 +243,58 @@ func TestValidateClusterUpdatingStatus(t *testing.T) {
 			wantUpdatingStageIndex:     -1,
 			wantLastFinishedStageIndex: -1,
 		},
+		{
+			name:                   "validateClusterUpdatingStatus should not count the tolerated failed clusters as updating clusters",
+			curStage:               0,
+			updatingStageIndex:     -1,
+			lastFinishedStageIndex: -1,
+			stageStatus: &placementv1beta1.StageUpdatingStatus{
+				StageName:      "test-stage",
+				Conditions:     []metav1.Condition{generateTrueCondition(updateRun, placementv1beta1.StageUpdatingConditionProgressing)},
+				FailedClusters: []string{"cluster-2"},
+				Clusters: []placementv1beta1.ClusterUpdatingStatus{
+					{
+						ClusterName: "cluster-1",
+						Conditions:  []metav1.Condition{generateTrueCondition(updateRun, placementv1beta1.ClusterUpdatingConditionStarted)},
+					},
+					{
+						ClusterName: "cluster-2",
+						Conditions:  []metav1.Condition{generateTrueCondition(updateRun, placementv1beta1.ClusterUpdatingConditionStarted), generateFalseCondition(updateRun, placementv1beta1.ClusterUpdatingConditionSucceeded)},
+					},
+				},
+			},
+			maxConcurrency:             1,
+			wantErr:                    nil,
+			wantUpdatingStageIndex:     0,
+			wantLastFinishedStageIndex: -1,
+		},
+		{
+			name:                   "validateClusterUpdatingStatus should allow the tolerated failed clusters in a succeeded stage",
+			curStage:               0,
+			updatingStageIndex:     -1,
+			lastFinishedStageIndex: -1,
+			stageStatus: &placementv1beta1.StageUpdatingStatus{
+				StageName: "test-stage",
+				Conditions: []metav1.Condition{
+					generateTrueCondition(updateRun, placementv1beta1.StageUpdatingConditionProgressing),
+					generateTrueCondition(updateRun, placementv1beta1.StageUpdatingConditionSucceeded),
+				},
+				FailedClusters: []string{"cluster-2"},
+				Clusters: []placementv1beta1.ClusterUpdatingStatus{
+					{
+						ClusterName: "cluster-1",
+						Conditions:  []metav1.Condition{generateTrueCondition(updateRun, placementv1beta1.ClusterUpdatingConditionStarted), generateTrueCondition(updateRun, placementv1beta1.ClusterUpdatingConditionSucceeded)},
+					},
+					{
+						ClusterName: "cluster-2",
+						Conditions:  []metav1.Condition{generateTrueCondition(updateRun, placementv1beta1.ClusterUpdatingConditionStarted), generateFalseCondition(updateRun, placementv1beta1.ClusterUpdatingConditionSucceeded)},
+					},
+				},
+			},
+			wantErr:                    nil,
+			wantUpdatingStageIndex:     -1,
+			wantLastFinishedStageIndex: 0,
+		},
 		{
 			name:                   "validateClusterUpdatingStatus should return -1 as the updatingStageIndex if no stage is updating",
 			curStage:               0,
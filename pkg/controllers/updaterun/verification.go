@@ -0,0 +1,495 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterun
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// defaultVerificationJobTimeout is the default time to wait for the verification Jobs to complete.
+	defaultVerificationJobTimeout = 30 * time.Minute
+
+	// verificationJobCheckInterval is the time between two checks of the verification Jobs.
+	verificationJobCheckInterval = 15 * time.Second
+
+	// verificationJobWorkNameFmt is the format of the name of the Works that place the verification Jobs.
+	verificationJobWorkNameFmt = "verification-job-%s"
+
+	// maxTerminationMessagesKept is the maximum number of container termination messages kept per cluster.
+	maxTerminationMessagesKept = 3
+
+	// maxTerminationMessageLength is the maximum length of a container termination message kept in the status.
+	maxTerminationMessageLength = 512
+
+	// verificationJobSuffixLength is the length of the suffix that makes the verification Jobs unique
+	// to an update run and a stage.
+	verificationJobSuffixLength = 10
+
+	// defaultVerificationJobName is the name used for the verification Jobs if the template has no name.
+	defaultVerificationJobName = "verification"
+)
+
+// verificationJobStatusPaths are the status fields of the verification Jobs reported back via the Work API.
+var verificationJobStatusPaths = []string{".status.conditions", ".status.active", ".status.succeeded", ".status.failed"}
+
+// handleStageVerificationJobTask handles the VerificationJob task logic for after stage tasks.
+// It returns true if the Jobs on all the clusters in the stage have succeeded, and the time to wait before
+// checking the Jobs again if some of them are still running. An errStagedUpdatedAborted error is returned
+// if a Job has failed or the Jobs have not completed in time.
+func (r *Reconciler) handleStageVerificationJobTask(
+	ctx context.Context,
+	stageTaskStatus *placementv1beta1.StageTaskStatus,
+	task *placementv1beta1.StageTask,
+	updatingStageStatus *placementv1beta1.StageUpdatingStatus,
+	updateRun placementv1beta1.UpdateRunObj,
+) (bool, time.Duration, error) {
+	updateRunRef := klog.KObj(updateRun)
+	stageName := updatingStageStatus.StageName
+
+	verificationCond := meta.FindStatusCondition(stageTaskStatus.Conditions, string(placementv1beta1.StageTaskConditionVerificationJobSucceeded))
+	if condition.IsConditionStatusTrue(verificationCond, updateRun.GetGeneration()) {
+		// The verification Jobs have succeeded.
+		return true, 0, nil
+	}
+	if task.VerificationJob == nil {
+		// This should have been caught by the validation.
+		unexpectedErr := controller.NewUnexpectedBehaviorError(fmt.Errorf("the verification job task in stage `%s` has no verification job config", stageName))
+		klog.ErrorS(unexpectedErr, "Found a verification job task without verification job config", "stage", stageName, "updateRun", updateRunRef)
+		return false, -1, fmt.Errorf("%w: %s", errStagedUpdatedAborted, unexpectedErr.Error())
+	}
+
+	now := time.Now()
+	if stageTaskStatus.VerificationJobStatus == nil {
+		stageTaskStatus.VerificationJobStatus = &placementv1beta1.VerificationJobStatus{
+			StartTime: metav1.NewTime(now),
+		}
+	}
+	jobStatus := stageTaskStatus.VerificationJobStatus
+	workName := fmt.Sprintf(verificationJobWorkNameFmt, verificationJobSuffix(updateRun, stageName))
+
+	// The Work is built lazily, as the template only needs to be read when some Work has not been created yet.
+	var verificationWork *placementv1beta1.Work
+	for i := range updatingStageStatus.Clusters {
+		clusterName := updatingStageStatus.Clusters[i].ClusterName
+		clusterStatus := findOrAddClusterVerificationJobStatus(jobStatus, clusterName)
+		if clusterStatus.Phase != placementv1beta1.VerificationJobPhaseRunning {
+			continue
+		}
+
+		workKey := types.NamespacedName{Namespace: fmt.Sprintf(utils.NamespaceNameFormat, clusterName), Name: workName}
+		var work placementv1beta1.Work
+		if err := r.Client.Get(ctx, workKey, &work); err != nil {
+			if !apierrors.IsNotFound(err) {
+				klog.ErrorS(err, "Failed to get the verification job work", "work", workKey, "stage", stageName, "updateRun", updateRunRef)
+				return false, -1, controller.NewAPIServerError(true, err)
+			}
+			if verificationWork == nil {
+				job, err := r.buildVerificationJob(ctx, task.VerificationJob, updateRun, stageName)
+				if err != nil {
+					if errors.Is(err, controller.ErrUserError) {
+						klog.ErrorS(err, "Failed to build the verification job", "stage", stageName, "updateRun", updateRunRef)
+						markAfterStageVerificationJobFailed(stageTaskStatus, updateRun.GetGeneration(), err.Error())
+						return false, -1, fmt.Errorf("%w: %s", errStagedUpdatedAborted, err.Error())
+					}
+					return false, -1, err
+				}
+				jobStatus.JobName = job.Name
+				jobStatus.JobNamespace = job.Namespace
+				if verificationWork, err = buildVerificationJobWork(job, updateRun, stageName); err != nil {
+					return false, -1, err
+				}
+			}
+			newWork := verificationWork.DeepCopy()
+			newWork.Name = workKey.Name
+			newWork.Namespace = workKey.Namespace
+			if err := r.Client.Create(ctx, newWork); err != nil && !apierrors.IsAlreadyExists(err) {
+				klog.ErrorS(err, "Failed to create the verification job work", "work", workKey, "stage", stageName, "updateRun", updateRunRef)
+				return false, -1, controller.NewAPIServerError(false, err)
+			}
+			klog.V(2).InfoS("Created the verification job work", "work", workKey, "stage", stageName, "updateRun", updateRunRef)
+			clusterStatus.Message = "The Job has not been applied yet"
+			continue
+		}
+		refreshClusterVerificationJobStatus(clusterStatus, jobStatus, &work)
+	}
+
+	runningClusters := make([]string, 0, len(jobStatus.Clusters))
+	for i := range jobStatus.Clusters {
+		clusterStatus := &jobStatus.Clusters[i]
+		switch clusterStatus.Phase {
+		case placementv1beta1.VerificationJobPhaseFailed:
+			failedErr := controller.NewUserError(fmt.Errorf("the verification job on cluster `%s` has failed: %s", clusterStatus.ClusterName, clusterStatus.Message))
+			klog.ErrorS(failedErr, "The verification job task has failed", "stage", stageName, "updateRun", updateRunRef)
+			markAfterStageVerificationJobFailed(stageTaskStatus, updateRun.GetGeneration(), failedErr.Error())
+			return false, -1, fmt.Errorf("%w: %s", errStagedUpdatedAborted, failedErr.Error())
+		case placementv1beta1.VerificationJobPhaseRunning:
+			runningClusters = append(runningClusters, clusterStatus.ClusterName)
+		}
+	}
+
+	if len(runningClusters) == 0 {
+		klog.V(2).InfoS("The verification job task has succeeded", "stage", stageName, "updateRun", updateRunRef)
+		// The Jobs are no longer needed; the failed ones are kept for troubleshooting until the update run is deleted.
+		if err := r.deleteVerificationJobWorks(ctx, updateRun, stageName); err != nil {
+			return false, -1, err
+		}
+		markAfterStageVerificationJobSucceeded(stageTaskStatus, updateRun.GetGeneration())
+		return true, 0, nil
+	}
+
+	timeout := defaultVerificationJobTimeout
+	if task.VerificationJob.Timeout != nil && task.VerificationJob.Timeout.Duration > 0 {
+		timeout = task.VerificationJob.Timeout.Duration
+	}
+	remaining := jobStatus.StartTime.Add(timeout).Sub(now)
+	if remaining <= 0 {
+		timeoutErr := controller.NewUserError(fmt.Errorf("the verification jobs on clusters %s have not completed within %s", strings.Join(runningClusters, ", "), timeout))
+		klog.ErrorS(timeoutErr, "The verification job task has timed out", "stage", stageName, "updateRun", updateRunRef)
+		markAfterStageVerificationJobFailed(stageTaskStatus, updateRun.GetGeneration(), timeoutErr.Error())
+		return false, -1, fmt.Errorf("%w: %s", errStagedUpdatedAborted, timeoutErr.Error())
+	}
+	klog.V(2).InfoS("The verification jobs are still running", "clusters", runningClusters, "remaining", remaining, "stage", stageName, "updateRun", updateRunRef)
+	return false, minPositiveDuration(verificationJobCheckInterval, remaining), nil
+}
+
+// buildVerificationJob reads the Job template of a VerificationJob stage task and builds the Job to run on
+// the clusters in the stage.
+func (r *Reconciler) buildVerificationJob(
+	ctx context.Context,
+	config *placementv1beta1.VerificationJobConfig,
+	updateRun placementv1beta1.UpdateRunObj,
+	stageName string,
+) (*batchv1.Job, error) {
+	templateRef := config.JobTemplateRef
+	namespace := templateRef.Namespace
+	if updateRun.GetNamespace() != "" {
+		// Namespaced update runs can only read envelopes in their own namespace.
+		if namespace != "" && namespace != updateRun.GetNamespace() {
+			return nil, controller.NewUserError(fmt.Errorf("the job template must be in the namespace %s of the update run, got %s", updateRun.GetNamespace(), namespace))
+		}
+		namespace = updateRun.GetNamespace()
+	}
+	if namespace == "" {
+		return nil, controller.NewUserError(fmt.Errorf("the namespace of the job template %s is not specified", templateRef.Name))
+	}
+
+	reader := r.UncachedReader
+	if reader == nil {
+		reader = r.Client
+	}
+	envelopeKey := client.ObjectKey{Namespace: namespace, Name: templateRef.Name}
+	var envelope placementv1beta1.ResourceEnvelope
+	if err := reader.Get(ctx, envelopeKey, &envelope); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, controller.NewUserError(fmt.Errorf("the job template %s is not found", envelopeKey))
+		}
+		klog.ErrorS(err, "Failed to get the verification job template", "resourceEnvelope", envelopeKey, "updateRun", klog.KObj(updateRun))
+		return nil, controller.NewAPIServerError(reader == r.Client, err)
+	}
+	if len(envelope.Data) != 1 {
+		return nil, controller.NewUserError(fmt.Errorf("the job template %s must wrap exactly one Job, got %d objects", envelopeKey, len(envelope.Data)))
+	}
+	var template batchv1.Job
+	for _, raw := range envelope.Data {
+		if err := json.Unmarshal(raw.Raw, &template); err != nil {
+			return nil, controller.NewUserError(fmt.Errorf("failed to decode the job template %s: %w", envelopeKey, err))
+		}
+	}
+	if template.APIVersion != batchv1.SchemeGroupVersion.String() || template.Kind != utils.JobKind {
+		return nil, controller.NewUserError(fmt.Errorf("the job template %s must wrap a %s %s, got %s %s", envelopeKey, batchv1.SchemeGroupVersion, utils.JobKind, template.APIVersion, template.Kind))
+	}
+	if template.Namespace != "" && template.Namespace != envelope.Namespace {
+		return nil, controller.NewUserError(fmt.Errorf("the job in the job template %s must be in the namespace of the envelope, got %s", envelopeKey, template.Namespace))
+	}
+
+	job := &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{APIVersion: batchv1.SchemeGroupVersion.String(), Kind: utils.JobKind},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        verificationJobName(template.Name, verificationJobSuffix(updateRun, stageName)),
+			Namespace:   envelope.Namespace,
+			Labels:      template.Labels,
+			Annotations: template.Annotations,
+		},
+		Spec: template.Spec,
+	}
+	// A Job deleted after it finishes would be re-created by the work applier and run again.
+	job.Spec.TTLSecondsAfterFinished = nil
+	setDefaultTerminationMessagePolicy(job.Spec.Template.Spec.InitContainers)
+	setDefaultTerminationMessagePolicy(job.Spec.Template.Spec.Containers)
+	return job, nil
+}
+
+// buildVerificationJobWork builds the Work that places a verification Job onto a cluster, without its name and
+// namespace. The status of the Job is reported back via the Work API.
+func buildVerificationJobWork(job *batchv1.Job, updateRun placementv1beta1.UpdateRunObj, stageName string) (*placementv1beta1.Work, error) {
+	raw, err := json.Marshal(job)
+	if err != nil {
+		unexpectedErr := controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to marshal the verification job: %w", err))
+		klog.ErrorS(unexpectedErr, "Failed to build the verification job work", "stage", stageName, "updateRun", klog.KObj(updateRun))
+		return nil, unexpectedErr
+	}
+	return &placementv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				placementv1beta1.TargetUpdateRunLabel:         updateRun.GetName(),
+				placementv1beta1.TargetUpdatingStageNameLabel: stageName,
+				placementv1beta1.VerificationJobWorkLabel:     updateRun.GetNamespace(),
+			},
+		},
+		Spec: placementv1beta1.WorkSpec{
+			Workload: placementv1beta1.WorkloadTemplate{
+				Manifests: []placementv1beta1.Manifest{{RawExtension: runtime.RawExtension{Raw: raw}}},
+			},
+			ReportBackStrategy: &placementv1beta1.ReportBackStrategy{
+				Type:        placementv1beta1.ReportBackStrategyTypeProjected,
+				Destination: ptr.To(placementv1beta1.ReportBackDestinationWorkAPI),
+				StatusPaths: verificationJobStatusPaths,
+			},
+		},
+	}, nil
+}
+
+// refreshClusterVerificationJobStatus refreshes the status of the verification Job on a cluster based on the
+// status of the Work that places it.
+func refreshClusterVerificationJobStatus(
+	clusterStatus *placementv1beta1.ClusterVerificationJobStatus,
+	jobStatus *placementv1beta1.VerificationJobStatus,
+	work *placementv1beta1.Work,
+) {
+	var manifestCond *placementv1beta1.ManifestCondition
+	for i := range work.Status.ManifestConditions {
+		identifier := &work.Status.ManifestConditions[i].Identifier
+		if identifier.Group == batchv1.GroupName && identifier.Kind == utils.JobKind {
+			manifestCond = &work.Status.ManifestConditions[i]
+			break
+		}
+	}
+	if manifestCond == nil {
+		clusterStatus.Message = "The Job has not been applied yet"
+		return
+	}
+	if jobStatus.JobName == "" {
+		// The status might have been lost before it was written; recover it from the Work.
+		jobStatus.JobName = manifestCond.Identifier.Name
+		jobStatus.JobNamespace = manifestCond.Identifier.Namespace
+	}
+	if manifestCond.BackReportedStatus == nil || len(manifestCond.BackReportedStatus.ObservedStatus.Raw) == 0 {
+		appliedCond := meta.FindStatusCondition(manifestCond.Conditions, placementv1beta1.WorkConditionTypeApplied)
+		if appliedCond != nil && appliedCond.Status == metav1.ConditionFalse {
+			clusterStatus.Message = fmt.Sprintf("The Job has not been applied: %s", appliedCond.Message)
+		} else {
+			clusterStatus.Message = "The status of the Job has not been reported yet"
+		}
+		return
+	}
+
+	var observed map[string]json.RawMessage
+	if err := json.Unmarshal(manifestCond.BackReportedStatus.ObservedStatus.Raw, &observed); err != nil {
+		clusterStatus.Message = fmt.Sprintf("Failed to parse the reported status of the Job: %v", err)
+		return
+	}
+	var projected map[string]json.RawMessage
+	if raw, ok := observed[placementv1beta1.ProjectedStatusField]; ok {
+		if err := json.Unmarshal(raw, &projected); err != nil {
+			clusterStatus.Message = fmt.Sprintf("Failed to parse the reported status of the Job: %v", err)
+			return
+		}
+	}
+	var conditions []batchv1.JobCondition
+	if raw, ok := projected[".status.conditions"]; ok {
+		if err := json.Unmarshal(raw, &conditions); err != nil {
+			clusterStatus.Message = fmt.Sprintf("Failed to parse the reported conditions of the Job: %v", err)
+			return
+		}
+	}
+	var terminationMessages []placementv1beta1.ContainerTerminationMessage
+	if raw, ok := observed[placementv1beta1.TerminationMessagesField]; ok {
+		if err := json.Unmarshal(raw, &terminationMessages); err != nil {
+			klog.V(2).InfoS("Failed to parse the reported termination messages of the verification job", "work", klog.KObj(work), "err", err)
+		}
+	}
+	clusterStatus.TerminationMessages = trimTerminationMessages(terminationMessages)
+
+	clusterStatus.Message = "The Job is running"
+	for _, cond := range conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			clusterStatus.Phase = placementv1beta1.VerificationJobPhaseSucceeded
+			clusterStatus.Message = "The Job has completed"
+			return
+		case batchv1.JobFailed:
+			clusterStatus.Phase = placementv1beta1.VerificationJobPhaseFailed
+			clusterStatus.Message = fmt.Sprintf("%s: %s", cond.Reason, cond.Message)
+			return
+		}
+	}
+}
+
+// deleteVerificationJobWorks deletes the Works that place the verification Jobs of an update run.
+// If a stage name is given, only the Works of that stage are deleted.
+func (r *Reconciler) deleteVerificationJobWorks(ctx context.Context, updateRun placementv1beta1.UpdateRunObj, stageName string) error {
+	matchingLabels := client.MatchingLabels{
+		placementv1beta1.TargetUpdateRunLabel:     updateRun.GetName(),
+		placementv1beta1.VerificationJobWorkLabel: updateRun.GetNamespace(),
+	}
+	if stageName != "" {
+		matchingLabels[placementv1beta1.TargetUpdatingStageNameLabel] = stageName
+	}
+	var works placementv1beta1.WorkList
+	if err := r.Client.List(ctx, &works, matchingLabels); err != nil {
+		klog.ErrorS(err, "Failed to list the verification job works", "updateRun", klog.KObj(updateRun))
+		return controller.NewAPIServerError(true, err)
+	}
+	for i := range works.Items {
+		if err := r.Client.Delete(ctx, &works.Items[i]); err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete the verification job work", "work", klog.KObj(&works.Items[i]), "updateRun", klog.KObj(updateRun))
+			return controller.NewAPIServerError(false, err)
+		}
+	}
+	return nil
+}
+
+// findOrAddClusterVerificationJobStatus returns the status of the verification Job on the given cluster,
+// adding one if not found.
+func findOrAddClusterVerificationJobStatus(jobStatus *placementv1beta1.VerificationJobStatus, clusterName string) *placementv1beta1.ClusterVerificationJobStatus {
+	for i := range jobStatus.Clusters {
+		if jobStatus.Clusters[i].ClusterName == clusterName {
+			return &jobStatus.Clusters[i]
+		}
+	}
+	jobStatus.Clusters = append(jobStatus.Clusters, placementv1beta1.ClusterVerificationJobStatus{
+		ClusterName: clusterName,
+		Phase:       placementv1beta1.VerificationJobPhaseRunning,
+	})
+	return &jobStatus.Clusters[len(jobStatus.Clusters)-1]
+}
+
+// trimTerminationMessages keeps the termination messages of the failed containers first, up to
+// maxTerminationMessagesKept, each truncated to its last maxTerminationMessageLength bytes.
+func trimTerminationMessages(messages []placementv1beta1.ContainerTerminationMessage) []placementv1beta1.ContainerTerminationMessage {
+	if len(messages) == 0 {
+		return nil
+	}
+	trimmed := make([]placementv1beta1.ContainerTerminationMessage, len(messages))
+	copy(trimmed, messages)
+	sort.SliceStable(trimmed, func(i, j int) bool {
+		return trimmed[i].ExitCode != 0 && trimmed[j].ExitCode == 0
+	})
+	if len(trimmed) > maxTerminationMessagesKept {
+		trimmed = trimmed[:maxTerminationMessagesKept]
+	}
+	for i := range trimmed {
+		if len(trimmed[i].Message) > maxTerminationMessageLength {
+			// Keep the tail, as it is usually where the logs show what has gone wrong.
+			trimmed[i].Message = "..." + trimmed[i].Message[len(trimmed[i].Message)-maxTerminationMessageLength:]
+		}
+	}
+	return trimmed
+}
+
+// setDefaultTerminationMessagePolicy makes the containers that do not set a termination message policy fall back
+// to their logs if they fail.
+func setDefaultTerminationMessagePolicy(containers []corev1.Container) {
+	for i := range containers {
+		if containers[i].TerminationMessagePolicy == "" {
+			containers[i].TerminationMessagePolicy = corev1.TerminationMessageFallbackToLogsOnError
+		}
+	}
+}
+
+// verificationJobSuffix returns a suffix that is unique to the update run and the stage.
+func verificationJobSuffix(updateRun placementv1beta1.UpdateRunObj, stageName string) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s", updateRun.GetNamespace(), updateRun.GetName(), stageName)))
+	return hex.EncodeToString(hash[:])[:verificationJobSuffixLength]
+}
+
+// verificationJobName returns the name of the verification Jobs, which is the name of the template with the
+// suffix appended, shortened as needed to fit in a label value as Kubernetes requires for Job names.
+func verificationJobName(templateName, suffix string) string {
+	if templateName == "" {
+		templateName = defaultVerificationJobName
+	}
+	maxPrefixLength := 63 - len(suffix) - 1
+	if len(templateName) > maxPrefixLength {
+		templateName = strings.TrimRight(templateName[:maxPrefixLength], "-.")
+	}
+	return fmt.Sprintf("%s-%s", templateName, suffix)
+}
+
+// validateVerificationJobConfig validates the verification job config of a VerificationJob stage task.
+func validateVerificationJobConfig(verificationJob *placementv1beta1.VerificationJobConfig) error {
+	if verificationJob == nil {
+		return fmt.Errorf("verification job config is not set")
+	}
+	if verificationJob.JobTemplateRef.Name == "" {
+		return fmt.Errorf("the name of the job template is not set")
+	}
+	if verificationJob.Timeout != nil && verificationJob.Timeout.Duration <= 0 {
+		return fmt.Errorf("verification job timeout %s must be positive", verificationJob.Timeout.Duration)
+	}
+	return nil
+}
+
+// markAfterStageVerificationJobSucceeded marks the VerificationJob after stage task as succeeded in memory.
+func markAfterStageVerificationJobSucceeded(afterStageTaskStatus *placementv1beta1.StageTaskStatus, generation int64) {
+	meta.SetStatusCondition(&afterStageTaskStatus.Conditions, metav1.Condition{
+		Type:               string(placementv1beta1.StageTaskConditionVerificationJobSucceeded),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             condition.AfterStageTaskVerificationJobSucceededReason,
+		Message:            "The verification jobs on all the clusters in the stage have succeeded",
+	})
+}
+
+// markAfterStageVerificationJobFailed marks the VerificationJob after stage task as failed in memory.
+func markAfterStageVerificationJobFailed(afterStageTaskStatus *placementv1beta1.StageTaskStatus, generation int64, message string) {
+	meta.SetStatusCondition(&afterStageTaskStatus.Conditions, metav1.Condition{
+		Type:               string(placementv1beta1.StageTaskConditionVerificationJobSucceeded),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             condition.AfterStageTaskVerificationJobFailedReason,
+		Message:            message,
+	})
+}
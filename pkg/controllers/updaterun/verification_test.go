@@ -0,0 +1,409 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterun
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+)
+
+func TestHandleStageVerificationJobTask(t *testing.T) {
+	updateRun := &placementv1beta1.StagedUpdateRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-update-run", Namespace: "app", Generation: 1},
+	}
+	workName := fmt.Sprintf(verificationJobWorkNameFmt, verificationJobSuffix(updateRun, "canary"))
+	jobName := verificationJobName("smoke-test", verificationJobSuffix(updateRun, "canary"))
+	envelope := func(obj interface{}) *placementv1beta1.ResourceEnvelope {
+		raw, err := json.Marshal(obj)
+		if err != nil {
+			t.Fatalf("Failed to marshal the envelope data: %v", err)
+		}
+		return &placementv1beta1.ResourceEnvelope{
+			ObjectMeta: metav1.ObjectMeta{Name: "smoke-test", Namespace: "app"},
+			Data:       map[string]runtime.RawExtension{"job.yaml": {Raw: raw}},
+		}
+	}
+	jobTemplate := envelope(&batchv1.Job{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+		ObjectMeta: metav1.ObjectMeta{Name: "smoke-test"},
+		Spec: batchv1.JobSpec{
+			TTLSecondsAfterFinished: ptr.To(int32(60)),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "main", Image: "smoke-test:latest"},
+						{Name: "sidecar", Image: "sidecar:latest", TerminationMessagePolicy: corev1.TerminationMessageReadFile},
+					},
+					RestartPolicy: corev1.RestartPolicyNever,
+				},
+			},
+		},
+	})
+	deploymentTemplate := envelope(map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": map[string]interface{}{"name": "smoke-test"}})
+	verificationWork := func(clusterName string, jobCondition batchv1.JobConditionType, terminationMessages string) *placementv1beta1.Work {
+		work := &placementv1beta1.Work{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      workName,
+				Namespace: fmt.Sprintf(utils.NamespaceNameFormat, clusterName),
+				Labels: map[string]string{
+					placementv1beta1.TargetUpdateRunLabel:         "test-update-run",
+					placementv1beta1.TargetUpdatingStageNameLabel: "canary",
+					placementv1beta1.VerificationJobWorkLabel:     "app",
+				},
+			},
+		}
+		observedStatus := `{"apiVersion":"batch/v1","kind":"Job","projectedStatus":{".status.active":1}}`
+		if jobCondition != "" {
+			observedStatus = fmt.Sprintf(`{"apiVersion":"batch/v1","kind":"Job","projectedStatus":{".status.conditions":[{"type":%q,"status":"True","reason":"BackoffLimitExceeded","message":"Job has reached the specified backoff limit"}]},"terminationMessages":%s}`, jobCondition, terminationMessages)
+		}
+		work.Status.ManifestConditions = []placementv1beta1.ManifestCondition{
+			{
+				Identifier:         placementv1beta1.WorkResourceIdentifier{Group: "batch", Version: "v1", Kind: "Job", Namespace: "app", Name: jobName},
+				BackReportedStatus: &placementv1beta1.BackReportedStatus{ObservedStatus: runtime.RawExtension{Raw: []byte(observedStatus)}},
+			},
+		}
+		return work
+	}
+
+	tests := []struct {
+		name                string
+		objects             []client.Object
+		jobStatus           *placementv1beta1.VerificationJobStatus
+		wantSucceeded       bool
+		wantWaitTime        time.Duration
+		wantErrAborted      bool
+		wantErrMsg          string
+		wantClusterStatuses []placementv1beta1.ClusterVerificationJobStatus
+		wantWorks           []string
+		wantCondition       *metav1.ConditionStatus
+	}{
+		{
+			name:         "works are created",
+			objects:      []client.Object{jobTemplate},
+			wantWaitTime: verificationJobCheckInterval,
+			wantClusterStatuses: []placementv1beta1.ClusterVerificationJobStatus{
+				{ClusterName: "cluster-1", Phase: placementv1beta1.VerificationJobPhaseRunning, Message: "The Job has not been applied yet"},
+				{ClusterName: "cluster-2", Phase: placementv1beta1.VerificationJobPhaseRunning, Message: "The Job has not been applied yet"},
+			},
+			wantWorks: []string{"cluster-1", "cluster-2"},
+		},
+		{
+			name: "jobs are running",
+			objects: []client.Object{
+				verificationWork("cluster-1", batchv1.JobComplete, "[]"),
+				verificationWork("cluster-2", "", ""),
+			},
+			wantWaitTime: verificationJobCheckInterval,
+			wantClusterStatuses: []placementv1beta1.ClusterVerificationJobStatus{
+				{ClusterName: "cluster-1", Phase: placementv1beta1.VerificationJobPhaseSucceeded, Message: "The Job has completed"},
+				{ClusterName: "cluster-2", Phase: placementv1beta1.VerificationJobPhaseRunning, Message: "The Job is running"},
+			},
+			wantWorks: []string{"cluster-1", "cluster-2"},
+		},
+		{
+			name: "all jobs have succeeded",
+			objects: []client.Object{
+				verificationWork("cluster-1", batchv1.JobComplete, "[]"),
+				verificationWork("cluster-2", batchv1.JobComplete, `[{"podName":"smoke-test-x","containerName":"main","message":"ok"}]`),
+			},
+			wantSucceeded: true,
+			wantClusterStatuses: []placementv1beta1.ClusterVerificationJobStatus{
+				{ClusterName: "cluster-1", Phase: placementv1beta1.VerificationJobPhaseSucceeded, Message: "The Job has completed"},
+				{
+					ClusterName: "cluster-2", Phase: placementv1beta1.VerificationJobPhaseSucceeded, Message: "The Job has completed",
+					TerminationMessages: []placementv1beta1.ContainerTerminationMessage{{PodName: "smoke-test-x", ContainerName: "main", Message: "ok"}},
+				},
+			},
+			wantCondition: ptr.To(metav1.ConditionTrue),
+		},
+		{
+			name: "job has failed",
+			objects: []client.Object{
+				verificationWork("cluster-1", batchv1.JobComplete, "[]"),
+				verificationWork("cluster-2", batchv1.JobFailed, `[{"podName":"smoke-test-x","containerName":"main","exitCode":1,"reason":"Error","message":"check foo failed"}]`),
+			},
+			wantErrAborted: true,
+			wantErrMsg:     "the verification job on cluster `cluster-2` has failed: BackoffLimitExceeded: Job has reached the specified backoff limit",
+			wantWaitTime:   -1,
+			wantClusterStatuses: []placementv1beta1.ClusterVerificationJobStatus{
+				{ClusterName: "cluster-1", Phase: placementv1beta1.VerificationJobPhaseSucceeded, Message: "The Job has completed"},
+				{
+					ClusterName: "cluster-2", Phase: placementv1beta1.VerificationJobPhaseFailed, Message: "BackoffLimitExceeded: Job has reached the specified backoff limit",
+					TerminationMessages: []placementv1beta1.ContainerTerminationMessage{{PodName: "smoke-test-x", ContainerName: "main", ExitCode: 1, Reason: "Error", Message: "check foo failed"}},
+				},
+			},
+			wantWorks:     []string{"cluster-1", "cluster-2"},
+			wantCondition: ptr.To(metav1.ConditionFalse),
+		},
+		{
+			name: "jobs have timed out",
+			objects: []client.Object{
+				verificationWork("cluster-1", "", ""),
+				verificationWork("cluster-2", "", ""),
+			},
+			jobStatus:      &placementv1beta1.VerificationJobStatus{StartTime: metav1.NewTime(time.Now().Add(-time.Hour)), JobName: jobName, JobNamespace: "app"},
+			wantErrAborted: true,
+			wantErrMsg:     "the verification jobs on clusters cluster-1, cluster-2 have not completed within 10m0s",
+			wantWaitTime:   -1,
+			wantClusterStatuses: []placementv1beta1.ClusterVerificationJobStatus{
+				{ClusterName: "cluster-1", Phase: placementv1beta1.VerificationJobPhaseRunning, Message: "The Job is running"},
+				{ClusterName: "cluster-2", Phase: placementv1beta1.VerificationJobPhaseRunning, Message: "The Job is running"},
+			},
+			wantWorks:     []string{"cluster-1", "cluster-2"},
+			wantCondition: ptr.To(metav1.ConditionFalse),
+		},
+		{
+			name:           "job template not found",
+			wantErrAborted: true,
+			wantErrMsg:     "the job template app/smoke-test is not found",
+			wantWaitTime:   -1,
+			wantClusterStatuses: []placementv1beta1.ClusterVerificationJobStatus{
+				{ClusterName: "cluster-1", Phase: placementv1beta1.VerificationJobPhaseRunning},
+			},
+			wantCondition: ptr.To(metav1.ConditionFalse),
+		},
+		{
+			name:           "job template does not wrap a job",
+			objects:        []client.Object{deploymentTemplate},
+			wantErrAborted: true,
+			wantErrMsg:     "the job template app/smoke-test must wrap a batch/v1 Job, got apps/v1 Deployment",
+			wantWaitTime:   -1,
+			wantClusterStatuses: []placementv1beta1.ClusterVerificationJobStatus{
+				{ClusterName: "cluster-1", Phase: placementv1beta1.VerificationJobPhaseRunning},
+			},
+			wantCondition: ptr.To(metav1.ConditionFalse),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := placementv1beta1.AddToScheme(scheme); err != nil {
+				t.Fatalf("Failed to add scheme: %v", err)
+			}
+			r := &Reconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...).Build(),
+			}
+			stageStatus := &placementv1beta1.StageUpdatingStatus{
+				StageName: "canary",
+				Clusters: []placementv1beta1.ClusterUpdatingStatus{
+					{ClusterName: "cluster-1"},
+					{ClusterName: "cluster-2"},
+				},
+			}
+			task := &placementv1beta1.StageTask{
+				Type: placementv1beta1.StageTaskTypeVerificationJob,
+				VerificationJob: &placementv1beta1.VerificationJobConfig{
+					JobTemplateRef: placementv1beta1.VerificationJobTemplateReference{Name: "smoke-test"},
+					Timeout:        &metav1.Duration{Duration: 10 * time.Minute},
+				},
+			}
+			taskStatus := &placementv1beta1.StageTaskStatus{
+				Type:                  placementv1beta1.StageTaskTypeVerificationJob,
+				VerificationJobStatus: tt.jobStatus,
+			}
+
+			succeeded, waitTime, err := r.handleStageVerificationJobTask(context.Background(), taskStatus, task, stageStatus, updateRun)
+			if gotAborted := errors.Is(err, errStagedUpdatedAborted); gotAborted != tt.wantErrAborted {
+				t.Fatalf("handleStageVerificationJobTask() error = %v, want aborted %t", err, tt.wantErrAborted)
+			}
+			if tt.wantErrAborted && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("handleStageVerificationJobTask() error = %v, want error containing %q", err, tt.wantErrMsg)
+			}
+			if !tt.wantErrAborted && err != nil {
+				t.Fatalf("handleStageVerificationJobTask() error = %v, want no error", err)
+			}
+			if succeeded != tt.wantSucceeded {
+				t.Errorf("handleStageVerificationJobTask() succeeded = %t, want %t", succeeded, tt.wantSucceeded)
+			}
+			if waitTime != tt.wantWaitTime {
+				t.Errorf("handleStageVerificationJobTask() waitTime = %v, want %v", waitTime, tt.wantWaitTime)
+			}
+			if diff := cmp.Diff(taskStatus.VerificationJobStatus.Clusters, tt.wantClusterStatuses); diff != "" {
+				t.Errorf("cluster verification job statuses mismatch (-got, +want):\n%s", diff)
+			}
+
+			var works placementv1beta1.WorkList
+			if err := r.Client.List(context.Background(), &works); err != nil {
+				t.Fatalf("Failed to list works: %v", err)
+			}
+			var gotWorks []string
+			for i := range works.Items {
+				work := &works.Items[i]
+				gotWorks = append(gotWorks, strings.TrimPrefix(work.Namespace, utils.FleetMemberNamespacePrefix))
+				if work.Name != workName {
+					t.Errorf("work name = %s, want %s", work.Name, workName)
+				}
+			}
+			if diff := cmp.Diff(gotWorks, tt.wantWorks); diff != "" {
+				t.Errorf("works mismatch (-got, +want):\n%s", diff)
+			}
+
+			cond := meta.FindStatusCondition(taskStatus.Conditions, string(placementv1beta1.StageTaskConditionVerificationJobSucceeded))
+			switch {
+			case tt.wantCondition == nil && cond != nil:
+				t.Errorf("condition = %v, want nil", cond)
+			case tt.wantCondition != nil && (cond == nil || cond.Status != *tt.wantCondition):
+				t.Errorf("condition = %v, want status %s", cond, *tt.wantCondition)
+			}
+		})
+	}
+}
+
+func TestBuildVerificationJobWork(t *testing.T) {
+	updateRun := &placementv1beta1.ClusterStagedUpdateRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-update-run"},
+	}
+	envelopeData, err := json.Marshal(&batchv1.Job{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+		ObjectMeta: metav1.ObjectMeta{Name: "smoke-test", Labels: map[string]string{"app": "smoke-test"}},
+		Spec: batchv1.JobSpec{
+			TTLSecondsAfterFinished: ptr.To(int32(60)),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{{Name: "init", Image: "init:latest"}},
+					Containers: []corev1.Container{
+						{Name: "main", Image: "smoke-test:latest"},
+						{Name: "sidecar", Image: "sidecar:latest", TerminationMessagePolicy: corev1.TerminationMessageReadFile},
+					},
+					RestartPolicy: corev1.RestartPolicyNever,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal the job template: %v", err)
+	}
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add scheme: %v", err)
+	}
+	r := &Reconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(&placementv1beta1.ResourceEnvelope{
+			ObjectMeta: metav1.ObjectMeta{Name: "smoke-test", Namespace: "app"},
+			Data:       map[string]runtime.RawExtension{"job.yaml": {Raw: envelopeData}},
+		}).Build(),
+	}
+	config := &placementv1beta1.VerificationJobConfig{
+		JobTemplateRef: placementv1beta1.VerificationJobTemplateReference{Name: "smoke-test", Namespace: "app"},
+	}
+
+	job, err := r.buildVerificationJob(context.Background(), config, updateRun, "canary")
+	if err != nil {
+		t.Fatalf("buildVerificationJob() error = %v, want no error", err)
+	}
+	work, err := buildVerificationJobWork(job, updateRun, "canary")
+	if err != nil {
+		t.Fatalf("buildVerificationJobWork() error = %v, want no error", err)
+	}
+
+	wantJob := &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "smoke-test-" + verificationJobSuffix(updateRun, "canary"),
+			Namespace: "app",
+			Labels:    map[string]string{"app": "smoke-test"},
+		},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{{Name: "init", Image: "init:latest", TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError}},
+					Containers: []corev1.Container{
+						{Name: "main", Image: "smoke-test:latest", TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError},
+						{Name: "sidecar", Image: "sidecar:latest", TerminationMessagePolicy: corev1.TerminationMessageReadFile},
+					},
+					RestartPolicy: corev1.RestartPolicyNever,
+				},
+			},
+		},
+	}
+	var gotJob batchv1.Job
+	if err := json.Unmarshal(work.Spec.Workload.Manifests[0].Raw, &gotJob); err != nil {
+		t.Fatalf("Failed to unmarshal the work manifest: %v", err)
+	}
+	if diff := cmp.Diff(&gotJob, wantJob); diff != "" {
+		t.Errorf("verification job mismatch (-got, +want):\n%s", diff)
+	}
+	wantLabels := map[string]string{
+		placementv1beta1.TargetUpdateRunLabel:         "test-update-run",
+		placementv1beta1.TargetUpdatingStageNameLabel: "canary",
+		placementv1beta1.VerificationJobWorkLabel:     "",
+	}
+	if diff := cmp.Diff(work.Labels, wantLabels); diff != "" {
+		t.Errorf("work labels mismatch (-got, +want):\n%s", diff)
+	}
+	wantReportBackStrategy := &placementv1beta1.ReportBackStrategy{
+		Type:        placementv1beta1.ReportBackStrategyTypeProjected,
+		Destination: ptr.To(placementv1beta1.ReportBackDestinationWorkAPI),
+		StatusPaths: verificationJobStatusPaths,
+	}
+	if diff := cmp.Diff(work.Spec.ReportBackStrategy, wantReportBackStrategy); diff != "" {
+		t.Errorf("work report back strategy mismatch (-got, +want):\n%s", diff)
+	}
+}
+
+func TestVerificationJobName(t *testing.T) {
+	tests := []struct {
+		name         string
+		templateName string
+		want         string
+	}{
+		{
+			name:         "short name",
+			templateName: "smoke-test",
+			want:         "smoke-test-0123456789",
+		},
+		{
+			name: "empty name",
+			want: "verification-0123456789",
+		},
+		{
+			name:         "long name is shortened",
+			templateName: strings.Repeat("a", 51) + "-b",
+			want:         strings.Repeat("a", 51) + "-0123456789",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verificationJobName(tt.templateName, "0123456789")
+			if got != tt.want {
+				t.Errorf("verificationJobName() = %s, want %s", got, tt.want)
+			}
+			if len(got) > 63 {
+				t.Errorf("verificationJobName() has %d characters, want at most 63", len(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,304 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterun
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/stagewebhook"
+)
+
+const (
+	// defaultWebhookTimeout is the default timeout of a single call to a webhook.
+	defaultWebhookTimeout = 5 * time.Second
+
+	// maxWebhookTimeout is the longest timeout allowed for a single call to a webhook; it's kept short as the call
+	// blocks the reconciliation. Webhooks that need more time to decide should ask to be called again later.
+	maxWebhookTimeout = 10 * time.Second
+
+	// defaultWebhookMaxAttempts is the default number of failed calls after which a Webhook task fails.
+	defaultWebhookMaxAttempts = 3
+
+	// defaultWebhookRetryInterval is the default time to wait before calling a webhook again.
+	defaultWebhookRetryInterval = time.Minute
+
+	// maxWebhookCallsKept is the maximum number of calls kept in the status of a Webhook task.
+	maxWebhookCallsKept = 10
+)
+
+// webhookHTTPClient is the HTTP client used to call webhooks; the timeout of each call is set with its context.
+var webhookHTTPClient = &http.Client{}
+
+// handleStageWebhookTask handles the webhook task logic for before or after stage tasks.
+// It returns true if the webhook has approved the stage, and the time to wait before calling the webhook again
+// if it has not. An errStagedUpdatedAborted error is returned if the webhook has rejected the stage or the calls
+// to it have failed too many times.
+func (r *Reconciler) handleStageWebhookTask(
+	ctx context.Context,
+	stageTaskStatus *placementv1beta1.StageTaskStatus,
+	task *placementv1beta1.StageTask,
+	updatingStageStatus *placementv1beta1.StageUpdatingStatus,
+	updateRun placementv1beta1.UpdateRunObj,
+	stageTaskType string,
+) (bool, time.Duration, error) {
+	updateRunRef := klog.KObj(updateRun)
+	stageName := updatingStageStatus.StageName
+
+	webhookCond := meta.FindStatusCondition(stageTaskStatus.Conditions, string(placementv1beta1.StageTaskConditionWebhookApproved))
+	if condition.IsConditionStatusTrue(webhookCond, updateRun.GetGeneration()) {
+		// The webhook has approved the stage.
+		return true, 0, nil
+	}
+	if task.Webhook == nil {
+		// This should have been caught by the validation.
+		unexpectedErr := controller.NewUnexpectedBehaviorError(fmt.Errorf("the webhook task in stage `%s` has no webhook config", stageName))
+		klog.ErrorS(unexpectedErr, "Found a webhook task without webhook config", "stage", stageName, "updateRun", updateRunRef)
+		return false, -1, fmt.Errorf("%w: %s", errStagedUpdatedAborted, unexpectedErr.Error())
+	}
+	webhook := task.Webhook
+
+	now := time.Now()
+	if stageTaskStatus.WebhookStatus == nil {
+		stageTaskStatus.WebhookStatus = &placementv1beta1.WebhookStatus{}
+	}
+	webhookStatus := stageTaskStatus.WebhookStatus
+	if webhookStatus.NextAttemptTime != nil {
+		if remaining := webhookStatus.NextAttemptTime.Sub(now); remaining > 0 {
+			klog.V(2).InfoS("The next call to the webhook is not due yet", "remaining", remaining, "stage", stageName, "updateRun", updateRunRef)
+			return false, remaining, nil
+		}
+	}
+
+	headers, err := r.getWebhookHeaders(ctx, webhook.HeadersSecretRef, updateRun)
+	if err != nil && !errors.Is(err, controller.ErrUserError) {
+		// API server errors are not counted as failed calls; the reconciliation will be retried.
+		return false, -1, err
+	}
+	webhookStatus.Attempts++
+	call := placementv1beta1.WebhookCall{
+		Attempt:      webhookStatus.Attempts,
+		CalledAt:     metav1.NewTime(now),
+		ClusterCount: int32(len(updatingStageStatus.Clusters)),
+	}
+	var resp *stagewebhook.Response
+	if err != nil {
+		call.Result = placementv1beta1.WebhookCallResultError
+		call.Message = err.Error()
+	} else {
+		timeout := defaultWebhookTimeout
+		if webhook.Timeout != nil && webhook.Timeout.Duration > 0 {
+			timeout = webhook.Timeout.Duration
+		}
+		request := buildWebhookRequest(updateRun, updatingStageStatus, stageTaskType, call.Attempt)
+		var statusCode int
+		statusCode, resp, err = stagewebhook.Call(ctx, webhookHTTPClient, webhook.URL, headers, timeout, request)
+		call.StatusCode = int32(statusCode)
+		if err != nil {
+			call.Result = placementv1beta1.WebhookCallResultError
+			call.Message = err.Error()
+		} else {
+			call.Message = resp.Message
+			switch resp.Decision {
+			case stagewebhook.DecisionApprove:
+				call.Result = placementv1beta1.WebhookCallResultApproved
+			case stagewebhook.DecisionReject:
+				call.Result = placementv1beta1.WebhookCallResultRejected
+			default:
+				call.Result = placementv1beta1.WebhookCallResultRetryLater
+			}
+		}
+	}
+	klog.V(2).InfoS("Called the webhook", "attempt", call.Attempt, "statusCode", call.StatusCode, "result", call.Result, "message", call.Message, "stage", stageName, "updateRun", updateRunRef)
+	recordWebhookCall(webhookStatus, call)
+
+	retryInterval := defaultWebhookRetryInterval
+	maxAttempts := int32(defaultWebhookMaxAttempts)
+	if webhook.RetryPolicy != nil {
+		if webhook.RetryPolicy.Interval != nil && webhook.RetryPolicy.Interval.Duration > 0 {
+			retryInterval = webhook.RetryPolicy.Interval.Duration
+		}
+		if webhook.RetryPolicy.MaxAttempts > 0 {
+			maxAttempts = webhook.RetryPolicy.MaxAttempts
+		}
+	}
+
+	switch call.Result {
+	case placementv1beta1.WebhookCallResultApproved:
+		webhookStatus.NextAttemptTime = nil
+		klog.V(2).InfoS("The webhook has approved the stage", "stage", stageName, "updateRun", updateRunRef)
+		markStageTaskWebhookApproved(stageTaskStatus, updateRun.GetGeneration(), call.Message)
+		return true, 0, nil
+	case placementv1beta1.WebhookCallResultRejected:
+		webhookStatus.NextAttemptTime = nil
+		rejectedErr := controller.NewUserError(fmt.Errorf("the webhook has rejected the stage: %s", call.Message))
+		klog.ErrorS(rejectedErr, "The webhook task has failed", "stage", stageName, "updateRun", updateRunRef)
+		markStageTaskWebhookFailed(stageTaskStatus, updateRun.GetGeneration(), condition.StageTaskWebhookRejectedReason, rejectedErr.Error())
+		return false, -1, fmt.Errorf("%w: %s", errStagedUpdatedAborted, rejectedErr.Error())
+	case placementv1beta1.WebhookCallResultRetryLater:
+		waitTime := retryInterval
+		if retryAfter := resp.RetryAfter(); retryAfter > 0 {
+			waitTime = retryAfter
+		}
+		webhookStatus.NextAttemptTime = &metav1.Time{Time: now.Add(waitTime)}
+		return false, waitTime, nil
+	default:
+		webhookStatus.FailedAttempts++
+		if webhookStatus.FailedAttempts >= maxAttempts {
+			webhookStatus.NextAttemptTime = nil
+			failedErr := controller.NewUserError(fmt.Errorf("the calls to the webhook have failed %d times, the last error: %s", webhookStatus.FailedAttempts, call.Message))
+			klog.ErrorS(failedErr, "The webhook task has failed", "stage", stageName, "updateRun", updateRunRef)
+			markStageTaskWebhookFailed(stageTaskStatus, updateRun.GetGeneration(), condition.StageTaskWebhookFailedReason, failedErr.Error())
+			return false, -1, fmt.Errorf("%w: %s", errStagedUpdatedAborted, failedErr.Error())
+		}
+		webhookStatus.NextAttemptTime = &metav1.Time{Time: now.Add(retryInterval)}
+		return false, retryInterval, nil
+	}
+}
+
+// getWebhookHeaders reads the HTTP headers of a webhook from the Secret it refers to.
+// A user error is returned if the Secret cannot be used.
+func (r *Reconciler) getWebhookHeaders(
+	ctx context.Context,
+	secretRef *placementv1beta1.WebhookSecretReference,
+	updateRun placementv1beta1.UpdateRunObj,
+) (map[string]string, error) {
+	if secretRef == nil {
+		return nil, nil
+	}
+	namespace := secretRef.Namespace
+	if updateRun.GetNamespace() != "" {
+		// Namespaced update runs can only read Secrets in their own namespace.
+		if namespace != "" && namespace != updateRun.GetNamespace() {
+			return nil, controller.NewUserError(fmt.Errorf("the headers secret must be in the namespace %s of the update run, got %s", updateRun.GetNamespace(), namespace))
+		}
+		namespace = updateRun.GetNamespace()
+	}
+	if namespace == "" {
+		return nil, controller.NewUserError(fmt.Errorf("the namespace of the headers secret %s is not specified", secretRef.Name))
+	}
+
+	reader := r.UncachedReader
+	if reader == nil {
+		reader = r.Client
+	}
+	secretKey := client.ObjectKey{Namespace: namespace, Name: secretRef.Name}
+	var secret corev1.Secret
+	if err := reader.Get(ctx, secretKey, &secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, controller.NewUserError(fmt.Errorf("the headers secret %s is not found", secretKey))
+		}
+		klog.ErrorS(err, "Failed to get the webhook headers secret", "secret", secretKey, "updateRun", klog.KObj(updateRun))
+		return nil, controller.NewAPIServerError(reader == r.Client, err)
+	}
+	if secret.Labels[placementv1beta1.WebhookHeadersSecretLabel] != "true" {
+		return nil, controller.NewUserError(fmt.Errorf("the headers secret %s does not have the label %s=true", secretKey, placementv1beta1.WebhookHeadersSecretLabel))
+	}
+	headers := make(map[string]string, len(secret.Data))
+	for name, value := range secret.Data {
+		headers[name] = string(value)
+	}
+	return headers, nil
+}
+
+// buildWebhookRequest builds the request sent to the webhook of a stage.
+func buildWebhookRequest(
+	updateRun placementv1beta1.UpdateRunObj,
+	updatingStageStatus *placementv1beta1.StageUpdatingStatus,
+	stageTaskType string,
+	attempt int32,
+) *stagewebhook.Request {
+	clusterNames := make([]string, 0, len(updatingStageStatus.Clusters))
+	for i := range updatingStageStatus.Clusters {
+		clusterNames = append(clusterNames, updatingStageStatus.Clusters[i].ClusterName)
+	}
+	return &stagewebhook.Request{
+		UpdateRun: stagewebhook.ObjectReference{Name: updateRun.GetName(), Namespace: updateRun.GetNamespace()},
+		Placement: stagewebhook.ObjectReference{
+			Name:      updateRun.GetUpdateRunSpec().PlacementName,
+			Namespace: updateRun.GetNamespace(),
+		},
+		ResourceSnapshotIndex: updateRun.GetUpdateRunStatus().ResourceSnapshotIndexUsed,
+		Stage:                 updatingStageStatus.StageName,
+		TaskType:              stageTaskType,
+		Clusters:              clusterNames,
+		Attempt:               attempt,
+	}
+}
+
+// recordWebhookCall records a call in the status of the webhook task.
+func recordWebhookCall(webhookStatus *placementv1beta1.WebhookStatus, call placementv1beta1.WebhookCall) {
+	webhookStatus.Calls = append(webhookStatus.Calls, call)
+	if len(webhookStatus.Calls) > maxWebhookCallsKept {
+		webhookStatus.Calls = webhookStatus.Calls[len(webhookStatus.Calls)-maxWebhookCallsKept:]
+	}
+}
+
+// validateWebhookConfig validates the webhook config of a Webhook stage task.
+func validateWebhookConfig(webhook *placementv1beta1.WebhookConfig) error {
+	if webhook == nil {
+		return fmt.Errorf("webhook config is not set")
+	}
+	if webhook.URL == "" {
+		return fmt.Errorf("webhook URL is not set")
+	}
+	if webhook.Timeout != nil && (webhook.Timeout.Duration <= 0 || webhook.Timeout.Duration > maxWebhookTimeout) {
+		return fmt.Errorf("webhook timeout %s must be positive and at most %s", webhook.Timeout.Duration, maxWebhookTimeout)
+	}
+	if webhook.RetryPolicy != nil && webhook.RetryPolicy.Interval != nil && webhook.RetryPolicy.Interval.Duration <= 0 {
+		return fmt.Errorf("webhook retry interval %s must be positive", webhook.RetryPolicy.Interval.Duration)
+	}
+	return nil
+}
+
+// markStageTaskWebhookApproved marks the Webhook stage task as approved in memory.
+func markStageTaskWebhookApproved(stageTaskStatus *placementv1beta1.StageTaskStatus, generation int64, message string) {
+	if message == "" {
+		message = "The webhook has approved the stage"
+	}
+	meta.SetStatusCondition(&stageTaskStatus.Conditions, metav1.Condition{
+		Type:               string(placementv1beta1.StageTaskConditionWebhookApproved),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             condition.StageTaskWebhookApprovedReason,
+		Message:            message,
+	})
+}
+
+// markStageTaskWebhookFailed marks the Webhook stage task as failed in memory.
+func markStageTaskWebhookFailed(stageTaskStatus *placementv1beta1.StageTaskStatus, generation int64, reason, message string) {
+	meta.SetStatusCondition(&stageTaskStatus.Conditions, metav1.Condition{
+		Type:               string(placementv1beta1.StageTaskConditionWebhookApproved),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
@@ -0,0 +1,331 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updaterun
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/stagewebhook"
+)
+
+func TestHandleStageWebhookTask(t *testing.T) {
+	headersSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "change-management",
+			Namespace: "fleet-system",
+			Labels:    map[string]string{placementv1beta1.WebhookHeadersSecretLabel: "true"},
+		},
+		Data: map[string][]byte{"Authorization": []byte("Bearer token")},
+	}
+	unlabeledSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "unlabeled", Namespace: "fleet-system"},
+		Data:       map[string][]byte{"Authorization": []byte("Bearer token")},
+	}
+
+	tests := []struct {
+		name              string
+		status            int
+		response          string
+		secretRef         *placementv1beta1.WebhookSecretReference
+		webhookStatus     *placementv1beta1.WebhookStatus
+		wantCalled        bool
+		wantAuthorization string
+		wantApproved      bool
+		wantWaitTime      time.Duration
+		wantErrAborted    bool
+		wantWebhookStatus *placementv1beta1.WebhookStatus
+		wantCondition     *metav1.ConditionStatus
+	}{
+		{
+			name:              "approved",
+			status:            http.StatusOK,
+			response:          `{"decision": "Approve", "message": "change CHG-42 is approved"}`,
+			secretRef:         &placementv1beta1.WebhookSecretReference{Name: "change-management", Namespace: "fleet-system"},
+			wantCalled:        true,
+			wantAuthorization: "Bearer token",
+			wantApproved:      true,
+			wantWebhookStatus: &placementv1beta1.WebhookStatus{
+				Attempts: 1,
+				Calls: []placementv1beta1.WebhookCall{
+					{Attempt: 1, ClusterCount: 2, StatusCode: http.StatusOK, Result: placementv1beta1.WebhookCallResultApproved, Message: "change CHG-42 is approved"},
+				},
+			},
+			wantCondition: ptr.To(metav1.ConditionTrue),
+		},
+		{
+			name:           "rejected",
+			status:         http.StatusOK,
+			response:       `{"decision": "Reject", "message": "change freeze"}`,
+			wantCalled:     true,
+			wantErrAborted: true,
+			wantWaitTime:   -1,
+			wantWebhookStatus: &placementv1beta1.WebhookStatus{
+				Attempts: 1,
+				Calls: []placementv1beta1.WebhookCall{
+					{Attempt: 1, ClusterCount: 2, StatusCode: http.StatusOK, Result: placementv1beta1.WebhookCallResultRejected, Message: "change freeze"},
+				},
+			},
+			wantCondition: ptr.To(metav1.ConditionFalse),
+		},
+		{
+			name:         "retry later after the specified time",
+			status:       http.StatusOK,
+			response:     `{"decision": "RetryLater", "retryAfterSeconds": 300}`,
+			wantCalled:   true,
+			wantWaitTime: 5 * time.Minute,
+			wantWebhookStatus: &placementv1beta1.WebhookStatus{
+				Attempts: 1,
+				Calls: []placementv1beta1.WebhookCall{
+					{Attempt: 1, ClusterCount: 2, StatusCode: http.StatusOK, Result: placementv1beta1.WebhookCallResultRetryLater},
+				},
+			},
+		},
+		{
+			name:         "server error is retried",
+			status:       http.StatusServiceUnavailable,
+			response:     "unavailable",
+			wantCalled:   true,
+			wantWaitTime: 10 * time.Second,
+			wantWebhookStatus: &placementv1beta1.WebhookStatus{
+				Attempts:       1,
+				FailedAttempts: 1,
+				Calls: []placementv1beta1.WebhookCall{
+					{Attempt: 1, ClusterCount: 2, StatusCode: http.StatusServiceUnavailable, Result: placementv1beta1.WebhookCallResultError, Message: "the webhook responded with HTTP status 503: unavailable"},
+				},
+			},
+		},
+		{
+			name:           "malformed response exhausts the attempts",
+			status:         http.StatusOK,
+			response:       `{"decision": "Maybe"}`,
+			webhookStatus:  &placementv1beta1.WebhookStatus{Attempts: 1, FailedAttempts: 1},
+			wantCalled:     true,
+			wantErrAborted: true,
+			wantWaitTime:   -1,
+			wantWebhookStatus: &placementv1beta1.WebhookStatus{
+				Attempts:       2,
+				FailedAttempts: 2,
+				Calls: []placementv1beta1.WebhookCall{
+					{Attempt: 2, ClusterCount: 2, StatusCode: http.StatusOK, Result: placementv1beta1.WebhookCallResultError, Message: `the webhook responded with an unknown decision "Maybe", want one of Approve, Reject, and RetryLater`},
+				},
+			},
+			wantCondition: ptr.To(metav1.ConditionFalse),
+		},
+		{
+			name:         "secret without the label is not used",
+			secretRef:    &placementv1beta1.WebhookSecretReference{Name: "unlabeled", Namespace: "fleet-system"},
+			wantWaitTime: 10 * time.Second,
+			wantWebhookStatus: &placementv1beta1.WebhookStatus{
+				Attempts:       1,
+				FailedAttempts: 1,
+				Calls: []placementv1beta1.WebhookCall{
+					{Attempt: 1, ClusterCount: 2, Result: placementv1beta1.WebhookCallResultError, Message: "failed to process the request due to a client error: the headers secret fleet-system/unlabeled does not have the label kubernetes-fleet.io/webhook-headers=true"},
+				},
+			},
+		},
+		{
+			name:          "next attempt not due yet",
+			webhookStatus: &placementv1beta1.WebhookStatus{Attempts: 1, NextAttemptTime: &metav1.Time{Time: time.Now().Add(30 * time.Second)}},
+			wantWaitTime:  30 * time.Second,
+			wantWebhookStatus: &placementv1beta1.WebhookStatus{
+				Attempts: 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotRequest *stagewebhook.Request
+			var gotAuthorization string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				gotRequest = &stagewebhook.Request{}
+				if err := json.NewDecoder(req.Body).Decode(gotRequest); err != nil {
+					t.Errorf("Failed to decode the webhook request: %v", err)
+				}
+				gotAuthorization = req.Header.Get("Authorization")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			scheme := runtime.NewScheme()
+			if err := corev1.AddToScheme(scheme); err != nil {
+				t.Fatalf("Failed to add scheme: %v", err)
+			}
+			r := &Reconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(headersSecret, unlabeledSecret).Build(),
+			}
+			updateRun := &placementv1beta1.ClusterStagedUpdateRun{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-update-run", Generation: 1},
+				Spec:       placementv1beta1.UpdateRunSpec{PlacementName: "test-placement"},
+				Status:     placementv1beta1.UpdateRunStatus{ResourceSnapshotIndexUsed: "3"},
+			}
+			stageStatus := &placementv1beta1.StageUpdatingStatus{
+				StageName: "canary",
+				Clusters: []placementv1beta1.ClusterUpdatingStatus{
+					{ClusterName: "cluster-1"},
+					{ClusterName: "cluster-2"},
+				},
+			}
+			task := &placementv1beta1.StageTask{
+				Type: placementv1beta1.StageTaskTypeWebhook,
+				Webhook: &placementv1beta1.WebhookConfig{
+					URL:              server.URL,
+					HeadersSecretRef: tt.secretRef,
+					RetryPolicy: &placementv1beta1.WebhookRetryPolicy{
+						MaxAttempts: 2,
+						Interval:    &metav1.Duration{Duration: 10 * time.Second},
+					},
+				},
+			}
+			taskStatus := &placementv1beta1.StageTaskStatus{
+				Type:          placementv1beta1.StageTaskTypeWebhook,
+				WebhookStatus: tt.webhookStatus,
+			}
+
+			approved, waitTime, err := r.handleStageWebhookTask(context.Background(), taskStatus, task, stageStatus, updateRun, placementv1beta1.BeforeStageTaskLabelValue)
+			if gotAborted := errors.Is(err, errStagedUpdatedAborted); gotAborted != tt.wantErrAborted {
+				t.Fatalf("handleStageWebhookTask() error = %v, want aborted %t", err, tt.wantErrAborted)
+			}
+			if !tt.wantErrAborted && err != nil {
+				t.Fatalf("handleStageWebhookTask() error = %v, want no error", err)
+			}
+			if approved != tt.wantApproved {
+				t.Errorf("handleStageWebhookTask() approved = %t, want %t", approved, tt.wantApproved)
+			}
+			// Allow some slack for the time elapsed during the test.
+			if diff := waitTime - tt.wantWaitTime; diff > 0 || diff < -5*time.Second {
+				t.Errorf("handleStageWebhookTask() waitTime = %v, want %v", waitTime, tt.wantWaitTime)
+			}
+
+			if gotCalled := gotRequest != nil; gotCalled != tt.wantCalled {
+				t.Fatalf("webhook called = %t, want %t", gotCalled, tt.wantCalled)
+			}
+			if tt.wantCalled {
+				wantRequest := &stagewebhook.Request{
+					UpdateRun:             stagewebhook.ObjectReference{Name: "test-update-run"},
+					Placement:             stagewebhook.ObjectReference{Name: "test-placement"},
+					ResourceSnapshotIndex: "3",
+					Stage:                 "canary",
+					TaskType:              placementv1beta1.BeforeStageTaskLabelValue,
+					Clusters:              []string{"cluster-1", "cluster-2"},
+					Attempt:               tt.wantWebhookStatus.Attempts,
+				}
+				if diff := cmp.Diff(gotRequest, wantRequest); diff != "" {
+					t.Errorf("webhook request mismatch (-got, +want):\n%s", diff)
+				}
+				if gotAuthorization != tt.wantAuthorization {
+					t.Errorf("webhook request Authorization header = %q, want %q", gotAuthorization, tt.wantAuthorization)
+				}
+			}
+
+			if diff := cmp.Diff(taskStatus.WebhookStatus, tt.wantWebhookStatus,
+				cmpopts.IgnoreFields(placementv1beta1.WebhookCall{}, "CalledAt"),
+				cmpopts.IgnoreFields(placementv1beta1.WebhookStatus{}, "NextAttemptTime")); diff != "" {
+				t.Errorf("webhook status mismatch (-got, +want):\n%s", diff)
+			}
+			cond := meta.FindStatusCondition(taskStatus.Conditions, string(placementv1beta1.StageTaskConditionWebhookApproved))
+			switch {
+			case tt.wantCondition == nil && cond != nil:
+				t.Errorf("WebhookApproved condition = %v, want nil", cond)
+			case tt.wantCondition != nil && (cond == nil || cond.Status != *tt.wantCondition):
+				t.Errorf("WebhookApproved condition = %v, want status %s", cond, *tt.wantCondition)
+			}
+		})
+	}
+}
+
+func TestGetWebhookHeaders(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "headers",
+			Namespace: "team-a",
+			Labels:    map[string]string{placementv1beta1.WebhookHeadersSecretLabel: "true"},
+		},
+		Data: map[string][]byte{"X-Api-Key": []byte("key")},
+	}
+	tests := []struct {
+		name        string
+		secretRef   *placementv1beta1.WebhookSecretReference
+		updateRun   placementv1beta1.UpdateRunObj
+		wantHeaders map[string]string
+		wantErr     bool
+	}{
+		{
+			name:      "no secret",
+			updateRun: &placementv1beta1.ClusterStagedUpdateRun{},
+		},
+		{
+			name:        "namespaced update run defaults to its own namespace",
+			secretRef:   &placementv1beta1.WebhookSecretReference{Name: "headers"},
+			updateRun:   &placementv1beta1.StagedUpdateRun{ObjectMeta: metav1.ObjectMeta{Name: "run", Namespace: "team-a"}},
+			wantHeaders: map[string]string{"X-Api-Key": "key"},
+		},
+		{
+			name:      "namespaced update run cannot read secrets in other namespaces",
+			secretRef: &placementv1beta1.WebhookSecretReference{Name: "headers", Namespace: "team-a"},
+			updateRun: &placementv1beta1.StagedUpdateRun{ObjectMeta: metav1.ObjectMeta{Name: "run", Namespace: "team-b"}},
+			wantErr:   true,
+		},
+		{
+			name:      "cluster-scoped update run requires the namespace",
+			secretRef: &placementv1beta1.WebhookSecretReference{Name: "headers"},
+			updateRun: &placementv1beta1.ClusterStagedUpdateRun{ObjectMeta: metav1.ObjectMeta{Name: "run"}},
+			wantErr:   true,
+		},
+		{
+			name:      "secret not found",
+			secretRef: &placementv1beta1.WebhookSecretReference{Name: "missing", Namespace: "team-a"},
+			updateRun: &placementv1beta1.ClusterStagedUpdateRun{ObjectMeta: metav1.ObjectMeta{Name: "run"}},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := corev1.AddToScheme(scheme); err != nil {
+				t.Fatalf("Failed to add scheme: %v", err)
+			}
+			var reader client.Reader = fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+			r := &Reconciler{UncachedReader: reader}
+			gotHeaders, err := r.getWebhookHeaders(context.Background(), tt.secretRef, tt.updateRun)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getWebhookHeaders() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(gotHeaders, tt.wantHeaders); diff != "" {
+				t.Errorf("getWebhookHeaders() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
 	"k8s.io/apimachinery/pkg/util/mergepatch"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/dynamic"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/v2"
 	"k8s.io/kubectl/pkg/util/deployment"
@@ -48,11 +49,20 @@ func init() {
 }
 
 // applyInDryRunMode dry-runs an apply op.
+//
+// The dry-run apply op runs with the same identity as the actual apply op, so that the admission
+// and defaulting behaviors (and any permission errors) match those of the actual apply op.
 func (r *Reconciler) applyInDryRunMode(
 	ctx context.Context,
 	gvr *schema.GroupVersionResource,
 	manifestObj, inMemberClusterObj *unstructured.Unstructured,
+	applyStrategy *fleetv1beta1.ApplyStrategy,
 ) (*unstructured.Unstructured, error) {
+	applierDynamicClient, err := r.applierDynamicClientFor(applyStrategy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare the client for the dry-run apply op: %w", err)
+	}
+
 	// In this method, Fleet will always use forced server-side apply
 	// w/o optimistic lock for diff calculation.
 	//
@@ -61,7 +71,7 @@ func (r *Reconciler) applyInDryRunMode(
 	// before the comparison.
 	//
 	// Note that full comparison can be carried out directly without involving the apply op.
-	return r.serverSideApply(ctx, gvr, manifestObj, inMemberClusterObj, true, false, true)
+	return r.serverSideApply(ctx, applierDynamicClient, gvr, manifestObj, inMemberClusterObj, true, false, true)
 }
 
 func (r *Reconciler) apply(
@@ -84,6 +94,13 @@ func (r *Reconciler) apply(
 	// backwards compatibility concerns.
 	manifestObjCopy := sanitizeManifestObject(manifestObj)
 
+	// Pick the client to run the apply op with; if the apply strategy requests impersonation,
+	// the apply op will be subject to the permissions granted to the impersonated identity.
+	applierDynamicClient, err := r.applierDynamicClientFor(applyStrategy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare the client for the apply op: %w", err)
+	}
+
 	// Compute the hash of the manifest object.
 	//
 	// Originally the manifest hash is kept only if three-way merge patch (client side apply)
@@ -129,7 +146,7 @@ func (r *Reconciler) apply(
 
 	// Create the object if it does not exist in the member cluster.
 	if inMemberClusterObj == nil {
-		return r.createManifestObject(ctx, gvr, manifestObjCopy)
+		return r.createManifestObject(ctx, applierDynamicClient, gvr, manifestObjCopy)
 	}
 
 	// Note: originally Fleet will add its owner reference and
@@ -159,7 +176,7 @@ func (r *Reconciler) apply(
 		// has been set.
 		klog.V(2).InfoS("Using three-way merge patch to apply the manifest object",
 			"GVR", *gvr, "manifestObj", klog.KObj(manifestObjCopy))
-		return r.threeWayMergePatch(ctx, gvr, manifestObjCopy, inMemberClusterObj, isOptimisticLockEnabled, false)
+		return r.threeWayMergePatch(ctx, applierDynamicClient, gvr, manifestObjCopy, inMemberClusterObj, isOptimisticLockEnabled, false)
 	case applyStrategy.Type == fleetv1beta1.ApplyStrategyTypeClientSideApply:
 		// The apply strategy dictates that three-way merge patch
 		// (client-side apply) should be used, but the last applied annotation
@@ -167,7 +184,7 @@ func (r *Reconciler) apply(
 		klog.V(2).InfoS("Falling back to server-side apply as the last applied annotation cannot be set",
 			"GVR", *gvr, "manifestObj", klog.KObj(manifestObjCopy))
 		return r.serverSideApply(
-			ctx,
+			ctx, applierDynamicClient,
 			gvr, manifestObjCopy, inMemberClusterObj,
 			// When falling back to SSA, always disable force apply ops (this is also the default
 			// behavior).
@@ -181,7 +198,7 @@ func (r *Reconciler) apply(
 		klog.V(2).InfoS("Using server-side apply to apply the manifest object",
 			"GVR", *gvr, "manifestObj", klog.KObj(manifestObjCopy))
 		return r.serverSideApply(
-			ctx,
+			ctx, applierDynamicClient,
 			gvr, manifestObjCopy, inMemberClusterObj,
 			applyStrategy.ServerSideApplyConfig.ForceConflicts, isOptimisticLockEnabled, false,
 		)
@@ -197,13 +214,14 @@ func (r *Reconciler) apply(
 // createManifestObject creates the manifest object in the member cluster.
 func (r *Reconciler) createManifestObject(
 	ctx context.Context,
+	dynamicClient dynamic.Interface,
 	gvr *schema.GroupVersionResource,
 	manifestObject *unstructured.Unstructured,
 ) (*unstructured.Unstructured, error) {
 	createOpts := metav1.CreateOptions{
 		FieldManager: workFieldManagerName,
 	}
-	createdObj, err := r.spokeDynamicClient.Resource(*gvr).Namespace(manifestObject.GetNamespace()).Create(ctx, manifestObject, createOpts)
+	createdObj, err := dynamicClient.Resource(*gvr).Namespace(manifestObject.GetNamespace()).Create(ctx, manifestObject, createOpts)
 	if err != nil {
 		wrappedErr := controller.NewAPIServerError(false, err)
 		return nil, fmt.Errorf("failed to create manifest object: %w", wrappedErr)
@@ -215,6 +233,7 @@ func (r *Reconciler) createManifestObject(
 // threeWayMergePatch uses three-way merge patch to apply the manifest object.
 func (r *Reconciler) threeWayMergePatch(
 	ctx context.Context,
+	dynamicClient dynamic.Interface,
 	gvr *schema.GroupVersionResource,
 	manifestObj, inMemberClusterObj *unstructured.Unstructured,
 	optimisticLock, dryRun bool,
@@ -261,7 +280,7 @@ func (r *Reconciler) threeWayMergePatch(
 	if dryRun {
 		patchOpts.DryRun = []string{metav1.DryRunAll}
 	}
-	patchedObj, err := r.spokeDynamicClient.
+	patchedObj, err := dynamicClient.
 		Resource(*gvr).Namespace(manifestObj.GetNamespace()).
 		Patch(ctx, manifestObj.GetName(), patch.Type(), data, patchOpts)
 	if err != nil {
@@ -274,6 +293,7 @@ func (r *Reconciler) threeWayMergePatch(
 // serverSideApply uses server-side apply to apply the manifest object.
 func (r *Reconciler) serverSideApply(
 	ctx context.Context,
+	dynamicClient dynamic.Interface,
 	gvr *schema.GroupVersionResource,
 	manifestObj, inMemberClusterObj *unstructured.Unstructured,
 	force, optimisticLock, dryRun bool,
@@ -317,7 +337,7 @@ func (r *Reconciler) serverSideApply(
 	if dryRun {
 		applyOpts.DryRun = []string{metav1.DryRunAll}
 	}
-	appliedObj, err := r.spokeDynamicClient.
+	appliedObj, err := dynamicClient.
 		Resource(*gvr).Namespace(manifestObj.GetNamespace()).
 		Apply(ctx, manifestObj.GetName(), manifestObj, applyOpts)
 	if err != nil {
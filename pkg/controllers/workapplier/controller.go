@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
@@ -41,8 +42,11 @@ import (
 	ctrloption "sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
@@ -111,6 +115,16 @@ type Reconciler struct {
 	parallelizer         parallelizerutil.Parallelizer
 	requeueRateLimiter   *RequeueMultiStageWithExponentialBackoffRateLimiter
 	usePriorityQueue     bool
+	// The cache of dynamic clients that impersonate specific identities on the member cluster
+	// side, as requested by the apply strategies of Work objects. It is nil if no member
+	// cluster config is provided, in which case impersonation is not supported.
+	impersonatedClients *impersonatedDynamicClientCache
+	// The local cache of Work objects, which helps keep enforcing Work objects when the hub
+	// cluster is not reachable. It is nil if offline operation is not enabled.
+	localWorkCache *LocalWorkCache
+	// hubDisconnected is set by the offline work enforcer when the hub cluster is not reachable;
+	// the regular reconciliation loop is paused in this case.
+	hubDisconnected atomic.Bool
 	// The custom priority queue in use if the option watchWorkWithPriorityQueue is enabled.
 	//
 	// Note that this variable is set only after the controller starts.
@@ -126,6 +140,7 @@ func NewReconciler(
 	controllerName string,
 	hubClient client.Client, workNameSpace string,
 	spokeDynamicClient dynamic.Interface, spokeClient client.Client, restMapper meta.RESTMapper,
+	spokeConfig *rest.Config, agentNamespace string,
 	recorder record.EventRecorder,
 	concurrentReconciles int,
 	parallelizer parallelizerutil.Parallelizer,
@@ -149,6 +164,12 @@ func NewReconciler(
 		priorityLinearEquationCoeffA = ptr.To(-3)
 		priorityLinearEquationCoeffB = ptr.To(int(highestPriorityLevel))
 	}
+	var impersonatedClients *impersonatedDynamicClientCache
+	if spokeConfig != nil {
+		impersonatedClients = newImpersonatedDynamicClientCache(spokeConfig, agentNamespace)
+	} else {
+		klog.V(2).InfoS("member cluster config is not set; impersonation in apply strategies will not be supported")
+	}
 
 	return &Reconciler{
 		controllerName:       controllerName,
@@ -157,6 +178,7 @@ func NewReconciler(
 		spokeClient:          spokeClient,
 		restMapper:           restMapper,
 		recorder:             recorder,
+		impersonatedClients:  impersonatedClients,
 		concurrentReconciles: concurrentReconciles,
 		parallelizer:         parallelizer,
 		workNameSpace:        workNameSpace,
@@ -309,6 +331,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		klog.V(2).InfoS("Work applier has not started yet", "work", req.NamespacedName)
 		return ctrl.Result{RequeueAfter: time.Second * 5}, nil
 	}
+	if r.hubDisconnected.Load() {
+		klog.V(2).InfoS("The hub cluster is not reachable; the Work object is enforced from the local cache", "work", req.NamespacedName)
+		return ctrl.Result{RequeueAfter: hubDisconnectedRequeueDelay}, nil
+	}
 	startTime := time.Now()
 	klog.V(2).InfoS("Work applier reconciliation starts", "work", req.NamespacedName)
 	defer func() {
@@ -322,6 +348,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	switch {
 	case apierrors.IsNotFound(err):
 		klog.V(2).InfoS("Work object has been deleted", "work", req.NamespacedName)
+		if err := r.removeFromLocalWorkCache(req.Namespace, req.Name); err != nil {
+			return ctrl.Result{}, err
+		}
 		return ctrl.Result{}, nil
 	case err != nil:
 		klog.ErrorS(err, "Failed to retrieve the work", "work", req.NamespacedName)
@@ -333,6 +362,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	// Garbage collect the AppliedWork object if the Work object has been deleted.
 	if !work.DeletionTimestamp.IsZero() {
 		klog.V(2).InfoS("Work object has been marked for deletion; start garbage collection", work.Kind, workRef)
+		if err := r.removeFromLocalWorkCache(work.Namespace, work.Name); err != nil {
+			return ctrl.Result{}, err
+		}
 		return r.garbageCollectAppliedWork(ctx, work)
 	}
 
@@ -410,6 +442,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
+	// Keep the Work object in the local cache (if enabled), so that it can still be enforced
+	// when the hub cluster is not reachable.
+	if r.localWorkCache != nil {
+		if err := r.localWorkCache.Store(work); err != nil {
+			// Failing to cache the Work object does not affect the current reconciliation loop.
+			klog.ErrorS(err, "Failed to store the work object in the local cache", "work", workRef)
+		}
+	}
+
 	trackWorkAndManifestProcessingRequestMetrics(work)
 
 	// Requeue the Work object with a delay based on the requeue rate limiter.
@@ -656,6 +697,25 @@ func (r *Reconciler) Leave(ctx context.Context) error {
 	}
 	klog.V(2).InfoS("Successfully removed all the work finalizers in the cluster namespace",
 		"clusterNS", r.workNameSpace, "number of work", len(works.Items))
+	// The member cluster has left the fleet; the cached Work objects should no longer be enforced.
+	if r.localWorkCache != nil {
+		if err := r.localWorkCache.Purge(); err != nil {
+			klog.ErrorS(err, "Failed to purge the local work cache")
+			return err
+		}
+	}
+	return nil
+}
+
+// removeFromLocalWorkCache removes a Work object from the local cache (if enabled).
+func (r *Reconciler) removeFromLocalWorkCache(namespace, name string) error {
+	if r.localWorkCache == nil {
+		return nil
+	}
+	if err := r.localWorkCache.Remove(namespace, name); err != nil {
+		klog.ErrorS(err, "Failed to remove the work object from the local cache", "work", klog.KRef(namespace, name))
+		return err
+	}
 	return nil
 }
 
@@ -696,3 +756,21 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		For(&fleetv1beta1.Work{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Complete(r)
 }
+
+// SetupWithManagerAndEventSource wires up the controller with a channel of Work object events
+// rather than a watch on the hub cluster; this is used when the member agent reaches the hub cluster
+// via the pull-based hub transport, in which case the controller runs with the member cluster
+// controller manager.
+//
+// The priority queue is not supported in this mode.
+func (r *Reconciler) SetupWithManagerAndEventSource(mgr ctrl.Manager, workEvents <-chan event.GenericEvent) error {
+	if r.usePriorityQueue {
+		return fmt.Errorf("the priority queue is not supported when Work object events come from a channel")
+	}
+	return ctrl.NewControllerManagedBy(mgr).Named(r.controllerName).
+		WithOptions(ctrloption.Options{
+			MaxConcurrentReconciles: r.concurrentReconciles,
+		}).
+		WatchesRawSource(source.Channel(workEvents, &handler.EnqueueRequestForObject{})).
+		Complete(r)
+}
@@ -91,7 +91,7 @@ func (r *Reconciler) takeOverPreExistingObject(
 	//
 	// Note that the default takeover action is AlwaysApply.
 	if applyStrategy.WhenToTakeOver == fleetv1beta1.WhenToTakeOverTypeIfNoDiff {
-		configDiffs, diffCalculatedInDegradedMode, err := r.diffBetweenManifestAndInMemberClusterObjects(ctx, gvr, manifestObj, inMemberClusterObjCopy, applyStrategy.ComparisonOption)
+		configDiffs, diffCalculatedInDegradedMode, err := r.diffBetweenManifestAndInMemberClusterObjects(ctx, gvr, manifestObj, inMemberClusterObjCopy, applyStrategy)
 		switch {
 		case err != nil:
 			return nil, nil, false, fmt.Errorf("failed to calculate configuration diffs between the manifest object and the object from the member cluster: %w", err)
@@ -101,9 +101,16 @@ func (r *Reconciler) takeOverPreExistingObject(
 	}
 
 	// Take over the object.
+	//
+	// Note that the takeover op is considered as a part of the apply op; if the apply strategy
+	// requests impersonation, the impersonated identity must be allowed to update the object.
+	applierDynamicClient, err := r.applierDynamicClientFor(applyStrategy)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("failed to prepare the client for the takeover op: %w", err)
+	}
 	updatedOwnerRefs := append(existingOwnerRefs, *expectedAppliedWorkOwnerRef)
 	inMemberClusterObjCopy.SetOwnerReferences(updatedOwnerRefs)
-	takenOverInMemberClusterObj, err := r.spokeDynamicClient.
+	takenOverInMemberClusterObj, err := applierDynamicClient.
 		Resource(*gvr).Namespace(inMemberClusterObjCopy.GetNamespace()).
 		Update(ctx, inMemberClusterObjCopy, metav1.UpdateOptions{})
 	if err != nil {
@@ -115,16 +122,16 @@ func (r *Reconciler) takeOverPreExistingObject(
 }
 
 // diffBetweenManifestAndInMemberClusterObjects calculates the differences between the manifest object
-// and its corresponding object in the member cluster.
+// and its corresponding object in the member cluster, using the comparison option in the apply strategy.
 func (r *Reconciler) diffBetweenManifestAndInMemberClusterObjects(
 	ctx context.Context,
 	gvr *schema.GroupVersionResource,
 	manifestObj, inMemberClusterObj *unstructured.Unstructured,
-	cmpOption fleetv1beta1.ComparisonOptionType,
+	applyStrategy *fleetv1beta1.ApplyStrategy,
 ) ([]fleetv1beta1.PatchDetail, bool, error) {
-	switch cmpOption {
+	switch applyStrategy.ComparisonOption {
 	case fleetv1beta1.ComparisonOptionTypePartialComparison:
-		return r.partialDiffBetweenManifestAndInMemberClusterObjects(ctx, gvr, manifestObj, inMemberClusterObj)
+		return r.partialDiffBetweenManifestAndInMemberClusterObjects(ctx, gvr, manifestObj, inMemberClusterObj, applyStrategy)
 	case fleetv1beta1.ComparisonOptionTypeFullComparison:
 		// For the full comparison, Fleet compares directly the JSON representations of the
 		// manifest object and the object in the member cluster.
@@ -143,10 +150,11 @@ func (r *Reconciler) partialDiffBetweenManifestAndInMemberClusterObjects(
 	ctx context.Context,
 	gvr *schema.GroupVersionResource,
 	manifestObj, inMemberClusterObj *unstructured.Unstructured,
+	applyStrategy *fleetv1beta1.ApplyStrategy,
 ) ([]fleetv1beta1.PatchDetail, bool, error) {
 	// Fleet calculates the partial diff between two objects by running apply ops in the dry-run
-	// mode.
-	appliedObj, err := r.applyInDryRunMode(ctx, gvr, manifestObj, inMemberClusterObj)
+	// mode, with the identity that the apply strategy specifies.
+	appliedObj, err := r.applyInDryRunMode(ctx, gvr, manifestObj, inMemberClusterObj, applyStrategy)
 
 	// After the dry-run apply op, all the managed fields should have been overwritten using the
 	// values from the manifest object, while leaving all the unmanaged fields untouched. This
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// serviceAccountUserNameFmt is the format of the user name that Kubernetes assigns to
+	// a service account.
+	serviceAccountUserNameFmt = "system:serviceaccount:%s:%s"
+
+	// reservedIdentityPrefix is the prefix of the user and group names that Kubernetes reserves
+	// for system components, e.g., `system:masters` and `system:kube-controller-manager`; such
+	// identities are never impersonated, as they are privileged on every member cluster.
+	reservedIdentityPrefix = "system:"
+
+	// reservedServiceAccountNamespace is the namespace of the service accounts of the system
+	// controllers, which are never impersonated for the same reason. Service accounts in the
+	// namespace of the member agent itself are refused as well, as they share its privileges.
+	reservedServiceAccountNamespace = "kube-system"
+)
+
+// impersonatedDynamicClientCache builds and caches dynamic clients that impersonate
+// specific identities on the member cluster side.
+//
+// Clients are keyed by the impersonated identity (user name and groups) rather than by the
+// Work object, so that all the Work objects that share the same apply identity share the same
+// client (and its underlying connection pool).
+type impersonatedDynamicClientCache struct {
+	mu         sync.Mutex
+	baseConfig *rest.Config
+	// agentNamespace is the namespace where the member agent runs; its service accounts are
+	// never impersonated.
+	agentNamespace string
+	clients        map[string]dynamic.Interface
+	// newForConfig is the function used to build a dynamic client; it is kept as a field
+	// for testing purposes.
+	newForConfig func(*rest.Config) (dynamic.Interface, error)
+}
+
+// newImpersonatedDynamicClientCache returns a new impersonatedDynamicClientCache that builds
+// clients from the given base config.
+func newImpersonatedDynamicClientCache(baseConfig *rest.Config, agentNamespace string) *impersonatedDynamicClientCache {
+	return &impersonatedDynamicClientCache{
+		baseConfig:     baseConfig,
+		agentNamespace: agentNamespace,
+		clients:        make(map[string]dynamic.Interface),
+		newForConfig: func(c *rest.Config) (dynamic.Interface, error) {
+			return dynamic.NewForConfig(c)
+		},
+	}
+}
+
+// get returns a dynamic client that impersonates the given identity; a new client
+// will be built if none has been cached yet.
+func (c *impersonatedDynamicClientCache) get(impersonation *fleetv1beta1.ImpersonationConfig) (dynamic.Interface, error) {
+	impCfg, err := buildImpersonationConfig(impersonation, c.agentNamespace)
+	if err != nil {
+		return nil, err
+	}
+	key := impersonationCacheKey(impCfg)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if client, ok := c.clients[key]; ok {
+		return client, nil
+	}
+
+	cfg := rest.CopyConfig(c.baseConfig)
+	cfg.Impersonate = impCfg
+	client, err := c.newForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build an impersonating dynamic client for user %s: %w", impCfg.UserName, err)
+	}
+	c.clients[key] = client
+	klog.V(2).InfoS("Built a new impersonating dynamic client", "user", impCfg.UserName, "groups", impCfg.Groups)
+	return client, nil
+}
+
+// buildImpersonationConfig converts the impersonation settings in an apply strategy to
+// a client-go impersonation config; service accounts in the given member agent namespace are refused.
+func buildImpersonationConfig(impersonation *fleetv1beta1.ImpersonationConfig, agentNamespace string) (rest.ImpersonationConfig, error) {
+	var userName string
+	switch {
+	case impersonation.ServiceAccount != nil && impersonation.User != nil:
+		return rest.ImpersonationConfig{}, fmt.Errorf("both a service account and a user are specified for impersonation")
+	case impersonation.ServiceAccount != nil:
+		sa := impersonation.ServiceAccount
+		if len(sa.Namespace) == 0 || len(sa.Name) == 0 {
+			return rest.ImpersonationConfig{}, fmt.Errorf("the service account to impersonate must have both a namespace and a name (got %+v)", *sa)
+		}
+		if sa.Namespace == reservedServiceAccountNamespace {
+			return rest.ImpersonationConfig{}, fmt.Errorf("service accounts in the %s namespace cannot be impersonated (got %s)", reservedServiceAccountNamespace, sa.Name)
+		}
+		if len(agentNamespace) > 0 && sa.Namespace == agentNamespace {
+			return rest.ImpersonationConfig{}, fmt.Errorf("service accounts in the namespace of the member agent (%s) cannot be impersonated (got %s)", agentNamespace, sa.Name)
+		}
+		userName = fmt.Sprintf(serviceAccountUserNameFmt, sa.Namespace, sa.Name)
+	case impersonation.User != nil && len(*impersonation.User) > 0:
+		userName = *impersonation.User
+		if strings.HasPrefix(userName, reservedIdentityPrefix) {
+			return rest.ImpersonationConfig{}, fmt.Errorf("users with the reserved prefix %q cannot be impersonated (got %s)", reservedIdentityPrefix, userName)
+		}
+	default:
+		return rest.ImpersonationConfig{}, fmt.Errorf("no service account or user is specified for impersonation")
+	}
+
+	for _, group := range impersonation.Groups {
+		if strings.HasPrefix(group, reservedIdentityPrefix) {
+			return rest.ImpersonationConfig{}, fmt.Errorf("groups with the reserved prefix %q cannot be impersonated (got %s)", reservedIdentityPrefix, group)
+		}
+	}
+	var groups []string
+	if len(impersonation.Groups) > 0 {
+		groups = slices.Clone(impersonation.Groups)
+		slices.Sort(groups)
+		groups = slices.Compact(groups)
+	}
+	return rest.ImpersonationConfig{
+		UserName: userName,
+		Groups:   groups,
+	}, nil
+}
+
+// impersonationCacheKey returns the key for an impersonated identity in the client cache.
+func impersonationCacheKey(impCfg rest.ImpersonationConfig) string {
+	return fmt.Sprintf("%s|%s", impCfg.UserName, strings.Join(impCfg.Groups, ","))
+}
+
+// applierDynamicClientFor returns the dynamic client that the work applier should use to
+// run apply ops with the given apply strategy.
+//
+// If no impersonation is requested, the member agent's own client is returned.
+func (r *Reconciler) applierDynamicClientFor(applyStrategy *fleetv1beta1.ApplyStrategy) (dynamic.Interface, error) {
+	if applyStrategy == nil || applyStrategy.Impersonation == nil {
+		return r.spokeDynamicClient, nil
+	}
+	if r.impersonatedClients == nil {
+		return nil, fmt.Errorf("impersonation is requested in the apply strategy but the work applier is not configured to support impersonation")
+	}
+	return r.impersonatedClients.get(applyStrategy.Impersonation)
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+)
+
+// TestBuildImpersonationConfig tests the buildImpersonationConfig function.
+func TestBuildImpersonationConfig(t *testing.T) {
+	testCases := []struct {
+		name          string
+		impersonation *fleetv1beta1.ImpersonationConfig
+		want          rest.ImpersonationConfig
+		wantErred     bool
+	}{
+		{
+			name: "service account",
+			impersonation: &fleetv1beta1.ImpersonationConfig{
+				ServiceAccount: &fleetv1beta1.ServiceAccountReference{
+					Namespace: nsName,
+					Name:      "deployer",
+				},
+			},
+			want: rest.ImpersonationConfig{
+				UserName: "system:serviceaccount:" + nsName + ":deployer",
+			},
+		},
+		{
+			name: "user with groups (unsorted, duplicated)",
+			impersonation: &fleetv1beta1.ImpersonationConfig{
+				User:   ptr.To("alice"),
+				Groups: []string{"team-b", "team-a", "team-b"},
+			},
+			want: rest.ImpersonationConfig{
+				UserName: "alice",
+				Groups:   []string{"team-a", "team-b"},
+			},
+		},
+		{
+			name: "both service account and user",
+			impersonation: &fleetv1beta1.ImpersonationConfig{
+				ServiceAccount: &fleetv1beta1.ServiceAccountReference{
+					Namespace: nsName,
+					Name:      "deployer",
+				},
+				User: ptr.To("alice"),
+			},
+			wantErred: true,
+		},
+		{
+			name: "service account with no namespace",
+			impersonation: &fleetv1beta1.ImpersonationConfig{
+				ServiceAccount: &fleetv1beta1.ServiceAccountReference{
+					Name: "deployer",
+				},
+			},
+			wantErred: true,
+		},
+		{
+			name: "empty user",
+			impersonation: &fleetv1beta1.ImpersonationConfig{
+				User: ptr.To(""),
+			},
+			wantErred: true,
+		},
+		{
+			name: "privileged group",
+			impersonation: &fleetv1beta1.ImpersonationConfig{
+				User:   ptr.To("alice"),
+				Groups: []string{"team-a", "system:masters"},
+			},
+			wantErred: true,
+		},
+		{
+			name: "reserved user",
+			impersonation: &fleetv1beta1.ImpersonationConfig{
+				User: ptr.To("system:kube-controller-manager"),
+			},
+			wantErred: true,
+		},
+		{
+			name: "service account in the kube-system namespace",
+			impersonation: &fleetv1beta1.ImpersonationConfig{
+				ServiceAccount: &fleetv1beta1.ServiceAccountReference{
+					Namespace: "kube-system",
+					Name:      "clusterrole-aggregation-controller",
+				},
+			},
+			wantErred: true,
+		},
+		{
+			name: "service account in the member agent namespace",
+			impersonation: &fleetv1beta1.ImpersonationConfig{
+				ServiceAccount: &fleetv1beta1.ServiceAccountReference{
+					Namespace: utils.FleetSystemNamespace,
+					Name:      "member-agent-sa",
+				},
+			},
+			wantErred: true,
+		},
+		{
+			name:          "no identity",
+			impersonation: &fleetv1beta1.ImpersonationConfig{},
+			wantErred:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := buildImpersonationConfig(tc.impersonation, utils.FleetSystemNamespace)
+			if tc.wantErred {
+				if err == nil {
+					t.Errorf("buildImpersonationConfig() = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildImpersonationConfig() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("buildImpersonationConfig() mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestApplierDynamicClientFor tests the applierDynamicClientFor method.
+func TestApplierDynamicClientFor(t *testing.T) {
+	defaultClient := fake.NewSimpleDynamicClient(scheme.Scheme)
+	saImpersonation := &fleetv1beta1.ImpersonationConfig{
+		ServiceAccount: &fleetv1beta1.ServiceAccountReference{
+			Namespace: nsName,
+			Name:      "deployer",
+		},
+	}
+
+	t.Run("no impersonation", func(t *testing.T) {
+		r := &Reconciler{
+			spokeDynamicClient: defaultClient,
+		}
+		for _, applyStrategy := range []*fleetv1beta1.ApplyStrategy{nil, {Type: fleetv1beta1.ApplyStrategyTypeServerSideApply}} {
+			got, err := r.applierDynamicClientFor(applyStrategy)
+			if err != nil {
+				t.Fatalf("applierDynamicClientFor() = %v, want no error", err)
+			}
+			if got != dynamic.Interface(defaultClient) {
+				t.Errorf("applierDynamicClientFor() did not return the default client")
+			}
+		}
+	})
+
+	t.Run("impersonation not supported", func(t *testing.T) {
+		r := &Reconciler{
+			spokeDynamicClient: defaultClient,
+		}
+		if _, err := r.applierDynamicClientFor(&fleetv1beta1.ApplyStrategy{Impersonation: saImpersonation}); err == nil {
+			t.Errorf("applierDynamicClientFor() = nil, want error")
+		}
+	})
+
+	t.Run("impersonating clients are cached per identity", func(t *testing.T) {
+		var builtConfigs []*rest.Config
+		cache := newImpersonatedDynamicClientCache(&rest.Config{Host: "https://member.example.com"}, utils.FleetSystemNamespace)
+		cache.newForConfig = func(c *rest.Config) (dynamic.Interface, error) {
+			builtConfigs = append(builtConfigs, c)
+			return fake.NewSimpleDynamicClient(scheme.Scheme), nil
+		}
+		r := &Reconciler{
+			spokeDynamicClient:  defaultClient,
+			impersonatedClients: cache,
+		}
+
+		saClient1, err := r.applierDynamicClientFor(&fleetv1beta1.ApplyStrategy{Impersonation: saImpersonation})
+		if err != nil {
+			t.Fatalf("applierDynamicClientFor() = %v, want no error", err)
+		}
+		saClient2, err := r.applierDynamicClientFor(&fleetv1beta1.ApplyStrategy{Impersonation: saImpersonation.DeepCopy()})
+		if err != nil {
+			t.Fatalf("applierDynamicClientFor() = %v, want no error", err)
+		}
+		if saClient1 != saClient2 {
+			t.Errorf("applierDynamicClientFor() returned different clients for the same identity")
+		}
+		if saClient1 == dynamic.Interface(defaultClient) {
+			t.Errorf("applierDynamicClientFor() returned the default client for an impersonated identity")
+		}
+
+		userClient, err := r.applierDynamicClientFor(&fleetv1beta1.ApplyStrategy{Impersonation: &fleetv1beta1.ImpersonationConfig{User: ptr.To("alice")}})
+		if err != nil {
+			t.Fatalf("applierDynamicClientFor() = %v, want no error", err)
+		}
+		if userClient == saClient1 {
+			t.Errorf("applierDynamicClientFor() returned the same client for different identities")
+		}
+
+		wantImpersonations := []rest.ImpersonationConfig{
+			{UserName: "system:serviceaccount:" + nsName + ":deployer"},
+			{UserName: "alice"},
+		}
+		gotImpersonations := make([]rest.ImpersonationConfig, 0, len(builtConfigs))
+		for _, c := range builtConfigs {
+			if c.Host != "https://member.example.com" {
+				t.Errorf("built client config host = %s, want %s", c.Host, "https://member.example.com")
+			}
+			gotImpersonations = append(gotImpersonations, c.Impersonate)
+		}
+		if diff := cmp.Diff(gotImpersonations, wantImpersonations); diff != "" {
+			t.Errorf("built client impersonation configs mismatch (-got, +want):\n%s", diff)
+		}
+	})
+}
+
+// TestDiffBetweenManifestAndInMemberClusterObjects_Impersonation tests that the dry-run apply op for
+// partial comparison runs with the identity requested in the apply strategy.
+func TestDiffBetweenManifestAndInMemberClusterObjects_Impersonation(t *testing.T) {
+	ctx := context.Background()
+	manifestObj := toUnstructured(t, deploy.DeepCopy())
+	inMemberClusterObj := toUnstructured(t, deploy.DeepCopy())
+
+	newRecordingClient := func(patched *[]string, identity string) dynamic.Interface {
+		c := fake.NewSimpleDynamicClient(scheme.Scheme)
+		c.PrependReactor("patch", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			*patched = append(*patched, identity)
+			return true, inMemberClusterObj.DeepCopy(), nil
+		})
+		return c
+	}
+
+	var patchedBy []string
+	cache := newImpersonatedDynamicClientCache(&rest.Config{Host: "https://member.example.com"}, utils.FleetSystemNamespace)
+	cache.newForConfig = func(c *rest.Config) (dynamic.Interface, error) {
+		return newRecordingClient(&patchedBy, c.Impersonate.UserName), nil
+	}
+	r := &Reconciler{
+		spokeDynamicClient:  newRecordingClient(&patchedBy, "member-agent"),
+		impersonatedClients: cache,
+	}
+
+	testCases := []struct {
+		name          string
+		applyStrategy *fleetv1beta1.ApplyStrategy
+		wantPatchedBy []string
+	}{
+		{
+			name: "no impersonation",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{
+				ComparisonOption: fleetv1beta1.ComparisonOptionTypePartialComparison,
+			},
+			wantPatchedBy: []string{"member-agent"},
+		},
+		{
+			name: "impersonation",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{
+				ComparisonOption: fleetv1beta1.ComparisonOptionTypePartialComparison,
+				Impersonation:    &fleetv1beta1.ImpersonationConfig{User: ptr.To("alice")},
+			},
+			wantPatchedBy: []string{"alice"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			patchedBy = nil
+			if _, _, err := r.diffBetweenManifestAndInMemberClusterObjects(ctx, &utils.DeploymentGVR, manifestObj, inMemberClusterObj, tc.applyStrategy); err != nil {
+				t.Fatalf("diffBetweenManifestAndInMemberClusterObjects() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(patchedBy, tc.wantPatchedBy); diff != "" {
+				t.Errorf("dry-run apply op identities mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
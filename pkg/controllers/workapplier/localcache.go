@@ -0,0 +1,291 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/writefile"
+)
+
+const (
+	localWorkCacheFileExt     = ".json"
+	localWorkCacheTmpFileExt  = ".tmp"
+	localWorkCacheFileNameFmt = "%s_%s" + localWorkCacheFileExt
+
+	// hubConnectivityStateFileName is the name of the file that keeps the hub connectivity
+	// state; it does not have the cache file extension, so that it is not listed as a Work object.
+	hubConnectivityStateFileName = "hub-connectivity.state"
+)
+
+// LocalWorkCache persists the last known Work objects on the member cluster side (e.g., on a
+// file system backed by a persistent volume), so that the work applier can keep enforcing them
+// when the hub cluster is not reachable, even across agent restarts.
+//
+// Each Work object is kept in its own file in the cache directory.
+type LocalWorkCache struct {
+	dir string
+
+	mu sync.Mutex
+	// resourceVersions tracks the resource versions of the cached Work objects, so that
+	// unchanged objects will not be written again.
+	resourceVersions map[string]string
+}
+
+// CachedWork is a Work object kept in the local cache.
+type CachedWork struct {
+	// Work is the last known Work object, including its status.
+	Work *fleetv1beta1.Work `json:"work"`
+	// StatusPendingReplay is true if the status of the Work object has been refreshed while
+	// the hub cluster is not reachable and is yet to be reported back to the hub cluster.
+	StatusPendingReplay bool `json:"statusPendingReplay,omitempty"`
+}
+
+// hubConnectivityState is the hub connectivity state kept in the cache, so that the offline
+// duration survives agent restarts.
+type hubConnectivityState struct {
+	// DisconnectedSince is when the member agent found the hub cluster unreachable.
+	DisconnectedSince metav1.Time `json:"disconnectedSince"`
+}
+
+// NewLocalWorkCache returns a local work cache that keeps its data in the given directory.
+// The directory is created if it does not exist yet.
+func NewLocalWorkCache(dir string) (*LocalWorkCache, error) {
+	if len(dir) == 0 {
+		return nil, fmt.Errorf("the local work cache directory must be specified")
+	}
+	if err := os.MkdirAll(filepath.Clean(dir), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create the local work cache directory %s: %w", dir, err)
+	}
+	return &LocalWorkCache{
+		dir:              filepath.Clean(dir),
+		resourceVersions: make(map[string]string),
+	}, nil
+}
+
+// Store writes the given Work object to the cache, as retrieved from the hub cluster.
+//
+// The write is skipped if the cache already keeps the same version of the Work object.
+func (c *LocalWorkCache) Store(work *fleetv1beta1.Work) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := c.fileNameFor(work.Namespace, work.Name)
+	if rv, ok := c.resourceVersions[key]; ok && rv == work.ResourceVersion && len(rv) > 0 {
+		return nil
+	}
+	if err := c.write(key, &CachedWork{Work: work}); err != nil {
+		return err
+	}
+	c.resourceVersions[key] = work.ResourceVersion
+	return nil
+}
+
+// StoreOfflineStatus writes the given Work object to the cache with a status that has been
+// refreshed while the hub cluster is not reachable; the status will be replayed to the hub
+// cluster once connectivity is restored.
+func (c *LocalWorkCache) StoreOfflineStatus(work *fleetv1beta1.Work) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := c.fileNameFor(work.Namespace, work.Name)
+	if err := c.write(key, &CachedWork{Work: work, StatusPendingReplay: true}); err != nil {
+		return err
+	}
+	// Always write the next version retrieved from the hub cluster.
+	delete(c.resourceVersions, key)
+	return nil
+}
+
+// Remove deletes a Work object from the cache.
+func (c *LocalWorkCache) Remove(namespace, name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := c.fileNameFor(namespace, name)
+	delete(c.resourceVersions, key)
+	if err := os.Remove(filepath.Join(c.dir, key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove work %s/%s from the local cache: %w", namespace, name, err)
+	}
+	return nil
+}
+
+// Purge deletes all Work objects from the cache.
+func (c *LocalWorkCache) Purge() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fileNames, err := c.listFileNames()
+	if err != nil {
+		return err
+	}
+	for _, fileName := range fileNames {
+		if err := os.Remove(filepath.Join(c.dir, fileName)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove %s from the local cache: %w", fileName, err)
+		}
+	}
+	c.resourceVersions = make(map[string]string)
+	if err := os.Remove(filepath.Join(c.dir, hubConnectivityStateFileName)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove the hub connectivity state from the local cache: %w", err)
+	}
+	return nil
+}
+
+// StoreDisconnectedSince records in the cache when the hub cluster became unreachable.
+func (c *LocalWorkCache) StoreDisconnectedSince(since time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.Marshal(&hubConnectivityState{DisconnectedSince: metav1.NewTime(since)})
+	if err != nil {
+		return fmt.Errorf("failed to encode the hub connectivity state for the local cache: %w", err)
+	}
+	return c.writeFile(hubConnectivityStateFileName, data)
+}
+
+// LoadDisconnectedSince returns when the hub cluster became unreachable, as recorded in the
+// cache; it returns nil if the hub cluster is not known to be unreachable.
+func (c *LocalWorkCache) LoadDisconnectedSince() (*time.Time, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := os.ReadFile(filepath.Join(c.dir, hubConnectivityStateFileName))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to read the hub connectivity state from the local cache: %w", err)
+	}
+	state := &hubConnectivityState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to decode the hub connectivity state from the local cache: %w", err)
+	}
+	return &state.DisconnectedSince.Time, nil
+}
+
+// ClearDisconnectedSince removes the record of the hub cluster being unreachable from the cache.
+func (c *LocalWorkCache) ClearDisconnectedSince() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := os.Remove(filepath.Join(c.dir, hubConnectivityStateFileName)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove the hub connectivity state from the local cache: %w", err)
+	}
+	return nil
+}
+
+// List returns all the Work objects in the cache, sorted by their names.
+//
+// Entries that cannot be read or decoded are skipped and reported in the returned error; the
+// valid entries are still returned.
+func (c *LocalWorkCache) List() ([]*CachedWork, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fileNames, err := c.listFileNames()
+	if err != nil {
+		return nil, err
+	}
+
+	cachedWorks := make([]*CachedWork, 0, len(fileNames))
+	var errs []error
+	for _, fileName := range fileNames {
+		data, err := os.ReadFile(filepath.Join(c.dir, fileName))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to read %s from the local cache: %w", fileName, err))
+			continue
+		}
+		cachedWork := &CachedWork{}
+		if err := json.Unmarshal(data, cachedWork); err != nil || cachedWork.Work == nil {
+			errs = append(errs, fmt.Errorf("failed to decode %s from the local cache: %w", fileName, err))
+			continue
+		}
+		cachedWorks = append(cachedWorks, cachedWork)
+	}
+	return cachedWorks, errors.Join(errs...)
+}
+
+// listFileNames returns the names of all the cache files, sorted. The caller must hold the lock.
+func (c *LocalWorkCache) listFileNames() ([]string, error) {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the local work cache directory %s: %w", c.dir, err)
+	}
+	fileNames := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), localWorkCacheFileExt) {
+			continue
+		}
+		fileNames = append(fileNames, entry.Name())
+	}
+	sort.Strings(fileNames)
+	return fileNames, nil
+}
+
+// write writes a cache entry. The caller must hold the lock.
+func (c *LocalWorkCache) write(fileName string, cachedWork *CachedWork) error {
+	data, err := json.Marshal(cachedWork)
+	if err != nil {
+		return fmt.Errorf("failed to encode work %s for the local cache: %w", fileName, err)
+	}
+	return c.writeFile(fileName, data)
+}
+
+// writeFile writes a file in the cache directory atomically (write to a temporary file, then
+// rename). The caller must hold the lock.
+func (c *LocalWorkCache) writeFile(fileName string, data []byte) error {
+	path := filepath.Join(c.dir, fileName)
+	tmpPath := path + localWorkCacheTmpFileExt
+	f, err := writefile.CreateSecureFile(tmpPath)
+	if err != nil {
+		return fmt.Errorf("failed to create a temporary file for %s in the local cache: %w", fileName, err)
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write %s to the local cache: %w", fileName, err)
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to flush %s to the local cache: %w", fileName, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close the temporary file for %s in the local cache: %w", fileName, err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to commit %s to the local cache: %w", fileName, err)
+	}
+	return nil
+}
+
+// fileNameFor returns the name of the cache file for a Work object.
+//
+// Note that underscores are not allowed in Kubernetes object names, so the file name is
+// guaranteed to be unique.
+func (c *LocalWorkCache) fileNameFor(namespace, name string) string {
+	return fmt.Sprintf(localWorkCacheFileNameFmt, namespace, name)
+}
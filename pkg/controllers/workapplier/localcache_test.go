@@ -0,0 +1,153 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func newCachedTestWork(name, resourceVersion string) *fleetv1beta1.Work {
+	return &fleetv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       memberReservedNSName1,
+			ResourceVersion: resourceVersion,
+		},
+	}
+}
+
+// TestLocalWorkCache tests the basic operations of the LocalWorkCache.
+func TestLocalWorkCache(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "work-cache")
+	cache, err := NewLocalWorkCache(dir)
+	if err != nil {
+		t.Fatalf("NewLocalWorkCache() = %v, want no error", err)
+	}
+
+	work1 := newCachedTestWork("work-1", "1")
+	work2 := newCachedTestWork("work-2", "1")
+	if err := cache.Store(work1); err != nil {
+		t.Fatalf("Store() = %v, want no error", err)
+	}
+	if err := cache.Store(work2); err != nil {
+		t.Fatalf("Store() = %v, want no error", err)
+	}
+
+	// Update the status of the second Work object while offline.
+	work2Offline := work2.DeepCopy()
+	work2Offline.Status.Conditions = []metav1.Condition{
+		{
+			Type:   fleetv1beta1.WorkConditionTypeApplied,
+			Status: metav1.ConditionTrue,
+			Reason: "Applied",
+		},
+	}
+	if err := cache.StoreOfflineStatus(work2Offline); err != nil {
+		t.Fatalf("StoreOfflineStatus() = %v, want no error", err)
+	}
+
+	got, err := cache.List()
+	if err != nil {
+		t.Fatalf("List() = %v, want no error", err)
+	}
+	want := []*CachedWork{
+		{Work: work1},
+		{Work: work2Offline, StatusPendingReplay: true},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("List() mismatches (-got, +want):\n%s", diff)
+	}
+
+	// The same version must be written again after an offline status update.
+	if err := cache.Store(work2); err != nil {
+		t.Fatalf("Store() = %v, want no error", err)
+	}
+	if err := cache.Remove(memberReservedNSName1, "work-1"); err != nil {
+		t.Fatalf("Remove() = %v, want no error", err)
+	}
+	// Removing a non-existent entry is a no-op.
+	if err := cache.Remove(memberReservedNSName1, "work-3"); err != nil {
+		t.Fatalf("Remove() = %v, want no error", err)
+	}
+
+	// Read the data with a new cache instance, as if the agent has restarted.
+	restartedCache, err := NewLocalWorkCache(dir)
+	if err != nil {
+		t.Fatalf("NewLocalWorkCache() = %v, want no error", err)
+	}
+	got, err = restartedCache.List()
+	if err != nil {
+		t.Fatalf("List() = %v, want no error", err)
+	}
+	want = []*CachedWork{
+		{Work: work2},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("List() after restart mismatches (-got, +want):\n%s", diff)
+	}
+
+	if err := restartedCache.Purge(); err != nil {
+		t.Fatalf("Purge() = %v, want no error", err)
+	}
+	got, err = restartedCache.List()
+	if err != nil {
+		t.Fatalf("List() = %v, want no error", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("List() after purge = %v, want empty", got)
+	}
+}
+
+// TestLocalWorkCacheListWithCorruptedEntries tests the List method of the LocalWorkCache when
+// some of the entries are corrupted.
+func TestLocalWorkCacheListWithCorruptedEntries(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := NewLocalWorkCache(dir)
+	if err != nil {
+		t.Fatalf("NewLocalWorkCache() = %v, want no error", err)
+	}
+
+	work := newCachedTestWork("work-1", "1")
+	if err := cache.Store(work); err != nil {
+		t.Fatalf("Store() = %v, want no error", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, memberReservedNSName1+"_work-2.json"), []byte("{not json"), 0600); err != nil {
+		t.Fatalf("failed to write a corrupted cache entry: %v", err)
+	}
+	// Files with other extensions (e.g., left-over temporary files) are ignored.
+	if err := os.WriteFile(filepath.Join(dir, memberReservedNSName1+"_work-3.json.tmp"), []byte("{not json"), 0600); err != nil {
+		t.Fatalf("failed to write a temporary cache file: %v", err)
+	}
+
+	got, err := cache.List()
+	if err == nil {
+		t.Errorf("List() = nil, want error")
+	}
+	want := []*CachedWork{
+		{Work: work},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("List() mismatches (-got, +want):\n%s", diff)
+	}
+}
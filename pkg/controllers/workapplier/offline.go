@@ -0,0 +1,294 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/defaulter"
+)
+
+const (
+	// hubDisconnectedRequeueDelay is the delay the work applier waits before it re-checks a Work
+	// object when the hub cluster is not reachable; during this time the offline work enforcer
+	// processes the locally cached Work objects instead.
+	hubDisconnectedRequeueDelay = time.Second * 30
+
+	// hubProbeFailureThreshold is the number of consecutive failed hub cluster probes before the
+	// member agent considers the hub cluster to be unreachable.
+	hubProbeFailureThreshold = 3
+)
+
+var _ manager.Runnable = &OfflineWorkEnforcer{}
+
+// HubProbeFunc checks if the hub cluster is reachable; it returns an error if it is not.
+type HubProbeFunc func(ctx context.Context) error
+
+// OfflineWorkEnforcer keeps enforcing the Work objects in the local work cache when the hub
+// cluster is not reachable.
+//
+// It probes the hub cluster periodically; after a few consecutive failed probes, it pauses the
+// regular work applier reconciliation loop and processes the cached Work objects on its own, so
+// that drifts are still corrected and objects are still taken over as the apply strategies
+// specify. Status updates are kept in the local cache and replayed to the hub cluster once it is
+// reachable again. Enforcement stops if the hub cluster has been unreachable for longer than the
+// max offline duration, as the cached Work objects are deemed too stale by then; the time when the
+// hub cluster became unreachable is kept in the local cache as well, so that the offline duration
+// is not reset by agent restarts.
+//
+// Note that left-over objects (i.e., objects applied from manifests that have been removed from a
+// Work object) are not cleaned up while the hub cluster is unreachable; the work applier will
+// handle them once the connectivity is restored.
+type OfflineWorkEnforcer struct {
+	r                  *Reconciler
+	cache              *LocalWorkCache
+	probeHub           HubProbeFunc
+	probeInterval      time.Duration
+	maxOfflineDuration time.Duration
+
+	// The states below are only accessed from the probing loop.
+	consecutiveProbeFailures int
+	disconnectedSince        *time.Time
+	enforcementStopped       bool
+}
+
+// NewOfflineWorkEnforcer returns a new offline work enforcer. It also enables the local work
+// cache in the given work applier, so that the applier persists the Work objects it has
+// processed.
+func NewOfflineWorkEnforcer(
+	r *Reconciler,
+	cache *LocalWorkCache,
+	probeHub HubProbeFunc,
+	probeInterval time.Duration,
+	maxOfflineDuration time.Duration,
+) *OfflineWorkEnforcer {
+	r.localWorkCache = cache
+	return &OfflineWorkEnforcer{
+		r:                  r,
+		cache:              cache,
+		probeHub:           probeHub,
+		probeInterval:      probeInterval,
+		maxOfflineDuration: maxOfflineDuration,
+	}
+}
+
+// Start runs the offline work enforcer until the context is cancelled.
+func (e *OfflineWorkEnforcer) Start(ctx context.Context) error {
+	klog.InfoS("Starting the offline work enforcer", "probeInterval", e.probeInterval, "maxOfflineDuration", e.maxOfflineDuration)
+	defer klog.InfoS("The offline work enforcer is stopped")
+
+	e.loadOfflineState()
+	wait.UntilWithContext(ctx, e.probeAndEnforce, e.probeInterval)
+	return nil
+}
+
+// loadOfflineState resumes the offline mode if the hub cluster was unreachable when the agent
+// stopped; the status updates kept in the local cache are replayed once the hub cluster is
+// reachable again.
+func (e *OfflineWorkEnforcer) loadOfflineState() {
+	disconnectedSince, err := e.cache.LoadDisconnectedSince()
+	if err != nil {
+		klog.ErrorS(err, "Failed to load the hub connectivity state from the local cache")
+		return
+	}
+	if disconnectedSince == nil {
+		return
+	}
+	klog.InfoS("The hub cluster was unreachable before the agent restarted; resuming offline work enforcement", "disconnectedSince", *disconnectedSince)
+	e.disconnectedSince = disconnectedSince
+	e.r.hubDisconnected.Store(true)
+}
+
+// probeAndEnforce probes the hub cluster and enforces the cached Work objects if the hub cluster
+// is not reachable.
+func (e *OfflineWorkEnforcer) probeAndEnforce(ctx context.Context) {
+	if err := e.probeHub(ctx); err != nil {
+		e.consecutiveProbeFailures++
+		klog.V(2).InfoS("Failed to reach the hub cluster", "consecutiveFailures", e.consecutiveProbeFailures, "err", err)
+		if e.disconnectedSince == nil {
+			if e.consecutiveProbeFailures < hubProbeFailureThreshold {
+				return
+			}
+			klog.InfoS("The hub cluster is not reachable; switching to offline work enforcement with locally cached Work objects")
+			e.disconnectedSince = ptr.To(time.Now())
+			e.r.hubDisconnected.Store(true)
+			if err := e.cache.StoreDisconnectedSince(*e.disconnectedSince); err != nil {
+				klog.ErrorS(err, "Failed to keep the hub connectivity state in the local cache")
+			}
+		}
+
+		offlineDuration := time.Since(*e.disconnectedSince)
+		if offlineDuration > e.maxOfflineDuration {
+			if !e.enforcementStopped {
+				klog.InfoS("The hub cluster has been unreachable for longer than the max offline duration; stop enforcing locally cached Work objects",
+					"offlineDuration", offlineDuration, "maxOfflineDuration", e.maxOfflineDuration)
+				e.enforcementStopped = true
+			}
+			return
+		}
+		if err := e.enforceCachedWorks(ctx); err != nil {
+			klog.ErrorS(err, "Failed to enforce locally cached Work objects")
+		}
+		return
+	}
+
+	e.consecutiveProbeFailures = 0
+	if e.disconnectedSince == nil {
+		return
+	}
+	// The hub cluster is reachable again; replay the status updates before resuming the regular
+	// reconciliation loop, so that the hub cluster gets the latest status as soon as possible.
+	if err := e.replayStatusUpdates(ctx); err != nil {
+		klog.ErrorS(err, "Failed to replay status updates to the hub cluster; will retry")
+		return
+	}
+	if err := e.cache.ClearDisconnectedSince(); err != nil {
+		klog.ErrorS(err, "Failed to clear the hub connectivity state in the local cache; will retry")
+		return
+	}
+	klog.InfoS("The hub cluster is reachable again; resuming regular work applier reconciliation", "offlineDuration", time.Since(*e.disconnectedSince))
+	e.disconnectedSince = nil
+	e.enforcementStopped = false
+	e.r.hubDisconnected.Store(false)
+}
+
+// enforceCachedWorks processes all the Work objects in the local work cache.
+func (e *OfflineWorkEnforcer) enforceCachedWorks(ctx context.Context) error {
+	cachedWorks, listErr := e.cache.List()
+	errs := []error{listErr}
+	for idx := range cachedWorks {
+		work := cachedWorks[idx].Work
+		if err := e.enforceCachedWork(ctx, work); err != nil {
+			klog.ErrorS(err, "Failed to enforce a locally cached Work object", "work", klog.KObj(work))
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// enforceCachedWork processes a Work object in the local work cache, in the same way as the
+// work applier does, except that the Work object status is written to the local cache rather
+// than the hub cluster.
+func (e *OfflineWorkEnforcer) enforceCachedWork(ctx context.Context, work *fleetv1beta1.Work) error {
+	workRef := klog.KObj(work)
+	if !work.DeletionTimestamp.IsZero() {
+		klog.V(2).InfoS("Skip a locally cached Work object that has been marked for deletion", "work", workRef)
+		return nil
+	}
+
+	// The AppliedWork object is not created in the offline mode, as the work applier must first
+	// add a finalizer to the Work object on the hub cluster side.
+	appliedWork := &fleetv1beta1.AppliedWork{}
+	if err := e.r.spokeClient.Get(ctx, types.NamespacedName{Name: work.Name}, appliedWork); err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(2).InfoS("Skip a locally cached Work object that has no AppliedWork object", "work", workRef)
+			return nil
+		}
+		return controller.NewAPIServerError(true, err)
+	}
+	expectedAppliedWorkOwnerRef := &metav1.OwnerReference{
+		APIVersion:         fleetv1beta1.GroupVersion.String(),
+		Kind:               fleetv1beta1.AppliedWorkKind,
+		Name:               appliedWork.GetName(),
+		UID:                appliedWork.GetUID(),
+		BlockOwnerDeletion: ptr.To(true),
+	}
+
+	defaulter.SetDefaultsWork(work)
+	bundles := prepareManifestProcessingBundles(work)
+	e.r.decodeManifestsAndCheckForDuplicates(ctx, bundles, work)
+	if err := e.r.processManifests(ctx, bundles, work, expectedAppliedWorkOwnerRef); err != nil {
+		return fmt.Errorf("failed to process the manifests: %w", err)
+	}
+	if err := e.r.trackInMemberClusterObjAvailability(ctx, bundles, workRef); err != nil {
+		return fmt.Errorf("failed to check for object availability: %w", err)
+	}
+	if _, _, err := rebuildWorkStatus(work, bundles); err != nil {
+		return fmt.Errorf("failed to rebuild the work object status: %w", err)
+	}
+	if err := e.r.refreshAppliedWorkStatus(ctx, appliedWork, bundles); err != nil {
+		return fmt.Errorf("failed to refresh the appliedWork object status: %w", err)
+	}
+	return e.cache.StoreOfflineStatus(work)
+}
+
+// replayStatusUpdates reports the Work object status that has been refreshed in the offline mode
+// back to the hub cluster.
+func (e *OfflineWorkEnforcer) replayStatusUpdates(ctx context.Context) error {
+	cachedWorks, err := e.cache.List()
+	if err != nil {
+		// Corrupted entries cannot be replayed anyway; proceed with the valid ones.
+		klog.ErrorS(err, "Failed to read some of the locally cached Work objects")
+	}
+
+	for idx := range cachedWorks {
+		cachedWork := cachedWorks[idx]
+		if !cachedWork.StatusPendingReplay {
+			continue
+		}
+		work := cachedWork.Work
+		workRef := klog.KObj(work)
+
+		// Use a merge patch (rather than an update) as the Work object might have changed on the
+		// hub cluster side while the member agent is offline.
+		patchData, err := json.Marshal(map[string]interface{}{"status": work.Status})
+		if err != nil {
+			return fmt.Errorf("failed to prepare the status patch for work %s: %w", workRef, err)
+		}
+		patchTarget := &fleetv1beta1.Work{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: work.Namespace,
+				Name:      work.Name,
+			},
+		}
+		err = e.r.hubClient.Status().Patch(ctx, patchTarget, client.RawPatch(types.MergePatchType, patchData))
+		switch {
+		case apierrors.IsNotFound(err):
+			// The Work object has been deleted while the member agent is offline.
+			klog.V(2).InfoS("Locally cached Work object no longer exists on the hub cluster", "work", workRef)
+			if err := e.cache.Remove(work.Namespace, work.Name); err != nil {
+				return err
+			}
+			continue
+		case err != nil:
+			return controller.NewAPIServerError(false, err)
+		}
+		klog.V(2).InfoS("Replayed the status update of a locally cached Work object", "work", workRef)
+
+		// Mark the status as replayed; the work applier will refresh the cache entry with the
+		// latest version from the hub cluster afterwards.
+		if err := e.cache.Store(work); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// TestOfflineWorkEnforcerProbeAndEnforce tests the connectivity state transitions of the
+// OfflineWorkEnforcer.
+func TestOfflineWorkEnforcerProbeAndEnforce(t *testing.T) {
+	ctx := context.Background()
+	cache, err := NewLocalWorkCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalWorkCache() = %v, want no error", err)
+	}
+
+	var probeErr error
+	probeHub := func(_ context.Context) error {
+		return probeErr
+	}
+	r := &Reconciler{}
+	e := NewOfflineWorkEnforcer(r, cache, probeHub, time.Second, time.Hour)
+	if r.localWorkCache != cache {
+		t.Fatalf("NewOfflineWorkEnforcer() did not enable the local work cache in the work applier")
+	}
+
+	// The hub cluster is reachable.
+	e.probeAndEnforce(ctx)
+	if r.hubDisconnected.Load() {
+		t.Fatalf("hubDisconnected = true, want false (hub cluster reachable)")
+	}
+
+	// The hub cluster becomes unreachable; the first few failures are tolerated.
+	probeErr = fmt.Errorf("connection refused")
+	for i := 1; i < hubProbeFailureThreshold; i++ {
+		e.probeAndEnforce(ctx)
+		if r.hubDisconnected.Load() {
+			t.Fatalf("hubDisconnected = true after %d failed probes, want false", i)
+		}
+	}
+	e.probeAndEnforce(ctx)
+	if !r.hubDisconnected.Load() {
+		t.Fatalf("hubDisconnected = false after %d failed probes, want true", hubProbeFailureThreshold)
+	}
+	if e.enforcementStopped {
+		t.Fatalf("enforcementStopped = true, want false (within max offline duration)")
+	}
+
+	// The hub cluster stays unreachable for longer than the max offline duration.
+	e.disconnectedSince = ptr.To(time.Now().Add(-time.Hour * 2))
+	e.probeAndEnforce(ctx)
+	if !e.enforcementStopped {
+		t.Fatalf("enforcementStopped = false, want true (max offline duration exceeded)")
+	}
+
+	// The hub cluster becomes reachable again.
+	probeErr = nil
+	e.probeAndEnforce(ctx)
+	if r.hubDisconnected.Load() {
+		t.Fatalf("hubDisconnected = true, want false (hub cluster reachable again)")
+	}
+	if e.disconnectedSince != nil || e.enforcementStopped || e.consecutiveProbeFailures != 0 {
+		t.Fatalf("offline states are not reset after reconnection: disconnectedSince = %v, enforcementStopped = %t, consecutiveProbeFailures = %d",
+			e.disconnectedSince, e.enforcementStopped, e.consecutiveProbeFailures)
+	}
+}
+
+// TestOfflineWorkEnforcerRestartWhileOffline tests that the offline duration is not reset when
+// the member agent restarts while the hub cluster is unreachable.
+func TestOfflineWorkEnforcerRestartWhileOffline(t *testing.T) {
+	ctx := context.Background()
+	cacheDir := t.TempDir()
+	probeErr := fmt.Errorf("connection refused")
+	probeHub := func(_ context.Context) error {
+		return probeErr
+	}
+
+	cache, err := NewLocalWorkCache(cacheDir)
+	if err != nil {
+		t.Fatalf("NewLocalWorkCache() = %v, want no error", err)
+	}
+	r := &Reconciler{}
+	e := NewOfflineWorkEnforcer(r, cache, probeHub, time.Second, time.Hour)
+	for i := 0; i < hubProbeFailureThreshold; i++ {
+		e.probeAndEnforce(ctx)
+	}
+	if !r.hubDisconnected.Load() {
+		t.Fatalf("hubDisconnected = false after %d failed probes, want true", hubProbeFailureThreshold)
+	}
+	// The hub cluster stays unreachable for longer than the max offline duration across the restart.
+	disconnectedSince := time.Now().Add(-time.Hour * 2).Truncate(time.Second)
+	if err := cache.StoreDisconnectedSince(disconnectedSince); err != nil {
+		t.Fatalf("StoreDisconnectedSince() = %v, want no error", err)
+	}
+
+	// The member agent restarts.
+	restartedCache, err := NewLocalWorkCache(cacheDir)
+	if err != nil {
+		t.Fatalf("NewLocalWorkCache() = %v, want no error", err)
+	}
+	restartedR := &Reconciler{}
+	restartedE := NewOfflineWorkEnforcer(restartedR, restartedCache, probeHub, time.Second, time.Hour)
+	restartedE.loadOfflineState()
+	if !restartedR.hubDisconnected.Load() {
+		t.Fatalf("hubDisconnected = false after restart, want true")
+	}
+	if restartedE.disconnectedSince == nil || !restartedE.disconnectedSince.Equal(disconnectedSince) {
+		t.Fatalf("disconnectedSince = %v after restart, want %v", restartedE.disconnectedSince, disconnectedSince)
+	}
+	restartedE.probeAndEnforce(ctx)
+	if !restartedE.enforcementStopped {
+		t.Fatalf("enforcementStopped = false, want true (max offline duration exceeded before the restart)")
+	}
+
+	// The hub cluster becomes reachable again.
+	probeErr = nil
+	restartedE.probeAndEnforce(ctx)
+	if restartedR.hubDisconnected.Load() {
+		t.Fatalf("hubDisconnected = true, want false (hub cluster reachable again)")
+	}
+	got, err := restartedCache.LoadDisconnectedSince()
+	if err != nil || got != nil {
+		t.Fatalf("LoadDisconnectedSince() = %v, %v, want nil, nil after reconnection", got, err)
+	}
+}
+
+// TestOfflineWorkEnforcerReplayStatusUpdates tests the replayStatusUpdates method of the
+// OfflineWorkEnforcer.
+func TestOfflineWorkEnforcerReplayStatusUpdates(t *testing.T) {
+	ctx := context.Background()
+
+	appliedCond := metav1.Condition{
+		Type:               fleetv1beta1.WorkConditionTypeApplied,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Applied",
+		ObservedGeneration: 1,
+		LastTransitionTime: metav1.Now(),
+	}
+	// A Work object that still exists on the hub cluster.
+	existingWork := &fleetv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "work-1",
+			Namespace: memberReservedNSName1,
+		},
+	}
+	cachedExistingWork := existingWork.DeepCopy()
+	cachedExistingWork.Status.Conditions = []metav1.Condition{appliedCond}
+	// A Work object that has been deleted from the hub cluster while offline.
+	cachedDeletedWork := &fleetv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "work-2",
+			Namespace: memberReservedNSName1,
+		},
+		Status: fleetv1beta1.WorkStatus{
+			Conditions: []metav1.Condition{appliedCond},
+		},
+	}
+	// A Work object whose status has not been refreshed while offline.
+	noReplayWork := &fleetv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "work-3",
+			Namespace: memberReservedNSName1,
+		},
+	}
+
+	cache, err := NewLocalWorkCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalWorkCache() = %v, want no error", err)
+	}
+	if err := cache.StoreOfflineStatus(cachedExistingWork); err != nil {
+		t.Fatalf("StoreOfflineStatus() = %v, want no error", err)
+	}
+	if err := cache.StoreOfflineStatus(cachedDeletedWork); err != nil {
+		t.Fatalf("StoreOfflineStatus() = %v, want no error", err)
+	}
+	if err := cache.Store(noReplayWork); err != nil {
+		t.Fatalf("Store() = %v, want no error", err)
+	}
+
+	fakeHubClient := fake.NewClientBuilder().
+		WithScheme(fakeClientScheme(t)).
+		WithObjects(existingWork).
+		WithStatusSubresource(existingWork).
+		Build()
+	r := &Reconciler{
+		hubClient: fakeHubClient,
+	}
+	e := NewOfflineWorkEnforcer(r, cache, nil, time.Second, time.Hour)
+	if err := e.replayStatusUpdates(ctx); err != nil {
+		t.Fatalf("replayStatusUpdates() = %v, want no error", err)
+	}
+
+	// Verify that the status has been replayed to the hub cluster.
+	gotWork := &fleetv1beta1.Work{}
+	if err := fakeHubClient.Get(ctx, types.NamespacedName{Namespace: memberReservedNSName1, Name: "work-1"}, gotWork); err != nil {
+		t.Fatalf("failed to get the Work object: %v", err)
+	}
+	if diff := cmp.Diff(gotWork.Status, cachedExistingWork.Status, cmpopts.EquateApproxTime(time.Second)); diff != "" {
+		t.Errorf("replayed Work status mismatches (-got, +want):\n%s", diff)
+	}
+	// Verify that the status replay does not create the deleted Work object.
+	if err := fakeHubClient.Get(ctx, types.NamespacedName{Namespace: memberReservedNSName1, Name: "work-2"}, &fleetv1beta1.Work{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Get() = %v, want not found error", err)
+	}
+
+	// Verify the cache entries.
+	got, err := cache.List()
+	if err != nil {
+		t.Fatalf("List() = %v, want no error", err)
+	}
+	want := []*CachedWork{
+		{Work: cachedExistingWork},
+		{Work: noReplayWork},
+	}
+	if diff := cmp.Diff(got, want, cmpopts.EquateApproxTime(time.Second)); diff != "" {
+		t.Errorf("cached Work objects mismatch (-got, +want):\n%s", diff)
+	}
+}
@@ -41,6 +41,30 @@ func (r *Reconciler) preProcessManifests(
 	work *fleetv1beta1.Work,
 	expectedAppliedWorkOwnerRef *metav1.OwnerReference,
 ) error {
+	// Decode the manifests and check for duplicates.
+	r.decodeManifestsAndCheckForDuplicates(ctx, bundles, work)
+
+	// Write ahead the manifest processing attempts in the Work object status. In the process
+	// Fleet will also perform a cleanup to remove any left-over manifests that are applied
+	// from previous runs.
+	//
+	// This is set up to address a corner case where the agent could crash right after manifests
+	// are applied but before the status is properly updated, and upon the agent's restart, the
+	// list of manifests has changed (some manifests have been removed). This would lead to a
+	// situation where Fleet would lose track of the removed manifests.
+	//
+	// To avoid conflicts (or the hassle of preparing individual patches), the status update is
+	// done in batch.
+	return r.writeAheadManifestProcessingAttempts(ctx, bundles, work, expectedAppliedWorkOwnerRef)
+}
+
+// decodeManifestsAndCheckForDuplicates decodes the manifests in the bundles and checks for
+// duplicated manifests. Any manifest that fails the checks will have its processing error set.
+func (r *Reconciler) decodeManifestsAndCheckForDuplicates(
+	ctx context.Context,
+	bundles []*manifestProcessingBundle,
+	work *fleetv1beta1.Work,
+) {
 	// Decode the manifests.
 	// Run the decoding in parallel to boost performance.
 	//
@@ -108,19 +132,6 @@ func (r *Reconciler) preProcessManifests(
 	//
 	// Note that the CRP/RP APIs will block repeated resource selectors.
 	checkForDuplicatedManifests(bundles, work)
-
-	// Write ahead the manifest processing attempts in the Work object status. In the process
-	// Fleet will also perform a cleanup to remove any left-over manifests that are applied
-	// from previous runs.
-	//
-	// This is set up to address a corner case where the agent could crash right after manifests
-	// are applied but before the status is properly updated, and upon the agent's restart, the
-	// list of manifests has changed (some manifests have been removed). This would lead to a
-	// situation where Fleet would lose track of the removed manifests.
-	//
-	// To avoid conflicts (or the hassle of preparing individual patches), the status update is
-	// done in batch.
-	return r.writeAheadManifestProcessingAttempts(ctx, bundles, work, expectedAppliedWorkOwnerRef)
 }
 
 // writeAheadManifestProcessingAttempts helps write ahead manifest processing attempts so that
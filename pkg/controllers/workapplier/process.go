@@ -374,7 +374,7 @@ func (r *Reconciler) reportDiffOnlyIfApplicable(
 	configDiffs, diffCalculatedInDegradedMode, err := r.diffBetweenManifestAndInMemberClusterObjects(ctx,
 		bundle.gvr,
 		bundle.manifestObj, bundle.inMemberClusterObj,
-		work.Spec.ApplyStrategy.ComparisonOption)
+		work.Spec.ApplyStrategy)
 	switch {
 	case err != nil:
 		// Failed to calculate the configuration diffs.
@@ -466,7 +466,7 @@ func (r *Reconciler) performPreApplyDriftDetectionIfApplicable(
 		drifts, driftsCalculatedInDegradedMode, err := r.diffBetweenManifestAndInMemberClusterObjects(ctx,
 			bundle.gvr,
 			bundle.manifestObj, bundle.inMemberClusterObj,
-			work.Spec.ApplyStrategy.ComparisonOption)
+			work.Spec.ApplyStrategy)
 		switch {
 		case err != nil:
 			// An unexpected error has occurred.
@@ -549,7 +549,7 @@ func (r *Reconciler) performPostApplyDriftDetectionIfApplicable(
 	drifts, driftsCalculatedInDegradedMode, err := r.diffBetweenManifestAndInMemberClusterObjects(ctx,
 		bundle.gvr,
 		bundle.manifestObj, bundle.inMemberClusterObj,
-		work.Spec.ApplyStrategy.ComparisonOption)
+		work.Spec.ApplyStrategy)
 	switch {
 	case err != nil:
 		// An unexpected error has occurred.
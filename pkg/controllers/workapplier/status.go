@@ -20,12 +20,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 
@@ -41,15 +43,43 @@ const (
 )
 
 // refreshWorkStatus refreshes the status of a Work object based on the processing results of its manifests.
-//
-// TO-DO (chenyu1): refactor this method a bit to reduce its complexity and enable parallelization.
-func (r *Reconciler) refreshWorkStatus( //nolint:gocyclo
+func (r *Reconciler) refreshWorkStatus(
 	ctx context.Context,
 	work *fleetv1beta1.Work,
 	bundles []*manifestProcessingBundle,
 ) error {
 	originalStatus := work.Status.DeepCopy()
 
+	isDriftedOrDiffed, isStatusBackReportingOn, err := rebuildWorkStatus(work, bundles)
+	if err != nil {
+		return err
+	}
+	if _, ok := work.Labels[fleetv1beta1.VerificationJobWorkLabel]; ok && isStatusBackReportingOn {
+		r.backReportJobTerminationMessages(ctx, work)
+	}
+
+	// Update the Work object status.
+	if shouldSkipStatusUpdate(isDriftedOrDiffed, isStatusBackReportingOn, originalStatus, &work.Status) {
+		// No status change found; skip the update.
+		klog.V(2).InfoS("No status change found for Work object; skip the status update", "work", klog.KObj(work))
+	} else {
+		klog.V(2).InfoS("Refreshing work object status", "work", klog.KObj(work), "isDriftedOrDiffed", isDriftedOrDiffed, "isStatusBackReportingOn", isStatusBackReportingOn)
+		if err := r.hubClient.Status().Update(ctx, work); err != nil {
+			return controller.NewAPIServerError(false, err)
+		}
+	}
+	return nil
+}
+
+// rebuildWorkStatus rebuilds the status of a Work object (in place) based on the processing results
+// of its manifests. It reports whether drifts/diffs have been found and whether status back-reporting
+// is on, which help decide if a status update is needed.
+//
+// TO-DO (chenyu1): refactor this method a bit to reduce its complexity and enable parallelization.
+func rebuildWorkStatus( //nolint:gocyclo
+	work *fleetv1beta1.Work,
+	bundles []*manifestProcessingBundle,
+) (isDriftedOrDiffed, isStatusBackReportingOn bool, err error) {
 	// Note (chenyu1): this method can run in parallel; however, for simplicity reasons,
 	// considering that in most of the time the count of manifests would be low, currently
 	// Fleet still does the status refresh sequentially.
@@ -102,8 +132,16 @@ func (r *Reconciler) refreshWorkStatus( //nolint:gocyclo
 
 	// Set the two flags here as they are per-work-object settings.
 	isReportDiffModeOn := work.Spec.ApplyStrategy != nil && work.Spec.ApplyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff
-	isStatusBackReportingOn := work.Spec.ReportBackStrategy != nil && work.Spec.ReportBackStrategy.Type == fleetv1beta1.ReportBackStrategyTypeMirror
-	isDriftedOrDiffed := false
+	var projectedStatusPaths []string
+	if work.Spec.ReportBackStrategy != nil {
+		switch work.Spec.ReportBackStrategy.Type {
+		case fleetv1beta1.ReportBackStrategyTypeMirror:
+			isStatusBackReportingOn = true
+		case fleetv1beta1.ReportBackStrategyTypeProjected:
+			isStatusBackReportingOn = true
+			projectedStatusPaths = work.Spec.ReportBackStrategy.StatusPaths
+		}
+	}
 	for idx := range bundles {
 		bundle := bundles[idx]
 
@@ -180,9 +218,9 @@ func (r *Reconciler) refreshWorkStatus( //nolint:gocyclo
 				// Back-report the status from the member cluster side, if applicable.
 				//
 				// Back-reporting is only performed when:
-				// a) the ReportBackStrategy is of the type Mirror; and
+				// a) the ReportBackStrategy is of the type Mirror or Projected; and
 				// b) the manifest object has been applied successfully.
-				backReportStatus(bundle.inMemberClusterObj, manifestCond, now, klog.KObj(work))
+				backReportStatus(bundle.inMemberClusterObj, manifestCond, projectedStatusPaths, now, klog.KObj(work))
 			}
 		}
 		if isAppliedObjectAvailable(bundle.availabilityResTyp) {
@@ -201,7 +239,7 @@ func (r *Reconciler) refreshWorkStatus( //nolint:gocyclo
 	// Do a sanity check.
 	if appliedManifestsCount > manifestCount || availableAppliedObjectsCount > manifestCount || untrackableAppliedObjectsCount > manifestCount || diffReportedObjectsCount > manifestCount {
 		// Normally this should never happen.
-		return controller.NewUnexpectedBehaviorError(
+		return false, false, controller.NewUnexpectedBehaviorError(
 			fmt.Errorf("the number of applied manifests (%d), available applied objects (%d), untrackable applied objects (%d), or diff reported objects (%d) exceeds the total number of manifests (%d)",
 				appliedManifestsCount, availableAppliedObjectsCount, untrackableAppliedObjectsCount, diffReportedObjectsCount, manifestCount))
 	}
@@ -245,7 +283,7 @@ func (r *Reconciler) refreshWorkStatus( //nolint:gocyclo
 		// Normally this should never occur.
 		klog.ErrorS(err, "Failed to check Work object size before status update", "work", klog.KObj(work))
 		wrappedErr := fmt.Errorf("failed to check work object size before status update: %w", err)
-		return controller.NewUnexpectedBehaviorError(wrappedErr)
+		return false, false, controller.NewUnexpectedBehaviorError(wrappedErr)
 	}
 	if sizeDeltaBytes > 0 {
 		klog.V(2).InfoS("Must trim status data as the work object has grown over its size limit",
@@ -254,18 +292,7 @@ func (r *Reconciler) refreshWorkStatus( //nolint:gocyclo
 		trimWorkStatusDataWhenOversized(work)
 	}
 	setWorkStatusTrimmedCondition(work, sizeDeltaBytes, resource.DefaultObjSizeLimitWithPaddingBytes)
-
-	// Update the Work object status.
-	if shouldSkipStatusUpdate(isDriftedOrDiffed, isStatusBackReportingOn, originalStatus, &work.Status) {
-		// No status change found; skip the update.
-		klog.V(2).InfoS("No status change found for Work object; skip the status update", "work", klog.KObj(work))
-	} else {
-		klog.V(2).InfoS("Refreshing work object status", "work", klog.KObj(work), "isDriftedOrDiffed", isDriftedOrDiffed, "isStatusBackReportingOn", isStatusBackReportingOn)
-		if err := r.hubClient.Status().Update(ctx, work); err != nil {
-			return controller.NewAPIServerError(false, err)
-		}
-	}
-	return nil
+	return isDriftedOrDiffed, isStatusBackReportingOn, nil
 }
 
 // refreshAppliedWorkStatus refreshes the status of an AppliedWork object based on the processing results of its manifests.
@@ -730,10 +757,12 @@ func prepareRebuiltManifestCondQIdx(bundles []*manifestProcessingBundle) map[str
 }
 
 // backReportStatus writes the status field of an object applied on the member cluster side in
-// the status of the Work object.
+// the status of the Work object. If a list of projected status paths is given, only the status
+// fields selected by the paths are written.
 func backReportStatus(
 	inMemberClusterObj *unstructured.Unstructured,
 	manifestCond *fleetv1beta1.ManifestCondition,
+	projectedStatusPaths []string,
 	now metav1.Time,
 	workRef klog.ObjectRef,
 ) {
@@ -762,7 +791,11 @@ func backReportStatus(
 	// the API server.
 	statusBackReportingWrapper["apiVersion"] = inMemberClusterObj.GetAPIVersion()
 	statusBackReportingWrapper["kind"] = inMemberClusterObj.GetKind()
-	statusBackReportingWrapper["status"] = inMemberClusterObj.Object["status"]
+	if len(projectedStatusPaths) > 0 {
+		statusBackReportingWrapper[fleetv1beta1.ProjectedStatusField] = projectStatusFields(inMemberClusterObj, projectedStatusPaths, manifestCond, workRef)
+	} else {
+		statusBackReportingWrapper["status"] = inMemberClusterObj.Object["status"]
+	}
 	statusData, err := json.Marshal(statusBackReportingWrapper)
 	if err != nil {
 		// This normally should never occur.
@@ -780,6 +813,55 @@ func backReportStatus(
 	}
 }
 
+// projectStatusFields returns the status fields selected by the given JSONPath expressions from
+// an object applied on the member cluster side, as a map keyed by expression. Expressions that
+// select no fields are left out; invalid expressions are logged and left out as well.
+func projectStatusFields(
+	inMemberClusterObj *unstructured.Unstructured,
+	projectedStatusPaths []string,
+	manifestCond *fleetv1beta1.ManifestCondition,
+	workRef klog.ObjectRef,
+) map[string]interface{} {
+	projected := make(map[string]interface{}, len(projectedStatusPaths))
+	for _, path := range projectedStatusPaths {
+		// Users may specify the expressions with or without the enclosing braces
+		// (e.g., `.status.readyReplicas` or `{.status.readyReplicas}`).
+		tmpl := path
+		if !strings.HasPrefix(tmpl, "{") {
+			tmpl = fmt.Sprintf("{%s}", tmpl)
+		}
+		jp := jsonpath.New(path).AllowMissingKeys(true)
+		if err := jp.Parse(tmpl); err != nil {
+			// The expression is invalid; this is a user error.
+			_ = controller.NewUserError(fmt.Errorf("failed to parse status path %s: %w", path, err))
+			klog.ErrorS(err, "Failed to parse status path for projected status back-reporting", "work", workRef, "resourceIdentifier", manifestCond.Identifier, "statusPath", path)
+			continue
+		}
+		results, err := jp.FindResults(inMemberClusterObj.Object)
+		if err != nil {
+			klog.ErrorS(err, "Failed to evaluate status path for projected status back-reporting", "work", workRef, "resourceIdentifier", manifestCond.Identifier, "statusPath", path)
+			continue
+		}
+
+		var values []interface{}
+		for _, result := range results {
+			for _, v := range result {
+				values = append(values, v.Interface())
+			}
+		}
+		switch len(values) {
+		case 0:
+			// The expression selects no fields; leave it out.
+		case 1:
+			projected[path] = values[0]
+		default:
+			// The expression selects multiple fields (e.g., with wildcards or filters).
+			projected[path] = values
+		}
+	}
+	return projected
+}
+
 // trimWorkStatusDataWhenOversized trims some data from the Work object status when the object
 // reaches its size limit.
 func trimWorkStatusDataWhenOversized(work *fleetv1beta1.Work) {
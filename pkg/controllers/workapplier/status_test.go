@@ -2167,7 +2167,7 @@ func TestBackReportStatus(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			backReportStatus(tc.inMemberClusterObj, tc.manifestCond, now, workRef)
+			backReportStatus(tc.inMemberClusterObj, tc.manifestCond, nil, now, workRef)
 
 			if tc.wantIgnored {
 				if tc.manifestCond.BackReportedStatus != nil {
@@ -2200,6 +2200,88 @@ func TestBackReportStatus(t *testing.T) {
 	}
 }
 
+// TestBackReportStatusWithProjection tests the backReportStatus method with projected status paths.
+func TestBackReportStatusWithProjection(t *testing.T) {
+	workRef := klog.ObjectRef{
+		Name:      workName,
+		Namespace: memberReservedNSName1,
+	}
+	now := metav1.Now()
+
+	deployWithStatus := deploy.DeepCopy()
+	deployWithStatus.Status = appsv1.DeploymentStatus{
+		ObservedGeneration: 2,
+		Replicas:           5,
+		ReadyReplicas:      4,
+		Conditions: []appsv1.DeploymentCondition{
+			{
+				Type:   appsv1.DeploymentAvailable,
+				Status: corev1.ConditionTrue,
+			},
+			{
+				Type:   appsv1.DeploymentProgressing,
+				Status: corev1.ConditionTrue,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		projectedStatusPaths []string
+		wantProjectedStatus  map[string]interface{}
+	}{
+		{
+			name:                 "single field",
+			projectedStatusPaths: []string{".status.readyReplicas"},
+			wantProjectedStatus: map[string]interface{}{
+				".status.readyReplicas": float64(4),
+			},
+		},
+		{
+			name:                 "path with braces",
+			projectedStatusPaths: []string{"{.status.replicas}"},
+			wantProjectedStatus: map[string]interface{}{
+				"{.status.replicas}": float64(5),
+			},
+		},
+		{
+			name:                 "multiple fields selected by one path",
+			projectedStatusPaths: []string{".status.conditions[*].type"},
+			wantProjectedStatus: map[string]interface{}{
+				".status.conditions[*].type": []interface{}{"Available", "Progressing"},
+			},
+		},
+		{
+			name:                 "missing and invalid paths",
+			projectedStatusPaths: []string{".status.readyReplicas", ".status.availableReplicas", ".status.conditions[", ".status.loadBalancer.ingress"},
+			wantProjectedStatus: map[string]interface{}{
+				".status.readyReplicas": float64(4),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			manifestCond := &fleetv1beta1.ManifestCondition{}
+			backReportStatus(toUnstructured(t, deployWithStatus), manifestCond, tc.projectedStatusPaths, now, workRef)
+
+			if manifestCond.BackReportedStatus == nil {
+				t.Fatalf("backReportStatus() did not report status data")
+			}
+			wrapper := map[string]interface{}{}
+			if err := json.Unmarshal(manifestCond.BackReportedStatus.ObservedStatus.Raw, &wrapper); err != nil {
+				t.Fatalf("back reported data unmarshalling err: %v", err)
+			}
+			if _, ok := wrapper["status"]; ok {
+				t.Errorf("backReportStatus() reported the whole status with projected status paths")
+			}
+			if diff := cmp.Diff(wrapper[fleetv1beta1.ProjectedStatusField], tc.wantProjectedStatus); diff != "" {
+				t.Errorf("backReportStatus() projected status diffs (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
 // TestTrimWorkStatusDataWhenOversized tests the trimWorkStatusDataWhenOversized function.
 func TestTrimWorkStatusDataWhenOversized(t *testing.T) {
 	now := metav1.Now()
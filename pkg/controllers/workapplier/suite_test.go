@@ -46,6 +46,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/parallelizer"
 	testv1alpha1 "github.com/kubefleet-dev/kubefleet/test/apis/v1alpha1"
 )
@@ -319,6 +320,7 @@ var _ = BeforeSuite(func() {
 		memberDynamicClient1,
 		memberClient1,
 		memberClient1.RESTMapper(),
+		memberCfg1, utils.FleetSystemNamespace,
 		hubMgr1.GetEventRecorderFor("work-applier"),
 		maxConcurrentReconciles,
 		parallelizer.NewParallelizer(workerCount),
@@ -370,6 +372,7 @@ var _ = BeforeSuite(func() {
 		memberDynamicClient2,
 		memberClient2,
 		memberClient2.RESTMapper(),
+		memberCfg2, utils.FleetSystemNamespace,
 		hubMgr2.GetEventRecorderFor("work-applier-long-backoff"),
 		maxConcurrentReconciles,
 		parallelizer.NewParallelizer(workerCount),
@@ -409,6 +412,7 @@ var _ = BeforeSuite(func() {
 		memberDynamicClient3,
 		memberClient3,
 		memberClient3.RESTMapper(),
+		memberCfg3, utils.FleetSystemNamespace,
 		hubMgr3.GetEventRecorderFor("work-applier"),
 		maxConcurrentReconciles,
 		pWithDelay,
@@ -446,6 +450,7 @@ var _ = BeforeSuite(func() {
 		memberDynamicClient4,
 		wrappedMemberClient4,
 		memberClient4.RESTMapper(),
+		memberCfg4, utils.FleetSystemNamespace,
 		hubMgr4.GetEventRecorderFor("work-applier-wrapped-client"),
 		maxConcurrentReconciles,
 		parallelizer.NewParallelizer(workerCount),
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// maxBackReportedTerminationMessages is the maximum number of container termination messages
+	// back-reported for a Job.
+	maxBackReportedTerminationMessages = 5
+
+	// maxBackReportedTerminationMessageLength is the maximum length of a back-reported container termination
+	// message; it matches the size of the log tail that Kubernetes uses with the FallbackToLogsOnError policy.
+	maxBackReportedTerminationMessageLength = 2048
+)
+
+// backReportJobTerminationMessages adds the termination messages of the containers in the pods of the Jobs
+// in a Work object to their back-reported statuses. It only applies to the Work objects that place the
+// verification Jobs of staged update runs, so that the update runs can tell why the Jobs have failed.
+func (r *Reconciler) backReportJobTerminationMessages(ctx context.Context, work *fleetv1beta1.Work) {
+	for idx := range work.Status.ManifestConditions {
+		manifestCond := &work.Status.ManifestConditions[idx]
+		if manifestCond.Identifier.Group != batchv1.GroupName || manifestCond.Identifier.Kind != utils.JobKind {
+			continue
+		}
+		if manifestCond.BackReportedStatus == nil || len(manifestCond.BackReportedStatus.ObservedStatus.Raw) == 0 {
+			continue
+		}
+
+		messages, err := r.collectJobTerminationMessages(ctx, manifestCond.Identifier.Namespace, manifestCond.Identifier.Name)
+		if err != nil {
+			klog.ErrorS(err, "Failed to collect the termination messages of the Job", "work", klog.KObj(work), "resourceIdentifier", manifestCond.Identifier)
+			continue
+		}
+		if len(messages) == 0 {
+			continue
+		}
+
+		var statusBackReportingWrapper map[string]interface{}
+		if err := json.Unmarshal(manifestCond.BackReportedStatus.ObservedStatus.Raw, &statusBackReportingWrapper); err != nil {
+			// This normally should never occur.
+			_ = controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to unmarshal wrapped back-reported status: %w", err))
+			klog.ErrorS(err, "Failed to add the termination messages of the Job", "work", klog.KObj(work), "resourceIdentifier", manifestCond.Identifier)
+			continue
+		}
+		statusBackReportingWrapper[fleetv1beta1.TerminationMessagesField] = messages
+		statusData, err := json.Marshal(statusBackReportingWrapper)
+		if err != nil {
+			// This normally should never occur.
+			_ = controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to marshal wrapped back-reported status: %w", err))
+			klog.ErrorS(err, "Failed to add the termination messages of the Job", "work", klog.KObj(work), "resourceIdentifier", manifestCond.Identifier)
+			continue
+		}
+		manifestCond.BackReportedStatus.ObservedStatus = runtime.RawExtension{Raw: statusData}
+	}
+}
+
+// collectJobTerminationMessages returns the termination messages of the containers in the pods of a Job,
+// with those of the failed containers first and then those of the newer pods first.
+func (r *Reconciler) collectJobTerminationMessages(ctx context.Context, namespace, jobName string) ([]fleetv1beta1.ContainerTerminationMessage, error) {
+	podList, err := r.spokeDynamicClient.Resource(utils.PodGVR).Namespace(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", batchv1.JobNameLabel, jobName),
+	})
+	if err != nil {
+		return nil, controller.NewAPIServerError(false, err)
+	}
+	pods := make([]corev1.Pod, len(podList.Items))
+	for i := range podList.Items {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(podList.Items[i].Object, &pods[i]); err != nil {
+			return nil, controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to convert the pod %s: %w", podList.Items[i].GetName(), err))
+		}
+	}
+	sort.SliceStable(pods, func(i, j int) bool {
+		return pods[j].CreationTimestamp.Before(&pods[i].CreationTimestamp)
+	})
+
+	var messages []fleetv1beta1.ContainerTerminationMessage
+	for i := range pods {
+		pod := &pods[i]
+		statuses := make([]corev1.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+		statuses = append(statuses, pod.Status.InitContainerStatuses...)
+		statuses = append(statuses, pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			terminated := status.State.Terminated
+			if terminated == nil || terminated.Message == "" {
+				continue
+			}
+			message := terminated.Message
+			if len(message) > maxBackReportedTerminationMessageLength {
+				message = message[len(message)-maxBackReportedTerminationMessageLength:]
+			}
+			messages = append(messages, fleetv1beta1.ContainerTerminationMessage{
+				PodName:       pod.Name,
+				ContainerName: status.Name,
+				ExitCode:      terminated.ExitCode,
+				Reason:        terminated.Reason,
+				Message:       message,
+			})
+		}
+	}
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].ExitCode != 0 && messages[j].ExitCode == 0
+	})
+	if len(messages) > maxBackReportedTerminationMessages {
+		messages = messages[:maxBackReportedTerminationMessages]
+	}
+	return messages, nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// TestBackReportJobTerminationMessages tests the backReportJobTerminationMessages method.
+func TestBackReportJobTerminationMessages(t *testing.T) {
+	now := time.Now()
+	terminatedPod := func(name string, created time.Time, containerName string, exitCode int32, message string) *corev1.Pod {
+		return &corev1.Pod{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         nsName,
+				Labels:            map[string]string{batchv1.JobNameLabel: "smoke-test"},
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name: containerName,
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode, Reason: "Error", Message: message},
+						},
+					},
+				},
+			},
+		}
+	}
+	otherJobPod := terminatedPod("other-job-pod", now, "main", 1, "not mine")
+	otherJobPod.Labels[batchv1.JobNameLabel] = "other-job"
+
+	testCases := []struct {
+		name         string
+		pods         []runtime.Object
+		wantMessages []fleetv1beta1.ContainerTerminationMessage
+	}{
+		{
+			name: "no pods",
+		},
+		{
+			name: "failed containers and newer pods first",
+			pods: []runtime.Object{
+				terminatedPod("smoke-test-a", now.Add(-2*time.Minute), "main", 0, "all checks passed"),
+				terminatedPod("smoke-test-b", now.Add(-time.Minute), "main", 1, "check foo failed"),
+				terminatedPod("smoke-test-c", now, "main", 2, "check bar failed"),
+				otherJobPod,
+			},
+			wantMessages: []fleetv1beta1.ContainerTerminationMessage{
+				{PodName: "smoke-test-c", ContainerName: "main", ExitCode: 2, Reason: "Error", Message: "check bar failed"},
+				{PodName: "smoke-test-b", ContainerName: "main", ExitCode: 1, Reason: "Error", Message: "check foo failed"},
+				{PodName: "smoke-test-a", ContainerName: "main", Reason: "Error", Message: "all checks passed"},
+			},
+		},
+		{
+			name: "long message is truncated to its tail",
+			pods: []runtime.Object{
+				terminatedPod("smoke-test-a", now, "main", 1, strings.Repeat("a", 100)+strings.Repeat("b", maxBackReportedTerminationMessageLength)),
+			},
+			wantMessages: []fleetv1beta1.ContainerTerminationMessage{
+				{PodName: "smoke-test-a", ContainerName: "main", ExitCode: 1, Reason: "Error", Message: strings.Repeat("b", maxBackReportedTerminationMessageLength)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Reconciler{
+				spokeDynamicClient: fake.NewSimpleDynamicClient(scheme.Scheme, tc.pods...),
+			}
+			work := &fleetv1beta1.Work{
+				Status: fleetv1beta1.WorkStatus{
+					ManifestConditions: []fleetv1beta1.ManifestCondition{
+						{
+							Identifier: fleetv1beta1.WorkResourceIdentifier{Group: "batch", Version: "v1", Kind: "Job", Resource: "jobs", Namespace: nsName, Name: "smoke-test"},
+							BackReportedStatus: &fleetv1beta1.BackReportedStatus{
+								ObservedStatus: runtime.RawExtension{Raw: []byte(`{"apiVersion":"batch/v1","kind":"Job","projectedStatus":{".status.failed":1}}`)},
+							},
+						},
+					},
+				},
+			}
+
+			r.backReportJobTerminationMessages(context.Background(), work)
+
+			var got struct {
+				ProjectedStatus     map[string]interface{}                     `json:"projectedStatus"`
+				TerminationMessages []fleetv1beta1.ContainerTerminationMessage `json:"terminationMessages"`
+			}
+			if err := json.Unmarshal(work.Status.ManifestConditions[0].BackReportedStatus.ObservedStatus.Raw, &got); err != nil {
+				t.Fatalf("Failed to unmarshal the back-reported status: %v", err)
+			}
+			if diff := cmp.Diff(got.ProjectedStatus, map[string]interface{}{".status.failed": float64(1)}); diff != "" {
+				t.Errorf("projected status mismatch (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(got.TerminationMessages, tc.wantMessages); diff != "" {
+				t.Errorf("termination messages mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -1508,6 +1508,10 @@ func workHandlerFuncs(enqueueCRB bool) handler.Funcs {
 					"Failed to process a delete event for work object")
 				return
 			}
+			if _, ok := evt.Object.GetLabels()[fleetv1beta1.VerificationJobWorkLabel]; ok {
+				// Works that place the verification Jobs of staged update runs are not generated from bindings.
+				return
+			}
 			parentNamespaceName := evt.Object.GetLabels()[fleetv1beta1.ParentNamespaceLabel]
 			if shouldIgnoreWork(enqueueCRB, parentNamespaceName) {
 				klog.V(2).InfoS("Ignoring the work owned by different placement scope", "work", klog.KObj(evt.Object), "parentNamespaceName", parentNamespaceName, "enqueueCRP", enqueueCRB)
@@ -1534,6 +1538,10 @@ func workHandlerFuncs(enqueueCRB bool) handler.Funcs {
 					"Failed to process an update event for work object")
 				return
 			}
+			if _, ok := evt.ObjectNew.GetLabels()[fleetv1beta1.VerificationJobWorkLabel]; ok {
+				// Works that place the verification Jobs of staged update runs are not generated from bindings.
+				return
+			}
 			parentNamespaceName := evt.ObjectNew.GetLabels()[fleetv1beta1.ParentNamespaceLabel]
 			if shouldIgnoreWork(enqueueCRB, parentNamespaceName) {
 				klog.V(2).InfoS("Ignoring the work owned by different placement scope", "work", klog.KObj(evt.ObjectNew), "parentNamespaceName", parentNamespaceName, "enqueueCRP", enqueueCRB)
@@ -0,0 +1,377 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package workloadsummary features a controller that aggregates the statuses back-reported from
+// member clusters into a fleet-wide view of workload readiness for each placement.
+package workloadsummary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// Reconciler reconciles a placement object to maintain the workload summary object that aggregates
+// the statuses back-reported for the resources it places.
+type Reconciler struct {
+	Client client.Client
+	Scheme *runtime.Scheme
+}
+
+// Reconcile reconciles the placement object to create, update, or delete its workload summary object.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	placementKey := controller.GetObjectKeyFromRequest(req)
+	startTime := time.Now()
+	klog.V(2).InfoS("Reconciliation loop starts", "controller", "workloadSummary", "placement", placementKey)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Reconciliation loop ends", "controller", "workloadSummary", "placement", placementKey, "latency", latency)
+	}()
+
+	placementObj, err := controller.FetchPlacementFromNamespacedName(ctx, r.Client, req.NamespacedName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// The workload summary object is owned by the placement object and will be garbage collected.
+			klog.V(2).InfoS("Placement object is not found; skip", "placement", placementKey)
+			return ctrl.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to get the placement object", "placement", placementKey)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+	if placementObj.GetDeletionTimestamp() != nil {
+		klog.V(2).InfoS("Placement object is being deleted; skip", "placement", placementKey)
+		return ctrl.Result{}, nil
+	}
+
+	if !isStatusBackReportingOn(placementObj) {
+		klog.V(2).InfoS("Status back-reporting is not enabled on the placement; remove the workload summary if any", "placement", placementKey)
+		return ctrl.Result{}, r.deleteWorkloadSummary(ctx, placementObj)
+	}
+
+	works, err := r.listWorks(ctx, placementObj)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list the Work objects of the placement", "placement", placementKey)
+		return ctrl.Result{}, err
+	}
+
+	summary := buildWorkloadSummary(works)
+	if len(summary.Resources) == 0 {
+		klog.V(2).InfoS("No workload status has been back-reported for the placement; remove the workload summary if any", "placement", placementKey)
+		return ctrl.Result{}, r.deleteWorkloadSummary(ctx, placementObj)
+	}
+	return ctrl.Result{}, r.syncWorkloadSummary(ctx, placementObj, summary)
+}
+
+// isStatusBackReportingOn returns whether the placement object has status back-reporting enabled, i.e.,
+// whether member agents back-report the statuses of the placed resources.
+func isStatusBackReportingOn(placementObj placementv1beta1.PlacementObj) bool {
+	reportBackStrategy := placementObj.GetPlacementSpec().Strategy.ReportBackStrategy
+	return reportBackStrategy != nil && reportBackStrategy.Type != placementv1beta1.ReportBackStrategyTypeDisabled
+}
+
+// listWorks lists all the Work objects created for the placement object across all member clusters.
+func (r *Reconciler) listWorks(ctx context.Context, placementObj placementv1beta1.PlacementObj) ([]placementv1beta1.Work, error) {
+	labelSelector := client.MatchingLabels{
+		placementv1beta1.PlacementTrackingLabel: placementObj.GetName(),
+	}
+	if placementObj.GetNamespace() != "" {
+		labelSelector[placementv1beta1.ParentNamespaceLabel] = placementObj.GetNamespace()
+	}
+	workList := &placementv1beta1.WorkList{}
+	if err := r.Client.List(ctx, workList, labelSelector); err != nil {
+		return nil, controller.NewAPIServerError(true, fmt.Errorf("failed to list Work objects: %w", err))
+	}
+
+	works := make([]placementv1beta1.Work, 0, len(workList.Items))
+	for idx := range workList.Items {
+		work := workList.Items[idx]
+		// A ClusterResourcePlacement and a ResourcePlacement might share the same name; skip the
+		// Work objects of a ResourcePlacement when listing for a ClusterResourcePlacement.
+		if placementObj.GetNamespace() == "" && work.Labels[placementv1beta1.ParentNamespaceLabel] != "" {
+			continue
+		}
+		if work.DeletionTimestamp != nil {
+			continue
+		}
+		works = append(works, work)
+	}
+	return works, nil
+}
+
+// syncWorkloadSummary creates or updates the workload summary object of the placement object.
+func (r *Reconciler) syncWorkloadSummary(ctx context.Context, placementObj placementv1beta1.PlacementObj, summary placementv1beta1.WorkloadSummary) error {
+	summaryObj := newWorkloadSummaryObj(placementObj)
+	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, summaryObj, func() error {
+		// Only bump the last updated time when the summary changes, so that no-op reconciliations
+		// do not write to the API server.
+		if !equality.Semantic.DeepEqual(*summaryObj.GetWorkloadSummary(), summary) {
+			summaryObj.SetWorkloadSummary(summary)
+			summaryObj.SetLastUpdatedTime(metav1.Now())
+		}
+		// Set the placement as owner - this ensures automatic cleanup when the placement is deleted.
+		return controllerutil.SetControllerReference(placementObj, summaryObj, r.Scheme)
+	})
+	if err != nil {
+		klog.ErrorS(err, "Failed to create or update the workload summary", "placement", klog.KObj(placementObj))
+		return controller.NewAPIServerError(false, fmt.Errorf("failed to create or update the workload summary: %w", err))
+	}
+	klog.V(2).InfoS("Successfully handled the workload summary", "placement", klog.KObj(placementObj), "operation", op)
+	return nil
+}
+
+// deleteWorkloadSummary deletes the workload summary object of the placement object, if any.
+func (r *Reconciler) deleteWorkloadSummary(ctx context.Context, placementObj placementv1beta1.PlacementObj) error {
+	summaryObj := newWorkloadSummaryObj(placementObj)
+	if err := r.Client.Delete(ctx, summaryObj); err != nil && !apierrors.IsNotFound(err) {
+		klog.ErrorS(err, "Failed to delete the workload summary", "placement", klog.KObj(placementObj))
+		return controller.NewAPIServerError(false, fmt.Errorf("failed to delete the workload summary: %w", err))
+	}
+	return nil
+}
+
+// newWorkloadSummaryObj returns an empty workload summary object with the same name (and namespace) as
+// the placement object.
+func newWorkloadSummaryObj(placementObj placementv1beta1.PlacementObj) placementv1beta1.WorkloadSummaryObj {
+	if placementObj.GetNamespace() == "" {
+		return &placementv1beta1.ClusterResourcePlacementWorkloadSummary{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: placementObj.GetName(),
+			},
+		}
+	}
+	return &placementv1beta1.ResourcePlacementWorkloadSummary{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      placementObj.GetName(),
+			Namespace: placementObj.GetNamespace(),
+		},
+	}
+}
+
+// buildWorkloadSummary aggregates the statuses back-reported in the given Work objects into a
+// workload summary, with the resources and clusters sorted by their identifiers.
+func buildWorkloadSummary(works []placementv1beta1.Work) placementv1beta1.WorkloadSummary {
+	resSummaryByIDStr := make(map[string]*placementv1beta1.WorkloadResourceSummary)
+	for idx := range works {
+		work := &works[idx]
+		clusterName := strings.TrimPrefix(work.Namespace, utils.FleetMemberNamespacePrefix)
+		for condIdx := range work.Status.ManifestConditions {
+			manifestCond := &work.Status.ManifestConditions[condIdx]
+			if manifestCond.BackReportedStatus == nil {
+				continue
+			}
+			readyReplicas, replicas, ok := extractReplicaCounts(manifestCond.BackReportedStatus)
+			if !ok {
+				// The resource is not a workload with replicas (or its replica counts are not back-reported).
+				continue
+			}
+
+			resIdentifier := placementv1beta1.ResourceIdentifier{
+				Group:     manifestCond.Identifier.Group,
+				Version:   manifestCond.Identifier.Version,
+				Kind:      manifestCond.Identifier.Kind,
+				Namespace: manifestCond.Identifier.Namespace,
+				Name:      manifestCond.Identifier.Name,
+			}
+			idStr := formatResourceIdentifier(&resIdentifier)
+			resSummary, found := resSummaryByIDStr[idStr]
+			if !found {
+				resSummary = &placementv1beta1.WorkloadResourceSummary{ResourceIdentifier: resIdentifier}
+				resSummaryByIDStr[idStr] = resSummary
+			}
+			resSummary.Clusters = append(resSummary.Clusters, placementv1beta1.ClusterWorkloadSummary{
+				ClusterName:     clusterName,
+				ReadyReplicas:   readyReplicas,
+				Replicas:        replicas,
+				ObservationTime: manifestCond.BackReportedStatus.ObservationTime,
+			})
+		}
+	}
+
+	summary := placementv1beta1.WorkloadSummary{}
+	idStrs := make([]string, 0, len(resSummaryByIDStr))
+	for idStr := range resSummaryByIDStr {
+		idStrs = append(idStrs, idStr)
+	}
+	sort.Strings(idStrs)
+	for _, idStr := range idStrs {
+		resSummary := resSummaryByIDStr[idStr]
+		sort.Slice(resSummary.Clusters, func(i, j int) bool {
+			return resSummary.Clusters[i].ClusterName < resSummary.Clusters[j].ClusterName
+		})
+		for _, clusterSummary := range resSummary.Clusters {
+			resSummary.ReadyReplicas += clusterSummary.ReadyReplicas
+			resSummary.Replicas += clusterSummary.Replicas
+			if clusterSummary.ReadyReplicas >= clusterSummary.Replicas {
+				resSummary.ReadyClusterCount++
+			}
+		}
+		resSummary.ClusterCount = int32(len(resSummary.Clusters))
+		summary.ReadyReplicas += resSummary.ReadyReplicas
+		summary.Replicas += resSummary.Replicas
+		summary.Resources = append(summary.Resources, *resSummary)
+	}
+	return summary
+}
+
+// replicaCountFields are the pairs of status fields, in the order of precedence, that report the
+// numbers of ready and desired replicas of common workloads; DaemonSets use a different pair from
+// Deployments, StatefulSets, and ReplicaSets.
+var replicaCountFields = [][2]string{
+	{"readyReplicas", "replicas"},
+	{"numberReady", "desiredNumberScheduled"},
+}
+
+// extractReplicaCounts extracts the numbers of ready and desired replicas from a back-reported status.
+//
+// With the Mirror report back strategy the counts are read from the mirrored status; with the Projected
+// report back strategy the counts are read from the projected fields, if the status paths select them.
+func extractReplicaCounts(backReportedStatus *placementv1beta1.BackReportedStatus) (int32, int32, bool) {
+	if len(backReportedStatus.ObservedStatus.Raw) == 0 {
+		return 0, 0, false
+	}
+	statusWrapper := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(backReportedStatus.ObservedStatus.Raw, &statusWrapper); err != nil {
+		klog.V(2).InfoS("Failed to unmarshal back-reported status", "err", err)
+		return 0, 0, false
+	}
+
+	var lookup func(field string) (interface{}, bool)
+	switch {
+	case len(statusWrapper["status"]) > 0:
+		status := make(map[string]interface{})
+		if err := json.Unmarshal(statusWrapper["status"], &status); err != nil {
+			klog.V(2).InfoS("Failed to unmarshal back-reported status", "err", err)
+			return 0, 0, false
+		}
+		lookup = func(field string) (interface{}, bool) {
+			v, ok := status[field]
+			return v, ok
+		}
+	case len(statusWrapper[placementv1beta1.ProjectedStatusField]) > 0:
+		projected := make(map[string]interface{})
+		if err := json.Unmarshal(statusWrapper[placementv1beta1.ProjectedStatusField], &projected); err != nil {
+			klog.V(2).InfoS("Failed to unmarshal projected status", "err", err)
+			return 0, 0, false
+		}
+		lookup = func(field string) (interface{}, bool) {
+			// Users may specify the status paths with or without the enclosing braces.
+			path := fmt.Sprintf(".status.%s", field)
+			if v, ok := projected[path]; ok {
+				return v, true
+			}
+			v, ok := projected[fmt.Sprintf("{%s}", path)]
+			return v, ok
+		}
+	default:
+		return 0, 0, false
+	}
+
+	for _, fields := range replicaCountFields {
+		replicas, ok := toInt32(lookup(fields[1]))
+		if !ok {
+			continue
+		}
+		// Workloads might omit the ready count when no replica is ready.
+		readyReplicas, _ := toInt32(lookup(fields[0]))
+		return readyReplicas, replicas, true
+	}
+	return 0, 0, false
+}
+
+// toInt32 converts a JSON number to an int32 value.
+func toInt32(v interface{}, found bool) (int32, bool) {
+	if !found {
+		return 0, false
+	}
+	n, ok := v.(float64)
+	if !ok {
+		return 0, false
+	}
+	return int32(n), true
+}
+
+// formatResourceIdentifier formats a ResourceIdentifier object to a string for keying purposes.
+//
+// The format in use is `[API-GROUP]/[API-VERSION]/[API-KIND]/[NAMESPACE]/[NAME]`, e.g., `apps/v1/Deployment/work/app`.
+func formatResourceIdentifier(resourceIdentifier *placementv1beta1.ResourceIdentifier) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s", resourceIdentifier.Group, resourceIdentifier.Version, resourceIdentifier.Kind, resourceIdentifier.Namespace, resourceIdentifier.Name)
+}
+
+// placementNamespacedNameFromWork returns the namespaced name of the placement object that a Work object
+// is created for, as recorded in the labels of the Work object.
+func placementNamespacedNameFromWork(work client.Object) (types.NamespacedName, bool) {
+	placementName := work.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+	if len(placementName) == 0 {
+		return types.NamespacedName{}, false
+	}
+	return types.NamespacedName{
+		Namespace: work.GetLabels()[placementv1beta1.ParentNamespaceLabel],
+		Name:      placementName,
+	}, true
+}
+
+// enqueueForWork returns a map function that enqueues the placement object of a Work object, if the
+// placement object is of the expected scope.
+func enqueueForWork(isClusterScoped bool) handler.MapFunc {
+	return func(_ context.Context, obj client.Object) []reconcile.Request {
+		nn, ok := placementNamespacedNameFromWork(obj)
+		if !ok || (nn.Namespace == "") != isClusterScoped {
+			return nil
+		}
+		return []reconcile.Request{{NamespacedName: nn}}
+	}
+}
+
+// SetupWithManagerForClusterResourcePlacement sets up the controller with the manager for
+// ClusterResourcePlacement objects.
+func (r *Reconciler) SetupWithManagerForClusterResourcePlacement(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("cluster-resource-placement-workload-summary-controller").
+		For(&placementv1beta1.ClusterResourcePlacement{}).
+		Owns(&placementv1beta1.ClusterResourcePlacementWorkloadSummary{}).
+		Watches(&placementv1beta1.Work{}, handler.EnqueueRequestsFromMapFunc(enqueueForWork(true))).
+		Complete(r)
+}
+
+// SetupWithManagerForResourcePlacement sets up the controller with the manager for
+// ResourcePlacement objects.
+func (r *Reconciler) SetupWithManagerForResourcePlacement(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("resource-placement-workload-summary-controller").
+		For(&placementv1beta1.ResourcePlacement{}).
+		Owns(&placementv1beta1.ResourcePlacementWorkloadSummary{}).
+		Watches(&placementv1beta1.Work{}, handler.EnqueueRequestsFromMapFunc(enqueueForWork(false))).
+		Complete(r)
+}
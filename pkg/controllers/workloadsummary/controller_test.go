@@ -0,0 +1,416 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadsummary
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+)
+
+const (
+	crpName  = "crp-1"
+	rpName   = "rp-1"
+	nsName   = "work"
+	deploy1  = "app"
+	deploy2  = "web"
+	cluster1 = "cluster-1"
+	cluster2 = "cluster-2"
+)
+
+var (
+	observationTime = metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	deployIdentifier = func(name string) placementv1beta1.WorkResourceIdentifier {
+		return placementv1beta1.WorkResourceIdentifier{
+			Group:     "apps",
+			Version:   "v1",
+			Kind:      "Deployment",
+			Resource:  "deployments",
+			Namespace: nsName,
+			Name:      name,
+		}
+	}
+)
+
+func mirroredStatus(status string) *placementv1beta1.BackReportedStatus {
+	return &placementv1beta1.BackReportedStatus{
+		ObservedStatus: runtime.RawExtension{
+			Raw: []byte(fmt.Sprintf(`{"apiVersion":"apps/v1","kind":"Deployment","status":%s}`, status)),
+		},
+		ObservationTime: observationTime,
+	}
+}
+
+func projectedStatus(projected string) *placementv1beta1.BackReportedStatus {
+	return &placementv1beta1.BackReportedStatus{
+		ObservedStatus: runtime.RawExtension{
+			Raw: []byte(fmt.Sprintf(`{"apiVersion":"apps/v1","kind":"Deployment","projectedStatus":%s}`, projected)),
+		},
+		ObservationTime: observationTime,
+	}
+}
+
+func work(clusterName, name string, labels map[string]string, manifestConds ...placementv1beta1.ManifestCondition) placementv1beta1.Work {
+	return placementv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: fmt.Sprintf(utils.NamespaceNameFormat, clusterName),
+			Labels:    labels,
+		},
+		Status: placementv1beta1.WorkStatus{
+			ManifestConditions: manifestConds,
+		},
+	}
+}
+
+// TestExtractReplicaCounts tests the extractReplicaCounts function.
+func TestExtractReplicaCounts(t *testing.T) {
+	testCases := []struct {
+		name              string
+		status            *placementv1beta1.BackReportedStatus
+		wantReadyReplicas int32
+		wantReplicas      int32
+		wantOK            bool
+	}{
+		{
+			name:              "mirrored deployment status",
+			status:            mirroredStatus(`{"replicas":3,"readyReplicas":2}`),
+			wantReadyReplicas: 2,
+			wantReplicas:      3,
+			wantOK:            true,
+		},
+		{
+			name:         "mirrored status with no ready replicas",
+			status:       mirroredStatus(`{"replicas":3}`),
+			wantReplicas: 3,
+			wantOK:       true,
+		},
+		{
+			name:              "mirrored daemonset status",
+			status:            mirroredStatus(`{"desiredNumberScheduled":5,"numberReady":4}`),
+			wantReadyReplicas: 4,
+			wantReplicas:      5,
+			wantOK:            true,
+		},
+		{
+			name:   "mirrored status with no replica counts",
+			status: mirroredStatus(`{"phase":"Active"}`),
+		},
+		{
+			name:              "projected status",
+			status:            projectedStatus(`{".status.replicas":3,"{.status.readyReplicas}":3}`),
+			wantReadyReplicas: 3,
+			wantReplicas:      3,
+			wantOK:            true,
+		},
+		{
+			name:   "projected status with no replica counts",
+			status: projectedStatus(`{".status.conditions[*].type":["Available","Progressing"]}`),
+		},
+		{
+			name: "no observed status",
+			status: &placementv1beta1.BackReportedStatus{
+				ObservationTime: observationTime,
+			},
+		},
+		{
+			name: "malformed observed status",
+			status: &placementv1beta1.BackReportedStatus{
+				ObservedStatus:  runtime.RawExtension{Raw: []byte(`{"status":`)},
+				ObservationTime: observationTime,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			readyReplicas, replicas, ok := extractReplicaCounts(tc.status)
+			if readyReplicas != tc.wantReadyReplicas || replicas != tc.wantReplicas || ok != tc.wantOK {
+				t.Errorf("extractReplicaCounts() = (%d, %d, %t), want (%d, %d, %t)",
+					readyReplicas, replicas, ok, tc.wantReadyReplicas, tc.wantReplicas, tc.wantOK)
+			}
+		})
+	}
+}
+
+// TestBuildWorkloadSummary tests the buildWorkloadSummary function.
+func TestBuildWorkloadSummary(t *testing.T) {
+	works := []placementv1beta1.Work{
+		work(cluster2, "crp-1-work", nil,
+			placementv1beta1.ManifestCondition{
+				Identifier:         deployIdentifier(deploy1),
+				BackReportedStatus: mirroredStatus(`{"replicas":3,"readyReplicas":1}`),
+			},
+			placementv1beta1.ManifestCondition{
+				// A resource with no back-reported status.
+				Identifier: deployIdentifier(deploy2),
+			},
+		),
+		work(cluster1, "crp-1-work", nil,
+			placementv1beta1.ManifestCondition{
+				Identifier:         deployIdentifier(deploy2),
+				BackReportedStatus: mirroredStatus(`{"replicas":2,"readyReplicas":2}`),
+			},
+			placementv1beta1.ManifestCondition{
+				Identifier:         deployIdentifier(deploy1),
+				BackReportedStatus: mirroredStatus(`{"replicas":3,"readyReplicas":3}`),
+			},
+			placementv1beta1.ManifestCondition{
+				// A resource that is not a workload.
+				Identifier: placementv1beta1.WorkResourceIdentifier{
+					Version: "v1",
+					Kind:    "Namespace",
+					Name:    nsName,
+				},
+				BackReportedStatus: mirroredStatus(`{"phase":"Active"}`),
+			},
+		),
+	}
+
+	want := placementv1beta1.WorkloadSummary{
+		ReadyReplicas: 6,
+		Replicas:      8,
+		Resources: []placementv1beta1.WorkloadResourceSummary{
+			{
+				ResourceIdentifier: placementv1beta1.ResourceIdentifier{
+					Group:     "apps",
+					Version:   "v1",
+					Kind:      "Deployment",
+					Namespace: nsName,
+					Name:      deploy1,
+				},
+				ReadyReplicas:     4,
+				Replicas:          6,
+				ReadyClusterCount: 1,
+				ClusterCount:      2,
+				Clusters: []placementv1beta1.ClusterWorkloadSummary{
+					{ClusterName: cluster1, ReadyReplicas: 3, Replicas: 3, ObservationTime: observationTime},
+					{ClusterName: cluster2, ReadyReplicas: 1, Replicas: 3, ObservationTime: observationTime},
+				},
+			},
+			{
+				ResourceIdentifier: placementv1beta1.ResourceIdentifier{
+					Group:     "apps",
+					Version:   "v1",
+					Kind:      "Deployment",
+					Namespace: nsName,
+					Name:      deploy2,
+				},
+				ReadyReplicas:     2,
+				Replicas:          2,
+				ReadyClusterCount: 1,
+				ClusterCount:      1,
+				Clusters: []placementv1beta1.ClusterWorkloadSummary{
+					{ClusterName: cluster1, ReadyReplicas: 2, Replicas: 2, ObservationTime: observationTime},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(buildWorkloadSummary(works), want); diff != "" {
+		t.Errorf("buildWorkloadSummary() mismatches (-got, +want):\n%s", diff)
+	}
+}
+
+// TestEnqueueForWork tests the enqueueForWork function.
+func TestEnqueueForWork(t *testing.T) {
+	crpLabels := map[string]string{placementv1beta1.PlacementTrackingLabel: crpName}
+	rpLabels := map[string]string{
+		placementv1beta1.PlacementTrackingLabel: rpName,
+		placementv1beta1.ParentNamespaceLabel:   nsName,
+	}
+
+	testCases := []struct {
+		name            string
+		labels          map[string]string
+		isClusterScoped bool
+		want            []reconcile.Request
+	}{
+		{
+			name:            "CRP work for the CRP controller",
+			labels:          crpLabels,
+			isClusterScoped: true,
+			want:            []reconcile.Request{{NamespacedName: types.NamespacedName{Name: crpName}}},
+		},
+		{
+			name:            "CRP work for the RP controller",
+			labels:          crpLabels,
+			isClusterScoped: false,
+		},
+		{
+			name:            "RP work for the RP controller",
+			labels:          rpLabels,
+			isClusterScoped: false,
+			want:            []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: nsName, Name: rpName}}},
+		},
+		{
+			name:            "RP work for the CRP controller",
+			labels:          rpLabels,
+			isClusterScoped: true,
+		},
+		{
+			name:            "work without the placement tracking label",
+			isClusterScoped: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := work(cluster1, "work", tc.labels)
+			got := enqueueForWork(tc.isClusterScoped)(context.Background(), &w)
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("enqueueForWork() mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestReconcile tests the Reconcile method.
+func TestReconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add scheme: %v", err)
+	}
+
+	crp := func(reportBackStrategyType placementv1beta1.ReportBackStrategyType) *placementv1beta1.ClusterResourcePlacement {
+		return &placementv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: crpName,
+				UID:  "crp-uid",
+			},
+			Spec: placementv1beta1.PlacementSpec{
+				Strategy: placementv1beta1.RolloutStrategy{
+					ReportBackStrategy: &placementv1beta1.ReportBackStrategy{
+						Type: reportBackStrategyType,
+					},
+				},
+			},
+		}
+	}
+	crpWork := work(cluster1, "crp-1-work",
+		map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+		placementv1beta1.ManifestCondition{
+			Identifier:         deployIdentifier(deploy1),
+			BackReportedStatus: mirroredStatus(`{"replicas":3,"readyReplicas":2}`),
+		},
+	)
+	// A Work object of an RP of the same name, which should be ignored.
+	rpWork := work(cluster2, "work.rp-1-work",
+		map[string]string{
+			placementv1beta1.PlacementTrackingLabel: crpName,
+			placementv1beta1.ParentNamespaceLabel:   nsName,
+		},
+		placementv1beta1.ManifestCondition{
+			Identifier:         deployIdentifier(deploy2),
+			BackReportedStatus: mirroredStatus(`{"replicas":1,"readyReplicas":1}`),
+		},
+	)
+	existingSummary := &placementv1beta1.ClusterResourcePlacementWorkloadSummary{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: crpName,
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		objs        []client.Object
+		wantSummary *placementv1beta1.WorkloadSummary
+	}{
+		{
+			name: "mirror strategy",
+			objs: []client.Object{crp(placementv1beta1.ReportBackStrategyTypeMirror), &crpWork, &rpWork},
+			wantSummary: &placementv1beta1.WorkloadSummary{
+				ReadyReplicas: 2,
+				Replicas:      3,
+				Resources: []placementv1beta1.WorkloadResourceSummary{
+					{
+						ResourceIdentifier: placementv1beta1.ResourceIdentifier{
+							Group:     "apps",
+							Version:   "v1",
+							Kind:      "Deployment",
+							Namespace: nsName,
+							Name:      deploy1,
+						},
+						ReadyReplicas: 2,
+						Replicas:      3,
+						ClusterCount:  1,
+						Clusters: []placementv1beta1.ClusterWorkloadSummary{
+							{ClusterName: cluster1, ReadyReplicas: 2, Replicas: 3, ObservationTime: observationTime},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "disabled strategy",
+			objs: []client.Object{crp(placementv1beta1.ReportBackStrategyTypeDisabled), &crpWork, existingSummary},
+		},
+		{
+			name: "no back-reported workload status",
+			objs: []client.Object{crp(placementv1beta1.ReportBackStrategyTypeMirror), existingSummary},
+		},
+		{
+			name:        "placement not found",
+			objs:        []client.Object{existingSummary},
+			wantSummary: &placementv1beta1.WorkloadSummary{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.objs...).
+				Build()
+			r := &Reconciler{Client: fakeClient, Scheme: scheme}
+			ctx := context.Background()
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: crpName}}); err != nil {
+				t.Fatalf("Reconcile() = %v, want no error", err)
+			}
+
+			gotSummary := &placementv1beta1.ClusterResourcePlacementWorkloadSummary{}
+			err := fakeClient.Get(ctx, types.NamespacedName{Name: crpName}, gotSummary)
+			if tc.wantSummary == nil {
+				if !apierrors.IsNotFound(err) {
+					t.Fatalf("Get() workload summary = %v, want not found", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Get() workload summary = %v, want no error", err)
+			}
+			if diff := cmp.Diff(gotSummary.WorkloadSummary, *tc.wantSummary, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("workload summary mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
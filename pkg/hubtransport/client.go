@@ -0,0 +1,593 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hubtransport
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	hubtransportv1 "github.com/kubefleet-dev/kubefleet/pkg/hubtransport/proto/v1"
+)
+
+const (
+	// reconnectDelay is the delay before a member agent reconnects to the gateway after
+	// the stream breaks.
+	reconnectDelay = time.Second * 5
+	// eventChannelBufferSize is the buffer size of the channels that deliver object events to
+	// controllers.
+	eventChannelBufferSize = 256
+	// keepaliveTime is the interval at which a member agent pings the gateway on an idle
+	// connection, so that the connection is not dropped by NAT devices or proxies in between,
+	// and a dead gateway is detected.
+	keepaliveTime = time.Second * 30
+	// keepaliveTimeout is the time a member agent waits for the gateway to ack a ping before
+	// closing the connection.
+	keepaliveTimeout = time.Second * 10
+)
+
+// make sure that the client implements controller runtime interfaces
+var (
+	_ client.Client    = &Client{}
+	_ manager.Runnable = &Client{}
+)
+
+// Client is the member cluster side of the pull-based transport. It keeps a stream to the
+// gateway open, mirrors the hub cluster objects that the gateway sends, and forwards writes
+// to the gateway.
+//
+// Client implements the controller-runtime client interface, so that it can be plugged in
+// wherever the member agent uses a hub cluster client; reads are served from the mirrored
+// objects. Only Work and InternalMemberCluster objects are supported, and objects cannot be
+// created or deleted.
+type Client struct {
+	gatewayAddress string
+	scheme         *runtime.Scheme
+	restMapper     meta.RESTMapper
+	// dialOpts are the options for connecting to the gateway; it is kept as a field for testing
+	// purposes.
+	dialOpts []grpc.DialOption
+
+	mu sync.RWMutex
+	// objects are the mirrored hub cluster objects.
+	objects map[ObjectKind]map[types.NamespacedName]client.Object
+	// pending tracks the write requests that are waiting for responses.
+	pending map[uint64]chan *hubtransportv1.WriteResponse
+	// stream is the stream to the gateway, if connected; it is nil if the client is not
+	// connected, or the initial sync has not completed yet.
+	stream memberStream
+	// synced is true if the client has completed the initial sync at least once.
+	synced bool
+
+	// sendMu serializes sends on the stream, as gRPC streams do not support concurrent sends.
+	sendMu sync.Mutex
+	nextID atomic.Uint64
+	// events are the channels that deliver object events to controllers, by object kind.
+	events map[ObjectKind]chan event.GenericEvent
+}
+
+// NewClient returns a new client that connects to the gateway at the given address with the
+// given TLS config.
+func NewClient(gatewayAddress string, tlsConfig *tls.Config, scheme *runtime.Scheme) *Client {
+	restMapper := meta.NewDefaultRESTMapper(nil)
+	for _, kind := range []ObjectKind{ObjectKindWork, ObjectKindInternalMemberCluster} {
+		restMapper.Add(groupVersionKindOf(kind), meta.RESTScopeNamespace)
+	}
+	return &Client{
+		gatewayAddress: gatewayAddress,
+		scheme:         scheme,
+		restMapper:     restMapper,
+		dialOpts: []grpc.DialOption{
+			grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+			grpc.WithKeepaliveParams(keepalive.ClientParameters{
+				Time:                keepaliveTime,
+				Timeout:             keepaliveTimeout,
+				PermitWithoutStream: true,
+			}),
+		},
+		objects: map[ObjectKind]map[types.NamespacedName]client.Object{
+			ObjectKindWork:                  {},
+			ObjectKindInternalMemberCluster: {},
+		},
+		pending: make(map[uint64]chan *hubtransportv1.WriteResponse),
+		events:  make(map[ObjectKind]chan event.GenericEvent),
+	}
+}
+
+// EventsFor returns a channel that delivers events on objects of the given kind; controllers can
+// watch the channel as a source. An event is delivered when an object is created or deleted, or
+// when its generation changes.
+//
+// Note that this method must be called before the client starts.
+func (c *Client) EventsFor(kind ObjectKind) <-chan event.GenericEvent {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch, ok := c.events[kind]
+	if !ok {
+		ch = make(chan event.GenericEvent, eventChannelBufferSize)
+		c.events[kind] = ch
+	}
+	return ch
+}
+
+// IsConnected returns true if the client is connected to the gateway and has completed
+// the initial sync.
+func (c *Client) IsConnected() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.stream != nil
+}
+
+// Start keeps a stream to the gateway open until the context is cancelled.
+func (c *Client) Start(ctx context.Context) error {
+	klog.InfoS("Starting the hub transport client", "gateway", c.gatewayAddress)
+	defer klog.InfoS("The hub transport client is stopped")
+
+	for {
+		err := c.runStream(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		klog.ErrorS(err, "The stream to the hub transport gateway is broken; will reconnect", "gateway", c.gatewayAddress)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait.Jitter(reconnectDelay, 0.1)):
+		}
+	}
+}
+
+// runStream opens a stream to the gateway and processes the messages from the gateway until
+// the stream breaks.
+func (c *Client) runStream(ctx context.Context) error {
+	conn, err := grpc.NewClient(c.gatewayAddress, c.dialOpts...)
+	if err != nil {
+		return fmt.Errorf("failed to set up a connection to the gateway: %w", err)
+	}
+	defer conn.Close()
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := hubtransportv1.NewHubTransportClient(conn).Connect(streamCtx)
+	if err != nil {
+		return fmt.Errorf("failed to open a stream to the gateway: %w", err)
+	}
+	defer c.disconnect()
+
+	// seen tracks the objects received during the initial sync; objects that are mirrored
+	// before but not seen by the end of the initial sync have been deleted while the client
+	// is disconnected.
+	seen := make(map[ObjectKind]map[types.NamespacedName]bool)
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		switch {
+		case msg.GetObjectEvent() != nil:
+			if err := c.handleObjectEvent(ctx, msg.GetObjectEvent(), seen); err != nil {
+				klog.ErrorS(err, "Failed to handle an object event from the gateway", "kind", msg.GetObjectEvent().GetKind())
+			}
+		case msg.GetSyncComplete():
+			c.completeSync(ctx, stream, seen)
+			seen = nil
+			klog.V(2).InfoS("Completed the initial sync with the hub transport gateway")
+		case msg.GetWriteResponse() != nil:
+			resp := msg.GetWriteResponse()
+			c.mu.Lock()
+			ch, ok := c.pending[resp.GetId()]
+			delete(c.pending, resp.GetId())
+			c.mu.Unlock()
+			if ok {
+				ch <- resp
+			}
+		}
+	}
+}
+
+// handleObjectEvent updates the mirrored objects with an object event.
+func (c *Client) handleObjectEvent(ctx context.Context, ev *hubtransportv1.ObjectEvent, seen map[ObjectKind]map[types.NamespacedName]bool) error {
+	kind := ObjectKind(ev.GetKind())
+	obj, err := newObjectOfKind(kind)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(ev.GetObject(), obj); err != nil {
+		return fmt.Errorf("failed to decode the object: %w", err)
+	}
+	key := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+
+	switch ObjectEventType(ev.GetType()) {
+	case ObjectEventTypeUpserted:
+		if seen != nil {
+			if _, ok := seen[kind]; !ok {
+				seen[kind] = make(map[types.NamespacedName]bool)
+			}
+			seen[kind][key] = true
+		}
+		c.upsert(ctx, kind, obj)
+	case ObjectEventTypeDeleted:
+		c.remove(ctx, kind, key)
+	default:
+		return fmt.Errorf("unsupported object event type %s", ev.GetType())
+	}
+	return nil
+}
+
+// completeSync removes the mirrored objects that are not seen in the initial sync, and marks
+// the client as connected.
+func (c *Client) completeSync(ctx context.Context, stream memberStream, seen map[ObjectKind]map[types.NamespacedName]bool) {
+	c.mu.RLock()
+	var stale []struct {
+		kind ObjectKind
+		key  types.NamespacedName
+	}
+	for kind, objs := range c.objects {
+		for key := range objs {
+			if !seen[kind][key] {
+				stale = append(stale, struct {
+					kind ObjectKind
+					key  types.NamespacedName
+				}{kind: kind, key: key})
+			}
+		}
+	}
+	c.mu.RUnlock()
+	for _, s := range stale {
+		c.remove(ctx, s.kind, s.key)
+	}
+
+	c.mu.Lock()
+	c.stream = stream
+	c.synced = true
+	c.mu.Unlock()
+}
+
+// disconnect marks the client as disconnected and fails all the pending write requests.
+func (c *Client) disconnect() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stream = nil
+	// The error status is well-formed, so that encoding it never fails.
+	errStatus, _ := json.Marshal(apierrors.NewServiceUnavailable("the stream to the hub transport gateway is broken").ErrStatus)
+	for id, ch := range c.pending {
+		ch <- &hubtransportv1.WriteResponse{
+			Id:          id,
+			ErrorStatus: errStatus,
+		}
+		delete(c.pending, id)
+	}
+}
+
+// upsert adds or updates a mirrored object, and delivers an event if applicable.
+func (c *Client) upsert(ctx context.Context, kind ObjectKind, obj client.Object) {
+	key := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+	c.mu.Lock()
+	existing, found := c.objects[kind][key]
+	c.objects[kind][key] = obj
+	ch := c.events[kind]
+	c.mu.Unlock()
+
+	if found && existing.GetGeneration() == obj.GetGeneration() {
+		return
+	}
+	c.deliver(ctx, ch, obj)
+}
+
+// remove removes a mirrored object, and delivers an event if applicable.
+func (c *Client) remove(ctx context.Context, kind ObjectKind, key types.NamespacedName) {
+	c.mu.Lock()
+	existing, found := c.objects[kind][key]
+	delete(c.objects[kind], key)
+	ch := c.events[kind]
+	c.mu.Unlock()
+
+	if found {
+		c.deliver(ctx, ch, existing)
+	}
+}
+
+// deliver delivers an event on an object to a channel (if any).
+func (c *Client) deliver(ctx context.Context, ch chan event.GenericEvent, obj client.Object) {
+	if ch == nil {
+		return
+	}
+	select {
+	case ch <- event.GenericEvent{Object: obj.DeepCopyObject().(client.Object)}:
+	case <-ctx.Done():
+	}
+}
+
+// send sends a message to the gateway.
+func (c *Client) send(msg *hubtransportv1.MemberMessage) error {
+	c.mu.RLock()
+	stream := c.stream
+	c.mu.RUnlock()
+	if stream == nil {
+		return apierrors.NewServiceUnavailable("the hub transport client is not connected to the gateway")
+	}
+
+	c.sendMu.Lock()
+	defer c.sendMu.Unlock()
+	return stream.Send(msg)
+}
+
+// write sends a write request to the gateway and waits for the response; on success, the
+// given object is overwritten with the object returned by the gateway.
+func (c *Client) write(ctx context.Context, verb WriteVerb, obj client.Object, patch client.Patch) error {
+	kind, err := kindOfObject(obj)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed to encode the object: %w", err)
+	}
+	req := &hubtransportv1.WriteRequest{
+		Id:     c.nextID.Inc(),
+		Verb:   string(verb),
+		Kind:   string(kind),
+		Object: data,
+	}
+	if patch != nil {
+		req.PatchType = string(patch.Type())
+		if req.Patch, err = patch.Data(obj); err != nil {
+			return fmt.Errorf("failed to prepare the patch data: %w", err)
+		}
+	}
+
+	respCh := make(chan *hubtransportv1.WriteResponse, 1)
+	c.mu.Lock()
+	c.pending[req.Id] = respCh
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, req.Id)
+		c.mu.Unlock()
+	}()
+
+	if err := c.send(&hubtransportv1.MemberMessage{Message: &hubtransportv1.MemberMessage_WriteRequest{WriteRequest: req}}); err != nil {
+		return err
+	}
+	var resp *hubtransportv1.WriteResponse
+	select {
+	case resp = <-respCh:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if len(resp.GetErrorStatus()) > 0 {
+		errStatus := metav1.Status{}
+		if err := json.Unmarshal(resp.GetErrorStatus(), &errStatus); err != nil {
+			return fmt.Errorf("failed to decode the error status: %w", err)
+		}
+		return &apierrors.StatusError{ErrStatus: errStatus}
+	}
+
+	written, err := newObjectOfKind(kind)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(resp.GetObject(), written); err != nil {
+		return fmt.Errorf("failed to decode the written object: %w", err)
+	}
+	// Update the mirror right away so that subsequent reads observe the write.
+	c.upsert(ctx, kind, written.DeepCopyObject().(client.Object))
+	return assignObject(obj, written)
+}
+
+// Get implements the client.Reader interface.
+func (c *Client) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	kind, err := kindOfObject(obj)
+	if err != nil {
+		return err
+	}
+	c.mu.RLock()
+	synced := c.synced
+	stored, found := c.objects[kind][key]
+	c.mu.RUnlock()
+	if !synced {
+		// Do not report objects as not found before the mirror is populated.
+		return apierrors.NewServiceUnavailable("the hub transport client has not synced with the gateway yet")
+	}
+	if !found {
+		return apierrors.NewNotFound(groupResourceOfKind(kind), key.Name)
+	}
+	return assignObject(obj, stored)
+}
+
+// List implements the client.Reader interface.
+func (c *Client) List(_ context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+
+	var kind ObjectKind
+	switch list.(type) {
+	case *placementv1beta1.WorkList:
+		kind = ObjectKindWork
+	case *clusterv1beta1.InternalMemberClusterList:
+		kind = ObjectKindInternalMemberCluster
+	default:
+		return fmt.Errorf("unsupported object list type %T", list)
+	}
+
+	c.mu.RLock()
+	if !c.synced {
+		c.mu.RUnlock()
+		return apierrors.NewServiceUnavailable("the hub transport client has not synced with the gateway yet")
+	}
+	matched := make([]client.Object, 0, len(c.objects[kind]))
+	for key, obj := range c.objects[kind] {
+		if len(listOpts.Namespace) > 0 && key.Namespace != listOpts.Namespace {
+			continue
+		}
+		if listOpts.LabelSelector != nil && !listOpts.LabelSelector.Matches(labels.Set(obj.GetLabels())) {
+			continue
+		}
+		matched = append(matched, obj.DeepCopyObject().(client.Object))
+	}
+	c.mu.RUnlock()
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i].GetNamespace() != matched[j].GetNamespace() {
+			return matched[i].GetNamespace() < matched[j].GetNamespace()
+		}
+		return matched[i].GetName() < matched[j].GetName()
+	})
+
+	switch l := list.(type) {
+	case *placementv1beta1.WorkList:
+		l.Items = make([]placementv1beta1.Work, 0, len(matched))
+		for _, obj := range matched {
+			l.Items = append(l.Items, *obj.(*placementv1beta1.Work))
+		}
+	case *clusterv1beta1.InternalMemberClusterList:
+		l.Items = make([]clusterv1beta1.InternalMemberCluster, 0, len(matched))
+		for _, obj := range matched {
+			l.Items = append(l.Items, *obj.(*clusterv1beta1.InternalMemberCluster))
+		}
+	}
+	return nil
+}
+
+// Apply implements the client.Writer interface; it is not supported.
+func (c *Client) Apply(_ context.Context, _ runtime.ApplyConfiguration, _ ...client.ApplyOption) error {
+	return errUnsupportedVerb("apply")
+}
+
+// Create implements the client.Writer interface; it is not supported.
+func (c *Client) Create(_ context.Context, _ client.Object, _ ...client.CreateOption) error {
+	return errUnsupportedVerb("create")
+}
+
+// Delete implements the client.Writer interface; it is not supported.
+func (c *Client) Delete(_ context.Context, _ client.Object, _ ...client.DeleteOption) error {
+	return errUnsupportedVerb("delete")
+}
+
+// DeleteAllOf implements the client.Writer interface; it is not supported.
+func (c *Client) DeleteAllOf(_ context.Context, _ client.Object, _ ...client.DeleteAllOfOption) error {
+	return errUnsupportedVerb("deletecollection")
+}
+
+// Update implements the client.Writer interface.
+func (c *Client) Update(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
+	return c.write(ctx, WriteVerbUpdate, obj, nil)
+}
+
+// Patch implements the client.Writer interface.
+func (c *Client) Patch(ctx context.Context, obj client.Object, patch client.Patch, _ ...client.PatchOption) error {
+	return c.write(ctx, WriteVerbPatch, obj, patch)
+}
+
+// Status implements the client.StatusClient interface.
+func (c *Client) Status() client.SubResourceWriter {
+	return &statusClient{c: c}
+}
+
+// SubResource implements the client.SubResourceClientConstructor interface; only the status
+// subresource is supported.
+func (c *Client) SubResource(subResource string) client.SubResourceClient {
+	return &statusClient{c: c, unsupported: subResource != "status"}
+}
+
+// Scheme implements the client.Client interface.
+func (c *Client) Scheme() *runtime.Scheme {
+	return c.scheme
+}
+
+// RESTMapper implements the client.Client interface.
+func (c *Client) RESTMapper() meta.RESTMapper {
+	return c.restMapper
+}
+
+// GroupVersionKindFor implements the client.Client interface.
+func (c *Client) GroupVersionKindFor(obj runtime.Object) (schema.GroupVersionKind, error) {
+	return apiutil.GVKForObject(obj, c.scheme)
+}
+
+// IsObjectNamespaced implements the client.Client interface; all the supported objects are
+// namespaced.
+func (c *Client) IsObjectNamespaced(obj runtime.Object) (bool, error) {
+	clientObj, ok := obj.(client.Object)
+	if !ok {
+		return false, fmt.Errorf("unsupported object type %T", obj)
+	}
+	if _, err := kindOfObject(clientObj); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// statusClient writes the status subresource via the transport.
+type statusClient struct {
+	c           *Client
+	unsupported bool
+}
+
+// Get implements the client.SubResourceReader interface; it is not supported.
+func (s *statusClient) Get(_ context.Context, _ client.Object, _ client.Object, _ ...client.SubResourceGetOption) error {
+	return errUnsupportedVerb("get")
+}
+
+// Create implements the client.SubResourceWriter interface; it is not supported.
+func (s *statusClient) Create(_ context.Context, _ client.Object, _ client.Object, _ ...client.SubResourceCreateOption) error {
+	return errUnsupportedVerb("create")
+}
+
+// Update implements the client.SubResourceWriter interface.
+func (s *statusClient) Update(ctx context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+	if s.unsupported {
+		return errUnsupportedVerb("update")
+	}
+	return s.c.write(ctx, WriteVerbUpdateStatus, obj, nil)
+}
+
+// Patch implements the client.SubResourceWriter interface.
+func (s *statusClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, _ ...client.SubResourcePatchOption) error {
+	if s.unsupported {
+		return errUnsupportedVerb("patch")
+	}
+	return s.c.write(ctx, WriteVerbPatchStatus, obj, patch)
+}
+
+// errUnsupportedVerb returns an error for verbs that the transport does not support.
+func errUnsupportedVerb(verb string) error {
+	return apierrors.NewMethodNotSupported(schema.GroupResource{Group: placementv1beta1.GroupVersion.Group}, verb)
+}
@@ -0,0 +1,359 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hubtransport
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	hubtransportv1 "github.com/kubefleet-dev/kubefleet/pkg/hubtransport/proto/v1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+)
+
+// make sure that the gateway implements controller runtime interfaces
+var (
+	_ manager.Runnable               = &Gateway{}
+	_ manager.LeaderElectionRunnable = &Gateway{}
+)
+
+// Gateway is the hub cluster side of the pull-based transport. It accepts streams from member
+// agents, sends them the hub cluster objects in their reserved namespaces, and writes objects on
+// their behalf.
+//
+// Member agents authenticate with TLS client certificates; the common name of a certificate
+// must be the name of the member cluster, which decides the reserved namespace whose objects
+// the member agent can read and write.
+type Gateway struct {
+	hubClient      client.Client
+	listenAddress  string
+	tlsConfig      *tls.Config
+	resyncInterval time.Duration
+
+	// identify returns the name of the member cluster that opens a stream; it is kept as a field
+	// for testing purposes.
+	identify func(ctx context.Context) (string, error)
+}
+
+// NewGateway returns a new gateway that serves member agents at the given address with the
+// given TLS config, and checks for changes on hub cluster objects at the given interval.
+func NewGateway(hubClient client.Client, listenAddress string, tlsConfig *tls.Config, resyncInterval time.Duration) *Gateway {
+	return &Gateway{
+		hubClient:      hubClient,
+		listenAddress:  listenAddress,
+		tlsConfig:      tlsConfig,
+		resyncInterval: resyncInterval,
+		identify:       identifyMemberByTLSCert,
+	}
+}
+
+// Start runs the gateway until the context is cancelled.
+func (g *Gateway) Start(ctx context.Context) error {
+	if g.tlsConfig == nil {
+		return fmt.Errorf("the hub transport gateway requires a TLS config")
+	}
+	lis, err := net.Listen("tcp", g.listenAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen at %s: %w", g.listenAddress, err)
+	}
+	klog.InfoS("Starting the hub transport gateway", "address", g.listenAddress)
+	defer klog.InfoS("The hub transport gateway is stopped")
+	return g.serve(ctx, lis, grpc.Creds(credentials.NewTLS(g.tlsConfig)))
+}
+
+// NeedLeaderElection implements the LeaderElectionRunnable interface.
+// Returns false so that member agents can connect to any of the hub agent replicas.
+func (g *Gateway) NeedLeaderElection() bool {
+	return false
+}
+
+// serve serves the transport service with the given listener until the context is cancelled.
+func (g *Gateway) serve(ctx context.Context, lis net.Listener, opts ...grpc.ServerOption) error {
+	opts = append(opts,
+		// Accept the keepalive pings that member agents send on idle connections.
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             keepaliveTime / 2,
+			PermitWithoutStream: true,
+		}),
+	)
+	s := grpc.NewServer(opts...)
+	hubtransportv1.RegisterHubTransportServer(s, &gatewayServer{gateway: g})
+
+	go func() {
+		<-ctx.Done()
+		// Streams are long-lived; stop the server without waiting for them to end.
+		s.Stop()
+	}()
+	if err := s.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		return fmt.Errorf("failed to serve the hub transport: %w", err)
+	}
+	return nil
+}
+
+// connect serves a stream from a member agent.
+func (g *Gateway) connect(stream hubStream) error {
+	ctx := stream.Context()
+	memberClusterName, err := g.identify(ctx)
+	if err != nil {
+		klog.ErrorS(err, "Failed to identify the member agent")
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	namespace := fmt.Sprintf(utils.NamespaceNameFormat, memberClusterName)
+	klog.InfoS("A member agent has connected to the hub transport gateway", "memberCluster", memberClusterName)
+	defer klog.InfoS("A member agent has disconnected from the hub transport gateway", "memberCluster", memberClusterName)
+
+	// Receive messages in a separate goroutine; all sends happen in this goroutine, as gRPC
+	// streams do not support concurrent sends.
+	msgs := make(chan *hubtransportv1.MemberMessage)
+	recvErrs := make(chan error, 1)
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				recvErrs <- err
+				return
+			}
+			select {
+			case msgs <- msg:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// sent tracks the resource versions of the objects that have been sent to the member agent.
+	sent := make(map[ObjectKind]map[types.NamespacedName]string)
+	if err := g.resync(ctx, stream, namespace, sent); err != nil {
+		return err
+	}
+	if err := stream.Send(&hubtransportv1.HubMessage{Message: &hubtransportv1.HubMessage_SyncComplete{SyncComplete: true}}); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(g.resyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-recvErrs:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		case msg := <-msgs:
+			if msg.GetWriteRequest() != nil {
+				resp := g.write(ctx, namespace, msg.GetWriteRequest())
+				if err := stream.Send(&hubtransportv1.HubMessage{Message: &hubtransportv1.HubMessage_WriteResponse{WriteResponse: resp}}); err != nil {
+					return err
+				}
+			}
+		case <-ticker.C:
+			if err := g.resync(ctx, stream, namespace, sent); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// resync sends the member agent all the objects that have changed since the last resync.
+func (g *Gateway) resync(ctx context.Context, stream hubStream, namespace string, sent map[ObjectKind]map[types.NamespacedName]string) error {
+	for _, kind := range []ObjectKind{ObjectKindInternalMemberCluster, ObjectKindWork} {
+		objs, err := g.listObjects(ctx, kind, namespace)
+		if err != nil {
+			// Skip this round; the objects will be sent in the next resync.
+			klog.ErrorS(err, "Failed to list objects for the member agent", "kind", kind, "namespace", namespace)
+			continue
+		}
+
+		sentOfKind, ok := sent[kind]
+		if !ok {
+			sentOfKind = make(map[types.NamespacedName]string)
+			sent[kind] = sentOfKind
+		}
+		found := make(map[types.NamespacedName]bool, len(objs))
+		for _, obj := range objs {
+			key := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+			found[key] = true
+			if rv, ok := sentOfKind[key]; ok && rv == obj.GetResourceVersion() {
+				continue
+			}
+			if err := sendObjectEvent(stream, ObjectEventTypeUpserted, kind, obj); err != nil {
+				return err
+			}
+			sentOfKind[key] = obj.GetResourceVersion()
+		}
+		for key := range sentOfKind {
+			if found[key] {
+				continue
+			}
+			deleted := &metav1.PartialObjectMetadata{
+				ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+			}
+			if err := sendObjectEvent(stream, ObjectEventTypeDeleted, kind, deleted); err != nil {
+				return err
+			}
+			delete(sentOfKind, key)
+		}
+	}
+	return nil
+}
+
+// listObjects lists the objects of a specific kind in a namespace.
+func (g *Gateway) listObjects(ctx context.Context, kind ObjectKind, namespace string) ([]client.Object, error) {
+	switch kind {
+	case ObjectKindWork:
+		workList := &placementv1beta1.WorkList{}
+		if err := g.hubClient.List(ctx, workList, client.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+		objs := make([]client.Object, 0, len(workList.Items))
+		for idx := range workList.Items {
+			objs = append(objs, &workList.Items[idx])
+		}
+		return objs, nil
+	case ObjectKindInternalMemberCluster:
+		imcList := &clusterv1beta1.InternalMemberClusterList{}
+		if err := g.hubClient.List(ctx, imcList, client.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+		objs := make([]client.Object, 0, len(imcList.Items))
+		for idx := range imcList.Items {
+			objs = append(objs, &imcList.Items[idx])
+		}
+		return objs, nil
+	default:
+		return nil, fmt.Errorf("unsupported object kind %s", kind)
+	}
+}
+
+// write runs a write request from a member agent.
+func (g *Gateway) write(ctx context.Context, namespace string, req *hubtransportv1.WriteRequest) *hubtransportv1.WriteResponse {
+	kind := ObjectKind(req.GetKind())
+	obj, err := newObjectOfKind(kind)
+	if err != nil {
+		return errorResponse(req.GetId(), &apierrors.NewBadRequest(err.Error()).ErrStatus)
+	}
+	if err := json.Unmarshal(req.GetObject(), obj); err != nil {
+		return errorResponse(req.GetId(), &apierrors.NewBadRequest(fmt.Sprintf("failed to decode the object: %v", err)).ErrStatus)
+	}
+	// Member agents can only write objects in their own reserved namespaces.
+	if obj.GetNamespace() != namespace {
+		return errorResponse(req.GetId(), &apierrors.NewForbidden(groupResourceOfKind(kind), obj.GetName(),
+			fmt.Errorf("member agents can only write objects in namespace %s", namespace)).ErrStatus)
+	}
+
+	patch := client.RawPatch(types.PatchType(req.GetPatchType()), req.GetPatch())
+	switch WriteVerb(req.GetVerb()) {
+	case WriteVerbUpdate:
+		err = g.hubClient.Update(ctx, obj)
+	case WriteVerbPatch:
+		err = g.hubClient.Patch(ctx, obj, patch)
+	case WriteVerbUpdateStatus:
+		err = g.hubClient.Status().Update(ctx, obj)
+	case WriteVerbPatchStatus:
+		err = g.hubClient.Status().Patch(ctx, obj, patch)
+	default:
+		err = apierrors.NewBadRequest(fmt.Sprintf("unsupported write verb %s", req.GetVerb()))
+	}
+	if err != nil {
+		klog.V(2).InfoS("Failed to write an object for the member agent", "verb", req.GetVerb(), "kind", kind, "object", klog.KObj(obj), "err", err)
+		return errorResponse(req.GetId(), statusOfError(err))
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return errorResponse(req.GetId(), &apierrors.NewInternalError(err).ErrStatus)
+	}
+	return &hubtransportv1.WriteResponse{Id: req.GetId(), Object: data}
+}
+
+// errorResponse returns the response to a failed write request, with the JSON-encoded API status.
+func errorResponse(id uint64, s *metav1.Status) *hubtransportv1.WriteResponse {
+	// The API status is well-formed, so that encoding it never fails.
+	data, _ := json.Marshal(s)
+	return &hubtransportv1.WriteResponse{Id: id, ErrorStatus: data}
+}
+
+// sendObjectEvent sends an object event to the member agent.
+func sendObjectEvent(stream hubStream, eventType ObjectEventType, kind ObjectKind, obj any) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed to encode the object: %w", err)
+	}
+	return stream.Send(&hubtransportv1.HubMessage{
+		Message: &hubtransportv1.HubMessage_ObjectEvent{
+			ObjectEvent: &hubtransportv1.ObjectEvent{
+				Type:   string(eventType),
+				Kind:   string(kind),
+				Object: data,
+			},
+		},
+	})
+}
+
+// statusOfError returns the API status of an error.
+func statusOfError(err error) *metav1.Status {
+	var apiStatus apierrors.APIStatus
+	if errors.As(err, &apiStatus) {
+		s := apiStatus.Status()
+		return &s
+	}
+	return &apierrors.NewInternalError(err).ErrStatus
+}
+
+// identifyMemberByTLSCert returns the name of the member cluster that opens a stream, as
+// specified by the common name of its (verified) TLS client certificate.
+func identifyMemberByTLSCert(ctx context.Context) (string, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", fmt.Errorf("no peer information is found")
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return "", fmt.Errorf("the connection is not secured with TLS")
+	}
+	chains := tlsInfo.State.VerifiedChains
+	if len(chains) == 0 || len(chains[0]) == 0 {
+		return "", fmt.Errorf("no verified client certificate is found")
+	}
+	name := chains[0][0].Subject.CommonName
+	if len(name) == 0 {
+		return "", fmt.Errorf("the client certificate has no common name")
+	}
+	return name, nil
+}
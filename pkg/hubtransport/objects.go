@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hubtransport
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// newObjectOfKind returns an empty object of the given kind.
+func newObjectOfKind(kind ObjectKind) (client.Object, error) {
+	switch kind {
+	case ObjectKindWork:
+		return &placementv1beta1.Work{}, nil
+	case ObjectKindInternalMemberCluster:
+		return &clusterv1beta1.InternalMemberCluster{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported object kind %s", kind)
+	}
+}
+
+// kindOfObject returns the kind of the given object.
+func kindOfObject(obj client.Object) (ObjectKind, error) {
+	switch obj.(type) {
+	case *placementv1beta1.Work:
+		return ObjectKindWork, nil
+	case *clusterv1beta1.InternalMemberCluster:
+		return ObjectKindInternalMemberCluster, nil
+	default:
+		return "", fmt.Errorf("unsupported object type %T", obj)
+	}
+}
+
+// groupVersionKindOf returns the group, version, and kind of the given object kind.
+func groupVersionKindOf(kind ObjectKind) schema.GroupVersionKind {
+	switch kind {
+	case ObjectKindInternalMemberCluster:
+		return clusterv1beta1.GroupVersion.WithKind(clusterv1beta1.InternalMemberClusterKind)
+	default:
+		return placementv1beta1.GroupVersion.WithKind(placementv1beta1.WorkKind)
+	}
+}
+
+// groupResourceOfKind returns the group and resource of the given object kind, for use in errors.
+func groupResourceOfKind(kind ObjectKind) schema.GroupResource {
+	switch kind {
+	case ObjectKindInternalMemberCluster:
+		return clusterv1beta1.GroupVersion.WithResource("internalmemberclusters").GroupResource()
+	default:
+		return placementv1beta1.GroupVersion.WithResource("works").GroupResource()
+	}
+}
+
+// assignObject overwrites the destination object with the source object, which must be of
+// the same type.
+func assignObject(dst, src client.Object) error {
+	switch d := dst.(type) {
+	case *placementv1beta1.Work:
+		s, ok := src.(*placementv1beta1.Work)
+		if !ok {
+			return fmt.Errorf("cannot assign an object of type %T to an object of type %T", src, dst)
+		}
+		*d = *s.DeepCopy()
+	case *clusterv1beta1.InternalMemberCluster:
+		s, ok := src.(*clusterv1beta1.InternalMemberCluster)
+		if !ok {
+			return fmt.Errorf("cannot assign an object of type %T to an object of type %T", src, dst)
+		}
+		*d = *s.DeepCopy()
+	default:
+		return fmt.Errorf("unsupported object type %T", dst)
+	}
+	return nil
+}
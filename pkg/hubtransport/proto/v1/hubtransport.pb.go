@@ -0,0 +1,531 @@
+// Copyright 2026 The KubeFleet Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The API between the KubeFleet hub transport gateway and member agents.
+//
+// The Go code in this directory is generated from this file with protoc-gen-go and
+// protoc-gen-go-grpc; run `make protos` after changing it.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: hubtransport.proto
+
+package hubtransportv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MemberMessage is a message that a member agent sends to the gateway.
+type MemberMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Message:
+	//
+	//	*MemberMessage_WriteRequest
+	Message       isMemberMessage_Message `protobuf_oneof:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberMessage) Reset() {
+	*x = MemberMessage{}
+	mi := &file_hubtransport_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberMessage) ProtoMessage() {}
+
+func (x *MemberMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_hubtransport_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberMessage.ProtoReflect.Descriptor instead.
+func (*MemberMessage) Descriptor() ([]byte, []int) {
+	return file_hubtransport_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MemberMessage) GetMessage() isMemberMessage_Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *MemberMessage) GetWriteRequest() *WriteRequest {
+	if x != nil {
+		if x, ok := x.Message.(*MemberMessage_WriteRequest); ok {
+			return x.WriteRequest
+		}
+	}
+	return nil
+}
+
+type isMemberMessage_Message interface {
+	isMemberMessage_Message()
+}
+
+type MemberMessage_WriteRequest struct {
+	// Asks the gateway to write an object on the hub cluster side.
+	WriteRequest *WriteRequest `protobuf:"bytes,1,opt,name=write_request,json=writeRequest,proto3,oneof"`
+}
+
+func (*MemberMessage_WriteRequest) isMemberMessage_Message() {}
+
+// HubMessage is a message that the gateway sends to a member agent.
+type HubMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Message:
+	//
+	//	*HubMessage_ObjectEvent
+	//	*HubMessage_SyncComplete
+	//	*HubMessage_WriteResponse
+	Message       isHubMessage_Message `protobuf_oneof:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HubMessage) Reset() {
+	*x = HubMessage{}
+	mi := &file_hubtransport_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HubMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HubMessage) ProtoMessage() {}
+
+func (x *HubMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_hubtransport_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HubMessage.ProtoReflect.Descriptor instead.
+func (*HubMessage) Descriptor() ([]byte, []int) {
+	return file_hubtransport_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HubMessage) GetMessage() isHubMessage_Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *HubMessage) GetObjectEvent() *ObjectEvent {
+	if x != nil {
+		if x, ok := x.Message.(*HubMessage_ObjectEvent); ok {
+			return x.ObjectEvent
+		}
+	}
+	return nil
+}
+
+func (x *HubMessage) GetSyncComplete() bool {
+	if x != nil {
+		if x, ok := x.Message.(*HubMessage_SyncComplete); ok {
+			return x.SyncComplete
+		}
+	}
+	return false
+}
+
+func (x *HubMessage) GetWriteResponse() *WriteResponse {
+	if x != nil {
+		if x, ok := x.Message.(*HubMessage_WriteResponse); ok {
+			return x.WriteResponse
+		}
+	}
+	return nil
+}
+
+type isHubMessage_Message interface {
+	isHubMessage_Message()
+}
+
+type HubMessage_ObjectEvent struct {
+	// A change to a hub cluster object.
+	ObjectEvent *ObjectEvent `protobuf:"bytes,1,opt,name=object_event,json=objectEvent,proto3,oneof"`
+}
+
+type HubMessage_SyncComplete struct {
+	// Signals that the gateway has sent all the objects that exist at the time the stream is
+	// established.
+	SyncComplete bool `protobuf:"varint,2,opt,name=sync_complete,json=syncComplete,proto3,oneof"`
+}
+
+type HubMessage_WriteResponse struct {
+	// The outcome of a write request.
+	WriteResponse *WriteResponse `protobuf:"bytes,3,opt,name=write_response,json=writeResponse,proto3,oneof"`
+}
+
+func (*HubMessage_ObjectEvent) isHubMessage_Message() {}
+
+func (*HubMessage_SyncComplete) isHubMessage_Message() {}
+
+func (*HubMessage_WriteResponse) isHubMessage_Message() {}
+
+// ObjectEvent is a change to a hub cluster object.
+type ObjectEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The type of the event; one of Upserted or Deleted.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The kind of the object; one of Work or InternalMemberCluster.
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// The JSON-encoded object; for deletions, only the object metadata is guaranteed to be present.
+	Object        []byte `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectEvent) Reset() {
+	*x = ObjectEvent{}
+	mi := &file_hubtransport_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectEvent) ProtoMessage() {}
+
+func (x *ObjectEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_hubtransport_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectEvent.ProtoReflect.Descriptor instead.
+func (*ObjectEvent) Descriptor() ([]byte, []int) {
+	return file_hubtransport_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ObjectEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ObjectEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ObjectEvent) GetObject() []byte {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+// WriteRequest asks the gateway to write an object on the hub cluster side.
+type WriteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifies the request; the gateway echoes it in the response.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The verb of the write; one of Update, Patch, UpdateStatus, or PatchStatus.
+	Verb string `protobuf:"bytes,2,opt,name=verb,proto3" json:"verb,omitempty"`
+	// The kind of the object; one of Work or InternalMemberCluster.
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// The JSON-encoded object to write; for patches, only the object metadata (namespace and name)
+	// is read.
+	Object []byte `protobuf:"bytes,4,opt,name=object,proto3" json:"object,omitempty"`
+	// The Kubernetes patch type, if the verb is a patch.
+	PatchType string `protobuf:"bytes,5,opt,name=patch_type,json=patchType,proto3" json:"patch_type,omitempty"`
+	// The patch data, if the verb is a patch.
+	Patch         []byte `protobuf:"bytes,6,opt,name=patch,proto3" json:"patch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WriteRequest) Reset() {
+	*x = WriteRequest{}
+	mi := &file_hubtransport_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteRequest) ProtoMessage() {}
+
+func (x *WriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hubtransport_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteRequest.ProtoReflect.Descriptor instead.
+func (*WriteRequest) Descriptor() ([]byte, []int) {
+	return file_hubtransport_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WriteRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WriteRequest) GetVerb() string {
+	if x != nil {
+		return x.Verb
+	}
+	return ""
+}
+
+func (x *WriteRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *WriteRequest) GetObject() []byte {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *WriteRequest) GetPatchType() string {
+	if x != nil {
+		return x.PatchType
+	}
+	return ""
+}
+
+func (x *WriteRequest) GetPatch() []byte {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+// WriteResponse is the outcome of a write request.
+type WriteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the request.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The JSON-encoded object after the write, if the write succeeds.
+	Object []byte `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	// The JSON-encoded Kubernetes API status returned by the hub cluster API server, if the write
+	// fails.
+	ErrorStatus   []byte `protobuf:"bytes,3,opt,name=error_status,json=errorStatus,proto3" json:"error_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WriteResponse) Reset() {
+	*x = WriteResponse{}
+	mi := &file_hubtransport_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteResponse) ProtoMessage() {}
+
+func (x *WriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hubtransport_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteResponse.ProtoReflect.Descriptor instead.
+func (*WriteResponse) Descriptor() ([]byte, []int) {
+	return file_hubtransport_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WriteResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WriteResponse) GetObject() []byte {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *WriteResponse) GetErrorStatus() []byte {
+	if x != nil {
+		return x.ErrorStatus
+	}
+	return nil
+}
+
+var File_hubtransport_proto protoreflect.FileDescriptor
+
+const file_hubtransport_proto_rawDesc = "" +
+	"\n" +
+	"\x12hubtransport.proto\x12\x19kubefleet.hubtransport.v1\"j\n" +
+	"\rMemberMessage\x12N\n" +
+	"\rwrite_request\x18\x01 \x01(\v2'.kubefleet.hubtransport.v1.WriteRequestH\x00R\fwriteRequestB\t\n" +
+	"\amessage\"\xde\x01\n" +
+	"\n" +
+	"HubMessage\x12K\n" +
+	"\fobject_event\x18\x01 \x01(\v2&.kubefleet.hubtransport.v1.ObjectEventH\x00R\vobjectEvent\x12%\n" +
+	"\rsync_complete\x18\x02 \x01(\bH\x00R\fsyncComplete\x12Q\n" +
+	"\x0ewrite_response\x18\x03 \x01(\v2(.kubefleet.hubtransport.v1.WriteResponseH\x00R\rwriteResponseB\t\n" +
+	"\amessage\"M\n" +
+	"\vObjectEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06object\x18\x03 \x01(\fR\x06object\"\x93\x01\n" +
+	"\fWriteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04verb\x18\x02 \x01(\tR\x04verb\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06object\x18\x04 \x01(\fR\x06object\x12\x1d\n" +
+	"\n" +
+	"patch_type\x18\x05 \x01(\tR\tpatchType\x12\x14\n" +
+	"\x05patch\x18\x06 \x01(\fR\x05patch\"Z\n" +
+	"\rWriteResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06object\x18\x02 \x01(\fR\x06object\x12!\n" +
+	"\ferror_status\x18\x03 \x01(\fR\verrorStatus2n\n" +
+	"\fHubTransport\x12^\n" +
+	"\aConnect\x12(.kubefleet.hubtransport.v1.MemberMessage\x1a%.kubefleet.hubtransport.v1.HubMessage(\x010\x01BMZKgithub.com/kubefleet-dev/kubefleet/pkg/hubtransport/proto/v1;hubtransportv1b\x06proto3"
+
+var (
+	file_hubtransport_proto_rawDescOnce sync.Once
+	file_hubtransport_proto_rawDescData []byte
+)
+
+func file_hubtransport_proto_rawDescGZIP() []byte {
+	file_hubtransport_proto_rawDescOnce.Do(func() {
+		file_hubtransport_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hubtransport_proto_rawDesc), len(file_hubtransport_proto_rawDesc)))
+	})
+	return file_hubtransport_proto_rawDescData
+}
+
+var file_hubtransport_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_hubtransport_proto_goTypes = []any{
+	(*MemberMessage)(nil), // 0: kubefleet.hubtransport.v1.MemberMessage
+	(*HubMessage)(nil),    // 1: kubefleet.hubtransport.v1.HubMessage
+	(*ObjectEvent)(nil),   // 2: kubefleet.hubtransport.v1.ObjectEvent
+	(*WriteRequest)(nil),  // 3: kubefleet.hubtransport.v1.WriteRequest
+	(*WriteResponse)(nil), // 4: kubefleet.hubtransport.v1.WriteResponse
+}
+var file_hubtransport_proto_depIdxs = []int32{
+	3, // 0: kubefleet.hubtransport.v1.MemberMessage.write_request:type_name -> kubefleet.hubtransport.v1.WriteRequest
+	2, // 1: kubefleet.hubtransport.v1.HubMessage.object_event:type_name -> kubefleet.hubtransport.v1.ObjectEvent
+	4, // 2: kubefleet.hubtransport.v1.HubMessage.write_response:type_name -> kubefleet.hubtransport.v1.WriteResponse
+	0, // 3: kubefleet.hubtransport.v1.HubTransport.Connect:input_type -> kubefleet.hubtransport.v1.MemberMessage
+	1, // 4: kubefleet.hubtransport.v1.HubTransport.Connect:output_type -> kubefleet.hubtransport.v1.HubMessage
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_hubtransport_proto_init() }
+func file_hubtransport_proto_init() {
+	if File_hubtransport_proto != nil {
+		return
+	}
+	file_hubtransport_proto_msgTypes[0].OneofWrappers = []any{
+		(*MemberMessage_WriteRequest)(nil),
+	}
+	file_hubtransport_proto_msgTypes[1].OneofWrappers = []any{
+		(*HubMessage_ObjectEvent)(nil),
+		(*HubMessage_SyncComplete)(nil),
+		(*HubMessage_WriteResponse)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hubtransport_proto_rawDesc), len(file_hubtransport_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_hubtransport_proto_goTypes,
+		DependencyIndexes: file_hubtransport_proto_depIdxs,
+		MessageInfos:      file_hubtransport_proto_msgTypes,
+	}.Build()
+	File_hubtransport_proto = out.File
+	file_hubtransport_proto_goTypes = nil
+	file_hubtransport_proto_depIdxs = nil
+}
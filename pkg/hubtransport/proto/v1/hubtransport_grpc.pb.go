@@ -0,0 +1,142 @@
+// Copyright 2026 The KubeFleet Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The API between the KubeFleet hub transport gateway and member agents.
+//
+// The Go code in this directory is generated from this file with protoc-gen-go and
+// protoc-gen-go-grpc; run `make protos` after changing it.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: hubtransport.proto
+
+package hubtransportv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	HubTransport_Connect_FullMethodName = "/kubefleet.hubtransport.v1.HubTransport/Connect"
+)
+
+// HubTransportClient is the client API for HubTransport service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// HubTransport is the service that the gateway serves on the hub cluster side.
+type HubTransportClient interface {
+	// Connect opens the stream between a member agent and the gateway; the gateway streams the hub
+	// cluster objects that concern the member cluster, and the member agent sends back writes.
+	Connect(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[MemberMessage, HubMessage], error)
+}
+
+type hubTransportClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHubTransportClient(cc grpc.ClientConnInterface) HubTransportClient {
+	return &hubTransportClient{cc}
+}
+
+func (c *hubTransportClient) Connect(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[MemberMessage, HubMessage], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HubTransport_ServiceDesc.Streams[0], HubTransport_Connect_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[MemberMessage, HubMessage]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HubTransport_ConnectClient = grpc.BidiStreamingClient[MemberMessage, HubMessage]
+
+// HubTransportServer is the server API for HubTransport service.
+// All implementations must embed UnimplementedHubTransportServer
+// for forward compatibility.
+//
+// HubTransport is the service that the gateway serves on the hub cluster side.
+type HubTransportServer interface {
+	// Connect opens the stream between a member agent and the gateway; the gateway streams the hub
+	// cluster objects that concern the member cluster, and the member agent sends back writes.
+	Connect(grpc.BidiStreamingServer[MemberMessage, HubMessage]) error
+	mustEmbedUnimplementedHubTransportServer()
+}
+
+// UnimplementedHubTransportServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHubTransportServer struct{}
+
+func (UnimplementedHubTransportServer) Connect(grpc.BidiStreamingServer[MemberMessage, HubMessage]) error {
+	return status.Errorf(codes.Unimplemented, "method Connect not implemented")
+}
+func (UnimplementedHubTransportServer) mustEmbedUnimplementedHubTransportServer() {}
+func (UnimplementedHubTransportServer) testEmbeddedByValue()                      {}
+
+// UnsafeHubTransportServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HubTransportServer will
+// result in compilation errors.
+type UnsafeHubTransportServer interface {
+	mustEmbedUnimplementedHubTransportServer()
+}
+
+func RegisterHubTransportServer(s grpc.ServiceRegistrar, srv HubTransportServer) {
+	// If the following call pancis, it indicates UnimplementedHubTransportServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HubTransport_ServiceDesc, srv)
+}
+
+func _HubTransport_Connect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(HubTransportServer).Connect(&grpc.GenericServerStream[MemberMessage, HubMessage]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HubTransport_ConnectServer = grpc.BidiStreamingServer[MemberMessage, HubMessage]
+
+// HubTransport_ServiceDesc is the grpc.ServiceDesc for HubTransport service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HubTransport_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kubefleet.hubtransport.v1.HubTransport",
+	HandlerType: (*HubTransportServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Connect",
+			Handler:       _HubTransport_Connect_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "hubtransport.proto",
+}
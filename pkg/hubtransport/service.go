@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hubtransport
+
+import (
+	hubtransportv1 "github.com/kubefleet-dev/kubefleet/pkg/hubtransport/proto/v1"
+)
+
+// hubStream is the gateway side of a stream from a member agent.
+type hubStream = hubtransportv1.HubTransport_ConnectServer
+
+// memberStream is the member agent side of a stream to the gateway.
+type memberStream = hubtransportv1.HubTransport_ConnectClient
+
+// gatewayServer serves the transport service, as defined in proto/v1/hubtransport.proto, with a
+// gateway; it keeps the generated server methods off the exported Gateway type.
+type gatewayServer struct {
+	hubtransportv1.UnimplementedHubTransportServer
+
+	gateway *Gateway
+}
+
+// Connect implements the HubTransportServer interface.
+func (s *gatewayServer) Connect(stream hubStream) error {
+	return s.gateway.connect(stream)
+}
@@ -0,0 +1,292 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hubtransport
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+)
+
+const (
+	memberClusterName      = "member-1"
+	otherMemberClusterName = "member-2"
+	workName               = "work-1"
+
+	eventuallyTimeout  = time.Second * 10
+	eventuallyInterval = time.Millisecond * 20
+)
+
+var (
+	memberNamespace      = fmt.Sprintf(utils.NamespaceNameFormat, memberClusterName)
+	otherMemberNamespace = fmt.Sprintf(utils.NamespaceNameFormat, otherMemberClusterName)
+)
+
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add placement v1beta1 scheme: %v", err)
+	}
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add cluster v1beta1 scheme: %v", err)
+	}
+	return scheme
+}
+
+// setUpTransport starts an in-process gateway and a client connected to it.
+func setUpTransport(t *testing.T, objs ...client.Object) (client.Client, *Client) {
+	scheme := newTestScheme(t)
+	hubClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(objs...).
+		Build()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	lis := bufconn.Listen(1024 * 1024)
+	gateway := NewGateway(hubClient, "", nil, time.Millisecond*50)
+	gateway.identify = func(_ context.Context) (string, error) {
+		return memberClusterName, nil
+	}
+	go func() {
+		if err := gateway.serve(ctx, lis); err != nil {
+			t.Errorf("gateway serve() = %v, want no error", err)
+		}
+	}()
+
+	c := NewClient("passthrough:///bufnet", nil, scheme)
+	c.dialOpts = []grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+	return hubClient, c
+}
+
+func startClient(t *testing.T, c *Client) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		_ = c.Start(ctx)
+	}()
+	eventually(t, "the client connects to the gateway", func() bool {
+		return c.IsConnected()
+	})
+}
+
+func eventually(t *testing.T, desc string, cond func() bool) {
+	t.Helper()
+	err := wait.PollUntilContextTimeout(context.Background(), eventuallyInterval, eventuallyTimeout, true, func(_ context.Context) (bool, error) {
+		return cond(), nil
+	})
+	if err != nil {
+		t.Fatalf("timed out waiting for %s", desc)
+	}
+}
+
+// TestTransportReads tests that the client mirrors the hub cluster objects in the member
+// cluster's reserved namespace, and only those objects.
+func TestTransportReads(t *testing.T) {
+	work := &placementv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{Name: workName, Namespace: memberNamespace, Generation: 1},
+	}
+	otherWork := &placementv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{Name: workName, Namespace: otherMemberNamespace, Generation: 1},
+	}
+	imc := &clusterv1beta1.InternalMemberCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: memberClusterName, Namespace: memberNamespace, Generation: 1},
+		Spec: clusterv1beta1.InternalMemberClusterSpec{
+			State:                  clusterv1beta1.ClusterStateJoin,
+			HeartbeatPeriodSeconds: 30,
+		},
+	}
+	hubClient, c := setUpTransport(t, work, otherWork, imc)
+	workEvents := c.EventsFor(ObjectKindWork)
+
+	// Reads fail before the initial sync.
+	if err := c.Get(context.Background(), types.NamespacedName{Namespace: memberNamespace, Name: workName}, &placementv1beta1.Work{}); !apierrors.IsServiceUnavailable(err) {
+		t.Fatalf("Get() before sync = %v, want service unavailable error", err)
+	}
+	startClient(t, c)
+
+	ctx := context.Background()
+	gotWork := &placementv1beta1.Work{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: memberNamespace, Name: workName}, gotWork); err != nil {
+		t.Fatalf("Get() = %v, want no error", err)
+	}
+	wantWork := &placementv1beta1.Work{}
+	if err := hubClient.Get(ctx, types.NamespacedName{Namespace: memberNamespace, Name: workName}, wantWork); err != nil {
+		t.Fatalf("hub client Get() = %v, want no error", err)
+	}
+	if diff := cmp.Diff(gotWork, wantWork); diff != "" {
+		t.Errorf("Get() mismatches (-got, +want):\n%s", diff)
+	}
+
+	// Objects in other namespaces are not sent to the member agent.
+	if err := c.Get(ctx, types.NamespacedName{Namespace: otherMemberNamespace, Name: workName}, &placementv1beta1.Work{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Get() for another member's work = %v, want not found error", err)
+	}
+
+	imcList := &clusterv1beta1.InternalMemberClusterList{}
+	if err := c.List(ctx, imcList, client.InNamespace(memberNamespace)); err != nil {
+		t.Fatalf("List() = %v, want no error", err)
+	}
+	if len(imcList.Items) != 1 || imcList.Items[0].Name != memberClusterName {
+		t.Errorf("List() = %v, want the internal member cluster %s", imcList.Items, memberClusterName)
+	}
+
+	select {
+	case ev := <-workEvents:
+		if ev.Object.GetName() != workName {
+			t.Errorf("work event object name = %s, want %s", ev.Object.GetName(), workName)
+		}
+	case <-time.After(eventuallyTimeout):
+		t.Fatalf("timed out waiting for a work event")
+	}
+
+	// Deletions on the hub cluster side are propagated.
+	if err := hubClient.Delete(ctx, wantWork); err != nil {
+		t.Fatalf("hub client Delete() = %v, want no error", err)
+	}
+	eventually(t, "the work is removed from the mirror", func() bool {
+		err := c.Get(ctx, types.NamespacedName{Namespace: memberNamespace, Name: workName}, &placementv1beta1.Work{})
+		return apierrors.IsNotFound(err)
+	})
+	select {
+	case ev := <-workEvents:
+		if ev.Object.GetName() != workName {
+			t.Errorf("work event object name = %s, want %s", ev.Object.GetName(), workName)
+		}
+	case <-time.After(eventuallyTimeout):
+		t.Fatalf("timed out waiting for a work deletion event")
+	}
+}
+
+// TestTransportWrites tests that the client forwards writes to the gateway.
+func TestTransportWrites(t *testing.T) {
+	work := &placementv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{Name: workName, Namespace: memberNamespace, Generation: 1},
+	}
+	otherWork := &placementv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{Name: workName, Namespace: otherMemberNamespace, Generation: 1},
+	}
+	hubClient, c := setUpTransport(t, work, otherWork)
+	startClient(t, c)
+	ctx := context.Background()
+	workKey := types.NamespacedName{Namespace: memberNamespace, Name: workName}
+
+	// Update the work object.
+	gotWork := &placementv1beta1.Work{}
+	if err := c.Get(ctx, workKey, gotWork); err != nil {
+		t.Fatalf("Get() = %v, want no error", err)
+	}
+	originalRV := gotWork.ResourceVersion
+	gotWork.Finalizers = []string{placementv1beta1.WorkFinalizer}
+	if err := c.Update(ctx, gotWork); err != nil {
+		t.Fatalf("Update() = %v, want no error", err)
+	}
+	if gotWork.ResourceVersion == originalRV {
+		t.Errorf("Update() did not refresh the resource version")
+	}
+	hubWork := &placementv1beta1.Work{}
+	if err := hubClient.Get(ctx, workKey, hubWork); err != nil {
+		t.Fatalf("hub client Get() = %v, want no error", err)
+	}
+	if diff := cmp.Diff(hubWork.Finalizers, []string{placementv1beta1.WorkFinalizer}); diff != "" {
+		t.Errorf("work finalizers on the hub cluster mismatch (-got, +want):\n%s", diff)
+	}
+	// Reads observe the write right away.
+	mirroredWork := &placementv1beta1.Work{}
+	if err := c.Get(ctx, workKey, mirroredWork); err != nil {
+		t.Fatalf("Get() = %v, want no error", err)
+	}
+	if mirroredWork.ResourceVersion != gotWork.ResourceVersion {
+		t.Errorf("Get() after Update() resource version = %s, want %s", mirroredWork.ResourceVersion, gotWork.ResourceVersion)
+	}
+
+	// Update the work object status.
+	appliedCond := metav1.Condition{
+		Type:               placementv1beta1.WorkConditionTypeApplied,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Applied",
+		ObservedGeneration: 1,
+		LastTransitionTime: metav1.NewTime(time.Now().Truncate(time.Second)),
+	}
+	gotWork.Status.Conditions = []metav1.Condition{appliedCond}
+	if err := c.Status().Update(ctx, gotWork); err != nil {
+		t.Fatalf("Status().Update() = %v, want no error", err)
+	}
+	if err := hubClient.Get(ctx, workKey, hubWork); err != nil {
+		t.Fatalf("hub client Get() = %v, want no error", err)
+	}
+	if diff := cmp.Diff(hubWork.Status.Conditions, []metav1.Condition{appliedCond}); diff != "" {
+		t.Errorf("work status conditions on the hub cluster mismatch (-got, +want):\n%s", diff)
+	}
+
+	// Patch the work object status.
+	patchTarget := &placementv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{Namespace: memberNamespace, Name: workName},
+	}
+	if err := c.Status().Patch(ctx, patchTarget, client.RawPatch(types.MergePatchType, []byte(`{"status":{"conditions":[]}}`))); err != nil {
+		t.Fatalf("Status().Patch() = %v, want no error", err)
+	}
+	if err := hubClient.Get(ctx, workKey, hubWork); err != nil {
+		t.Fatalf("hub client Get() = %v, want no error", err)
+	}
+	if len(hubWork.Status.Conditions) != 0 {
+		t.Errorf("work status conditions on the hub cluster = %v, want empty", hubWork.Status.Conditions)
+	}
+
+	// Writes to objects in other namespaces are forbidden.
+	if err := c.Update(ctx, otherWork.DeepCopy()); !apierrors.IsForbidden(err) {
+		t.Errorf("Update() on another member's work = %v, want forbidden error", err)
+	}
+	// Conflicts are reported as is.
+	staleWork := gotWork.DeepCopy()
+	staleWork.ResourceVersion = originalRV
+	if err := c.Update(ctx, staleWork); !apierrors.IsConflict(err) {
+		t.Errorf("Update() with a stale resource version = %v, want conflict error", err)
+	}
+	// Creations and deletions are not supported.
+	if err := c.Create(ctx, &placementv1beta1.Work{}); !apierrors.IsMethodNotSupported(err) {
+		t.Errorf("Create() = %v, want method not supported error", err)
+	}
+	if err := c.Delete(ctx, gotWork); !apierrors.IsMethodNotSupported(err) {
+		t.Errorf("Delete() = %v, want method not supported error", err)
+	}
+}
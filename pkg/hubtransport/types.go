@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package hubtransport features a pull-based transport between the KubeFleet hub cluster and
+// member clusters, as an alternative to having member agents access the hub cluster API server
+// directly.
+//
+// With this transport, a gateway runs on the hub cluster side; each member agent opens a single
+// outbound gRPC stream (secured with mutual TLS) to the gateway, over which the gateway streams
+// the hub cluster objects that concern the member cluster (Work and InternalMemberCluster objects
+// in the member cluster's reserved namespace), and the member agent sends back writes (e.g., status
+// updates). This helps set up member clusters that sit behind NAT or strict egress
+// firewalls.
+package hubtransport
+
+// ObjectKind is the kind of hub cluster objects that the transport carries.
+type ObjectKind string
+
+const (
+	// ObjectKindWork is the kind of Work objects.
+	ObjectKindWork ObjectKind = "Work"
+	// ObjectKindInternalMemberCluster is the kind of InternalMemberCluster objects.
+	ObjectKindInternalMemberCluster ObjectKind = "InternalMemberCluster"
+)
+
+// ObjectEventType is the type of an object event that the gateway sends to a member agent.
+type ObjectEventType string
+
+const (
+	// ObjectEventTypeUpserted signals that an object has been created or updated.
+	ObjectEventTypeUpserted ObjectEventType = "Upserted"
+	// ObjectEventTypeDeleted signals that an object has been deleted.
+	ObjectEventTypeDeleted ObjectEventType = "Deleted"
+)
+
+// WriteVerb is the verb of a write request that a member agent sends to the gateway.
+type WriteVerb string
+
+const (
+	// WriteVerbUpdate updates an object.
+	WriteVerbUpdate WriteVerb = "Update"
+	// WriteVerbPatch patches an object.
+	WriteVerbPatch WriteVerb = "Patch"
+	// WriteVerbUpdateStatus updates the status subresource of an object.
+	WriteVerbUpdateStatus WriteVerb = "UpdateStatus"
+	// WriteVerbPatchStatus patches the status subresource of an object.
+	WriteVerbPatchStatus WriteVerb = "PatchStatus"
+)
@@ -58,6 +58,31 @@ const (
 	AvailableCapacityName   = "available"
 )
 
+const (
+	// LatencyPropertyTmpl is a property that describes the network latency, in milliseconds, from
+	// the cluster to a peer (e.g., another member cluster or a region), as measured by the member agent.
+	LatencyPropertyTmpl = "kubernetes-fleet.io/latency-ms/%s"
+)
+
+const (
+	// PropertyHistoryNamePrefix is the prefix (also known as the subdomain) of the label name
+	// associated with all the properties that the hub agent derives from the property history
+	// of a cluster.
+	PropertyHistoryNamePrefix = "history.kubernetes-fleet.io/"
+
+	// PropertyHistoryAggregatePropertyTmpl is a property that describes an aggregate over the
+	// history window of a property, in the format of `[PREFIX]/[AGGREGATE]/[PROPERTY_NAME]`; for
+	// example, the 95th percentile of the available CPU capacity of a cluster over the window has
+	// the label name, `history.kubernetes-fleet.io/p95/resources.kubernetes-fleet.io/available-cpu`.
+	PropertyHistoryAggregatePropertyTmpl = PropertyHistoryNamePrefix + "%s/%s"
+
+	// Below are a list of supported aggregates over the history window of a property.
+	MinAggregateName = "min"
+	MaxAggregateName = "max"
+	P50AggregateName = "p50"
+	P95AggregateName = "p95"
+)
+
 const (
 	NamespaceCollectionSucceededCondType = "NamespaceCollectionSucceeded"
 	NamespaceCollectionSucceededReason   = "Succeeded"
@@ -0,0 +1,302 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package composite features the composite property provider for Fleet, which chains multiple
+// property providers together.
+package composite
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+)
+
+const (
+	// The condition related values in use by the composite property provider.
+	PropertiesCollectionSucceededCondType = "CompositePropertyCollectionSucceeded"
+	PropertiesCollectionSucceededReason   = "AllProvidersCollected"
+	PropertiesCollectionTimedOutReason    = "ProvidersTimedOut"
+	PropertiesCollectionSucceededMsg      = "All property providers have completed the collection in time"
+	PropertiesCollectionTimedOutMsgTmpl   = "Property provider(s) %v did not complete the collection in time; the properties they collected last time are reported"
+	PropertiesConsistentCondType          = "CompositePropertiesConsistent"
+	PropertiesConsistentReason            = "NoConflicts"
+	PropertiesConflictedReason            = "ConflictsResolved"
+	PropertiesConsistentMsg               = "No property is reported by more than one property provider with different values"
+	PropertiesConflictedMsgTmpl           = "Some properties are reported by more than one property provider with different values; the values from the providers with higher precedence are used: %s"
+)
+
+const (
+	// maxConflictsInMsg is the maximum number of conflicts listed in the condition message.
+	maxConflictsInMsg = 10
+	// collectionDeadlineMargin is how much earlier the chained property providers must complete
+	// the collection than the composite property provider.
+	collectionDeadlineMargin = time.Second
+)
+
+// Member is a property provider chained in the composite property provider.
+type Member struct {
+	// Name is the name of the property provider, which is used in conflict reporting.
+	Name string
+	// Provider is the property provider.
+	Provider propertyprovider.PropertyProvider
+}
+
+// member tracks the state of a property provider chained in the composite property provider.
+type member struct {
+	Member
+
+	// doneCh is closed when the Collect call in flight to the property provider returns; it is
+	// nil if there is no call in flight.
+	doneCh chan struct{}
+	// lastResponse is the last response that the property provider returns.
+	lastResponse propertyprovider.PropertyCollectionResponse
+	// mu is a mutex that protects the fields above against concurrent access.
+	mu sync.Mutex
+}
+
+// PropertyProvider is the composite property provider for Fleet.
+//
+// It runs the chained property providers concurrently and merges their responses; when more than
+// one property provider reports the same property, resource, or namespace, the one that comes
+// first in the chain takes precedence.
+type PropertyProvider struct {
+	// members are the chained property providers, in the order of precedence.
+	members []*member
+}
+
+// Verify that the composite property provider implements the PropertyProvider interface at compile time.
+var _ propertyprovider.PropertyProvider = &PropertyProvider{}
+
+// Start starts all the chained property providers.
+func (p *PropertyProvider) Start(ctx context.Context, config *rest.Config) error {
+	klog.V(2).InfoS("Starting composite property provider", "providers", p.names())
+	for _, m := range p.members {
+		if err := m.Provider.Start(ctx, config); err != nil {
+			klog.ErrorS(err, "Failed to start a property provider in the composite property provider", "provider", m.Name)
+			return fmt.Errorf("failed to start property provider %s: %w", m.Name, err)
+		}
+	}
+	return nil
+}
+
+// Collect collects properties from all the chained property providers and merges them.
+//
+// The chained property providers are given a slightly earlier deadline than the composite one,
+// so that the composite property provider can still return in time; a property provider that does
+// not complete the collection in time is represented by its last response.
+func (p *PropertyProvider) Collect(ctx context.Context) propertyprovider.PropertyCollectionResponse {
+	childCtx := ctx
+	if deadline, ok := ctx.Deadline(); ok {
+		var cancel context.CancelFunc
+		childCtx, cancel = context.WithDeadline(ctx, deadline.Add(-collectionDeadlineMargin))
+		defer cancel()
+	}
+
+	doneChs := make([]chan struct{}, len(p.members))
+	for idx, m := range p.members {
+		m.mu.Lock()
+		if m.doneCh == nil {
+			doneCh := make(chan struct{})
+			m.doneCh = doneCh
+			go func() {
+				res := m.Provider.Collect(childCtx)
+				m.mu.Lock()
+				m.lastResponse = res
+				m.doneCh = nil
+				m.mu.Unlock()
+				close(doneCh)
+			}()
+		} else {
+			// The last call to the property provider has not returned yet; wait for it instead
+			// of calling the property provider again, so that calls do not pile up.
+			klog.V(2).InfoS("The last collection of the property provider is still on-going", "provider", m.Name)
+		}
+		doneChs[idx] = m.doneCh
+		m.mu.Unlock()
+	}
+
+	responses := make([]propertyprovider.PropertyCollectionResponse, len(p.members))
+	timedOut := []string{}
+	for idx, m := range p.members {
+		select {
+		case <-doneChs[idx]:
+		case <-childCtx.Done():
+			// Check again in case that the call has returned at the same time.
+			select {
+			case <-doneChs[idx]:
+			default:
+				timedOut = append(timedOut, m.Name)
+			}
+		}
+		m.mu.Lock()
+		responses[idx] = m.lastResponse
+		m.mu.Unlock()
+	}
+
+	res := p.merge(responses)
+	if len(timedOut) > 0 {
+		res.Conditions = append(res.Conditions, metav1.Condition{
+			Type:    PropertiesCollectionSucceededCondType,
+			Status:  metav1.ConditionFalse,
+			Reason:  PropertiesCollectionTimedOutReason,
+			Message: fmt.Sprintf(PropertiesCollectionTimedOutMsgTmpl, timedOut),
+		})
+	} else {
+		res.Conditions = append(res.Conditions, metav1.Condition{
+			Type:    PropertiesCollectionSucceededCondType,
+			Status:  metav1.ConditionTrue,
+			Reason:  PropertiesCollectionSucceededReason,
+			Message: PropertiesCollectionSucceededMsg,
+		})
+	}
+	return res
+}
+
+// merge merges the responses of the chained property providers, in the order of precedence.
+func (p *PropertyProvider) merge(responses []propertyprovider.PropertyCollectionResponse) propertyprovider.PropertyCollectionResponse {
+	res := propertyprovider.PropertyCollectionResponse{}
+	// conflicts are the descriptions of the conflicts found, in the form of
+	// "<name> (<provider that takes precedence>, <provider that is overridden>)".
+	conflicts := []string{}
+	// propertySources and resourceSources track which property provider reports the value in use.
+	propertySources := make(map[clusterv1beta1.PropertyName]string)
+	resourceSources := make(map[string]string)
+	conditionTypes := make(map[string]bool)
+
+	for idx, resp := range responses {
+		name := p.members[idx].Name
+
+		for pName, v := range resp.Properties {
+			existing, found := res.Properties[pName]
+			if !found {
+				if res.Properties == nil {
+					res.Properties = make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue)
+				}
+				res.Properties[pName] = v
+				propertySources[pName] = name
+				continue
+			}
+			if existing.Value != v.Value {
+				conflicts = append(conflicts, fmt.Sprintf("property %s (%s, %s)", pName, propertySources[pName], name))
+			}
+		}
+
+		res.Resources.Capacity = mergeResourceList(res.Resources.Capacity, resp.Resources.Capacity, propertyprovider.TotalCapacityName, name, resourceSources, &conflicts)
+		res.Resources.Allocatable = mergeResourceList(res.Resources.Allocatable, resp.Resources.Allocatable, propertyprovider.AllocatableCapacityName, name, resourceSources, &conflicts)
+		res.Resources.Available = mergeResourceList(res.Resources.Available, resp.Resources.Available, propertyprovider.AvailableCapacityName, name, resourceSources, &conflicts)
+
+		for ns, work := range resp.Namespaces {
+			if _, found := res.Namespaces[ns]; found {
+				continue
+			}
+			if res.Namespaces == nil {
+				res.Namespaces = make(map[string]string)
+			}
+			res.Namespaces[ns] = work
+		}
+
+		// Each condition type is reported only once, by the property provider with the highest
+		// precedence.
+		for _, cond := range resp.Conditions {
+			if conditionTypes[cond.Type] {
+				continue
+			}
+			conditionTypes[cond.Type] = true
+			res.Conditions = append(res.Conditions, cond)
+		}
+	}
+
+	if len(conflicts) == 0 {
+		res.Conditions = append(res.Conditions, metav1.Condition{
+			Type:    PropertiesConsistentCondType,
+			Status:  metav1.ConditionTrue,
+			Reason:  PropertiesConsistentReason,
+			Message: PropertiesConsistentMsg,
+		})
+		return res
+	}
+
+	slices.Sort(conflicts)
+	klog.V(2).InfoS("Found conflicts among the property providers", "conflicts", conflicts)
+	if len(conflicts) > maxConflictsInMsg {
+		conflicts = append(conflicts[:maxConflictsInMsg], fmt.Sprintf("and %d more", len(conflicts)-maxConflictsInMsg))
+	}
+	res.Conditions = append(res.Conditions, metav1.Condition{
+		Type:    PropertiesConsistentCondType,
+		Status:  metav1.ConditionFalse,
+		Reason:  PropertiesConflictedReason,
+		Message: fmt.Sprintf(PropertiesConflictedMsgTmpl, strings.Join(conflicts, "; ")),
+	})
+	return res
+}
+
+// mergeResourceList merges a resource list reported by a property provider with lower precedence
+// into the merged one.
+func mergeResourceList(
+	merged, rl corev1.ResourceList,
+	capacityType, name string,
+	sources map[string]string,
+	conflicts *[]string,
+) corev1.ResourceList {
+	for rn, q := range rl {
+		key := fmt.Sprintf("%s-%s", capacityType, rn)
+		existing, found := merged[rn]
+		if !found {
+			if merged == nil {
+				merged = make(corev1.ResourceList)
+			}
+			merged[rn] = q.DeepCopy()
+			sources[key] = name
+			continue
+		}
+		if !existing.Equal(q) {
+			*conflicts = append(*conflicts, fmt.Sprintf("resource %s (%s, %s)", key, sources[key], name))
+		}
+	}
+	return merged
+}
+
+// names returns the names of the chained property providers.
+func (p *PropertyProvider) names() []string {
+	names := make([]string, 0, len(p.members))
+	for _, m := range p.members {
+		names = append(names, m.Name)
+	}
+	return names
+}
+
+// New returns a new composite property provider that chains the given property providers;
+// the property providers that come first take precedence.
+func New(members ...Member) propertyprovider.PropertyProvider {
+	p := &PropertyProvider{
+		members: make([]*member, 0, len(members)),
+	}
+	for _, m := range members {
+		p.members = append(p.members, &member{Member: m})
+	}
+	return p
+}
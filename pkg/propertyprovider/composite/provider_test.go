@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package composite
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+)
+
+// dummyProvider is a dummy property provider that returns a fixed response after a delay.
+type dummyProvider struct {
+	res   propertyprovider.PropertyCollectionResponse
+	delay time.Duration
+	calls atomic.Int32
+}
+
+// Start implements the PropertyProvider interface.
+func (d *dummyProvider) Start(_ context.Context, _ *rest.Config) error {
+	return nil
+}
+
+// Collect implements the PropertyProvider interface.
+func (d *dummyProvider) Collect(_ context.Context) propertyprovider.PropertyCollectionResponse {
+	d.calls.Add(1)
+	// Ignore the context on purpose, to simulate a property provider that does not honor it.
+	time.Sleep(d.delay)
+	return d.res
+}
+
+func TestCollect(t *testing.T) {
+	costProvider := &dummyProvider{
+		res: propertyprovider.PropertyCollectionResponse{
+			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				"kubernetes.azure.com/per-cpu-core-cost": {Value: "0.100"},
+				propertyprovider.NodeCountProperty:       {Value: "3"},
+			},
+			Resources: clusterv1beta1.ResourceUsage{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("12"),
+				},
+			},
+			Namespaces: map[string]string{
+				"work-ns": "work-1",
+			},
+			Conditions: []metav1.Condition{
+				{Type: "CostCollectionSucceeded", Status: metav1.ConditionTrue, Reason: "Collected"},
+			},
+		},
+	}
+	inventoryProvider := &dummyProvider{
+		res: propertyprovider.PropertyCollectionResponse{
+			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				"kubernetes-fleet.io/instance-types/m5.large/count": {Value: "3"},
+				propertyprovider.NodeCountProperty:                  {Value: "4"},
+			},
+			Resources: clusterv1beta1.ResourceUsage{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("12"),
+					corev1.ResourceMemory: resource.MustParse("48Gi"),
+				},
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("11"),
+				},
+			},
+			Namespaces: map[string]string{
+				"work-ns":   "work-2",
+				"work-ns-2": "work-3",
+			},
+			Conditions: []metav1.Condition{
+				{Type: "CostCollectionSucceeded", Status: metav1.ConditionFalse, Reason: "Failed"},
+				{Type: "InventoryCollectionSucceeded", Status: metav1.ConditionTrue, Reason: "Collected"},
+			},
+		},
+	}
+	slowProvider := &dummyProvider{
+		res: propertyprovider.PropertyCollectionResponse{
+			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				"example.com/slow": {Value: "1"},
+			},
+		},
+		delay: time.Second * 3,
+	}
+
+	testCases := []struct {
+		name    string
+		members []Member
+		want    propertyprovider.PropertyCollectionResponse
+	}{
+		{
+			name: "merge with precedence",
+			members: []Member{
+				{Name: "cost", Provider: costProvider},
+				{Name: "inventory", Provider: inventoryProvider},
+			},
+			want: propertyprovider.PropertyCollectionResponse{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					"kubernetes.azure.com/per-cpu-core-cost":            {Value: "0.100"},
+					"kubernetes-fleet.io/instance-types/m5.large/count": {Value: "3"},
+					propertyprovider.NodeCountProperty:                  {Value: "3"},
+				},
+				Resources: clusterv1beta1.ResourceUsage{
+					Capacity: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("12"),
+						corev1.ResourceMemory: resource.MustParse("48Gi"),
+					},
+					Allocatable: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("11"),
+					},
+				},
+				Namespaces: map[string]string{
+					"work-ns":   "work-1",
+					"work-ns-2": "work-3",
+				},
+				Conditions: []metav1.Condition{
+					{Type: "CostCollectionSucceeded", Status: metav1.ConditionTrue, Reason: "Collected"},
+					{Type: "InventoryCollectionSucceeded", Status: metav1.ConditionTrue, Reason: "Collected"},
+					{
+						Type:    PropertiesConsistentCondType,
+						Status:  metav1.ConditionFalse,
+						Reason:  PropertiesConflictedReason,
+						Message: "Some properties are reported by more than one property provider with different values; the values from the providers with higher precedence are used: property kubernetes-fleet.io/node-count (cost, inventory)",
+					},
+					{
+						Type:    PropertiesCollectionSucceededCondType,
+						Status:  metav1.ConditionTrue,
+						Reason:  PropertiesCollectionSucceededReason,
+						Message: PropertiesCollectionSucceededMsg,
+					},
+				},
+			},
+		},
+		{
+			name: "slow provider times out",
+			members: []Member{
+				{Name: "slow", Provider: slowProvider},
+				{Name: "inventory", Provider: inventoryProvider},
+			},
+			want: propertyprovider.PropertyCollectionResponse{
+				Properties: inventoryProvider.res.Properties,
+				Resources:  inventoryProvider.res.Resources,
+				Namespaces: inventoryProvider.res.Namespaces,
+				Conditions: []metav1.Condition{
+					inventoryProvider.res.Conditions[0],
+					inventoryProvider.res.Conditions[1],
+					{
+						Type:    PropertiesConsistentCondType,
+						Status:  metav1.ConditionTrue,
+						Reason:  PropertiesConsistentReason,
+						Message: PropertiesConsistentMsg,
+					},
+					{
+						Type:    PropertiesCollectionSucceededCondType,
+						Status:  metav1.ConditionFalse,
+						Reason:  PropertiesCollectionTimedOutReason,
+						Message: "Property provider(s) [slow] did not complete the collection in time; the properties they collected last time are reported",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*2)
+			defer cancel()
+
+			p := New(tc.members...)
+			if err := p.Start(ctx, nil); err != nil {
+				t.Fatalf("Start() = %v, want nil", err)
+			}
+			got := p.Collect(ctx)
+			if diff := cmp.Diff(got, tc.want, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Collect() diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCollect_SkipInFlightProvider(t *testing.T) {
+	slowProvider := &dummyProvider{
+		res: propertyprovider.PropertyCollectionResponse{
+			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				"example.com/slow": {Value: "1"},
+			},
+		},
+		delay: time.Second * 2,
+	}
+	p := New(Member{Name: "slow", Provider: slowProvider})
+
+	// The first collection times out, with the call left in flight.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second+time.Millisecond*100)
+	defer cancel()
+	res := p.Collect(ctx)
+	if len(res.Properties) != 0 {
+		t.Fatalf("Collect() properties = %v, want none", res.Properties)
+	}
+
+	// The second collection does not call the property provider again; once the call in flight
+	// returns, its response is reported.
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second*4)
+	defer cancel()
+	res = p.Collect(ctx)
+	if diff := cmp.Diff(res.Properties, slowProvider.res.Properties); diff != "" {
+		t.Errorf("Collect() properties diff (-got, +want):\n%s", diff)
+	}
+	if calls := slowProvider.calls.Load(); calls != 1 {
+		t.Errorf("property provider calls = %d, want 1", calls)
+	}
+}
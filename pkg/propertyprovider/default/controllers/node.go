@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/default/trackers"
+)
+
+// NodeReconciler reconciles Node objects.
+type NodeReconciler struct {
+	NodeTracker *trackers.NodeTracker
+	Client      client.Client
+}
+
+// Reconcile reconciles a node object.
+func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	nodeRef := klog.KRef(req.Namespace, req.Name)
+	startTime := time.Now()
+	klog.V(2).InfoS("Reconciliation starts for node objects in the property provider", "node", nodeRef)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Reconciliation ends for node objects in the property provider", "node", nodeRef, "latency", latency)
+	}()
+
+	node := &corev1.Node{}
+	if err := r.Client.Get(ctx, req.NamespacedName, node); err != nil {
+		if errors.IsNotFound(err) {
+			// Note that this controller will not add any finalizer to node objects, so as to
+			// avoid blocking normal Kubernetes operations under unexpected circumstances.
+			klog.V(2).InfoS("Node is not found; untrack it from the property provider", "node", nodeRef)
+			r.NodeTracker.Remove(req.Name)
+			return ctrl.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to get the node object", "node", nodeRef)
+		return ctrl.Result{}, err
+	}
+
+	// Track the node, even if it has been marked for deletion, cordoned, or as unschedulable;
+	// this is consistent with the node tracking in the Azure property provider.
+	klog.V(2).InfoS("Attempt to track the node", "node", nodeRef)
+	r.NodeTracker.AddOrUpdate(node)
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NodeReconciler) SetupWithManager(mgr ctrl.Manager, controllerName string) error {
+	// Reconcile any node changes (create, update, delete).
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(controllerName).
+		For(&corev1.Node{}).
+		Complete(r)
+}
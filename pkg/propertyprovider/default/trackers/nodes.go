@@ -0,0 +1,241 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trackers
+
+import (
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// ReservedNameForUndefinedSKU is the SKU name used for nodes that have no instance type label.
+	ReservedNameForUndefinedSKU = "undefined"
+)
+
+// nodeInfo is the information that the node tracker keeps about a node.
+type nodeInfo struct {
+	// sku is the instance type of the node.
+	sku string
+	// labels are the values of the exported labels on the node.
+	labels map[string]string
+	// capacity and allocatable are the total and allocatable capacity of the tracked resources
+	// on the node, respectively.
+	capacity    corev1.ResourceList
+	allocatable corev1.ResourceList
+}
+
+// NodeTracker helps track specific stats about nodes in a Kubernetes cluster, e.g., their
+// count per SKU, per exported label value, and their total and allocatable capacity.
+//
+// Unlike its Azure counterpart, the tracker relies only on the well-known Kubernetes labels,
+// and tracks extended resources (e.g., nvidia.com/gpu) in addition to CPU and memory.
+type NodeTracker struct {
+	// exportedLabels are the keys of the node labels whose values are tracked.
+	exportedLabels []string
+
+	// nodes tracks the information about individual nodes in the cluster.
+	nodes map[string]*nodeInfo
+
+	// mu is a RWMutex that protects the tracker against concurrent access.
+	mu sync.RWMutex
+}
+
+// NewNodeTracker returns a node tracker that tracks the values of the given node labels.
+func NewNodeTracker(exportedLabels []string) *NodeTracker {
+	return &NodeTracker{
+		exportedLabels: exportedLabels,
+		nodes:          make(map[string]*nodeInfo),
+	}
+}
+
+// AddOrUpdate starts tracking a node or updates the information about a node that has been
+// tracked.
+func (nt *NodeTracker) AddOrUpdate(node *corev1.Node) {
+	nt.mu.Lock()
+	defer nt.mu.Unlock()
+
+	labels := make(map[string]string, len(nt.exportedLabels))
+	for _, key := range nt.exportedLabels {
+		if v, ok := node.Labels[key]; ok && len(v) > 0 {
+			labels[key] = v
+		}
+	}
+	nt.nodes[node.Name] = &nodeInfo{
+		sku:         skuOf(node),
+		labels:      labels,
+		capacity:    trackedResourcesOf(node.Status.Capacity),
+		allocatable: trackedResourcesOf(node.Status.Allocatable),
+	}
+	klog.V(4).InfoS("Tracked the node", "node", klog.KObj(node))
+}
+
+// Remove stops tracking a node.
+func (nt *NodeTracker) Remove(nodeName string) {
+	nt.mu.Lock()
+	defer nt.mu.Unlock()
+
+	if _, ok := nt.nodes[nodeName]; ok {
+		delete(nt.nodes, nodeName)
+		klog.V(4).InfoS("Untracked the node", "node", nodeName)
+	}
+}
+
+// NodeCount returns the number of nodes that the node tracker tracks.
+func (nt *NodeTracker) NodeCount() int {
+	nt.mu.RLock()
+	defer nt.mu.RUnlock()
+
+	return len(nt.nodes)
+}
+
+// NodeCountPerSKU returns a counter that tracks the number of nodes per SKU in the cluster.
+func (nt *NodeTracker) NodeCountPerSKU() map[string]int {
+	nt.mu.RLock()
+	defer nt.mu.RUnlock()
+
+	res := make(map[string]int)
+	for _, n := range nt.nodes {
+		res[n.skuName()]++
+	}
+	return res
+}
+
+// TotalCapacityPerSKU returns the total capacity of all resources that the node tracker tracks,
+// per SKU in the cluster.
+func (nt *NodeTracker) TotalCapacityPerSKU() map[string]corev1.ResourceList {
+	nt.mu.RLock()
+	defer nt.mu.RUnlock()
+
+	res := make(map[string]corev1.ResourceList)
+	for _, n := range nt.nodes {
+		sku := n.skuName()
+		if _, ok := res[sku]; !ok {
+			res[sku] = corev1.ResourceList{
+				corev1.ResourceCPU:    resource.Quantity{},
+				corev1.ResourceMemory: resource.Quantity{},
+			}
+		}
+		for rn, q := range n.capacity {
+			total := res[sku][rn]
+			total.Add(q)
+			res[sku][rn] = total
+		}
+	}
+	return res
+}
+
+// NodeCountPerLabelValue returns a counter that tracks the number of nodes per value of each
+// exported label in the cluster, keyed by the label key first and the label value second.
+//
+// Nodes that do not have an exported label are not counted for that label.
+func (nt *NodeTracker) NodeCountPerLabelValue() map[string]map[string]int {
+	nt.mu.RLock()
+	defer nt.mu.RUnlock()
+
+	res := make(map[string]map[string]int)
+	for _, n := range nt.nodes {
+		for key, value := range n.labels {
+			if _, ok := res[key]; !ok {
+				res[key] = make(map[string]int)
+			}
+			res[key][value]++
+		}
+	}
+	return res
+}
+
+// TotalCapacity returns the total capacity of all resources that the node tracker tracks.
+func (nt *NodeTracker) TotalCapacity() corev1.ResourceList {
+	nt.mu.RLock()
+	defer nt.mu.RUnlock()
+
+	return nt.sumOf(func(n *nodeInfo) corev1.ResourceList { return n.capacity })
+}
+
+// TotalAllocatable returns the total allocatable capacity of all resources that
+// the node tracker tracks.
+func (nt *NodeTracker) TotalAllocatable() corev1.ResourceList {
+	nt.mu.RLock()
+	defer nt.mu.RUnlock()
+
+	return nt.sumOf(func(n *nodeInfo) corev1.ResourceList { return n.allocatable })
+}
+
+// sumOf sums up a resource list of all the tracked nodes.
+//
+// CPU and memory are always present in the result; extended resources are present only
+// if at least one node reports them.
+//
+// Note that this method assumes that the access lock has been acquired.
+func (nt *NodeTracker) sumOf(resourcesOf func(n *nodeInfo) corev1.ResourceList) corev1.ResourceList {
+	res := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.Quantity{},
+		corev1.ResourceMemory: resource.Quantity{},
+	}
+	for _, n := range nt.nodes {
+		for rn, q := range resourcesOf(n) {
+			total := res[rn]
+			total.Add(q)
+			res[rn] = total
+		}
+	}
+	return res
+}
+
+// skuName returns the SKU name of a tracked node; for those nodes without a SKU, `undefined`
+// is used as the SKU name.
+func (n *nodeInfo) skuName() string {
+	if len(n.sku) == 0 {
+		return ReservedNameForUndefinedSKU
+	}
+	return n.sku
+}
+
+// skuOf returns the instance type of a node, as indicated by the well-known instance type labels.
+func skuOf(node *corev1.Node) string {
+	if sku, ok := node.Labels[corev1.LabelInstanceTypeStable]; ok {
+		return sku
+	}
+	return node.Labels[corev1.LabelInstanceType]
+}
+
+// trackedResourcesOf returns the resources that the node tracker tracks from a resource list,
+// i.e., CPU, memory, and all extended resources.
+func trackedResourcesOf(rl corev1.ResourceList) corev1.ResourceList {
+	res := make(corev1.ResourceList)
+	for rn, q := range rl {
+		if rn == corev1.ResourceCPU || rn == corev1.ResourceMemory || IsExtendedResourceName(rn) {
+			res[rn] = q.DeepCopy()
+		}
+	}
+	return res
+}
+
+// IsExtendedResourceName returns whether a resource name is an extended resource name, i.e.,
+// a fully-qualified resource name outside of the kubernetes.io domain, such as nvidia.com/gpu.
+func IsExtendedResourceName(rn corev1.ResourceName) bool {
+	name := string(rn)
+	if !strings.Contains(name, "/") || strings.HasPrefix(name, corev1.DefaultResourceRequestsPrefix) {
+		return false
+	}
+	domain, _, _ := strings.Cut(name, "/")
+	return domain != "kubernetes.io" && !strings.HasSuffix(domain, ".kubernetes.io")
+}
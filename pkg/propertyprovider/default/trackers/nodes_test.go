@@ -0,0 +1,276 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trackers
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	gpuResourceName = corev1.ResourceName("nvidia.com/gpu")
+)
+
+func buildNode(name string, labels map[string]string, capacity, allocatable corev1.ResourceList) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+		Status: corev1.NodeStatus{
+			Capacity:    capacity,
+			Allocatable: allocatable,
+		},
+	}
+}
+
+func TestNodeTracker(t *testing.T) {
+	exportedLabels := []string{corev1.LabelTopologyZone, corev1.LabelArchStable}
+	nodes := []*corev1.Node{
+		buildNode("node-1",
+			map[string]string{
+				corev1.LabelInstanceTypeStable: "m5.large",
+				corev1.LabelTopologyZone:       "zone-1",
+				corev1.LabelArchStable:         "amd64",
+				corev1.LabelOSStable:           "linux",
+			},
+			corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("2"),
+				corev1.ResourceMemory:           resource.MustParse("8Gi"),
+				corev1.ResourcePods:             resource.MustParse("110"),
+				corev1.ResourceEphemeralStorage: resource.MustParse("100Gi"),
+			},
+			corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1.9"),
+				corev1.ResourceMemory: resource.MustParse("7Gi"),
+			},
+		),
+		buildNode("node-2",
+			map[string]string{
+				corev1.LabelInstanceType: "p3.2xlarge",
+				corev1.LabelTopologyZone: "zone-2",
+				corev1.LabelArchStable:   "amd64",
+			},
+			corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("8"),
+				corev1.ResourceMemory: resource.MustParse("64Gi"),
+				gpuResourceName:       resource.MustParse("1"),
+			},
+			corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("7.8"),
+				corev1.ResourceMemory: resource.MustParse("60Gi"),
+				gpuResourceName:       resource.MustParse("1"),
+			},
+		),
+		buildNode("node-3",
+			map[string]string{
+				corev1.LabelInstanceTypeStable: "m5.large",
+				corev1.LabelArchStable:         "arm64",
+			},
+			corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+			corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1.9"),
+				corev1.ResourceMemory: resource.MustParse("7Gi"),
+			},
+		),
+		buildNode("node-4", nil, nil, nil),
+	}
+
+	testCases := []struct {
+		name                       string
+		nodes                      []*corev1.Node
+		removedNodes               []string
+		wantNodeCount              int
+		wantNodeCountPerSKU        map[string]int
+		wantNodeCountPerLabelValue map[string]map[string]int
+		wantTotalCapacity          corev1.ResourceList
+		wantTotalCapacityPerSKU    map[string]corev1.ResourceList
+		wantTotalAllocatable       corev1.ResourceList
+	}{
+		{
+			name:                       "no nodes",
+			wantNodeCountPerSKU:        map[string]int{},
+			wantNodeCountPerLabelValue: map[string]map[string]int{},
+			wantTotalCapacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.Quantity{},
+				corev1.ResourceMemory: resource.Quantity{},
+			},
+			wantTotalCapacityPerSKU: map[string]corev1.ResourceList{},
+			wantTotalAllocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.Quantity{},
+				corev1.ResourceMemory: resource.Quantity{},
+			},
+		},
+		{
+			name:          "multiple nodes",
+			nodes:         nodes,
+			wantNodeCount: 4,
+			wantNodeCountPerSKU: map[string]int{
+				"m5.large":                  2,
+				"p3.2xlarge":                1,
+				ReservedNameForUndefinedSKU: 1,
+			},
+			wantNodeCountPerLabelValue: map[string]map[string]int{
+				corev1.LabelTopologyZone: {
+					"zone-1": 1,
+					"zone-2": 1,
+				},
+				corev1.LabelArchStable: {
+					"amd64": 2,
+					"arm64": 1,
+				},
+			},
+			wantTotalCapacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("12"),
+				corev1.ResourceMemory: resource.MustParse("80Gi"),
+				gpuResourceName:       resource.MustParse("1"),
+			},
+			wantTotalCapacityPerSKU: map[string]corev1.ResourceList{
+				"m5.large": {
+					corev1.ResourceCPU:    resource.MustParse("4"),
+					corev1.ResourceMemory: resource.MustParse("16Gi"),
+				},
+				"p3.2xlarge": {
+					corev1.ResourceCPU:    resource.MustParse("8"),
+					corev1.ResourceMemory: resource.MustParse("64Gi"),
+					gpuResourceName:       resource.MustParse("1"),
+				},
+				ReservedNameForUndefinedSKU: {
+					corev1.ResourceCPU:    resource.Quantity{},
+					corev1.ResourceMemory: resource.Quantity{},
+				},
+			},
+			wantTotalAllocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("11.6"),
+				corev1.ResourceMemory: resource.MustParse("74Gi"),
+				gpuResourceName:       resource.MustParse("1"),
+			},
+		},
+		{
+			name:          "removed nodes",
+			nodes:         nodes,
+			removedNodes:  []string{"node-2", "node-4", "node-5"},
+			wantNodeCount: 2,
+			wantNodeCountPerSKU: map[string]int{
+				"m5.large": 2,
+			},
+			wantNodeCountPerLabelValue: map[string]map[string]int{
+				corev1.LabelTopologyZone: {
+					"zone-1": 1,
+				},
+				corev1.LabelArchStable: {
+					"amd64": 1,
+					"arm64": 1,
+				},
+			},
+			wantTotalCapacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("16Gi"),
+			},
+			wantTotalCapacityPerSKU: map[string]corev1.ResourceList{
+				"m5.large": {
+					corev1.ResourceCPU:    resource.MustParse("4"),
+					corev1.ResourceMemory: resource.MustParse("16Gi"),
+				},
+			},
+			wantTotalAllocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("3.8"),
+				corev1.ResourceMemory: resource.MustParse("14Gi"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nt := NewNodeTracker(exportedLabels)
+			for _, node := range tc.nodes {
+				nt.AddOrUpdate(node)
+			}
+			for _, name := range tc.removedNodes {
+				nt.Remove(name)
+			}
+
+			if got := nt.NodeCount(); got != tc.wantNodeCount {
+				t.Errorf("NodeCount() = %d, want %d", got, tc.wantNodeCount)
+			}
+			if diff := cmp.Diff(nt.NodeCountPerSKU(), tc.wantNodeCountPerSKU); diff != "" {
+				t.Errorf("NodeCountPerSKU() diff (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(nt.NodeCountPerLabelValue(), tc.wantNodeCountPerLabelValue); diff != "" {
+				t.Errorf("NodeCountPerLabelValue() diff (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(nt.TotalCapacity(), tc.wantTotalCapacity); diff != "" {
+				t.Errorf("TotalCapacity() diff (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(nt.TotalCapacityPerSKU(), tc.wantTotalCapacityPerSKU); diff != "" {
+				t.Errorf("TotalCapacityPerSKU() diff (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(nt.TotalAllocatable(), tc.wantTotalAllocatable); diff != "" {
+				t.Errorf("TotalAllocatable() diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsExtendedResourceName(t *testing.T) {
+	testCases := []struct {
+		name string
+		rn   corev1.ResourceName
+		want bool
+	}{
+		{
+			name: "native resource",
+			rn:   corev1.ResourceCPU,
+		},
+		{
+			name: "hugepages resource",
+			rn:   corev1.ResourceName("hugepages-2Mi"),
+		},
+		{
+			name: "kubernetes.io resource",
+			rn:   corev1.ResourceName("kubernetes.io/batteries"),
+		},
+		{
+			name: "kubernetes.io subdomain resource",
+			rn:   corev1.ResourceName("example.kubernetes.io/batteries"),
+		},
+		{
+			name: "requests resource",
+			rn:   corev1.ResourceName("requests.nvidia.com/gpu"),
+		},
+		{
+			name: "extended resource",
+			rn:   gpuResourceName,
+			want: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsExtendedResourceName(tc.rn); got != tc.want {
+				t.Errorf("IsExtendedResourceName(%s) = %t, want %t", tc.rn, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,463 @@
+// Copyright 2026 The KubeFleet Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The API between the KubeFleet member agent and an external property provider.
+//
+// The Go code in this directory is generated from this file with protoc-gen-go and
+// protoc-gen-go-grpc; run `make protos` after changing it.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: propertyprovider.proto
+
+package propertyproviderv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CollectRequest is the request to collect properties.
+type CollectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectRequest) Reset() {
+	*x = CollectRequest{}
+	mi := &file_propertyprovider_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectRequest) ProtoMessage() {}
+
+func (x *CollectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_propertyprovider_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectRequest.ProtoReflect.Descriptor instead.
+func (*CollectRequest) Descriptor() ([]byte, []int) {
+	return file_propertyprovider_proto_rawDescGZIP(), []int{0}
+}
+
+// CollectResponse mirrors the PropertyCollectionResponse of the member agent.
+type CollectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The non-resource properties and their values, keyed by the property names.
+	Properties map[string]*PropertyValue `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The resources of the cluster.
+	Resources *ResourceUsage `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	// The namespaces managed by Fleet in the cluster, keyed by the namespace names, with the names
+	// of their associated works as the values.
+	Namespaces map[string]string `protobuf:"bytes,3,rep,name=namespaces,proto3" json:"namespaces,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The conditions that explain the property collection status.
+	Conditions    []*Condition `protobuf:"bytes,4,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectResponse) Reset() {
+	*x = CollectResponse{}
+	mi := &file_propertyprovider_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectResponse) ProtoMessage() {}
+
+func (x *CollectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_propertyprovider_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectResponse.ProtoReflect.Descriptor instead.
+func (*CollectResponse) Descriptor() ([]byte, []int) {
+	return file_propertyprovider_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CollectResponse) GetProperties() map[string]*PropertyValue {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *CollectResponse) GetResources() *ResourceUsage {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *CollectResponse) GetNamespaces() map[string]string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *CollectResponse) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+// PropertyValue is the value of a property.
+type PropertyValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The value of the property.
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// When the value is observed; if unset, the time when the member agent receives the response
+	// is used.
+	ObservationTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=observation_time,json=observationTime,proto3" json:"observation_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PropertyValue) Reset() {
+	*x = PropertyValue{}
+	mi := &file_propertyprovider_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyValue) ProtoMessage() {}
+
+func (x *PropertyValue) ProtoReflect() protoreflect.Message {
+	mi := &file_propertyprovider_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyValue.ProtoReflect.Descriptor instead.
+func (*PropertyValue) Descriptor() ([]byte, []int) {
+	return file_propertyprovider_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PropertyValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *PropertyValue) GetObservationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservationTime
+	}
+	return nil
+}
+
+// ResourceUsage describes the resources of a cluster; the values are Kubernetes quantities keyed
+// by the resource names.
+type ResourceUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The total capacity of the cluster.
+	Capacity map[string]string `protobuf:"bytes,1,rep,name=capacity,proto3" json:"capacity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The allocatable capacity of the cluster.
+	Allocatable map[string]string `protobuf:"bytes,2,rep,name=allocatable,proto3" json:"allocatable,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The available capacity of the cluster.
+	Available     map[string]string `protobuf:"bytes,3,rep,name=available,proto3" json:"available,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceUsage) Reset() {
+	*x = ResourceUsage{}
+	mi := &file_propertyprovider_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceUsage) ProtoMessage() {}
+
+func (x *ResourceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_propertyprovider_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceUsage.ProtoReflect.Descriptor instead.
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
+	return file_propertyprovider_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResourceUsage) GetCapacity() map[string]string {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
+func (x *ResourceUsage) GetAllocatable() map[string]string {
+	if x != nil {
+		return x.Allocatable
+	}
+	return nil
+}
+
+func (x *ResourceUsage) GetAvailable() map[string]string {
+	if x != nil {
+		return x.Available
+	}
+	return nil
+}
+
+// Condition explains an aspect of the property collection status.
+type Condition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The type of the condition.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The status of the condition; one of True, False, or Unknown.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// A programmatic identifier for the last transition of the condition.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// A human-readable message about the last transition of the condition.
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	mi := &file_propertyprovider_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_propertyprovider_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_propertyprovider_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Condition) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Condition) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Condition) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Condition) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_propertyprovider_proto protoreflect.FileDescriptor
+
+const file_propertyprovider_proto_rawDesc = "" +
+	"\n" +
+	"\x16propertyprovider.proto\x12\x1dkubefleet.propertyprovider.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x10\n" +
+	"\x0eCollectRequest\"\x93\x04\n" +
+	"\x0fCollectResponse\x12^\n" +
+	"\n" +
+	"properties\x18\x01 \x03(\v2>.kubefleet.propertyprovider.v1.CollectResponse.PropertiesEntryR\n" +
+	"properties\x12J\n" +
+	"\tresources\x18\x02 \x01(\v2,.kubefleet.propertyprovider.v1.ResourceUsageR\tresources\x12^\n" +
+	"\n" +
+	"namespaces\x18\x03 \x03(\v2>.kubefleet.propertyprovider.v1.CollectResponse.NamespacesEntryR\n" +
+	"namespaces\x12H\n" +
+	"\n" +
+	"conditions\x18\x04 \x03(\v2(.kubefleet.propertyprovider.v1.ConditionR\n" +
+	"conditions\x1ak\n" +
+	"\x0fPropertiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12B\n" +
+	"\x05value\x18\x02 \x01(\v2,.kubefleet.propertyprovider.v1.PropertyValueR\x05value:\x028\x01\x1a=\n" +
+	"\x0fNamespacesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"l\n" +
+	"\rPropertyValue\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12E\n" +
+	"\x10observation_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0fobservationTime\"\xde\x03\n" +
+	"\rResourceUsage\x12V\n" +
+	"\bcapacity\x18\x01 \x03(\v2:.kubefleet.propertyprovider.v1.ResourceUsage.CapacityEntryR\bcapacity\x12_\n" +
+	"\vallocatable\x18\x02 \x03(\v2=.kubefleet.propertyprovider.v1.ResourceUsage.AllocatableEntryR\vallocatable\x12Y\n" +
+	"\tavailable\x18\x03 \x03(\v2;.kubefleet.propertyprovider.v1.ResourceUsage.AvailableEntryR\tavailable\x1a;\n" +
+	"\rCapacityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AllocatableEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a<\n" +
+	"\x0eAvailableEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"i\n" +
+	"\tCondition\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage2|\n" +
+	"\x10PropertyProvider\x12h\n" +
+	"\aCollect\x12-.kubefleet.propertyprovider.v1.CollectRequest\x1a..kubefleet.propertyprovider.v1.CollectResponseB^Z\\github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/external/proto/v1;propertyproviderv1b\x06proto3"
+
+var (
+	file_propertyprovider_proto_rawDescOnce sync.Once
+	file_propertyprovider_proto_rawDescData []byte
+)
+
+func file_propertyprovider_proto_rawDescGZIP() []byte {
+	file_propertyprovider_proto_rawDescOnce.Do(func() {
+		file_propertyprovider_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_propertyprovider_proto_rawDesc), len(file_propertyprovider_proto_rawDesc)))
+	})
+	return file_propertyprovider_proto_rawDescData
+}
+
+var file_propertyprovider_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_propertyprovider_proto_goTypes = []any{
+	(*CollectRequest)(nil),        // 0: kubefleet.propertyprovider.v1.CollectRequest
+	(*CollectResponse)(nil),       // 1: kubefleet.propertyprovider.v1.CollectResponse
+	(*PropertyValue)(nil),         // 2: kubefleet.propertyprovider.v1.PropertyValue
+	(*ResourceUsage)(nil),         // 3: kubefleet.propertyprovider.v1.ResourceUsage
+	(*Condition)(nil),             // 4: kubefleet.propertyprovider.v1.Condition
+	nil,                           // 5: kubefleet.propertyprovider.v1.CollectResponse.PropertiesEntry
+	nil,                           // 6: kubefleet.propertyprovider.v1.CollectResponse.NamespacesEntry
+	nil,                           // 7: kubefleet.propertyprovider.v1.ResourceUsage.CapacityEntry
+	nil,                           // 8: kubefleet.propertyprovider.v1.ResourceUsage.AllocatableEntry
+	nil,                           // 9: kubefleet.propertyprovider.v1.ResourceUsage.AvailableEntry
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+}
+var file_propertyprovider_proto_depIdxs = []int32{
+	5,  // 0: kubefleet.propertyprovider.v1.CollectResponse.properties:type_name -> kubefleet.propertyprovider.v1.CollectResponse.PropertiesEntry
+	3,  // 1: kubefleet.propertyprovider.v1.CollectResponse.resources:type_name -> kubefleet.propertyprovider.v1.ResourceUsage
+	6,  // 2: kubefleet.propertyprovider.v1.CollectResponse.namespaces:type_name -> kubefleet.propertyprovider.v1.CollectResponse.NamespacesEntry
+	4,  // 3: kubefleet.propertyprovider.v1.CollectResponse.conditions:type_name -> kubefleet.propertyprovider.v1.Condition
+	10, // 4: kubefleet.propertyprovider.v1.PropertyValue.observation_time:type_name -> google.protobuf.Timestamp
+	7,  // 5: kubefleet.propertyprovider.v1.ResourceUsage.capacity:type_name -> kubefleet.propertyprovider.v1.ResourceUsage.CapacityEntry
+	8,  // 6: kubefleet.propertyprovider.v1.ResourceUsage.allocatable:type_name -> kubefleet.propertyprovider.v1.ResourceUsage.AllocatableEntry
+	9,  // 7: kubefleet.propertyprovider.v1.ResourceUsage.available:type_name -> kubefleet.propertyprovider.v1.ResourceUsage.AvailableEntry
+	2,  // 8: kubefleet.propertyprovider.v1.CollectResponse.PropertiesEntry.value:type_name -> kubefleet.propertyprovider.v1.PropertyValue
+	0,  // 9: kubefleet.propertyprovider.v1.PropertyProvider.Collect:input_type -> kubefleet.propertyprovider.v1.CollectRequest
+	1,  // 10: kubefleet.propertyprovider.v1.PropertyProvider.Collect:output_type -> kubefleet.propertyprovider.v1.CollectResponse
+	10, // [10:11] is the sub-list for method output_type
+	9,  // [9:10] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_propertyprovider_proto_init() }
+func file_propertyprovider_proto_init() {
+	if File_propertyprovider_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_propertyprovider_proto_rawDesc), len(file_propertyprovider_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_propertyprovider_proto_goTypes,
+		DependencyIndexes: file_propertyprovider_proto_depIdxs,
+		MessageInfos:      file_propertyprovider_proto_msgTypes,
+	}.Build()
+	File_propertyprovider_proto = out.File
+	file_propertyprovider_proto_goTypes = nil
+	file_propertyprovider_proto_depIdxs = nil
+}
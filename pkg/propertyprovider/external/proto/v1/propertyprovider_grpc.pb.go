@@ -0,0 +1,152 @@
+// Copyright 2026 The KubeFleet Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The API between the KubeFleet member agent and an external property provider.
+//
+// The Go code in this directory is generated from this file with protoc-gen-go and
+// protoc-gen-go-grpc; run `make protos` after changing it.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: propertyprovider.proto
+
+package propertyproviderv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PropertyProvider_Collect_FullMethodName = "/kubefleet.propertyprovider.v1.PropertyProvider/Collect"
+)
+
+// PropertyProviderClient is the client API for PropertyProvider service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PropertyProvider is the service that an external property provider serves.
+type PropertyProviderClient interface {
+	// Collect collects the properties of the cluster.
+	//
+	// The call should complete promptly; the member agent gives up on the call when its deadline
+	// is exceeded.
+	Collect(ctx context.Context, in *CollectRequest, opts ...grpc.CallOption) (*CollectResponse, error)
+}
+
+type propertyProviderClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPropertyProviderClient(cc grpc.ClientConnInterface) PropertyProviderClient {
+	return &propertyProviderClient{cc}
+}
+
+func (c *propertyProviderClient) Collect(ctx context.Context, in *CollectRequest, opts ...grpc.CallOption) (*CollectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectResponse)
+	err := c.cc.Invoke(ctx, PropertyProvider_Collect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PropertyProviderServer is the server API for PropertyProvider service.
+// All implementations must embed UnimplementedPropertyProviderServer
+// for forward compatibility.
+//
+// PropertyProvider is the service that an external property provider serves.
+type PropertyProviderServer interface {
+	// Collect collects the properties of the cluster.
+	//
+	// The call should complete promptly; the member agent gives up on the call when its deadline
+	// is exceeded.
+	Collect(context.Context, *CollectRequest) (*CollectResponse, error)
+	mustEmbedUnimplementedPropertyProviderServer()
+}
+
+// UnimplementedPropertyProviderServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPropertyProviderServer struct{}
+
+func (UnimplementedPropertyProviderServer) Collect(context.Context, *CollectRequest) (*CollectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Collect not implemented")
+}
+func (UnimplementedPropertyProviderServer) mustEmbedUnimplementedPropertyProviderServer() {}
+func (UnimplementedPropertyProviderServer) testEmbeddedByValue()                          {}
+
+// UnsafePropertyProviderServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PropertyProviderServer will
+// result in compilation errors.
+type UnsafePropertyProviderServer interface {
+	mustEmbedUnimplementedPropertyProviderServer()
+}
+
+func RegisterPropertyProviderServer(s grpc.ServiceRegistrar, srv PropertyProviderServer) {
+	// If the following call pancis, it indicates UnimplementedPropertyProviderServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PropertyProvider_ServiceDesc, srv)
+}
+
+func _PropertyProvider_Collect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyProviderServer).Collect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyProvider_Collect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyProviderServer).Collect(ctx, req.(*CollectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PropertyProvider_ServiceDesc is the grpc.ServiceDesc for PropertyProvider service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PropertyProvider_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kubefleet.propertyprovider.v1.PropertyProvider",
+	HandlerType: (*PropertyProviderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Collect",
+			Handler:    _PropertyProvider_Collect_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "propertyprovider.proto",
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package external features the external property provider for Fleet, which collects properties
+// from a property provider running out of process (e.g., as a sidecar of the member agent) over gRPC.
+package external
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	propertyproviderv1 "github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/external/proto/v1"
+)
+
+const (
+	// The condition related values in use by the external property provider.
+	PropertiesCollectionSucceededCondType = "ExternalPropertyProviderCollectionSucceeded"
+	PropertiesCollectionSucceededReason   = "PropertiesCollected"
+	PropertiesCollectionFailedReason      = "PropertiesCollectionFailed"
+	PropertiesCollectionSucceededMsg      = "All properties have been collected successfully from the external property provider"
+	PropertiesCollectionFailedMsgTemplate = "Failed to collect properties from the external property provider; the last collected properties are reported: %v"
+)
+
+// PropertyProvider is the external property provider for Fleet.
+type PropertyProvider struct {
+	// endpoint is the gRPC target of the external property provider, e.g.,
+	// unix:///var/run/kubefleet/property-provider.sock.
+	endpoint string
+	// timeout is the maximum amount of time that a Collect call to the external property
+	// provider may take; it should be shorter than the deadline that the member agent sets
+	// for the Collect call, so that the failure can be reported as a condition.
+	timeout time.Duration
+
+	// client is the client of the external property provider.
+	client propertyproviderv1.PropertyProviderClient
+
+	// lastResponse is the last response that has been collected successfully.
+	lastResponse propertyprovider.PropertyCollectionResponse
+	// mu is a mutex that protects the last response against concurrent access.
+	mu sync.Mutex
+}
+
+// Verify that the external property provider implements the PropertyProvider interface at compile time.
+var _ propertyprovider.PropertyProvider = &PropertyProvider{}
+
+// Start starts the external property provider.
+//
+// The connection is established lazily, so that the member agent can start before the
+// external property provider becomes ready.
+func (p *PropertyProvider) Start(ctx context.Context, _ *rest.Config) error {
+	klog.V(2).InfoS("Starting external property provider", "endpoint", p.endpoint)
+
+	// The external property provider is expected to run next to the member agent, e.g., as a
+	// sidecar listening on a Unix socket; as a result, transport security is not set up.
+	conn, err := grpc.NewClient(p.endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		err = fmt.Errorf("failed to set up a connection to the external property provider at %s: %w", p.endpoint, err)
+		klog.ErrorS(err, "Failed to start external property provider")
+		return err
+	}
+	p.client = propertyproviderv1.NewPropertyProviderClient(conn)
+
+	go func() {
+		<-ctx.Done()
+		if err := conn.Close(); err != nil {
+			klog.ErrorS(err, "Failed to close the connection to the external property provider")
+		}
+	}()
+	return nil
+}
+
+// Collect collects the properties of a cluster from the external property provider.
+//
+// If the collection fails, the properties collected last time are reported, with a condition
+// that explains the failure.
+func (p *PropertyProvider) Collect(ctx context.Context) propertyprovider.PropertyCollectionResponse {
+	res, err := p.collect(ctx)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err != nil {
+		klog.ErrorS(err, "Failed to collect properties from the external property provider", "endpoint", p.endpoint)
+		return withCondition(p.lastResponse, metav1.Condition{
+			Type:    PropertiesCollectionSucceededCondType,
+			Status:  metav1.ConditionFalse,
+			Reason:  PropertiesCollectionFailedReason,
+			Message: fmt.Sprintf(PropertiesCollectionFailedMsgTemplate, err),
+		})
+	}
+	p.lastResponse = res
+	return withCondition(res, metav1.Condition{
+		Type:    PropertiesCollectionSucceededCondType,
+		Status:  metav1.ConditionTrue,
+		Reason:  PropertiesCollectionSucceededReason,
+		Message: PropertiesCollectionSucceededMsg,
+	})
+}
+
+// collect calls the external property provider and converts its response.
+func (p *PropertyProvider) collect(ctx context.Context) (propertyprovider.PropertyCollectionResponse, error) {
+	if p.client == nil {
+		return propertyprovider.PropertyCollectionResponse{}, fmt.Errorf("the external property provider has not been started")
+	}
+
+	// Respect the deadline of the member agent if it comes earlier.
+	callCtx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	resp, err := p.client.Collect(callCtx, &propertyproviderv1.CollectRequest{})
+	if err != nil {
+		return propertyprovider.PropertyCollectionResponse{}, fmt.Errorf("failed to call the external property provider: %w", err)
+	}
+	return toPropertyCollectionResponse(resp, metav1.Now())
+}
+
+// toPropertyCollectionResponse converts a response from an external property provider.
+func toPropertyCollectionResponse(resp *propertyproviderv1.CollectResponse, now metav1.Time) (propertyprovider.PropertyCollectionResponse, error) {
+	if resp == nil {
+		return propertyprovider.PropertyCollectionResponse{}, fmt.Errorf("the external property provider returned no response")
+	}
+
+	res := propertyprovider.PropertyCollectionResponse{
+		Namespaces: resp.GetNamespaces(),
+	}
+	if len(resp.GetProperties()) > 0 {
+		res.Properties = make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue, len(resp.GetProperties()))
+		for name, v := range resp.GetProperties() {
+			observationTime := now
+			if v.GetObservationTime() != nil {
+				observationTime = metav1.NewTime(v.GetObservationTime().AsTime())
+			}
+			res.Properties[clusterv1beta1.PropertyName(name)] = clusterv1beta1.PropertyValue{
+				Value:           v.GetValue(),
+				ObservationTime: observationTime,
+			}
+		}
+	}
+
+	var err error
+	if res.Resources.Capacity, err = toResourceList(resp.GetResources().GetCapacity()); err != nil {
+		return propertyprovider.PropertyCollectionResponse{}, err
+	}
+	if res.Resources.Allocatable, err = toResourceList(resp.GetResources().GetAllocatable()); err != nil {
+		return propertyprovider.PropertyCollectionResponse{}, err
+	}
+	if res.Resources.Available, err = toResourceList(resp.GetResources().GetAvailable()); err != nil {
+		return propertyprovider.PropertyCollectionResponse{}, err
+	}
+
+	for _, c := range resp.GetConditions() {
+		status := metav1.ConditionStatus(c.GetStatus())
+		if status != metav1.ConditionTrue && status != metav1.ConditionFalse && status != metav1.ConditionUnknown {
+			return propertyprovider.PropertyCollectionResponse{}, fmt.Errorf("invalid status %q for condition %s", c.GetStatus(), c.GetType())
+		}
+		res.Conditions = append(res.Conditions, metav1.Condition{
+			Type:    c.GetType(),
+			Status:  status,
+			Reason:  c.GetReason(),
+			Message: c.GetMessage(),
+		})
+	}
+	return res, nil
+}
+
+// toResourceList converts a map of resource quantities to a resource list.
+func toResourceList(m map[string]string) (corev1.ResourceList, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+	rl := make(corev1.ResourceList, len(m))
+	for rn, v := range m {
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity %q for resource %s: %w", v, rn, err)
+		}
+		rl[corev1.ResourceName(rn)] = q
+	}
+	return rl, nil
+}
+
+// withCondition returns a copy of the response with the given condition added.
+func withCondition(res propertyprovider.PropertyCollectionResponse, cond metav1.Condition) propertyprovider.PropertyCollectionResponse {
+	conds := make([]metav1.Condition, 0, len(res.Conditions)+1)
+	conds = append(conds, res.Conditions...)
+	res.Conditions = append(conds, cond)
+	return res
+}
+
+// New returns a new external property provider that collects properties from the given
+// gRPC endpoint, with each call limited to the given timeout.
+func New(endpoint string, timeout time.Duration) propertyprovider.PropertyProvider {
+	return &PropertyProvider{
+		endpoint: endpoint,
+		timeout:  timeout,
+	}
+}
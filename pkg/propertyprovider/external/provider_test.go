@@ -0,0 +1,376 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	propertyproviderv1 "github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/external/proto/v1"
+)
+
+var (
+	observationTime = metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	validResponse = &propertyproviderv1.CollectResponse{
+		Properties: map[string]*propertyproviderv1.PropertyValue{
+			"example.com/zone-count": {
+				Value:           "3",
+				ObservationTime: timestamppb.New(observationTime.Time),
+			},
+		},
+		Resources: &propertyproviderv1.ResourceUsage{
+			Capacity: map[string]string{
+				"cpu":    "10",
+				"memory": "64Gi",
+			},
+		},
+		Namespaces: map[string]string{
+			"work-ns": "work",
+		},
+		Conditions: []*propertyproviderv1.Condition{
+			{
+				Type:    "ExampleCollectionSucceeded",
+				Status:  "True",
+				Reason:  "Collected",
+				Message: "collected",
+			},
+		},
+	}
+
+	wantValidResponse = propertyprovider.PropertyCollectionResponse{
+		Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+			"example.com/zone-count": {
+				Value:           "3",
+				ObservationTime: observationTime,
+			},
+		},
+		Resources: clusterv1beta1.ResourceUsage{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10"),
+				corev1.ResourceMemory: resource.MustParse("64Gi"),
+			},
+		},
+		Namespaces: map[string]string{
+			"work-ns": "work",
+		},
+		Conditions: []metav1.Condition{
+			{
+				Type:    "ExampleCollectionSucceeded",
+				Status:  metav1.ConditionTrue,
+				Reason:  "Collected",
+				Message: "collected",
+			},
+			{
+				Type:    PropertiesCollectionSucceededCondType,
+				Status:  metav1.ConditionTrue,
+				Reason:  PropertiesCollectionSucceededReason,
+				Message: PropertiesCollectionSucceededMsg,
+			},
+		},
+	}
+)
+
+// dummyServer is a dummy external property provider that returns the responses in order.
+type dummyServer struct {
+	propertyproviderv1.UnimplementedPropertyProviderServer
+
+	responses []*propertyproviderv1.CollectResponse
+	errs      []error
+	delays    []time.Duration
+	calls     int
+}
+
+// Collect implements the PropertyProviderServer interface.
+func (s *dummyServer) Collect(ctx context.Context, _ *propertyproviderv1.CollectRequest) (*propertyproviderv1.CollectResponse, error) {
+	idx := s.calls
+	s.calls++
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(s.delays[idx]):
+	}
+	return s.responses[idx], s.errs[idx]
+}
+
+// startDummyServer starts a dummy external property provider on a Unix socket and returns
+// the endpoint.
+func startDummyServer(t *testing.T, srv propertyproviderv1.PropertyProviderServer) string {
+	return serveOnUnixSocket(t, NewServer(srv))
+}
+
+// serveOnUnixSocket serves the gRPC server on a Unix socket and returns the endpoint.
+func serveOnUnixSocket(t *testing.T, s *grpc.Server) string {
+	// Unix socket paths are limited in length; use a short temporary directory.
+	dir, err := os.MkdirTemp("", "pp")
+	if err != nil {
+		t.Fatalf("failed to create a temporary directory: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	socket := filepath.Join(dir, "provider.sock")
+	lis, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatalf("failed to listen on %s: %v", socket, err)
+	}
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+	return "unix://" + socket
+}
+
+// rawCodec passes the messages through as is; it helps mimic an external property provider
+// that is not written with the generated Go code.
+type rawCodec struct{}
+
+// Marshal implements the encoding.Codec interface.
+func (rawCodec) Marshal(v any) ([]byte, error) {
+	return *(v.(*[]byte)), nil
+}
+
+// Unmarshal implements the encoding.Codec interface.
+func (rawCodec) Unmarshal(data []byte, v any) error {
+	*(v.(*[]byte)) = append([]byte(nil), data...)
+	return nil
+}
+
+// Name implements the encoding.Codec interface.
+func (rawCodec) Name() string {
+	return "raw"
+}
+
+// TestCollect_ProtobufWireFormat verifies that the member agent talks to external property
+// providers with the default gRPC content type and the binary protobuf encoding of the messages
+// defined in proto/v1/propertyprovider.proto, as providers written in other languages expect.
+func TestCollect_ProtobufWireFormat(t *testing.T) {
+	// The response as encoded by any protobuf implementation.
+	response, err := proto.Marshal(validResponse)
+	if err != nil {
+		t.Fatalf("failed to marshal the response: %v", err)
+	}
+
+	var gotMethod, gotContentType string
+	var gotRequest []byte
+	s := grpc.NewServer(
+		grpc.ForceServerCodec(rawCodec{}),
+		grpc.UnknownServiceHandler(func(_ any, stream grpc.ServerStream) error {
+			gotMethod, _ = grpc.MethodFromServerStream(stream)
+			if md, ok := metadata.FromIncomingContext(stream.Context()); ok && len(md.Get("content-type")) > 0 {
+				gotContentType = md.Get("content-type")[0]
+			}
+			if err := stream.RecvMsg(&gotRequest); err != nil {
+				return err
+			}
+			return stream.SendMsg(&response)
+		}),
+	)
+	endpoint := serveOnUnixSocket(t, s)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := New(endpoint, time.Second)
+	if err := p.Start(ctx, nil); err != nil {
+		t.Fatalf("Start() = %v, want nil", err)
+	}
+	got := p.Collect(ctx)
+	if diff := cmp.Diff(got, wantValidResponse); diff != "" {
+		t.Errorf("Collect() diff (-got, +want):\n%s", diff)
+	}
+	if gotMethod != propertyproviderv1.PropertyProvider_Collect_FullMethodName {
+		t.Errorf("called method = %q, want %q", gotMethod, propertyproviderv1.PropertyProvider_Collect_FullMethodName)
+	}
+	if gotContentType != "application/grpc" {
+		t.Errorf("request content type = %q, want %q", gotContentType, "application/grpc")
+	}
+	gotReq := &propertyproviderv1.CollectRequest{}
+	if err := proto.Unmarshal(gotRequest, gotReq); err != nil {
+		t.Errorf("failed to unmarshal the request: %v", err)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	ignoreConditionMessage := cmpopts.IgnoreFields(metav1.Condition{}, "Message")
+	failedCondition := metav1.Condition{
+		Type:   PropertiesCollectionSucceededCondType,
+		Status: metav1.ConditionFalse,
+		Reason: PropertiesCollectionFailedReason,
+	}
+
+	testCases := []struct {
+		name      string
+		server    *dummyServer
+		wantLasts []propertyprovider.PropertyCollectionResponse
+	}{
+		{
+			name: "collected successfully",
+			server: &dummyServer{
+				responses: []*propertyproviderv1.CollectResponse{validResponse},
+				errs:      []error{nil},
+				delays:    []time.Duration{0},
+			},
+			wantLasts: []propertyprovider.PropertyCollectionResponse{wantValidResponse},
+		},
+		{
+			name: "provider returns an error after a successful collection",
+			server: &dummyServer{
+				responses: []*propertyproviderv1.CollectResponse{validResponse, nil},
+				errs:      []error{nil, fmt.Errorf("unavailable")},
+				delays:    []time.Duration{0, 0},
+			},
+			wantLasts: []propertyprovider.PropertyCollectionResponse{
+				wantValidResponse,
+				{
+					Properties: wantValidResponse.Properties,
+					Resources:  wantValidResponse.Resources,
+					Namespaces: wantValidResponse.Namespaces,
+					Conditions: []metav1.Condition{wantValidResponse.Conditions[0], failedCondition},
+				},
+			},
+		},
+		{
+			name: "provider times out",
+			server: &dummyServer{
+				responses: []*propertyproviderv1.CollectResponse{validResponse},
+				errs:      []error{nil},
+				delays:    []time.Duration{time.Minute},
+			},
+			wantLasts: []propertyprovider.PropertyCollectionResponse{
+				{
+					Conditions: []metav1.Condition{failedCondition},
+				},
+			},
+		},
+		{
+			name: "provider returns an invalid quantity",
+			server: &dummyServer{
+				responses: []*propertyproviderv1.CollectResponse{
+					{
+						Resources: &propertyproviderv1.ResourceUsage{
+							Capacity: map[string]string{"cpu": "ten"},
+						},
+					},
+				},
+				errs:   []error{nil},
+				delays: []time.Duration{0},
+			},
+			wantLasts: []propertyprovider.PropertyCollectionResponse{
+				{
+					Conditions: []metav1.Condition{failedCondition},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			endpoint := startDummyServer(t, tc.server)
+			p := New(endpoint, time.Second)
+			if err := p.Start(ctx, nil); err != nil {
+				t.Fatalf("Start() = %v, want nil", err)
+			}
+			for i, want := range tc.wantLasts {
+				got := p.Collect(ctx)
+				if diff := cmp.Diff(got, want, ignoreConditionMessage); diff != "" {
+					t.Errorf("Collect() call #%d diff (-got, +want):\n%s", i, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToPropertyCollectionResponse(t *testing.T) {
+	now := metav1.NewTime(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	testCases := []struct {
+		name    string
+		resp    *propertyproviderv1.CollectResponse
+		want    propertyprovider.PropertyCollectionResponse
+		wantErr bool
+	}{
+		{
+			name:    "nil response",
+			wantErr: true,
+		},
+		{
+			name: "observation time defaults to now",
+			resp: &propertyproviderv1.CollectResponse{
+				Properties: map[string]*propertyproviderv1.PropertyValue{
+					"example.com/gpu-model-count": {Value: "2"},
+				},
+				Resources: &propertyproviderv1.ResourceUsage{
+					Allocatable: map[string]string{"nvidia.com/gpu": "4"},
+					Available:   map[string]string{"nvidia.com/gpu": "1"},
+				},
+			},
+			want: propertyprovider.PropertyCollectionResponse{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					"example.com/gpu-model-count": {Value: "2", ObservationTime: now},
+				},
+				Resources: clusterv1beta1.ResourceUsage{
+					Allocatable: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("4")},
+					Available:   corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+				},
+			},
+		},
+		{
+			name: "invalid condition status",
+			resp: &propertyproviderv1.CollectResponse{
+				Conditions: []*propertyproviderv1.Condition{{Type: "ExampleCollectionSucceeded", Status: "Yes", Reason: "Collected"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := toPropertyCollectionResponse(tc.resp, now)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("toPropertyCollectionResponse() = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("toPropertyCollectionResponse() = %v, want nil", err)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("toPropertyCollectionResponse() diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
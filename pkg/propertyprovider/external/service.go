@@ -0,0 +1,34 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"google.golang.org/grpc"
+
+	propertyproviderv1 "github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/external/proto/v1"
+)
+
+// NewServer returns a gRPC server that serves the property provider service with the given
+// implementation; external property providers written in Go can use it to serve the member agent.
+//
+// The service is defined in proto/v1/propertyprovider.proto; providers written in other languages
+// can serve it with the code generated from the definitions.
+func NewServer(srv propertyproviderv1.PropertyProviderServer, opts ...grpc.ServerOption) *grpc.Server {
+	s := grpc.NewServer(opts...)
+	propertyproviderv1.RegisterPropertyProviderServer(s, srv)
+	return s
+}
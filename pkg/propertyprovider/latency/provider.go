@@ -0,0 +1,248 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package latency features the latency property provider for Fleet, which measures the network
+// latency from the member cluster to a set of peers, and reports the results as properties for
+// network-aware placement.
+package latency
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+)
+
+const (
+	// The condition related values in use by the latency property provider.
+	LatencyProbeSucceededCondType   = "LatencyProbeSucceeded"
+	LatencyProbeSucceededReason     = "AllPeersProbed"
+	LatencyProbeDegradedReason      = "SomePeersUnreachable"
+	LatencyProbeFailedReason        = "AllPeersUnreachable"
+	LatencyProbeSucceededMsg        = "The latency to all peers has been measured successfully"
+	LatencyProbeDegradedMsgTemplate = "The latency to some peers cannot be measured and is not reported: %v"
+	LatencyProbeFailedMsgTemplate   = "The latency to none of the peers can be measured: %v"
+
+	LatencyPrecisionTemplate = "%.3f"
+)
+
+const (
+	// attemptsPerProbe is the number of connection attempts made to a peer in each probe.
+	attemptsPerProbe = 3
+	// defaultProbeTimeout is the maximum amount of time that a connection attempt may take.
+	defaultProbeTimeout = 3 * time.Second
+)
+
+// Peer is a peer that the latency property provider measures the network latency to.
+type Peer struct {
+	// Name is the name of the peer, e.g., the name of another member cluster or a region;
+	// it is a part of the name of the reported property.
+	Name string
+	// Endpoint is the TCP endpoint of the peer, in the form of host:port.
+	Endpoint string
+}
+
+// probeResult is the result of the latest probe to a peer.
+type probeResult struct {
+	latency    time.Duration
+	observedAt time.Time
+	err        error
+}
+
+// PropertyProvider is the latency property provider for Fleet.
+//
+// The provider measures the latency to each peer periodically as the time it takes to establish a
+// TCP connection to the peer's endpoint; each probe makes a few attempts and keeps the lowest reading
+// to filter out transient noise.
+type PropertyProvider struct {
+	// peers are the peers to measure the latency to.
+	peers []Peer
+	// interval is how often the peers are probed.
+	interval time.Duration
+	// timeout is the maximum amount of time that an attempt to connect to a peer may take.
+	timeout time.Duration
+
+	// dial establishes a connection to an endpoint; this field is mostly reserved for testing purposes.
+	dial func(ctx context.Context, network, address string) (net.Conn, error)
+
+	// results are the results of the latest probes, keyed by the peer names.
+	results map[string]probeResult
+	// mu is a mutex that protects the results against concurrent access.
+	mu sync.Mutex
+}
+
+// Verify that the latency property provider implements the PropertyProvider interface at compile time.
+var _ propertyprovider.PropertyProvider = &PropertyProvider{}
+
+// Start starts the latency property provider.
+//
+// The peers are probed once before the method returns, so that the first collection has the
+// measurements ready; they are then probed periodically in a separate goroutine until the
+// context exits.
+func (p *PropertyProvider) Start(ctx context.Context, _ *rest.Config) error {
+	klog.V(2).InfoS("Starting latency property provider", "peers", p.peers, "interval", p.interval)
+
+	p.probeAll(ctx)
+	go func() {
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				p.probeAll(ctx)
+			}
+		}
+	}()
+	return nil
+}
+
+// Collect reports the latency to each peer that has been measured successfully in the latest probe.
+func (p *PropertyProvider) Collect(_ context.Context) propertyprovider.PropertyCollectionResponse {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	properties := make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue, len(p.peers))
+	unreachable := make([]string, 0)
+	for _, peer := range p.peers {
+		res, ok := p.results[peer.Name]
+		if !ok {
+			unreachable = append(unreachable, fmt.Sprintf("%s (not probed yet)", peer.Name))
+			continue
+		}
+		if res.err != nil {
+			unreachable = append(unreachable, fmt.Sprintf("%s (%v)", peer.Name, res.err))
+			continue
+		}
+		pName := fmt.Sprintf(propertyprovider.LatencyPropertyTmpl, peer.Name)
+		properties[clusterv1beta1.PropertyName(pName)] = clusterv1beta1.PropertyValue{
+			Value:           fmt.Sprintf(LatencyPrecisionTemplate, float64(res.latency)/float64(time.Millisecond)),
+			ObservationTime: metav1.NewTime(res.observedAt),
+		}
+	}
+	// Sort the unreachable peers for stability reasons.
+	slices.Sort(unreachable)
+
+	var cond metav1.Condition
+	switch {
+	case len(unreachable) == 0:
+		cond = metav1.Condition{
+			Type:    LatencyProbeSucceededCondType,
+			Status:  metav1.ConditionTrue,
+			Reason:  LatencyProbeSucceededReason,
+			Message: LatencyProbeSucceededMsg,
+		}
+	case len(properties) > 0:
+		cond = metav1.Condition{
+			Type:    LatencyProbeSucceededCondType,
+			Status:  metav1.ConditionTrue,
+			Reason:  LatencyProbeDegradedReason,
+			Message: fmt.Sprintf(LatencyProbeDegradedMsgTemplate, unreachable),
+		}
+	default:
+		cond = metav1.Condition{
+			Type:    LatencyProbeSucceededCondType,
+			Status:  metav1.ConditionFalse,
+			Reason:  LatencyProbeFailedReason,
+			Message: fmt.Sprintf(LatencyProbeFailedMsgTemplate, unreachable),
+		}
+	}
+
+	return propertyprovider.PropertyCollectionResponse{
+		Properties: properties,
+		Conditions: []metav1.Condition{cond},
+	}
+}
+
+// probeAll probes all the peers concurrently and records the results.
+func (p *PropertyProvider) probeAll(ctx context.Context) {
+	results := make(map[string]probeResult, len(p.peers))
+	var resultsMu sync.Mutex
+	var wg sync.WaitGroup
+	for _, peer := range p.peers {
+		wg.Add(1)
+		go func(peer Peer) {
+			defer wg.Done()
+			latency, err := p.probe(ctx, peer)
+			if err != nil {
+				klog.V(2).InfoS("Failed to measure the latency to the peer", "peer", peer.Name, "endpoint", peer.Endpoint, "err", err)
+			}
+			resultsMu.Lock()
+			defer resultsMu.Unlock()
+			results[peer.Name] = probeResult{
+				latency:    latency,
+				observedAt: time.Now(),
+				err:        err,
+			}
+		}(peer)
+	}
+	wg.Wait()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.results = results
+}
+
+// probe measures the latency to a peer; it returns the lowest reading among all the attempts,
+// or an error if none of the attempts succeeds.
+func (p *PropertyProvider) probe(ctx context.Context, peer Peer) (time.Duration, error) {
+	var best time.Duration
+	var lastErr error
+	succeeded := false
+	for i := 0; i < attemptsPerProbe; i++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, p.timeout)
+		start := time.Now()
+		conn, err := p.dial(attemptCtx, "tcp", peer.Endpoint)
+		elapsed := time.Since(start)
+		cancel()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		_ = conn.Close()
+		if !succeeded || elapsed < best {
+			best = elapsed
+		}
+		succeeded = true
+	}
+	if !succeeded {
+		return 0, lastErr
+	}
+	return best, nil
+}
+
+// New returns a new latency property provider that measures the latency to the given peers
+// at the given interval.
+func New(peers []Peer, interval time.Duration) propertyprovider.PropertyProvider {
+	dialer := &net.Dialer{}
+	return &PropertyProvider{
+		peers:    peers,
+		interval: interval,
+		timeout:  defaultProbeTimeout,
+		dial:     dialer.DialContext,
+		results:  make(map[string]probeResult),
+	}
+}
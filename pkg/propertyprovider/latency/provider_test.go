@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latency
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+)
+
+// startPeer starts a local stand-in for a peer, which accepts and closes connections.
+func startPeer(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start a local peer: %v", err)
+	}
+	t.Cleanup(func() { _ = l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.Close()
+		}
+	}()
+	return l.Addr().String()
+}
+
+// closedEndpoint returns an endpoint that refuses connections.
+func closedEndpoint(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to reserve a local endpoint: %v", err)
+	}
+	addr := l.Addr().String()
+	_ = l.Close()
+	return addr
+}
+
+func propertyNameFor(peer string) clusterv1beta1.PropertyName {
+	return clusterv1beta1.PropertyName(fmt.Sprintf(propertyprovider.LatencyPropertyTmpl, peer))
+}
+
+func TestCollect(t *testing.T) {
+	reachable := startPeer(t)
+	unreachable := closedEndpoint(t)
+
+	testCases := []struct {
+		name           string
+		peers          []Peer
+		skipProbe      bool
+		wantProperties []clusterv1beta1.PropertyName
+		wantCond       metav1.Condition
+	}{
+		{
+			name: "all peers reachable",
+			peers: []Peer{
+				{Name: "member-2", Endpoint: reachable},
+				{Name: "eastus", Endpoint: reachable},
+			},
+			wantProperties: []clusterv1beta1.PropertyName{propertyNameFor("member-2"), propertyNameFor("eastus")},
+			wantCond: metav1.Condition{
+				Type:   LatencyProbeSucceededCondType,
+				Status: metav1.ConditionTrue,
+				Reason: LatencyProbeSucceededReason,
+			},
+		},
+		{
+			name: "some peers unreachable",
+			peers: []Peer{
+				{Name: "member-2", Endpoint: reachable},
+				{Name: "eastus", Endpoint: unreachable},
+			},
+			wantProperties: []clusterv1beta1.PropertyName{propertyNameFor("member-2")},
+			wantCond: metav1.Condition{
+				Type:   LatencyProbeSucceededCondType,
+				Status: metav1.ConditionTrue,
+				Reason: LatencyProbeDegradedReason,
+			},
+		},
+		{
+			name: "all peers unreachable",
+			peers: []Peer{
+				{Name: "eastus", Endpoint: unreachable},
+			},
+			wantCond: metav1.Condition{
+				Type:   LatencyProbeSucceededCondType,
+				Status: metav1.ConditionFalse,
+				Reason: LatencyProbeFailedReason,
+			},
+		},
+		{
+			name: "peers not probed yet",
+			peers: []Peer{
+				{Name: "member-2", Endpoint: reachable},
+			},
+			skipProbe: true,
+			wantCond: metav1.Condition{
+				Type:   LatencyProbeSucceededCondType,
+				Status: metav1.ConditionFalse,
+				Reason: LatencyProbeFailedReason,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := New(tc.peers, time.Minute).(*PropertyProvider)
+			p.timeout = time.Second
+			if !tc.skipProbe {
+				p.probeAll(context.Background())
+			}
+
+			res := p.Collect(context.Background())
+			if len(res.Properties) != len(tc.wantProperties) {
+				t.Fatalf("Collect() properties = %v, want %v", res.Properties, tc.wantProperties)
+			}
+			for _, name := range tc.wantProperties {
+				v, ok := res.Properties[name]
+				if !ok {
+					t.Fatalf("Collect() properties = %v, want property %s", res.Properties, name)
+				}
+				q, err := resource.ParseQuantity(v.Value)
+				if err != nil {
+					t.Fatalf("property %s has value %s, want a quantity: %v", name, v.Value, err)
+				}
+				if q.Sign() < 0 {
+					t.Errorf("property %s has value %s, want a non-negative latency", name, v.Value)
+				}
+			}
+			if len(res.Conditions) != 1 {
+				t.Fatalf("Collect() conditions = %v, want 1 condition", res.Conditions)
+			}
+			got := res.Conditions[0]
+			if got.Type != tc.wantCond.Type || got.Status != tc.wantCond.Status || got.Reason != tc.wantCond.Reason {
+				t.Errorf("Collect() condition = %v, want %v", got, tc.wantCond)
+			}
+		})
+	}
+}
+
+func TestProbe(t *testing.T) {
+	readings := []time.Duration{200 * time.Millisecond, 10 * time.Millisecond, 200 * time.Millisecond}
+	attempt := 0
+	p := &PropertyProvider{
+		timeout: time.Second,
+		// Simulate the latency of each attempt, so that the lowest reading can be verified.
+		dial: func(_ context.Context, _, _ string) (net.Conn, error) {
+			time.Sleep(readings[attempt])
+			attempt++
+			client, server := net.Pipe()
+			_ = server.Close()
+			return client, nil
+		},
+	}
+
+	got, err := p.probe(context.Background(), Peer{Name: "member-2", Endpoint: "member-2.example.com:443"})
+	if err != nil {
+		t.Fatalf("probe() = %v, want no error", err)
+	}
+	if attempt != attemptsPerProbe {
+		t.Errorf("probe() made %d attempts, want %d", attempt, attemptsPerProbe)
+	}
+	if got < 10*time.Millisecond || got >= 200*time.Millisecond {
+		t.Errorf("probe() = %v, want the lowest reading (about 10ms)", got)
+	}
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package nodes features the node inventory property provider for Fleet, a cloud-agnostic
+// property provider that relies only on the well-known Kubernetes node labels.
+package nodes
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/default/controllers"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/default/trackers"
+)
+
+const (
+	// A list of properties that the node inventory property provider collects in addition to the
+	// Fleet required ones.
+
+	// NodeCountPerSKUPropertyTmpl is a property that describes the number of nodes of a specific
+	// instance type, as indicated by the well-known instance type node label.
+	NodeCountPerSKUPropertyTmpl = "kubernetes-fleet.io/instance-types/%s/count"
+	// NodeCountPerLabelValuePropertyTmpl is a property that describes the number of nodes that have
+	// an exported label (the first argument) set to a specific value (the second argument).
+	NodeCountPerLabelValuePropertyTmpl = "kubernetes-fleet.io/node-labels/%s/%s/count"
+	// TotalExtendedResourcePropertyTmpl and AllocatableExtendedResourcePropertyTmpl are properties
+	// that describe the total and allocatable capacity of an extended resource (e.g., nvidia.com/gpu)
+	// in the cluster, respectively.
+	//
+	// Extended resources are also reported as a part of the resource usage of the cluster; however,
+	// as their names include a slash, they cannot be referred to as resource properties.
+	TotalExtendedResourcePropertyTmpl       = "kubernetes-fleet.io/extended-resources/%s/total"
+	AllocatableExtendedResourcePropertyTmpl = "kubernetes-fleet.io/extended-resources/%s/allocatable"
+)
+
+var (
+	// DefaultExportedLabels are the node labels whose values the node inventory property provider
+	// exports by default.
+	//
+	// The instance type label is not included, as the node counts per SKU are always exported.
+	DefaultExportedLabels = []string{
+		corev1.LabelTopologyZone,
+		corev1.LabelArchStable,
+		corev1.LabelOSStable,
+	}
+)
+
+// PropertyProvider is the node inventory property provider for Fleet.
+type PropertyProvider struct {
+	// The trackers.
+	nodeTracker *trackers.NodeTracker
+
+	// The keys of the node labels whose values are exported.
+	exportedLabels []string
+
+	// The controller manager in use by the property provider; this field is mostly reserved for
+	// testing purposes.
+	mgr ctrl.Manager
+	// The name in use by the node controller managed by the property provider; this field is
+	// exposed to avoid name conflicts, though at this moment is mostly reserved for testing purposes.
+	nodeControllerName string
+}
+
+// Verify that the node inventory property provider implements the PropertyProvider interface at compile time.
+var _ propertyprovider.PropertyProvider = &PropertyProvider{}
+
+// Start starts the node inventory property provider.
+func (p *PropertyProvider) Start(ctx context.Context, config *rest.Config) error {
+	klog.V(2).Info("Starting node inventory property provider")
+
+	mgr, err := ctrl.NewManager(config, ctrl.Options{
+		Scheme: scheme.Scheme,
+		// Disable metric serving for the property provider controller manager.
+		//
+		// Note that this will not stop the metrics from being collected and exported; as they
+		// are registered via a top-level variable as a part of the controller runtime package,
+		// which is also used by the Fleet member agent.
+		Metrics: metricsserver.Options{
+			BindAddress: "0",
+		},
+		// Disable health probe serving for the property provider controller manager.
+		HealthProbeBindAddress: "0",
+		// Disable leader election for the property provider; the property provider observes
+		// data individually in a passive manner with no need for any centralized state.
+		LeaderElection: false,
+	})
+	if err != nil {
+		klog.ErrorS(err, "Failed to start node inventory property provider")
+		return err
+	}
+	p.mgr = mgr
+
+	if p.nodeTracker != nil {
+		// A node tracker has been explicitly set; use it.
+		klog.V(2).Info("A node tracker has been explicitly set")
+	} else {
+		p.nodeTracker = trackers.NewNodeTracker(p.exportedLabels)
+	}
+
+	// Set up the node reconciler.
+	klog.V(2).Info("Setting up the node reconciler")
+	nodeReconciler := &controllers.NodeReconciler{
+		NodeTracker: p.nodeTracker,
+		Client:      mgr.GetClient(),
+	}
+	if err := nodeReconciler.SetupWithManager(mgr, p.nodeControllerName); err != nil {
+		klog.ErrorS(err, "Failed to start the node reconciler in the node inventory property provider")
+		return err
+	}
+
+	// Start the controller manager.
+	//
+	// Note that the controller manager will run in a separate goroutine to avoid blocking
+	// the member agent.
+	go func() {
+		// This call will block until the context exits.
+		if err := mgr.Start(ctx); err != nil {
+			klog.ErrorS(err, "Failed to start the node inventory property provider controller manager")
+		}
+	}()
+
+	// Wait for the cache to sync; some exported properties might be skewed initially if
+	// the node changes have not been processed yet.
+	mgr.GetCache().WaitForCacheSync(ctx)
+
+	return nil
+}
+
+// Collect collects the properties of a Kubernetes cluster from its nodes.
+func (p *PropertyProvider) Collect(_ context.Context) propertyprovider.PropertyCollectionResponse {
+	now := metav1.Now()
+	properties := make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue)
+
+	// Collect the total node count as a property.
+	properties[propertyprovider.NodeCountProperty] = clusterv1beta1.PropertyValue{
+		Value:           fmt.Sprintf("%d", p.nodeTracker.NodeCount()),
+		ObservationTime: now,
+	}
+
+	// Collect the per-SKU node counts as properties.
+	for sku, count := range p.nodeTracker.NodeCountPerSKU() {
+		pName := fmt.Sprintf(NodeCountPerSKUPropertyTmpl, sku)
+		properties[clusterv1beta1.PropertyName(pName)] = clusterv1beta1.PropertyValue{
+			Value:           fmt.Sprintf("%d", count),
+			ObservationTime: now,
+		}
+	}
+
+	// Collect the per-label-value node counts as properties.
+	for key, countPerValue := range p.nodeTracker.NodeCountPerLabelValue() {
+		for value, count := range countPerValue {
+			pName := fmt.Sprintf(NodeCountPerLabelValuePropertyTmpl, key, value)
+			properties[clusterv1beta1.PropertyName(pName)] = clusterv1beta1.PropertyValue{
+				Value:           fmt.Sprintf("%d", count),
+				ObservationTime: now,
+			}
+		}
+	}
+
+	// Collect the total and allocatable resource properties.
+	resources := clusterv1beta1.ResourceUsage{
+		Capacity:    p.nodeTracker.TotalCapacity(),
+		Allocatable: p.nodeTracker.TotalAllocatable(),
+	}
+
+	// Collect the extended resources as properties as well.
+	collectExtendedResources(resources.Capacity, TotalExtendedResourcePropertyTmpl, now, properties)
+	collectExtendedResources(resources.Allocatable, AllocatableExtendedResourcePropertyTmpl, now, properties)
+
+	return propertyprovider.PropertyCollectionResponse{
+		Properties: properties,
+		Resources:  resources,
+	}
+}
+
+// collectExtendedResources adds the extended resources in a resource list as properties.
+func collectExtendedResources(
+	rl corev1.ResourceList,
+	tmpl string,
+	now metav1.Time,
+	properties map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue,
+) {
+	for rn, q := range rl {
+		if !trackers.IsExtendedResourceName(rn) {
+			continue
+		}
+		pName := fmt.Sprintf(tmpl, rn)
+		properties[clusterv1beta1.PropertyName(pName)] = clusterv1beta1.PropertyValue{
+			Value:           q.String(),
+			ObservationTime: now,
+		}
+	}
+}
+
+// New returns a new node inventory property provider that exports the values of the given
+// node labels; if no labels are given, the default ones are exported.
+func New(exportedLabels []string) propertyprovider.PropertyProvider {
+	if len(exportedLabels) == 0 {
+		exportedLabels = DefaultExportedLabels
+	}
+	return &PropertyProvider{
+		exportedLabels: exportedLabels,
+		// Use the default name.
+		nodeControllerName: "nodes-property-provider-node-watcher",
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/default/trackers"
+)
+
+var (
+	ignoreObservationTimeFieldInPropertyValue = cmpopts.IgnoreFields(clusterv1beta1.PropertyValue{}, "ObservationTime")
+)
+
+func TestCollect(t *testing.T) {
+	testCases := []struct {
+		name                           string
+		nodes                          []corev1.Node
+		wantPropertyCollectionResponse propertyprovider.PropertyCollectionResponse
+	}{
+		{
+			name: "no nodes",
+			wantPropertyCollectionResponse: propertyprovider.PropertyCollectionResponse{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					propertyprovider.NodeCountProperty: {
+						Value: "0",
+					},
+				},
+				Resources: clusterv1beta1.ResourceUsage{
+					Capacity: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.Quantity{},
+						corev1.ResourceMemory: resource.Quantity{},
+					},
+					Allocatable: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.Quantity{},
+						corev1.ResourceMemory: resource.Quantity{},
+					},
+				},
+			},
+		},
+		{
+			name: "multiple nodes",
+			nodes: []corev1.Node{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-1",
+						Labels: map[string]string{
+							corev1.LabelInstanceTypeStable: "m5.large",
+							corev1.LabelTopologyZone:       "zone-1",
+							corev1.LabelArchStable:         "amd64",
+							corev1.LabelOSStable:           "linux",
+						},
+					},
+					Status: corev1.NodeStatus{
+						Capacity: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("2"),
+							corev1.ResourceMemory: resource.MustParse("8Gi"),
+						},
+						Allocatable: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("1.9"),
+							corev1.ResourceMemory: resource.MustParse("7Gi"),
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node-2",
+						Labels: map[string]string{
+							corev1.LabelInstanceTypeStable: "p3.2xlarge",
+							corev1.LabelTopologyZone:       "zone-2",
+							corev1.LabelArchStable:         "amd64",
+							corev1.LabelOSStable:           "linux",
+						},
+					},
+					Status: corev1.NodeStatus{
+						Capacity: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("8"),
+							corev1.ResourceMemory: resource.MustParse("64Gi"),
+							"nvidia.com/gpu":      resource.MustParse("2"),
+						},
+						Allocatable: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("7.8"),
+							corev1.ResourceMemory: resource.MustParse("60Gi"),
+							"nvidia.com/gpu":      resource.MustParse("1"),
+						},
+					},
+				},
+			},
+			wantPropertyCollectionResponse: propertyprovider.PropertyCollectionResponse{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					propertyprovider.NodeCountProperty: {
+						Value: "2",
+					},
+					"kubernetes-fleet.io/instance-types/m5.large/count": {
+						Value: "1",
+					},
+					"kubernetes-fleet.io/instance-types/p3.2xlarge/count": {
+						Value: "1",
+					},
+					"kubernetes-fleet.io/node-labels/topology.kubernetes.io/zone/zone-1/count": {
+						Value: "1",
+					},
+					"kubernetes-fleet.io/node-labels/topology.kubernetes.io/zone/zone-2/count": {
+						Value: "1",
+					},
+					"kubernetes-fleet.io/node-labels/kubernetes.io/arch/amd64/count": {
+						Value: "2",
+					},
+					"kubernetes-fleet.io/node-labels/kubernetes.io/os/linux/count": {
+						Value: "2",
+					},
+					"kubernetes-fleet.io/extended-resources/nvidia.com/gpu/total": {
+						Value: "2",
+					},
+					"kubernetes-fleet.io/extended-resources/nvidia.com/gpu/allocatable": {
+						Value: "1",
+					},
+				},
+				Resources: clusterv1beta1.ResourceUsage{
+					Capacity: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("10"),
+						corev1.ResourceMemory: resource.MustParse("72Gi"),
+						"nvidia.com/gpu":      resource.MustParse("2"),
+					},
+					Allocatable: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("9.7"),
+						corev1.ResourceMemory: resource.MustParse("67Gi"),
+						"nvidia.com/gpu":      resource.MustParse("1"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Build the tracker manually for testing purposes.
+			nodeTracker := trackers.NewNodeTracker(DefaultExportedLabels)
+			for idx := range tc.nodes {
+				nodeTracker.AddOrUpdate(&tc.nodes[idx])
+			}
+			p := &PropertyProvider{
+				nodeTracker:    nodeTracker,
+				exportedLabels: DefaultExportedLabels,
+			}
+			res := p.Collect(context.Background())
+			if diff := cmp.Diff(res, tc.wantPropertyCollectionResponse, ignoreObservationTimeFieldInPropertyValue); diff != "" {
+				t.Fatalf("Collect() property collection response diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
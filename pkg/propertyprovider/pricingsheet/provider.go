@@ -0,0 +1,344 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pricingsheet features the pricing sheet property provider for Fleet, a cloud-agnostic
+// property provider that calculates cost properties from an offline pricing sheet.
+package pricingsheet
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/azure"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/default/controllers"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/default/trackers"
+)
+
+const (
+	// A list of properties that the pricing sheet property provider collects in addition to the
+	// Fleet required ones.
+	//
+	// The cost properties share their names with the ones that the Azure property provider collects,
+	// so that cost-aware placements work the same way across clusters of different environments.
+
+	// PerCPUCoreCostProperty is a property that describes the average hourly cost of a CPU core in
+	// a Kubernetes cluster.
+	PerCPUCoreCostProperty = azure.PerCPUCoreCostProperty
+	// PerGBMemoryCostProperty is a property that describes the average cost of one GB of memory in
+	// a Kubernetes cluster.
+	PerGBMemoryCostProperty = azure.PerGBMemoryCostProperty
+
+	CostPrecisionTemplate = azure.CostPrecisionTemplate
+)
+
+const (
+	// The condition related values in use by the pricing sheet property provider.
+	CostPropertiesCollectionSucceededCondType   = "PricingSheetCostPropertiesCollectionSucceeded"
+	CostPropertiesCollectionSucceededReason     = "CostsCalculated"
+	CostPropertiesCollectionDegradedReason      = "CostsCalculationDegraded"
+	CostPropertiesCollectionFailedReason        = "CostsCalculationFailed"
+	CostPropertiesCollectionSucceededMsg        = "All cost properties have been collected successfully"
+	CostPropertiesCollectionDegradedMsgTemplate = "Cost properties are collected in a degraded mode with the following warning(s): %v"
+	CostPropertiesCollectionFailedMsgTemplate   = "An error has occurred when collecting cost properties: %v"
+)
+
+// PropertyProvider is the pricing sheet property provider for Fleet.
+//
+// The provider reads the hourly prices of instance types from a pricing sheet file, which is
+// usually a ConfigMap mounted into the member agent pod; the file is reloaded whenever it changes.
+// Nodes are matched against the pricing sheet by the well-known instance type node label.
+type PropertyProvider struct {
+	// The trackers.
+	nodeTracker *trackers.NodeTracker
+
+	// The path to the pricing sheet file.
+	sheetPath string
+	// sheet is the pricing sheet last loaded successfully; it is protected by sheetMutex.
+	sheet      *Sheet
+	sheetMutex sync.Mutex
+
+	// The controller manager in use by the property provider; this field is mostly reserved for
+	// testing purposes.
+	mgr ctrl.Manager
+	// The name in use by the node controller managed by the property provider; this field is
+	// exposed to avoid name conflicts, though at this moment is mostly reserved for testing purposes.
+	nodeControllerName string
+}
+
+// Verify that the pricing sheet property provider implements the PropertyProvider interface at compile time.
+var _ propertyprovider.PropertyProvider = &PropertyProvider{}
+
+// Start starts the pricing sheet property provider.
+func (p *PropertyProvider) Start(ctx context.Context, config *rest.Config) error {
+	klog.V(2).InfoS("Starting pricing sheet property provider", "sheetPath", p.sheetPath)
+
+	mgr, err := ctrl.NewManager(config, ctrl.Options{
+		Scheme: scheme.Scheme,
+		// Disable metric serving for the property provider controller manager.
+		//
+		// Note that this will not stop the metrics from being collected and exported; as they
+		// are registered via a top-level variable as a part of the controller runtime package,
+		// which is also used by the Fleet member agent.
+		Metrics: metricsserver.Options{
+			BindAddress: "0",
+		},
+		// Disable health probe serving for the property provider controller manager.
+		HealthProbeBindAddress: "0",
+		// Disable leader election for the property provider; the property provider observes
+		// data individually in a passive manner with no need for any centralized state.
+		LeaderElection: false,
+	})
+	if err != nil {
+		klog.ErrorS(err, "Failed to start pricing sheet property provider")
+		return err
+	}
+	p.mgr = mgr
+
+	// Load the pricing sheet for the first time.
+	//
+	// A missing or malformed pricing sheet does not stop the property provider from starting; it is
+	// reported as a condition instead, and the pricing sheet is loaded again upon the next collection.
+	if _, err := p.refreshSheet(); err != nil {
+		klog.ErrorS(err, "Failed to load the pricing sheet", "sheetPath", p.sheetPath)
+	}
+
+	if p.nodeTracker != nil {
+		// A node tracker has been explicitly set; use it.
+		klog.V(2).Info("A node tracker has been explicitly set")
+	} else {
+		p.nodeTracker = trackers.NewNodeTracker(nil)
+	}
+
+	// Set up the node reconciler.
+	klog.V(2).Info("Setting up the node reconciler")
+	nodeReconciler := &controllers.NodeReconciler{
+		NodeTracker: p.nodeTracker,
+		Client:      mgr.GetClient(),
+	}
+	if err := nodeReconciler.SetupWithManager(mgr, p.nodeControllerName); err != nil {
+		klog.ErrorS(err, "Failed to start the node reconciler in the pricing sheet property provider")
+		return err
+	}
+
+	// Start the controller manager.
+	//
+	// Note that the controller manager will run in a separate goroutine to avoid blocking
+	// the member agent.
+	go func() {
+		// This call will block until the context exits.
+		if err := mgr.Start(ctx); err != nil {
+			klog.ErrorS(err, "Failed to start the pricing sheet property provider controller manager")
+		}
+	}()
+
+	// Wait for the cache to sync; some exported properties might be skewed initially if
+	// the node changes have not been processed yet.
+	mgr.GetCache().WaitForCacheSync(ctx)
+
+	return nil
+}
+
+// Collect collects the properties of a Kubernetes cluster from its nodes and the pricing sheet.
+func (p *PropertyProvider) Collect(_ context.Context) propertyprovider.PropertyCollectionResponse {
+	properties := make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue)
+
+	// Collect the total node count as a property.
+	properties[propertyprovider.NodeCountProperty] = clusterv1beta1.PropertyValue{
+		Value:           fmt.Sprintf("%d", p.nodeTracker.NodeCount()),
+		ObservationTime: metav1.Now(),
+	}
+
+	// Collect the cost properties.
+	conds := p.collectCosts(properties)
+
+	// Collect the total and allocatable resource properties.
+	resources := clusterv1beta1.ResourceUsage{
+		Capacity:    p.nodeTracker.TotalCapacity(),
+		Allocatable: p.nodeTracker.TotalAllocatable(),
+	}
+
+	return propertyprovider.PropertyCollectionResponse{
+		Properties: properties,
+		Resources:  resources,
+		Conditions: conds,
+	}
+}
+
+// collectCosts collects the cost information.
+func (p *PropertyProvider) collectCosts(properties map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue) []metav1.Condition {
+	conds := make([]metav1.Condition, 0, 1)
+
+	var perCPUCost, perGBMemoryCost float64
+	var warnings []string
+	sheet, err := p.refreshSheet()
+	switch {
+	case err != nil && sheet == nil:
+		// No pricing sheet has ever been loaded successfully; costs cannot be calculated.
+	case err != nil:
+		// The pricing sheet has changed but cannot be reloaded; keep using the one last loaded
+		// successfully, and report the error as a warning.
+		warnings = append(warnings, fmt.Sprintf("failed to reload the pricing sheet (%v); the one last modified at %v is in use", err, sheet.modTime))
+		fallthrough
+	default:
+		var costWarnings []string
+		perCPUCost, perGBMemoryCost, costWarnings, err = calculateCosts(sheet, p.nodeTracker.NodeCountPerSKU(), p.nodeTracker.TotalCapacityPerSKU())
+		warnings = append(warnings, costWarnings...)
+	}
+
+	switch {
+	case err != nil:
+		// An error occurred when calculating costs; do no set the cost properties and
+		// track the error.
+		conds = append(conds, metav1.Condition{
+			Type:    CostPropertiesCollectionSucceededCondType,
+			Status:  metav1.ConditionFalse,
+			Reason:  CostPropertiesCollectionFailedReason,
+			Message: fmt.Sprintf(CostPropertiesCollectionFailedMsgTemplate, err),
+		})
+		return conds
+	case len(warnings) > 0:
+		// The costs are calculated, but some warnings have been issued; report the warnings
+		// as a condition.
+		conds = append(conds, metav1.Condition{
+			Type:    CostPropertiesCollectionSucceededCondType,
+			Status:  metav1.ConditionTrue,
+			Reason:  CostPropertiesCollectionDegradedReason,
+			Message: fmt.Sprintf(CostPropertiesCollectionDegradedMsgTemplate, warnings),
+		})
+	default:
+		// The costs are calculated successfully; report a success as a condition.
+		conds = append(conds, metav1.Condition{
+			Type:    CostPropertiesCollectionSucceededCondType,
+			Status:  metav1.ConditionTrue,
+			Reason:  CostPropertiesCollectionSucceededReason,
+			Message: CostPropertiesCollectionSucceededMsg,
+		})
+	}
+
+	properties[PerCPUCoreCostProperty] = clusterv1beta1.PropertyValue{
+		Value:           fmt.Sprintf(CostPrecisionTemplate, perCPUCost),
+		ObservationTime: metav1.Now(),
+	}
+	properties[PerGBMemoryCostProperty] = clusterv1beta1.PropertyValue{
+		Value:           fmt.Sprintf(CostPrecisionTemplate, perGBMemoryCost),
+		ObservationTime: metav1.Now(),
+	}
+	return conds
+}
+
+// refreshSheet reloads the pricing sheet if the file has changed since it was last loaded, and
+// returns the pricing sheet in use.
+//
+// If the pricing sheet cannot be reloaded, the one last loaded successfully (if any) is returned
+// along with the error.
+func (p *PropertyProvider) refreshSheet() (*Sheet, error) {
+	p.sheetMutex.Lock()
+	defer p.sheetMutex.Unlock()
+
+	// Note that ConfigMaps mounted as volumes are updated by swapping symlinks; os.Stat follows
+	// the symlinks and reports the modification time of the file currently in use.
+	if info, err := os.Stat(p.sheetPath); err == nil && p.sheet != nil && info.ModTime().Equal(p.sheet.modTime) {
+		return p.sheet, nil
+	}
+
+	sheet, err := LoadSheet(p.sheetPath)
+	if err != nil {
+		return p.sheet, err
+	}
+	klog.V(2).InfoS("Loaded the pricing sheet", "sheetPath", p.sheetPath, "instanceTypes", len(sheet.prices), "modTime", sheet.modTime)
+	p.sheet = sheet
+	return sheet, nil
+}
+
+// calculateCosts calculates the per CPU core and per GB memory cost in the cluster.
+//
+// Similar to the Azure property provider, the costs are average costs, i.e., the total hourly
+// costs of all nodes divided by the total CPU and memory capacity, respectively. Nodes whose
+// instance types are absent from the pricing sheet are excluded from the calculation, which
+// is reported as a warning; if none of the nodes can be priced, an error is returned.
+func calculateCosts(
+	sheet *Sheet,
+	nodeCountPerSKU map[string]int,
+	capacityPerSKU map[string]corev1.ResourceList,
+) (perCPUCoreCost, perGBMemoryCost float64, warnings []string, err error) {
+	if len(nodeCountPerSKU) == 0 {
+		// No nodes are present in the cluster. This is not considered as an error.
+		return 0.0, 0.0, nil, nil
+	}
+
+	totalHourlyRate := 0.0
+	pricedCPU := 0.0
+	pricedMemory := 0.0
+	missingSKUs := make([]string, 0)
+	for sku, count := range nodeCountPerSKU {
+		hourlyRate, found := sheet.OnDemandPrice(sku)
+		if !found {
+			missingSKUs = append(missingSKUs, sku)
+			continue
+		}
+		totalHourlyRate += hourlyRate * float64(count)
+		capacity := capacityPerSKU[sku]
+		cpu := capacity[corev1.ResourceCPU]
+		memory := capacity[corev1.ResourceMemory]
+		pricedCPU += cpu.AsApproximateFloat64()
+		pricedMemory += memory.AsApproximateFloat64()
+	}
+	// Sort the missing SKUs for stability reasons.
+	slices.Sort(missingSKUs)
+
+	if len(missingSKUs) == len(nodeCountPerSKU) {
+		return 0.0, 0.0, nil, fmt.Errorf("nodes are present, but no pricing data is available for any node SKUs (%v)", missingSKUs)
+	}
+	if len(missingSKUs) > 0 {
+		warnings = append(warnings, fmt.Sprintf("no pricing data is available for one or more of the node SKUs (%v) in the cluster; nodes of these SKUs are excluded from cost calculation", missingSKUs))
+	}
+
+	// Note that the minimum CPU resource quantity Kubernetes allows is one millicore, and the
+	// minimum memory resource quantity is one byte.
+	if math.IsInf(pricedCPU, 0) || pricedCPU <= 0.001 {
+		return 0.0, 0.0, nil, fmt.Errorf("failed to calculate costs: cpu quantity is of an invalid value: %v", pricedCPU)
+	}
+	if math.IsInf(pricedMemory, 0) || pricedMemory <= 1 {
+		return 0.0, 0.0, nil, fmt.Errorf("failed to calculate costs: memory quantity is of an invalid value: %v", pricedMemory)
+	}
+	perCPUCoreCost = totalHourlyRate / pricedCPU
+	perGBMemoryCost = totalHourlyRate / (pricedMemory / (1024.0 * 1024.0 * 1024.0))
+	return perCPUCoreCost, perGBMemoryCost, warnings, nil
+}
+
+// New returns a new pricing sheet property provider that reads the pricing sheet from the
+// given file.
+func New(sheetPath string) propertyprovider.PropertyProvider {
+	return &PropertyProvider{
+		sheetPath: sheetPath,
+		// Use the default name.
+		nodeControllerName: "pricingsheet-property-provider-node-watcher",
+	}
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pricingsheet
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/default/trackers"
+)
+
+const (
+	validSheet = `
+m5.large: 0.096
+m5.xlarge: 0.192
+`
+)
+
+var (
+	ignoreObservationTimeFieldInPropertyValue = cmpopts.IgnoreFields(clusterv1beta1.PropertyValue{}, "ObservationTime")
+)
+
+func buildNode(name, sku, cpu, memory string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				corev1.LabelInstanceTypeStable: sku,
+			},
+		},
+		Status: corev1.NodeStatus{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			},
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			},
+		},
+	}
+}
+
+func writeSheet(t *testing.T, path, data string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatalf("failed to write the pricing sheet: %v", err)
+	}
+}
+
+func TestParseSheet(t *testing.T) {
+	testCases := []struct {
+		name       string
+		data       string
+		wantPrices map[string]float64
+		wantErr    bool
+	}{
+		{
+			name: "valid sheet",
+			data: validSheet,
+			wantPrices: map[string]float64{
+				"m5.large":  0.096,
+				"m5.xlarge": 0.192,
+			},
+		},
+		{
+			name: "JSON sheet",
+			data: `{"Standard_D4s_v3": 0.192, "Standard_B1s": 0}`,
+			wantPrices: map[string]float64{
+				"Standard_D4s_v3": 0.192,
+				"Standard_B1s":    0,
+			},
+		},
+		{
+			name:    "negative price",
+			data:    "m5.large: -1",
+			wantErr: true,
+		},
+		{
+			name:    "non-numeric price",
+			data:    "m5.large: cheap",
+			wantErr: true,
+		},
+		{
+			name:    "duplicate instance types",
+			data:    "m5.large: 0.096\nm5.large: 0.1",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseSheet([]byte(tc.data))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("ParseSheet() = %v, want error", got.prices)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseSheet() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(got.prices, tc.wantPrices); diff != "" {
+				t.Errorf("ParseSheet() prices diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCalculateCosts(t *testing.T) {
+	sheet, err := ParseSheet([]byte(validSheet))
+	if err != nil {
+		t.Fatalf("ParseSheet() = %v, want no error", err)
+	}
+
+	testCases := []struct {
+		name                string
+		nodes               []*corev1.Node
+		wantPerCPUCoreCost  float64
+		wantPerGBMemoryCost float64
+		wantWarnings        int
+		wantErr             bool
+	}{
+		{
+			name: "no nodes",
+		},
+		{
+			name: "all nodes priced",
+			nodes: []*corev1.Node{
+				buildNode("node-1", "m5.large", "2", "8Gi"),
+				buildNode("node-2", "m5.large", "2", "8Gi"),
+				buildNode("node-3", "m5.xlarge", "4", "16Gi"),
+			},
+			// (0.096 * 2 + 0.192) / 8 cores
+			wantPerCPUCoreCost: 0.048,
+			// (0.096 * 2 + 0.192) / 32 GB
+			wantPerGBMemoryCost: 0.012,
+		},
+		{
+			name: "some nodes unpriced",
+			nodes: []*corev1.Node{
+				buildNode("node-1", "m5.large", "2", "8Gi"),
+				buildNode("node-2", "c5.large", "2", "4Gi"),
+			},
+			wantPerCPUCoreCost:  0.048,
+			wantPerGBMemoryCost: 0.012,
+			wantWarnings:        1,
+		},
+		{
+			name: "no nodes priced",
+			nodes: []*corev1.Node{
+				buildNode("node-1", "c5.large", "2", "4Gi"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "priced nodes with no capacity",
+			nodes: []*corev1.Node{
+				buildNode("node-1", "m5.large", "0", "0"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nt := trackers.NewNodeTracker(nil)
+			for _, node := range tc.nodes {
+				nt.AddOrUpdate(node)
+			}
+
+			perCPUCoreCost, perGBMemoryCost, warnings, err := calculateCosts(sheet, nt.NodeCountPerSKU(), nt.TotalCapacityPerSKU())
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("calculateCosts() = %v, %v, want error", perCPUCoreCost, perGBMemoryCost)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("calculateCosts() = %v, want no error", err)
+			}
+			if !cmp.Equal(perCPUCoreCost, tc.wantPerCPUCoreCost, cmpopts.EquateApprox(0, 1e-9)) {
+				t.Errorf("calculateCosts() perCPUCoreCost = %v, want %v", perCPUCoreCost, tc.wantPerCPUCoreCost)
+			}
+			if !cmp.Equal(perGBMemoryCost, tc.wantPerGBMemoryCost, cmpopts.EquateApprox(0, 1e-9)) {
+				t.Errorf("calculateCosts() perGBMemoryCost = %v, want %v", perGBMemoryCost, tc.wantPerGBMemoryCost)
+			}
+			if len(warnings) != tc.wantWarnings {
+				t.Errorf("calculateCosts() warnings = %v, want %d warning(s)", warnings, tc.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestCollect(t *testing.T) {
+	nodes := []*corev1.Node{
+		buildNode("node-1", "m5.large", "2", "8Gi"),
+		buildNode("node-2", "m5.xlarge", "4", "16Gi"),
+	}
+
+	testCases := []struct {
+		name           string
+		sheet          string
+		skipSheet      bool
+		wantProperties map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue
+		wantCondReason string
+	}{
+		{
+			name:  "valid sheet",
+			sheet: validSheet,
+			wantProperties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				propertyprovider.NodeCountProperty: {Value: "2"},
+				PerCPUCoreCostProperty:             {Value: "0.048"},
+				PerGBMemoryCostProperty:            {Value: "0.012"},
+			},
+			wantCondReason: CostPropertiesCollectionSucceededReason,
+		},
+		{
+			name:  "sheet with unknown SKUs",
+			sheet: "m5.large: 0.096",
+			wantProperties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				propertyprovider.NodeCountProperty: {Value: "2"},
+				PerCPUCoreCostProperty:             {Value: "0.048"},
+				PerGBMemoryCostProperty:            {Value: "0.012"},
+			},
+			wantCondReason: CostPropertiesCollectionDegradedReason,
+		},
+		{
+			name:      "no sheet",
+			skipSheet: true,
+			wantProperties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				propertyprovider.NodeCountProperty: {Value: "2"},
+			},
+			wantCondReason: CostPropertiesCollectionFailedReason,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "pricing-sheet.yaml")
+			if !tc.skipSheet {
+				writeSheet(t, path, tc.sheet)
+			}
+			nt := trackers.NewNodeTracker(nil)
+			for _, node := range nodes {
+				nt.AddOrUpdate(node)
+			}
+			p := &PropertyProvider{
+				nodeTracker: nt,
+				sheetPath:   path,
+			}
+
+			res := p.Collect(context.Background())
+			if diff := cmp.Diff(res.Properties, tc.wantProperties, ignoreObservationTimeFieldInPropertyValue); diff != "" {
+				t.Errorf("Collect() properties diff (-got, +want):\n%s", diff)
+			}
+			if len(res.Conditions) != 1 || res.Conditions[0].Reason != tc.wantCondReason {
+				t.Errorf("Collect() conditions = %v, want one condition with reason %s", res.Conditions, tc.wantCondReason)
+			}
+		})
+	}
+}
+
+func TestRefreshSheet(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pricing-sheet.yaml")
+	writeSheet(t, path, validSheet)
+	p := &PropertyProvider{sheetPath: path}
+
+	sheet, err := p.refreshSheet()
+	if err != nil {
+		t.Fatalf("refreshSheet() = %v, want no error", err)
+	}
+	if price, _ := sheet.OnDemandPrice("m5.large"); price != 0.096 {
+		t.Fatalf("OnDemandPrice(m5.large) = %v, want 0.096", price)
+	}
+
+	// Update the pricing sheet; the new prices should be picked up.
+	writeSheet(t, path, "m5.large: 0.1")
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatalf("failed to update the modification time of the pricing sheet: %v", err)
+	}
+	sheet, err = p.refreshSheet()
+	if err != nil {
+		t.Fatalf("refreshSheet() = %v, want no error", err)
+	}
+	if price, _ := sheet.OnDemandPrice("m5.large"); price != 0.1 {
+		t.Fatalf("OnDemandPrice(m5.large) = %v, want 0.1", price)
+	}
+
+	// Break the pricing sheet; the last loaded one should be kept in use.
+	writeSheet(t, path, "m5.large: cheap")
+	later = later.Add(time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatalf("failed to update the modification time of the pricing sheet: %v", err)
+	}
+	sheet, err = p.refreshSheet()
+	if err == nil {
+		t.Fatalf("refreshSheet() = nil, want error")
+	}
+	if price, _ := sheet.OnDemandPrice("m5.large"); price != 0.1 {
+		t.Fatalf("OnDemandPrice(m5.large) = %v, want 0.1", price)
+	}
+}
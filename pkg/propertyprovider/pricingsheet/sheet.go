@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pricingsheet
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Sheet is a pricing sheet, which maps instance types to their hourly on-demand prices.
+type Sheet struct {
+	// prices are the hourly prices keyed by instance types.
+	prices map[string]float64
+	// modTime is the modification time of the file from which the pricing sheet is loaded.
+	modTime time.Time
+}
+
+// OnDemandPrice returns the hourly on-demand price of an instance type.
+func (s *Sheet) OnDemandPrice(instanceType string) (float64, bool) {
+	price, ok := s.prices[instanceType]
+	return price, ok
+}
+
+// ParseSheet parses a pricing sheet in the form of a YAML (or JSON) mapping from instance types
+// to their hourly prices, e.g.,
+//
+//	m5.large: 0.096
+//	Standard_D4s_v3: 0.192
+func ParseSheet(data []byte) (*Sheet, error) {
+	prices := map[string]float64{}
+	if err := yaml.UnmarshalStrict(data, &prices); err != nil {
+		return nil, fmt.Errorf("failed to parse the pricing sheet: %w", err)
+	}
+	for instanceType, price := range prices {
+		if len(instanceType) == 0 {
+			return nil, fmt.Errorf("the pricing sheet has an entry with an empty instance type")
+		}
+		if math.IsNaN(price) || math.IsInf(price, 0) || price < 0 {
+			return nil, fmt.Errorf("the price of instance type %s is set to an invalid value (%v), must be a non-negative number", instanceType, price)
+		}
+	}
+	return &Sheet{prices: prices}, nil
+}
+
+// LoadSheet loads a pricing sheet from a file.
+func LoadSheet(path string) (*Sheet, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat the pricing sheet file: %w", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the pricing sheet file: %w", err)
+	}
+	sheet, err := ParseSheet(data)
+	if err != nil {
+		return nil, err
+	}
+	sheet.modTime = info.ModTime()
+	return sheet, nil
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package static features the static property provider for Fleet, which reports the properties
+// that a cluster admin declares in a ConfigMap on the member cluster.
+package static
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/validator"
+)
+
+const (
+	// PropertyNamePrefixAnnotation is the annotation on the ConfigMap that sets the prefix of the names of the
+	// declared properties, e.g., `example.com`; ConfigMap keys cannot contain slashes, so the prefix cannot be
+	// part of the keys.
+	PropertyNamePrefixAnnotation = "kubernetes-fleet.io/property-name-prefix"
+)
+
+const (
+	// The condition related values in use by the static property provider.
+	PropertiesDeclarationValidCondType     = "StaticPropertiesDeclarationValid"
+	PropertiesDeclarationValidReason       = "PropertiesDeclared"
+	PropertiesDeclarationNotFoundReason    = "NoPropertiesDeclared"
+	PropertiesDeclarationInvalidReason     = "InvalidPropertiesDeclared"
+	PropertiesDeclarationReadFailedReason  = "PropertiesDeclarationReadFailed"
+	PropertiesDeclarationValidMsgTmpl      = "All %d properties declared in ConfigMap %s are valid"
+	PropertiesDeclarationNotFoundMsgTmpl   = "ConfigMap %s is not found; no properties are declared"
+	PropertiesDeclarationInvalidMsgTmpl    = "Some properties declared in ConfigMap %s are invalid and not reported: %s"
+	PropertiesDeclarationReadFailedMsgTmpl = "Failed to read ConfigMap %s: %v"
+)
+
+// PropertyProvider is the static property provider for Fleet.
+//
+// Each entry in the data of the ConfigMap declares a property: the key is the property name,
+// and the value is either a Kubernetes quantity (e.g., 3, 10Gi, or 2.5), or a boolean (true or false),
+// which is reported as 1 or 0, so that all the declared properties can be compared numerically in
+// property selectors.
+//
+// ConfigMap keys cannot contain slashes; to declare properties with a prefix (e.g., `example.com/zone-count`),
+// set the prefix (e.g., `example.com`) in the `kubernetes-fleet.io/property-name-prefix` annotation of the
+// ConfigMap, which is then prepended to the names of all the declared properties (e.g., with the key `zone-count`).
+type PropertyProvider struct {
+	// configMap is the namespace and name of the ConfigMap where the properties are declared.
+	configMap types.NamespacedName
+
+	// client reads the ConfigMap from the cache.
+	client client.Reader
+}
+
+// Verify that the static property provider implements the PropertyProvider interface at compile time.
+var _ propertyprovider.PropertyProvider = &PropertyProvider{}
+
+// Start starts the static property provider.
+func (p *PropertyProvider) Start(ctx context.Context, config *rest.Config) error {
+	klog.V(2).InfoS("Starting static property provider", "configMap", p.configMap)
+
+	mgr, err := ctrl.NewManager(config, ctrl.Options{
+		Scheme: scheme.Scheme,
+		Cache: cache.Options{
+			ByObject: map[client.Object]cache.ByObject{
+				// Watch only the ConfigMap where the properties are declared.
+				&corev1.ConfigMap{}: {
+					Namespaces: map[string]cache.Config{
+						p.configMap.Namespace: {},
+					},
+					Field: fields.OneTermEqualSelector("metadata.name", p.configMap.Name),
+				},
+			},
+		},
+		// Disable metric serving for the static property provider controller manager.
+		//
+		// Note that this will not stop the metrics from being collected and exported; as they
+		// are registered via a top-level variable as a part of the controller runtime package,
+		// which is also used by the Fleet member agent.
+		Metrics: metricsserver.Options{
+			BindAddress: "0",
+		},
+		// Disable health probe serving for the static property provider controller manager.
+		HealthProbeBindAddress: "0",
+		// Disable leader election for the static property provider; it reads data in a passive
+		// manner with no need for any centralized state.
+		LeaderElection: false,
+	})
+	if err != nil {
+		klog.ErrorS(err, "Failed to start static property provider")
+		return err
+	}
+
+	// Set up the informer for ConfigMaps before the cache starts.
+	if _, err := mgr.GetCache().GetInformer(ctx, &corev1.ConfigMap{}); err != nil {
+		klog.ErrorS(err, "Failed to set up the ConfigMap informer in the static property provider")
+		return err
+	}
+	p.client = mgr.GetClient()
+
+	// Start the controller manager.
+	//
+	// Note that the controller manager will run in a separate goroutine to avoid blocking
+	// the member agent.
+	go func() {
+		// This call will block until the context exits.
+		if err := mgr.Start(ctx); err != nil {
+			klog.ErrorS(err, "Failed to start the static property provider controller manager")
+		}
+	}()
+
+	// Wait for the cache to sync.
+	mgr.GetCache().WaitForCacheSync(ctx)
+	return nil
+}
+
+// Collect collects the properties declared in the ConfigMap.
+func (p *PropertyProvider) Collect(ctx context.Context) propertyprovider.PropertyCollectionResponse {
+	cm := &corev1.ConfigMap{}
+	if err := p.client.Get(ctx, p.configMap, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return propertyprovider.PropertyCollectionResponse{
+				Conditions: []metav1.Condition{
+					{
+						Type:    PropertiesDeclarationValidCondType,
+						Status:  metav1.ConditionTrue,
+						Reason:  PropertiesDeclarationNotFoundReason,
+						Message: fmt.Sprintf(PropertiesDeclarationNotFoundMsgTmpl, p.configMap),
+					},
+				},
+			}
+		}
+		klog.ErrorS(err, "Failed to get the ConfigMap where the properties are declared", "configMap", p.configMap)
+		return propertyprovider.PropertyCollectionResponse{
+			Conditions: []metav1.Condition{
+				{
+					Type:    PropertiesDeclarationValidCondType,
+					Status:  metav1.ConditionUnknown,
+					Reason:  PropertiesDeclarationReadFailedReason,
+					Message: fmt.Sprintf(PropertiesDeclarationReadFailedMsgTmpl, p.configMap, err),
+				},
+			},
+		}
+	}
+
+	now := metav1.Now()
+	properties := make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue, len(cm.Data))
+	invalid := []string{}
+	prefix := cm.Annotations[PropertyNamePrefixAnnotation]
+	for key, value := range cm.Data {
+		name := key
+		if prefix != "" {
+			name = prefix + "/" + key
+		}
+		v, err := parseProperty(name, value)
+		if err != nil {
+			invalid = append(invalid, err.Error())
+			continue
+		}
+		properties[clusterv1beta1.PropertyName(name)] = clusterv1beta1.PropertyValue{
+			Value:           v,
+			ObservationTime: now,
+		}
+	}
+
+	cond := metav1.Condition{
+		Type:    PropertiesDeclarationValidCondType,
+		Status:  metav1.ConditionTrue,
+		Reason:  PropertiesDeclarationValidReason,
+		Message: fmt.Sprintf(PropertiesDeclarationValidMsgTmpl, len(properties), p.configMap),
+	}
+	if len(invalid) > 0 {
+		slices.Sort(invalid)
+		klog.V(2).InfoS("Found invalid properties declared in the ConfigMap", "configMap", p.configMap, "errors", invalid)
+		cond = metav1.Condition{
+			Type:    PropertiesDeclarationValidCondType,
+			Status:  metav1.ConditionFalse,
+			Reason:  PropertiesDeclarationInvalidReason,
+			Message: fmt.Sprintf(PropertiesDeclarationInvalidMsgTmpl, p.configMap, strings.Join(invalid, "; ")),
+		}
+	}
+	return propertyprovider.PropertyCollectionResponse{
+		Properties: properties,
+		Conditions: []metav1.Condition{cond},
+	}
+}
+
+// parseProperty validates a declared property and returns the value to report.
+func parseProperty(name, value string) (string, error) {
+	if strings.HasPrefix(name, propertyprovider.ResourcePropertyNamePrefix) {
+		return "", fmt.Errorf("property %s: resource properties cannot be declared", name)
+	}
+	if err := validator.ValidatePropertyName(name); err != nil {
+		return "", fmt.Errorf("property %s: %w", name, err)
+	}
+
+	value = strings.TrimSpace(value)
+	switch strings.ToLower(value) {
+	case "true":
+		return "1", nil
+	case "false":
+		return "0", nil
+	}
+	q, err := resource.ParseQuantity(value)
+	if err != nil {
+		return "", fmt.Errorf("property %s: value %q is neither a quantity nor a boolean", name, value)
+	}
+	return q.String(), nil
+}
+
+// New returns a new static property provider that reports the properties declared in the
+// given ConfigMap.
+func New(configMap types.NamespacedName) propertyprovider.PropertyProvider {
+	return &PropertyProvider{
+		configMap: configMap,
+	}
+}
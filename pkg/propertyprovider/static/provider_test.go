@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+)
+
+func TestCollect(t *testing.T) {
+	configMap := types.NamespacedName{Namespace: "fleet-system", Name: "fleet-cluster-properties"}
+
+	testCases := []struct {
+		name    string
+		objects []client.Object
+		want    propertyprovider.PropertyCollectionResponse
+	}{
+		{
+			name: "no ConfigMap",
+			want: propertyprovider.PropertyCollectionResponse{
+				Conditions: []metav1.Condition{
+					{
+						Type:    PropertiesDeclarationValidCondType,
+						Status:  metav1.ConditionTrue,
+						Reason:  PropertiesDeclarationNotFoundReason,
+						Message: "ConfigMap fleet-system/fleet-cluster-properties is not found; no properties are declared",
+					},
+				},
+			},
+		},
+		{
+			name: "valid properties",
+			objects: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   configMap.Namespace,
+						Name:        configMap.Name,
+						Annotations: map[string]string{PropertyNamePrefixAnnotation: "example.com"},
+					},
+					Data: map[string]string{
+						"datacenter-tier":    "3",
+						"network-bandwidth":  " 100G ",
+						"infiniband-enabled": "True",
+						"compliance-zone":    "false",
+					},
+				},
+			},
+			want: propertyprovider.PropertyCollectionResponse{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					"example.com/datacenter-tier":    {Value: "3"},
+					"example.com/network-bandwidth":  {Value: "100G"},
+					"example.com/infiniband-enabled": {Value: "1"},
+					"example.com/compliance-zone":    {Value: "0"},
+				},
+				Conditions: []metav1.Condition{
+					{
+						Type:    PropertiesDeclarationValidCondType,
+						Status:  metav1.ConditionTrue,
+						Reason:  PropertiesDeclarationValidReason,
+						Message: "All 4 properties declared in ConfigMap fleet-system/fleet-cluster-properties are valid",
+					},
+				},
+			},
+		},
+		{
+			name: "unprefixed properties",
+			objects: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: configMap.Namespace, Name: configMap.Name},
+					Data: map[string]string{
+						"datacenter-tier": "3",
+					},
+				},
+			},
+			want: propertyprovider.PropertyCollectionResponse{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					"datacenter-tier": {Value: "3"},
+				},
+				Conditions: []metav1.Condition{
+					{
+						Type:    PropertiesDeclarationValidCondType,
+						Status:  metav1.ConditionTrue,
+						Reason:  PropertiesDeclarationValidReason,
+						Message: "All 1 properties declared in ConfigMap fleet-system/fleet-cluster-properties are valid",
+					},
+				},
+			},
+		},
+		{
+			name: "invalid properties",
+			objects: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   configMap.Namespace,
+						Name:        configMap.Name,
+						Annotations: map[string]string{PropertyNamePrefixAnnotation: "example.com"},
+					},
+					Data: map[string]string{
+						"datacenter-tier": "3",
+						"region":          "eastus",
+						"-invalid-":       "1",
+					},
+				},
+			},
+			want: propertyprovider.PropertyCollectionResponse{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					"example.com/datacenter-tier": {Value: "3"},
+				},
+				Conditions: []metav1.Condition{
+					{
+						Type:   PropertiesDeclarationValidCondType,
+						Status: metav1.ConditionFalse,
+						Reason: PropertiesDeclarationInvalidReason,
+					},
+				},
+			},
+		},
+		{
+			name: "resource properties cannot be declared with a prefix",
+			objects: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   configMap.Namespace,
+						Name:        configMap.Name,
+						Annotations: map[string]string{PropertyNamePrefixAnnotation: "resources.kubernetes-fleet.io"},
+					},
+					Data: map[string]string{
+						"total-cpu": "10",
+					},
+				},
+			},
+			want: propertyprovider.PropertyCollectionResponse{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{},
+				Conditions: []metav1.Condition{
+					{
+						Type:   PropertiesDeclarationValidCondType,
+						Status: metav1.ConditionFalse,
+						Reason: PropertiesDeclarationInvalidReason,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &PropertyProvider{
+				configMap: configMap,
+				client:    fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(tc.objects...).Build(),
+			}
+			got := p.Collect(context.Background())
+			opts := []cmp.Option{
+				cmpopts.IgnoreFields(clusterv1beta1.PropertyValue{}, "ObservationTime"),
+			}
+			if tc.want.Conditions[0].Status == metav1.ConditionFalse {
+				opts = append(opts, cmpopts.IgnoreFields(metav1.Condition{}, "Message"))
+			}
+			if diff := cmp.Diff(got, tc.want, opts...); diff != "" {
+				t.Errorf("Collect() diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseProperty(t *testing.T) {
+	testCases := []struct {
+		name    string
+		pName   string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "integer",
+			pName: "example.com/datacenter-tier",
+			value: "3",
+			want:  "3",
+		},
+		{
+			name:  "decimal quantity",
+			pName: "example.com/power-usage-effectiveness",
+			value: "1.25",
+			want:  "1250m",
+		},
+		{
+			name:  "boolean",
+			pName: "example.com/infiniband-enabled",
+			value: "TRUE",
+			want:  "1",
+		},
+		{
+			name:    "string value",
+			pName:   "example.com/region",
+			value:   "eastus",
+			wantErr: true,
+		},
+		{
+			name:    "resource property",
+			pName:   "resources.kubernetes-fleet.io/available-cpu",
+			value:   "10",
+			wantErr: true,
+		},
+		{
+			name:    "invalid name",
+			pName:   "example.com/bad name",
+			value:   "1",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseProperty(tc.pName, tc.value)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("parseProperty() = %s, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseProperty() = %v, want nil", err)
+			}
+			if got != tc.want {
+				t.Errorf("parseProperty() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -104,6 +104,14 @@ func (checker *ClusterEligibilityChecker) IsEligible(cluster *clusterv1beta1.Mem
 		return false, "cluster has left the fleet"
 	}
 
+	// Filter out clusters that have been failed over and have not stayed available long enough since.
+	if failedOverCond := cluster.GetCondition(string(clusterv1beta1.ConditionTypeMemberClusterFailedOver)); failedOverCond != nil {
+		if failedOverCond.Status == metav1.ConditionTrue {
+			return false, "cluster has been failed over: member agent stopped sending heartbeats"
+		}
+		return false, "cluster has been failed over: waiting for the cluster to stay available after it recovered"
+	}
+
 	// Note that the following checks are performed against one specific agent, i.e., the member
 	// agent, which is critical for the work orchestration related tasks in the fleet; non-related
 	// agents (e.g., networking) are not accounted for in this plugin.
@@ -55,6 +55,40 @@ func TestIsClusterEligible(t *testing.T) {
 			},
 			wantReasonPrefix: "cluster has left the fleet",
 		},
+		{
+			name: "cluster failed over",
+			cluster: &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: clusterName,
+				},
+				Status: clusterv1beta1.MemberClusterStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:   string(clusterv1beta1.ConditionTypeMemberClusterFailedOver),
+							Status: metav1.ConditionTrue,
+						},
+					},
+				},
+			},
+			wantReasonPrefix: "cluster has been failed over: member agent stopped sending heartbeats",
+		},
+		{
+			name: "cluster recovering from a failover",
+			cluster: &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: clusterName,
+				},
+				Status: clusterv1beta1.MemberClusterStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:   string(clusterv1beta1.ConditionTypeMemberClusterFailedOver),
+							Status: metav1.ConditionFalse,
+						},
+					},
+				},
+			},
+			wantReasonPrefix: "cluster has been failed over: waiting for the cluster to stay available",
+		},
 		{
 			name: "no member agent status",
 			cluster: &clusterv1beta1.MemberCluster{
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterproximity
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// PreScore allows the plugin to connect to the PreScore extension point in the scheduling
+// framework.
+func (p *Plugin) PreScore(
+	_ context.Context,
+	state framework.CycleStatePluginReadWriter,
+	policy placementv1beta1.PolicySnapshotObj,
+) (status *framework.Status) {
+	proximity := clusterProximityOf(policy)
+	if proximity == nil {
+		// There is no cluster proximity specified in the scheduling policy; skip the step.
+		//
+		// Note that this will also skip the Score() extension point for the plugin.
+		return framework.NewNonErrorStatus(framework.Skip, p.Name(), "no cluster proximity specified")
+	}
+
+	// Prepare the plugin state. Specifically, pre-calculate the min. and max. observed
+	// latency to the reference.
+	ps, err := preparePluginState(state, proximity.Reference)
+	if err != nil {
+		return framework.FromError(err, p.Name(), "failed to prepare plugin state")
+	}
+
+	// Save the plugin state.
+	state.Write(framework.StateKey(p.Name()), ps)
+
+	// All done.
+	return nil
+}
+
+// Score allows the plugin to connect to the Score extension point in the scheduling framework.
+func (p *Plugin) Score(
+	_ context.Context,
+	state framework.CycleStatePluginReadWriter,
+	policy placementv1beta1.PolicySnapshotObj,
+	cluster *clusterv1beta1.MemberCluster,
+) (score *framework.ClusterScore, status *framework.Status) {
+	// Read the plugin state.
+	ps, err := p.readPluginState(state)
+	if err != nil {
+		// This branch should never be reached, as a state has been set
+		// in the PreScore stage.
+		return nil, framework.FromError(err, p.Name(), "failed to read plugin state")
+	}
+
+	proximity := clusterProximityOf(policy)
+	l, found, err := latencyTo(cluster, proximity.Reference)
+	if err != nil {
+		return nil, framework.FromError(fmt.Errorf("failed to calculate score for cluster %s: %w", cluster.Name, err), p.Name())
+	}
+	if !found || ps.minLatency == nil || ps.maxLatency == nil {
+		// The cluster does not report the latency to the reference; it gets no score.
+		return &framework.ClusterScore{}, nil
+	}
+
+	minL, maxL := *ps.minLatency, *ps.maxLatency
+	if l < minL || l > maxL {
+		// Normally this should never occur.
+		return nil, framework.FromError(fmt.Errorf("cannot interpolate weight, observed latency %v, observed min %v, observed max %v", l, minL, maxL), p.Name())
+	}
+	if minL == maxL {
+		// All the clusters that report the latency are equally close to the reference; each of
+		// them is the closest one.
+		return &framework.ClusterScore{AffinityScore: proximity.Weight}, nil
+	}
+
+	// The closer a cluster is to the reference, the higher score it gets.
+	w := (1 - (l-minL)/(maxL-minL)) * float64(proximity.Weight)
+	// Proximity is a preference on clusters similar to the preferred cluster affinity terms;
+	// its score is added to the affinity score.
+	return &framework.ClusterScore{AffinityScore: int32(math.Round(w))}, nil
+}
+
+// clusterProximityOf returns the cluster proximity (if any) specified in the scheduling policy.
+func clusterProximityOf(policy placementv1beta1.PolicySnapshotObj) *placementv1beta1.ClusterProximity {
+	p := policy.GetPolicySnapshotSpec().Policy
+	if p == nil || p.Affinity == nil {
+		return nil
+	}
+	return p.Affinity.ClusterProximity
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterproximity
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+const (
+	clusterName1 = "cluster-1"
+	clusterName2 = "cluster-2"
+	clusterName3 = "cluster-3"
+
+	reference = "eastus"
+)
+
+var (
+	p = New()
+
+	ignoreStatusErrorField = cmpopts.IgnoreFields(framework.Status{}, "err")
+)
+
+// buildCluster builds a member cluster that reports the given latency (if any) to a peer.
+func buildCluster(name, peer, latency string) clusterv1beta1.MemberCluster {
+	c := clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+	if len(latency) > 0 {
+		c.Status.Properties = map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+			clusterv1beta1.PropertyName(fmt.Sprintf(propertyprovider.LatencyPropertyTmpl, peer)): {
+				Value: latency,
+			},
+		}
+	}
+	return c
+}
+
+func buildPolicy(proximity *placementv1beta1.ClusterProximity) *placementv1beta1.ClusterSchedulingPolicySnapshot {
+	return &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: ptr.To(int32(1)),
+				Affinity: &placementv1beta1.Affinity{
+					ClusterProximity: proximity,
+				},
+			},
+		},
+	}
+}
+
+// TestPreScore tests the PreScore extension point of this plugin.
+func TestPreScore(t *testing.T) {
+	testCases := []struct {
+		name       string
+		clusters   []clusterv1beta1.MemberCluster
+		policy     *placementv1beta1.ClusterSchedulingPolicySnapshot
+		wantStatus *framework.Status
+		wantPS     *pluginState
+	}{
+		{
+			name: "no scheduling policy",
+			policy: &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+					Policy: nil,
+				},
+			},
+			wantStatus: framework.NewNonErrorStatus(framework.Skip, p.Name(), "no cluster proximity specified"),
+		},
+		{
+			name:       "no cluster proximity",
+			policy:     buildPolicy(nil),
+			wantStatus: framework.NewNonErrorStatus(framework.Skip, p.Name(), "no cluster proximity specified"),
+		},
+		{
+			name: "latency reported by some clusters",
+			clusters: []clusterv1beta1.MemberCluster{
+				buildCluster(clusterName1, reference, "12.5"),
+				buildCluster(clusterName2, reference, "80"),
+				buildCluster(clusterName3, "westus", "5"),
+			},
+			policy: buildPolicy(&placementv1beta1.ClusterProximity{Reference: reference, Weight: 100}),
+			wantPS: &pluginState{
+				minLatency: ptr.To(12.5),
+				maxLatency: ptr.To(80.0),
+			},
+		},
+		{
+			name: "reference is a member cluster",
+			clusters: []clusterv1beta1.MemberCluster{
+				buildCluster(clusterName1, clusterName2, "12.5"),
+				buildCluster(clusterName2, "", ""),
+			},
+			policy: buildPolicy(&placementv1beta1.ClusterProximity{Reference: clusterName2, Weight: 100}),
+			wantPS: &pluginState{
+				minLatency: ptr.To(0.0),
+				maxLatency: ptr.To(12.5),
+			},
+		},
+		{
+			name: "latency reported by no clusters",
+			clusters: []clusterv1beta1.MemberCluster{
+				buildCluster(clusterName1, "westus", "5"),
+			},
+			policy: buildPolicy(&placementv1beta1.ClusterProximity{Reference: reference, Weight: 100}),
+			wantPS: &pluginState{},
+		},
+		{
+			name: "invalid latency",
+			clusters: []clusterv1beta1.MemberCluster{
+				buildCluster(clusterName1, reference, "fast"),
+			},
+			policy:     buildPolicy(&placementv1beta1.ClusterProximity{Reference: reference, Weight: 100}),
+			wantStatus: framework.FromError(fmt.Errorf("invalid latency"), p.Name(), "failed to prepare plugin state"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			state := framework.NewCycleState(tc.clusters, nil, nil)
+			status := p.PreScore(ctx, state, tc.policy)
+
+			if diff := cmp.Diff(
+				status, tc.wantStatus,
+				cmp.AllowUnexported(framework.Status{}),
+				ignoreStatusErrorField,
+				cmpopts.IgnoreFields(framework.Status{}, "reasons"),
+			); diff != "" {
+				t.Errorf("PreScore() unexpected status (-got, +want):\n%s", diff)
+			}
+
+			if tc.wantPS != nil {
+				ps, err := p.readPluginState(state)
+				if err != nil {
+					t.Fatalf("failed to read plugin state: %v", err)
+				}
+
+				if diff := cmp.Diff(ps, tc.wantPS, cmp.AllowUnexported(pluginState{})); diff != "" {
+					t.Errorf("PreScore() unexpected plugin state (-got, +want):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+// TestPluginScore tests the Score extension point of this plugin.
+func TestPluginScore(t *testing.T) {
+	policy := buildPolicy(&placementv1beta1.ClusterProximity{Reference: reference, Weight: 50})
+
+	testCases := []struct {
+		name      string
+		ps        *pluginState
+		policy    *placementv1beta1.ClusterSchedulingPolicySnapshot
+		cluster   clusterv1beta1.MemberCluster
+		wantScore *framework.ClusterScore
+	}{
+		{
+			name:      "closest cluster",
+			ps:        &pluginState{minLatency: ptr.To(10.0), maxLatency: ptr.To(90.0)},
+			policy:    policy,
+			cluster:   buildCluster(clusterName1, reference, "10"),
+			wantScore: &framework.ClusterScore{AffinityScore: 50},
+		},
+		{
+			name:      "farthest cluster",
+			ps:        &pluginState{minLatency: ptr.To(10.0), maxLatency: ptr.To(90.0)},
+			policy:    policy,
+			cluster:   buildCluster(clusterName1, reference, "90"),
+			wantScore: &framework.ClusterScore{AffinityScore: 0},
+		},
+		{
+			name:      "cluster in between",
+			ps:        &pluginState{minLatency: ptr.To(10.0), maxLatency: ptr.To(90.0)},
+			policy:    policy,
+			cluster:   buildCluster(clusterName1, reference, "30"),
+			wantScore: &framework.ClusterScore{AffinityScore: 38},
+		},
+		{
+			name:      "reference cluster",
+			ps:        &pluginState{minLatency: ptr.To(0.0), maxLatency: ptr.To(90.0)},
+			policy:    buildPolicy(&placementv1beta1.ClusterProximity{Reference: clusterName1, Weight: 50}),
+			cluster:   buildCluster(clusterName1, "", ""),
+			wantScore: &framework.ClusterScore{AffinityScore: 50},
+		},
+		{
+			name:      "all clusters equally close",
+			ps:        &pluginState{minLatency: ptr.To(10.0), maxLatency: ptr.To(10.0)},
+			policy:    policy,
+			cluster:   buildCluster(clusterName1, reference, "10"),
+			wantScore: &framework.ClusterScore{AffinityScore: 50},
+		},
+		{
+			name:      "latency not reported",
+			ps:        &pluginState{minLatency: ptr.To(10.0), maxLatency: ptr.To(90.0)},
+			policy:    policy,
+			cluster:   buildCluster(clusterName1, "westus", "10"),
+			wantScore: &framework.ClusterScore{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			state := framework.NewCycleState(nil, nil, nil)
+			state.Write(framework.StateKey(p.Name()), tc.ps)
+
+			score, status := p.Score(ctx, state, tc.policy, &tc.cluster)
+			if status != nil {
+				t.Fatalf("Score() status = %v, want nil", status)
+			}
+			if diff := cmp.Diff(score, tc.wantScore); diff != "" {
+				t.Fatalf("Score() unexpected score (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
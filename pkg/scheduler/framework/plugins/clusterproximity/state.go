@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterproximity
+
+import (
+	"fmt"
+	"math"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+type pluginState struct {
+	// minLatency and maxLatency are the min. and max. observed latency (in milliseconds) to the
+	// reference across all clusters; they are nil if none of the clusters reports the latency.
+	minLatency *float64
+	maxLatency *float64
+}
+
+// preparePluginState prepares a common state for easier queries of min. and max. observed
+// latency to the reference.
+func preparePluginState(state framework.CycleStatePluginReadWriter, reference string) (*pluginState, error) {
+	ps := &pluginState{}
+
+	cs := state.ListClusters()
+	for idx := range cs {
+		c := &cs[idx]
+		l, found, err := latencyTo(c, reference)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			// The cluster does not report the latency to the reference.
+			continue
+		}
+
+		if ps.minLatency == nil || l < *ps.minLatency {
+			ps.minLatency = &l
+		}
+		if ps.maxLatency == nil || l > *ps.maxLatency {
+			ps.maxLatency = &l
+		}
+	}
+	return ps, nil
+}
+
+// latencyTo returns the latency (in milliseconds) from a cluster to the reference, and whether the
+// latency is known.
+//
+// The reference itself, if it is a member cluster, is considered to have no latency.
+func latencyTo(cluster *clusterv1beta1.MemberCluster, reference string) (float64, bool, error) {
+	if cluster.Name == reference {
+		return 0, true, nil
+	}
+
+	pName := clusterv1beta1.PropertyName(fmt.Sprintf(propertyprovider.LatencyPropertyTmpl, reference))
+	v, found := cluster.Status.Properties[pName]
+	if !found {
+		// The latency is not reported by the cluster.
+		//
+		// Note that this is not considered an error.
+		return 0, false, nil
+	}
+	q, err := resource.ParseQuantity(v.Value)
+	if err != nil {
+		return 0, false, fmt.Errorf("value %s of property %s from cluster %s is not a valid quantity: %w", v.Value, pName, cluster.Name, err)
+	}
+	l := q.AsApproximateFloat64()
+	if math.IsInf(l, 0) || l < 0 {
+		return 0, false, fmt.Errorf("value %s of property %s from cluster %s is not a valid latency", v.Value, pName, cluster.Name)
+	}
+	return l, true, nil
+}
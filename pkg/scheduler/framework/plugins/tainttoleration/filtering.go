@@ -10,6 +10,7 @@ import (
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+	tolerationutils "github.com/kubefleet-dev/kubefleet/pkg/utils/toleration"
 )
 
 var (
@@ -41,24 +42,17 @@ func findUntoleratedTaint(taints []clusterv1beta1.Taint, tolerations []placement
 	return nil, false
 }
 
+// tolerationsTolerateTaint returns whether the taint is tolerated when the scheduler picks clusters.
+//
+// A toleration with toleration seconds only tolerates a NoExecute taint for a limited period of time, after which
+// the placement is evicted from the cluster; such a toleration does not allow the cluster to be picked.
 func tolerationsTolerateTaint(taint clusterv1beta1.Taint, tolerations []placementv1beta1.Toleration) bool {
 	for _, toleration := range tolerations {
-		if canTolerationTolerateTaint(taint, toleration) {
-			return true
-		}
-	}
-	return false
-}
-
-func canTolerationTolerateTaint(taint clusterv1beta1.Taint, toleration placementv1beta1.Toleration) bool {
-	if toleration.Operator == corev1.TolerationOpExists {
-		if toleration.Key == "" || toleration.Key == taint.Key {
-			return toleration.Effect == taint.Effect || toleration.Effect == ""
+		if taint.Effect == corev1.TaintEffectNoExecute && toleration.TolerationSeconds != nil {
+			continue
 		}
-	}
-	if toleration.Operator == corev1.TolerationOpEqual {
-		if toleration.Key == taint.Key && toleration.Value == taint.Value {
-			return toleration.Effect == taint.Effect || toleration.Effect == ""
+		if tolerationutils.ToleratesTaint(toleration, taint) {
+			return true
 		}
 	}
 	return false
@@ -24,6 +24,7 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/clusteraffinity"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/clustereligibility"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/clusterproximity"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/namespaceaffinity"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/sameplacementaffinity"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/tainttoleration"
@@ -50,6 +51,7 @@ func TestNewDefaultProfile(t *testing.T) {
 	// Configure the expected profile with the same plugins
 	testClusterAffinityPlugin := clusteraffinity.New()
 	testClusterEligibilityPlugin := clustereligibility.New()
+	testClusterProximityPlugin := clusterproximity.New()
 	testNamespaceAffinityPlugin := namespaceaffinity.New()
 	testSamePlacementAffinityPlugin := sameplacementaffinity.New()
 	testTopologySpreadConstraintsPlugin := topologyspreadconstraints.New()
@@ -58,14 +60,15 @@ func TestNewDefaultProfile(t *testing.T) {
 	wantProfile.WithPostBatchPlugin(&testTopologySpreadConstraintsPlugin).
 		WithPreFilterPlugin(&testClusterAffinityPlugin).WithPreFilterPlugin(&testNamespaceAffinityPlugin).WithPreFilterPlugin(&testTopologySpreadConstraintsPlugin).
 		WithFilterPlugin(&testClusterAffinityPlugin).WithFilterPlugin(&testClusterEligibilityPlugin).WithFilterPlugin(&testNamespaceAffinityPlugin).WithFilterPlugin(&testTaintTolerationPlugin).WithFilterPlugin(&testSamePlacementAffinityPlugin).WithFilterPlugin(&testTopologySpreadConstraintsPlugin).
-		WithPreScorePlugin(&testClusterAffinityPlugin).WithPreScorePlugin(&testTopologySpreadConstraintsPlugin).
-		WithScorePlugin(&testClusterAffinityPlugin).WithScorePlugin(&testSamePlacementAffinityPlugin).WithScorePlugin(&testTopologySpreadConstraintsPlugin)
+		WithPreScorePlugin(&testClusterAffinityPlugin).WithPreScorePlugin(&testClusterProximityPlugin).WithPreScorePlugin(&testTopologySpreadConstraintsPlugin).
+		WithScorePlugin(&testClusterAffinityPlugin).WithScorePlugin(&testClusterProximityPlugin).WithScorePlugin(&testSamePlacementAffinityPlugin).WithScorePlugin(&testTopologySpreadConstraintsPlugin)
 
 	// Compare the profiles using cmp.Equal with AllowUnexported to access private fields
 	if diff := cmp.Diff(profile, wantProfile,
 		cmp.AllowUnexported(framework.Profile{},
 			clusteraffinity.Plugin{},
 			clustereligibility.Plugin{},
+			clusterproximity.Plugin{},
 			namespaceaffinity.Plugin{},
 			sameplacementaffinity.Plugin{},
 			topologyspreadconstraints.Plugin{},
@@ -308,11 +308,14 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 }
 
 func isTaintsUpdatedOrDeleted(oldTaints []clusterv1beta1.Taint, newTaints []clusterv1beta1.Taint) bool {
+	// The time a taint is added is set by Fleet after the taint is added and does not update the taint.
 	newTaintsMap := make(map[clusterv1beta1.Taint]bool)
 	for _, newTaint := range newTaints {
+		newTaint.TimeAdded = nil
 		newTaintsMap[newTaint] = true
 	}
 	for _, oldTaint := range oldTaints {
+		oldTaint.TimeAdded = nil
 		if !newTaintsMap[oldTaint] {
 			return true
 		}
@@ -384,6 +384,12 @@ var (
 		Resource: "jobs",
 	}
 
+	PodGVR = schema.GroupVersionResource{
+		Group:    corev1.GroupName,
+		Version:  corev1.SchemeGroupVersion.Version,
+		Resource: string(corev1.ResourcePods),
+	}
+
 	ConfigMapGVR = schema.GroupVersionResource{
 		Group:    corev1.GroupName,
 		Version:  corev1.SchemeGroupVersion.Version,
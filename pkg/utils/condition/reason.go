@@ -44,6 +44,14 @@ const (
 	// RolloutNotStartedYetReason is the reason string of placement condition if the rollout has not started yet.
 	RolloutNotStartedYetReason = "RolloutNotStartedYet"
 
+	// RolloutWaitingForMaintenanceWindowReason is the reason string of placement condition if the rollout has not started
+	// yet because the cluster is outside its maintenance windows.
+	RolloutWaitingForMaintenanceWindowReason = "RolloutWaitingForMaintenanceWindow"
+
+	// RolloutWaitingForRolloutStepReason is the reason string of placement condition if the rollout has not started
+	// yet because the current step of the rollout steps is full, or the next step has not started yet.
+	RolloutWaitingForRolloutStepReason = "RolloutWaitingForRolloutStep"
+
 	// RolloutStartedReason is the reason string of placement condition if rollout status is started.
 	RolloutStartedReason = "RolloutStarted"
 
@@ -209,6 +217,18 @@ const (
 	// ClusterUpdatingSucceededReason is the reason string of condition if the cluster updating succeeded.
 	ClusterUpdatingSucceededReason = "ClusterUpdatingSucceeded"
 
+	// ClusterUpdatingWaitingForMaintenanceWindowReason is the reason string of condition if the cluster updating has not
+	// started yet because the cluster or its stage is outside its maintenance windows.
+	ClusterUpdatingWaitingForMaintenanceWindowReason = "ClusterUpdatingWaitingForMaintenanceWindow"
+
+	// ClusterUpdatingWaitingForApprovalReason is the reason string of condition if the cluster updating has not
+	// started yet because the cluster is waiting for its per-cluster approval.
+	ClusterUpdatingWaitingForApprovalReason = "ClusterUpdatingWaitingForApproval"
+
+	// ClusterUpdatingSkippedReason is the reason string of condition if the cluster has been manually skipped
+	// and is not updated.
+	ClusterUpdatingSkippedReason = "ClusterUpdatingSkipped"
+
 	// StageTaskApprovalRequestApprovedReason is the reason string of condition if the approval request for before or after stage task has been approved.
 	StageTaskApprovalRequestApprovedReason = "StageTaskApprovalRequestApproved"
 
@@ -218,6 +238,27 @@ const (
 	// AfterStageTaskWaitTimeElapsedReason is the reason string of condition if the wait time for after stage task has elapsed.
 	AfterStageTaskWaitTimeElapsedReason = "AfterStageTaskWaitTimeElapsed"
 
+	// AfterStageTaskAnalysisSucceededReason is the reason string of condition if the metric analysis for after stage task has succeeded.
+	AfterStageTaskAnalysisSucceededReason = "AfterStageTaskAnalysisSucceeded"
+
+	// AfterStageTaskAnalysisFailedReason is the reason string of condition if the metric analysis for after stage task has failed.
+	AfterStageTaskAnalysisFailedReason = "AfterStageTaskAnalysisFailed"
+
+	// StageTaskWebhookApprovedReason is the reason string of condition if the webhook for before or after stage task has approved the stage.
+	StageTaskWebhookApprovedReason = "StageTaskWebhookApproved"
+
+	// StageTaskWebhookRejectedReason is the reason string of condition if the webhook for before or after stage task has rejected the stage.
+	StageTaskWebhookRejectedReason = "StageTaskWebhookRejected"
+
+	// StageTaskWebhookFailedReason is the reason string of condition if the calls to the webhook for before or after stage task have failed too many times.
+	StageTaskWebhookFailedReason = "StageTaskWebhookFailed"
+
+	// AfterStageTaskVerificationJobSucceededReason is the reason string of condition if the verification Jobs for after stage task have succeeded.
+	AfterStageTaskVerificationJobSucceededReason = "AfterStageTaskVerificationJobSucceeded"
+
+	// AfterStageTaskVerificationJobFailedReason is the reason string of condition if a verification Job for after stage task has failed or timed out.
+	AfterStageTaskVerificationJobFailedReason = "AfterStageTaskVerificationJobFailed"
+
 	// ApprovalRequestApprovalAcceptedReason is the reason string of condition if the approval of the approval request has been accepted.
 	ApprovalRequestApprovalAcceptedReason = "ApprovalRequestApprovalAccepted"
 
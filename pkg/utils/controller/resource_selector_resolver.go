@@ -177,6 +177,9 @@ func generateRawContent(object *unstructured.Unstructured) ([]byte, error) {
 		delete(annots, corev1.LastAppliedConfigAnnotation)
 		// Remove the revision annotation set by deployment
 		delete(annots, deployment.RevisionAnnotation)
+		// Remove the projected status back-reported by Fleet; it changes with the status of the
+		// resource on the member clusters, and would otherwise trigger new resource snapshots
+		delete(annots, placementv1beta1.ProjectedStatusAnnotation)
 		if len(annots) == 0 {
 			object.SetAnnotations(nil)
 		} else {
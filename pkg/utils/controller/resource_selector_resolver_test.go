@@ -39,6 +39,7 @@ import (
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/resource"
 	testinformer "github.com/kubefleet-dev/kubefleet/test/utils/informer"
 )
 
@@ -75,9 +76,10 @@ func TestGenerateResourceContent(t *testing.T) {
 						"label-key": "label-value",
 					},
 					Annotations: map[string]string{
-						corev1.LastAppliedConfigAnnotation: "svc-object-annotation-lac-value",
-						deployment.RevisionAnnotation:      "svc-object-revision-annotation-value",
-						"svc-annotation-key":               "svc-object-annotation-key-value",
+						corev1.LastAppliedConfigAnnotation:     "svc-object-annotation-lac-value",
+						deployment.RevisionAnnotation:          "svc-object-revision-annotation-value",
+						fleetv1beta1.ProjectedStatusAnnotation: `{"cluster-1":{".status.readyReplicas":3}}`,
+						"svc-annotation-key":                   "svc-object-annotation-key-value",
 					},
 					OwnerReferences: []metav1.OwnerReference{
 						{
@@ -263,6 +265,36 @@ func TestGenerateResourceContent(t *testing.T) {
 	}
 }
 
+// TestGenerateResourceContent_ProjectedStatusChange verifies that updating the projected status
+// back-reported to a resource does not change the hash of the resource snapshot.
+func TestGenerateResourceContent_ProjectedStatusChange(t *testing.T) {
+	hashOf := func(projectedStatus string) string {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("apps/v1")
+		obj.SetKind("Deployment")
+		obj.SetName("deploy-name")
+		obj.SetNamespace("deploy-namespace")
+		obj.SetAnnotations(map[string]string{
+			fleetv1beta1.ProjectedStatusAnnotation: projectedStatus,
+		})
+		content, err := generateResourceContent(obj)
+		if err != nil {
+			t.Fatalf("generateResourceContent() = %v, want no error", err)
+		}
+		hash, err := resource.HashOf(&fleetv1beta1.ResourceSnapshotSpec{SelectedResources: []fleetv1beta1.ResourceContent{*content}})
+		if err != nil {
+			t.Fatalf("HashOf() = %v, want no error", err)
+		}
+		return hash
+	}
+
+	before := hashOf(`{"cluster-1":{".status.readyReplicas":1}}`)
+	after := hashOf(`{"cluster-1":{".status.readyReplicas":3},"cluster-2":{".status.readyReplicas":2}}`)
+	if before != after {
+		t.Errorf("resource snapshot hash after a projected status update = %s, want %s", after, before)
+	}
+}
+
 func createResourceContentForTest(t *testing.T, obj interface{}) *fleetv1beta1.ResourceContent {
 	want, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&obj)
 	if err != nil {
@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancewindow
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// maxSearchYears bounds how far the schedule is searched for a matching time.
+	maxSearchYears = 5
+)
+
+var (
+	monthNames = map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}
+	weekdayNames = map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}
+)
+
+// schedule is a parsed standard cron expression with five fields. Each field is kept as a bit set of the
+// values it matches.
+type schedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64
+	// dayOfMonthStar and dayOfWeekStar tell whether the day fields are "*", which decides how they are combined.
+	dayOfMonthStar, dayOfWeekStar bool
+}
+
+// fieldBounds describes the range of values of a cron field and the names it accepts.
+type fieldBounds struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+// parseSchedule parses a standard cron expression with five fields: minute, hour, day of month, month,
+// and day of week.
+func parseSchedule(spec string) (*schedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("schedule %q must have 5 fields, got %d", spec, len(fields))
+	}
+	s := &schedule{}
+	var err error
+	if s.minute, err = parseField(fields[0], fieldBounds{name: "minute", min: 0, max: 59}); err != nil {
+		return nil, err
+	}
+	if s.hour, err = parseField(fields[1], fieldBounds{name: "hour", min: 0, max: 23}); err != nil {
+		return nil, err
+	}
+	if s.dayOfMonth, err = parseField(fields[2], fieldBounds{name: "day of month", min: 1, max: 31}); err != nil {
+		return nil, err
+	}
+	if s.month, err = parseField(fields[3], fieldBounds{name: "month", min: 1, max: 12, names: monthNames}); err != nil {
+		return nil, err
+	}
+	// Day of week accepts 7 as Sunday as well.
+	if s.dayOfWeek, err = parseField(fields[4], fieldBounds{name: "day of week", min: 0, max: 7, names: weekdayNames}); err != nil {
+		return nil, err
+	}
+	if s.dayOfWeek&(1<<7) != 0 {
+		s.dayOfWeek |= 1
+	}
+	s.dayOfMonthStar = fields[2] == "*" || fields[2] == "?"
+	s.dayOfWeekStar = fields[4] == "*" || fields[4] == "?"
+	return s, nil
+}
+
+// parseField parses a comma-separated list of values, ranges, and steps of a cron field into a bit set.
+func parseField(field string, bounds fieldBounds) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepPart); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q in the %s field", stepPart, bounds.name)
+			}
+		}
+		var low, high int
+		switch {
+		case rangePart == "*" || rangePart == "?":
+			low, high = bounds.min, bounds.max
+		case strings.Contains(rangePart, "-"):
+			lowPart, highPart, _ := strings.Cut(rangePart, "-")
+			var err error
+			if low, err = parseValue(lowPart, bounds); err != nil {
+				return 0, err
+			}
+			if high, err = parseValue(highPart, bounds); err != nil {
+				return 0, err
+			}
+			if low > high {
+				return 0, fmt.Errorf("invalid range %q in the %s field", rangePart, bounds.name)
+			}
+		default:
+			var err error
+			if low, err = parseValue(rangePart, bounds); err != nil {
+				return 0, err
+			}
+			high = low
+			if hasStep {
+				// "a/n" is a shorthand of "a-max/n".
+				high = bounds.max
+			}
+		}
+		for v := low; v <= high; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// parseValue parses a single value of a cron field, which is either a number or a name.
+func parseValue(value string, bounds fieldBounds) (int, error) {
+	if v, ok := bounds.names[strings.ToLower(value)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in the %s field", value, bounds.name)
+	}
+	if v < bounds.min || v > bounds.max {
+		return 0, fmt.Errorf("value %d in the %s field is out of range [%d, %d]", v, bounds.name, bounds.min, bounds.max)
+	}
+	return v, nil
+}
+
+// matchesDay tells whether the schedule matches the day of the given time.
+// As in standard cron, when both day fields are restricted, a day matches if either of them does.
+func (s *schedule) matchesDay(t time.Time) bool {
+	dayOfMonth := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+	if s.dayOfMonthStar || s.dayOfWeekStar {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
+
+// next returns the first time strictly after t that matches the schedule, in the location of t.
+// It returns the zero time if there's none within the search bound.
+func (s *schedule) next(t time.Time) time.Time {
+	loc := t.Location()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, loc).Add(time.Minute)
+	yearLimit := t.Year() + maxSearchYears
+	for t.Year() <= yearLimit {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !s.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc).Add(time.Hour)
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// prev returns the last time at or before t that matches the schedule, in the location of t.
+// It returns the zero time if there's none at or after notBefore.
+func (s *schedule) prev(t, notBefore time.Time) time.Time {
+	loc := t.Location()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, loc)
+	for !t.Before(notBefore) {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc).Add(-time.Minute)
+		case !s.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc).Add(-time.Minute)
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc).Add(-time.Minute)
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(-time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
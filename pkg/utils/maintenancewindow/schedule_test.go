@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancewindow
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestParseSchedule tests the parseSchedule function with invalid schedules.
+func TestParseSchedule(t *testing.T) {
+	testCases := []struct {
+		name             string
+		spec             string
+		wantErrMsgSubStr string
+	}{
+		{
+			name:             "too few fields",
+			spec:             "0 22 * *",
+			wantErrMsgSubStr: "must have 5 fields, got 4",
+		},
+		{
+			name:             "out of range",
+			spec:             "60 22 * * *",
+			wantErrMsgSubStr: "value 60 in the minute field is out of range [0, 59]",
+		},
+		{
+			name:             "invalid name",
+			spec:             "0 22 * * mon-fry",
+			wantErrMsgSubStr: `invalid value "fry" in the day of week field`,
+		},
+		{
+			name:             "reversed range",
+			spec:             "0 22 * 12-1 *",
+			wantErrMsgSubStr: `invalid range "12-1" in the month field`,
+		},
+		{
+			name:             "invalid step",
+			spec:             "*/0 * * * *",
+			wantErrMsgSubStr: `invalid step "0" in the minute field`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseSchedule(tc.spec)
+			if err == nil {
+				t.Fatalf("parseSchedule() = nil error, want error")
+			}
+			if !strings.Contains(err.Error(), tc.wantErrMsgSubStr) {
+				t.Errorf("parseSchedule() error = %v, want error containing %s", err, tc.wantErrMsgSubStr)
+			}
+		})
+	}
+}
+
+// TestScheduleNextAndPrev tests the next and prev methods of the schedule.
+func TestScheduleNextAndPrev(t *testing.T) {
+	// 2026-03-04 is a Wednesday.
+	now := time.Date(2026, 3, 4, 10, 30, 0, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		spec     string
+		wantNext time.Time
+		wantPrev time.Time
+	}{
+		{
+			name:     "every minute",
+			spec:     "* * * * *",
+			wantNext: time.Date(2026, 3, 4, 10, 31, 0, 0, time.UTC),
+			wantPrev: now,
+		},
+		{
+			name:     "weekday nights",
+			spec:     "0 22 * * mon-fri",
+			wantNext: time.Date(2026, 3, 4, 22, 0, 0, 0, time.UTC),
+			wantPrev: time.Date(2026, 3, 3, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "weekends",
+			spec:     "0 0 * * 6,7",
+			wantNext: time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC),
+			wantPrev: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "every 15 minutes during business hours",
+			spec:     "*/15 9-17 * * *",
+			wantNext: time.Date(2026, 3, 4, 10, 45, 0, 0, time.UTC),
+			wantPrev: now,
+		},
+		{
+			name:     "first day of the quarter",
+			spec:     "0 6 1 jan,apr,jul,oct *",
+			wantNext: time.Date(2026, 4, 1, 6, 0, 0, 0, time.UTC),
+			wantPrev: time.Date(2026, 1, 1, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "day of month or day of week",
+			spec:     "0 12 15 * fri",
+			wantNext: time.Date(2026, 3, 6, 12, 0, 0, 0, time.UTC),
+			wantPrev: time.Date(2026, 2, 27, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := parseSchedule(tc.spec)
+			if err != nil {
+				t.Fatalf("parseSchedule() error = %v, want no error", err)
+			}
+			if got := s.next(now); !got.Equal(tc.wantNext) {
+				t.Errorf("next() = %v, want %v", got, tc.wantNext)
+			}
+			if got := s.prev(now, now.AddDate(-1, 0, 0)); !got.Equal(tc.wantPrev) {
+				t.Errorf("prev() = %v, want %v", got, tc.wantPrev)
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package maintenancewindow features utilities to evaluate the maintenance windows that restrict when
+// Fleet may start updating the resources placed on member clusters.
+package maintenancewindow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// MaxWindowDuration is the longest duration a recurring time window can stay open.
+	MaxWindowDuration = 7 * 24 * time.Hour
+
+	// maxReopenAttempts bounds how many times the next open time is re-evaluated when windows and blackouts overlap.
+	maxReopenAttempts = 16
+)
+
+// Status describes whether updates can be started at a given time according to a maintenance window policy.
+type Status struct {
+	// Open tells whether updates can be started.
+	Open bool
+	// NextOpenTime is the earliest time at which updates can be started, if Open is false.
+	// It is the zero time if it cannot be determined.
+	NextOpenTime time.Time
+	// InvalidReason is set when the maintenance windows are invalid, in which case updates cannot be started
+	// until they are fixed.
+	InvalidReason string
+}
+
+// Message returns a human-readable description of a closed status.
+func (s Status) Message() string {
+	if s.InvalidReason != "" {
+		return fmt.Sprintf("the maintenance windows of the cluster are invalid: %s", s.InvalidReason)
+	}
+	if s.NextOpenTime.IsZero() {
+		return "the cluster is outside its maintenance windows"
+	}
+	return fmt.Sprintf("the cluster is outside its maintenance windows until %s", s.NextOpenTime.UTC().Format(time.RFC3339))
+}
+
+// parsedWindow is a recurring time window with its schedule parsed.
+type parsedWindow struct {
+	schedule *schedule
+	duration time.Duration
+	location *time.Location
+}
+
+// parseWindow parses and validates a recurring time window.
+func parseWindow(window *placementv1beta1.RecurringTimeWindow) (*parsedWindow, error) {
+	s, err := parseSchedule(window.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	if window.Duration.Duration <= 0 || window.Duration.Duration > MaxWindowDuration {
+		return nil, fmt.Errorf("duration %s of schedule %q must be positive and at most %s", window.Duration.Duration, window.Schedule, MaxWindowDuration)
+	}
+	location := time.UTC
+	if window.TimeZone != "" {
+		if location, err = time.LoadLocation(window.TimeZone); err != nil {
+			return nil, fmt.Errorf("invalid time zone %q of schedule %q: %w", window.TimeZone, window.Schedule, err)
+		}
+	}
+	return &parsedWindow{schedule: s, duration: window.Duration.Duration, location: location}, nil
+}
+
+// openUntil returns the end of the occurrence of the window that is open at t, or the zero time if the window is closed at t.
+func (w *parsedWindow) openUntil(t time.Time) time.Time {
+	t = t.In(w.location)
+	start := w.schedule.prev(t, t.Add(-w.duration))
+	if start.IsZero() {
+		return time.Time{}
+	}
+	if end := start.Add(w.duration); end.After(t) {
+		return end
+	}
+	return time.Time{}
+}
+
+// nextStart returns the start of the next occurrence of the window after t.
+func (w *parsedWindow) nextStart(t time.Time) time.Time {
+	return w.schedule.next(t.In(w.location))
+}
+
+// Validate validates all the recurring time windows in the policy.
+func Validate(policy *placementv1beta1.MaintenanceWindowPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	var errs []error
+	for i := range policy.Windows {
+		if _, err := parseWindow(&policy.Windows[i]); err != nil {
+			errs = append(errs, fmt.Errorf("window %d: %w", i, err))
+		}
+	}
+	for i := range policy.Blackouts {
+		if _, err := parseWindow(&policy.Blackouts[i]); err != nil {
+			errs = append(errs, fmt.Errorf("blackout %d: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Evaluate tells whether updates can be started at the given time according to the policies.
+// Updates can be started when every policy allows it, i.e., for each policy, any of its windows is open (or it has none)
+// and none of its blackouts is. Nil policies do not restrict anything.
+func Evaluate(now time.Time, policies ...*placementv1beta1.MaintenanceWindowPolicy) (Status, error) {
+	parsed := make([]parsedPolicy, 0, len(policies))
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+		p, err := parsePolicy(policy)
+		if err != nil {
+			return Status{}, err
+		}
+		parsed = append(parsed, p)
+	}
+
+	t := now
+	for i := 0; i < maxReopenAttempts; i++ {
+		reopenAt, open := evaluateAt(parsed, t)
+		if open {
+			if i == 0 {
+				return Status{Open: true}, nil
+			}
+			return Status{NextOpenTime: t}, nil
+		}
+		if reopenAt.IsZero() {
+			return Status{}, nil
+		}
+		t = reopenAt
+	}
+	// The policies keep overlapping; report the last candidate as the time to check again.
+	return Status{NextOpenTime: t}, nil
+}
+
+// parsedPolicy is a maintenance window policy with its windows parsed.
+type parsedPolicy struct {
+	windows, blackouts []*parsedWindow
+}
+
+// parsePolicy parses all the recurring time windows in the policy.
+func parsePolicy(policy *placementv1beta1.MaintenanceWindowPolicy) (parsedPolicy, error) {
+	var p parsedPolicy
+	for i := range policy.Windows {
+		w, err := parseWindow(&policy.Windows[i])
+		if err != nil {
+			return p, err
+		}
+		p.windows = append(p.windows, w)
+	}
+	for i := range policy.Blackouts {
+		w, err := parseWindow(&policy.Blackouts[i])
+		if err != nil {
+			return p, err
+		}
+		p.blackouts = append(p.blackouts, w)
+	}
+	return p, nil
+}
+
+// evaluateAt tells whether all the policies are open at t; if not, it returns the earliest time at which the
+// policies blocking t may open, which has to be evaluated again.
+func evaluateAt(policies []parsedPolicy, t time.Time) (time.Time, bool) {
+	var reopenAt time.Time
+	for _, p := range policies {
+		candidate, open := p.evaluateAt(t)
+		if open {
+			continue
+		}
+		if candidate.IsZero() {
+			return time.Time{}, false
+		}
+		// All the blocking policies need to open, so the latest candidate is the earliest possible time.
+		if candidate.After(reopenAt) {
+			reopenAt = candidate
+		}
+	}
+	return reopenAt, reopenAt.IsZero()
+}
+
+// evaluateAt tells whether the policy is open at t; if not, it returns the earliest time at which it may open.
+func (p parsedPolicy) evaluateAt(t time.Time) (time.Time, bool) {
+	var blackoutEnd time.Time
+	for _, b := range p.blackouts {
+		if end := b.openUntil(t); end.After(blackoutEnd) {
+			blackoutEnd = end
+		}
+	}
+	if !blackoutEnd.IsZero() {
+		return blackoutEnd, false
+	}
+	if len(p.windows) == 0 {
+		return time.Time{}, true
+	}
+	var nextStart time.Time
+	for _, w := range p.windows {
+		if !w.openUntil(t).IsZero() {
+			return time.Time{}, true
+		}
+		if start := w.nextStart(t); !start.IsZero() && (nextStart.IsZero() || start.Before(nextStart)) {
+			nextStart = start
+		}
+	}
+	return nextStart, false
+}
+
+// ListClusterMaintenanceWindows lists all the ClusterMaintenanceWindow objects.
+func ListClusterMaintenanceWindows(ctx context.Context, c client.Reader) ([]placementv1beta1.ClusterMaintenanceWindow, error) {
+	var windowList placementv1beta1.ClusterMaintenanceWindowList
+	if err := c.List(ctx, &windowList); err != nil {
+		klog.ErrorS(err, "Failed to list the cluster maintenance windows")
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	return windowList.Items, nil
+}
+
+// EvaluateCluster tells whether updates can be started on the member cluster at the given time according to the
+// ClusterMaintenanceWindow objects that select it, as well as the additional policies, e.g., the one of its stage.
+// Invalid maintenance windows keep the cluster closed and are reported in the status; only API errors are returned.
+func EvaluateCluster(
+	ctx context.Context,
+	c client.Reader,
+	windows []placementv1beta1.ClusterMaintenanceWindow,
+	clusterName string,
+	now time.Time,
+	additionalPolicies ...*placementv1beta1.MaintenanceWindowPolicy,
+) (Status, error) {
+	if len(windows) == 0 && len(additionalPolicies) == 0 {
+		return Status{Open: true}, nil
+	}
+	var policy *placementv1beta1.MaintenanceWindowPolicy
+	if len(windows) > 0 {
+		var cluster clusterv1beta1.MemberCluster
+		switch err := c.Get(ctx, client.ObjectKey{Name: clusterName}, &cluster); {
+		case apierrors.IsNotFound(err):
+			klog.V(2).InfoS("Member cluster is not found; it's not restricted by any cluster maintenance window", "memberCluster", clusterName)
+		case err != nil:
+			klog.ErrorS(err, "Failed to get the member cluster", "memberCluster", clusterName)
+			return Status{}, controller.NewAPIServerError(true, err)
+		default:
+			if policy, err = ClusterPolicy(windows, &cluster); err != nil {
+				return Status{InvalidReason: err.Error()}, nil
+			}
+		}
+	}
+	status, err := Evaluate(now, append([]*placementv1beta1.MaintenanceWindowPolicy{policy}, additionalPolicies...)...)
+	if err != nil {
+		return Status{InvalidReason: err.Error()}, nil
+	}
+	return status, nil
+}
+
+// ClusterPolicy returns the combined maintenance window policy of the ClusterMaintenanceWindow objects that select
+// the member cluster, or nil if none selects it.
+func ClusterPolicy(windows []placementv1beta1.ClusterMaintenanceWindow, cluster *clusterv1beta1.MemberCluster) (*placementv1beta1.MaintenanceWindowPolicy, error) {
+	var policy *placementv1beta1.MaintenanceWindowPolicy
+	for i := range windows {
+		selector, err := metav1.LabelSelectorAsSelector(&windows[i].Spec.ClusterSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cluster selector of the cluster maintenance window %s: %w", windows[i].Name, err)
+		}
+		if !selector.Matches(labels.Set(cluster.Labels)) {
+			continue
+		}
+		if policy == nil {
+			policy = &placementv1beta1.MaintenanceWindowPolicy{}
+		}
+		policy.Windows = append(policy.Windows, windows[i].Spec.Windows...)
+		policy.Blackouts = append(policy.Blackouts, windows[i].Spec.Blackouts...)
+	}
+	return policy, nil
+}
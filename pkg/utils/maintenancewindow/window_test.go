@@ -0,0 +1,256 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancewindow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func window(schedule string, duration time.Duration, timeZone string) placementv1beta1.RecurringTimeWindow {
+	return placementv1beta1.RecurringTimeWindow{Schedule: schedule, Duration: metav1.Duration{Duration: duration}, TimeZone: timeZone}
+}
+
+// TestEvaluate tests the Evaluate function.
+func TestEvaluate(t *testing.T) {
+	// 2026-03-04 is a Wednesday.
+	now := time.Date(2026, 3, 4, 10, 30, 0, 0, time.UTC)
+	nightly := &placementv1beta1.MaintenanceWindowPolicy{
+		Windows: []placementv1beta1.RecurringTimeWindow{window("0 22 * * *", 4*time.Hour, "")},
+	}
+	testCases := []struct {
+		name     string
+		policies []*placementv1beta1.MaintenanceWindowPolicy
+		want     Status
+	}{
+		{
+			name: "no policy",
+			want: Status{Open: true},
+		},
+		{
+			name:     "nil policy",
+			policies: []*placementv1beta1.MaintenanceWindowPolicy{nil},
+			want:     Status{Open: true},
+		},
+		{
+			name: "inside a window",
+			policies: []*placementv1beta1.MaintenanceWindowPolicy{{
+				Windows: []placementv1beta1.RecurringTimeWindow{window("0 9 * * mon-fri", 8*time.Hour, "")},
+			}},
+			want: Status{Open: true},
+		},
+		{
+			name:     "outside the window",
+			policies: []*placementv1beta1.MaintenanceWindowPolicy{nightly},
+			want:     Status{NextOpenTime: time.Date(2026, 3, 4, 22, 0, 0, 0, time.UTC)},
+		},
+		{
+			name: "window in another time zone",
+			policies: []*placementv1beta1.MaintenanceWindowPolicy{{
+				// 10:30 UTC is 19:30 in Tokyo.
+				Windows: []placementv1beta1.RecurringTimeWindow{window("0 19 * * *", time.Hour, "Asia/Tokyo")},
+			}},
+			want: Status{Open: true},
+		},
+		{
+			name: "inside a blackout",
+			policies: []*placementv1beta1.MaintenanceWindowPolicy{{
+				Blackouts: []placementv1beta1.RecurringTimeWindow{window("0 10 * * *", 2*time.Hour, "")},
+			}},
+			want: Status{NextOpenTime: time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)},
+		},
+		{
+			name: "blackout overlaps the next window",
+			policies: []*placementv1beta1.MaintenanceWindowPolicy{{
+				Windows:   []placementv1beta1.RecurringTimeWindow{window("0 22 * * *", 4*time.Hour, "")},
+				Blackouts: []placementv1beta1.RecurringTimeWindow{window("0 21 4 3 *", 2*time.Hour, "")},
+			}},
+			want: Status{NextOpenTime: time.Date(2026, 3, 4, 23, 0, 0, 0, time.UTC)},
+		},
+		{
+			name: "both policies must be open",
+			policies: []*placementv1beta1.MaintenanceWindowPolicy{
+				{Windows: []placementv1beta1.RecurringTimeWindow{window("0 9 * * *", 14*time.Hour, "")}},
+				nightly,
+			},
+			want: Status{NextOpenTime: time.Date(2026, 3, 4, 22, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Evaluate(now, tc.policies...)
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v, want no error", err)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("Evaluate() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestValidate tests the Validate function.
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		policy    *placementv1beta1.MaintenanceWindowPolicy
+		wantErred bool
+	}{
+		{
+			name: "nil policy",
+		},
+		{
+			name: "valid policy",
+			policy: &placementv1beta1.MaintenanceWindowPolicy{
+				Windows:   []placementv1beta1.RecurringTimeWindow{window("0 22 * * 1-5", 4*time.Hour, "Europe/Berlin")},
+				Blackouts: []placementv1beta1.RecurringTimeWindow{window("0 0 24 12 *", 48*time.Hour, "")},
+			},
+		},
+		{
+			name: "invalid time zone",
+			policy: &placementv1beta1.MaintenanceWindowPolicy{
+				Windows: []placementv1beta1.RecurringTimeWindow{window("0 22 * * *", time.Hour, "Mars/Olympus")},
+			},
+			wantErred: true,
+		},
+		{
+			name: "duration too long",
+			policy: &placementv1beta1.MaintenanceWindowPolicy{
+				Blackouts: []placementv1beta1.RecurringTimeWindow{window("0 0 1 * *", 8*24*time.Hour, "")},
+			},
+			wantErred: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := Validate(tc.policy); (err != nil) != tc.wantErred {
+				t.Errorf("Validate() error = %v, wantErred %t", err, tc.wantErred)
+			}
+		})
+	}
+}
+
+// TestEvaluateCluster tests the EvaluateCluster function.
+func TestEvaluateCluster(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add scheme: %v", err)
+	}
+	now := time.Date(2026, 3, 4, 10, 30, 0, 0, time.UTC)
+	cluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-1", Labels: map[string]string{"env": "prod"}},
+	}
+	windows := []placementv1beta1.ClusterMaintenanceWindow{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod-nightly"},
+			Spec: placementv1beta1.ClusterMaintenanceWindowSpec{
+				ClusterSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+				MaintenanceWindowPolicy: placementv1beta1.MaintenanceWindowPolicy{
+					Windows: []placementv1beta1.RecurringTimeWindow{window("0 22 * * *", 4*time.Hour, "")},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod-daytime"},
+			Spec: placementv1beta1.ClusterMaintenanceWindowSpec{
+				ClusterSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+				MaintenanceWindowPolicy: placementv1beta1.MaintenanceWindowPolicy{
+					Windows: []placementv1beta1.RecurringTimeWindow{window("0 10 * * *", time.Hour, "")},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "staging-blackout"},
+			Spec: placementv1beta1.ClusterMaintenanceWindowSpec{
+				ClusterSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "staging"}},
+				MaintenanceWindowPolicy: placementv1beta1.MaintenanceWindowPolicy{
+					Blackouts: []placementv1beta1.RecurringTimeWindow{window("* * * * *", time.Hour, "")},
+				},
+			},
+		},
+	}
+	stageBlackout := &placementv1beta1.MaintenanceWindowPolicy{
+		Blackouts: []placementv1beta1.RecurringTimeWindow{window("0 10 * * *", 2*time.Hour, "")},
+	}
+	testCases := []struct {
+		name               string
+		clusterName        string
+		windows            []placementv1beta1.ClusterMaintenanceWindow
+		additionalPolicies []*placementv1beta1.MaintenanceWindowPolicy
+		want               Status
+	}{
+		{
+			name:        "no maintenance window",
+			clusterName: "cluster-1",
+			want:        Status{Open: true},
+		},
+		{
+			name:        "windows of all the selecting objects are combined",
+			clusterName: "cluster-1",
+			windows:     windows,
+			want:        Status{Open: true},
+		},
+		{
+			name:               "stage blackout",
+			clusterName:        "cluster-1",
+			windows:            windows,
+			additionalPolicies: []*placementv1beta1.MaintenanceWindowPolicy{stageBlackout},
+			want:               Status{NextOpenTime: time.Date(2026, 3, 4, 22, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:        "cluster not found",
+			clusterName: "cluster-2",
+			windows:     windows,
+			want:        Status{Open: true},
+		},
+		{
+			name:        "invalid cluster selector",
+			clusterName: "cluster-1",
+			windows: []placementv1beta1.ClusterMaintenanceWindow{{
+				ObjectMeta: metav1.ObjectMeta{Name: "invalid"},
+				Spec: placementv1beta1.ClusterMaintenanceWindowSpec{
+					ClusterSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: "Near"}}},
+				},
+			}},
+			want: Status{InvalidReason: `invalid cluster selector of the cluster maintenance window invalid: "Near" is not a valid label selector operator`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster).Build()
+			got, err := EvaluateCluster(context.Background(), fakeClient, tc.windows, tc.clusterName, now, tc.additionalPolicies...)
+			if err != nil {
+				t.Fatalf("EvaluateCluster() error = %v, want no error", err)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("EvaluateCluster() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
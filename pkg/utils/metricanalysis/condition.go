@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricanalysis
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	// resultVariable is the variable that refers to the measured value in a condition.
+	resultVariable = "result"
+)
+
+// comparisonOperators are the supported comparison operators; two-character operators are listed
+// first so that they take precedence when parsing.
+var comparisonOperators = []string{"<=", ">=", "==", "!=", "<", ">"}
+
+// comparison is a single comparison in a condition, e.g., `result >= 0.99`.
+type comparison struct {
+	operator string
+	operand  float64
+}
+
+// ValidateCondition validates the format of a condition.
+func ValidateCondition(condition string) error {
+	_, err := parseCondition(condition)
+	return err
+}
+
+// EvaluateCondition evaluates a condition against a measured value.
+func EvaluateCondition(condition string, result float64) (bool, error) {
+	comparisons, err := parseCondition(condition)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range comparisons {
+		if !c.evaluate(result) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// parseCondition parses a condition, i.e., one or more comparisons joined by `&&`.
+func parseCondition(condition string) ([]comparison, error) {
+	if strings.TrimSpace(condition) == "" {
+		return nil, fmt.Errorf("the condition is empty")
+	}
+	parts := strings.Split(condition, "&&")
+	comparisons := make([]comparison, 0, len(parts))
+	for _, part := range parts {
+		c, err := parseComparison(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("invalid condition %q: %w", condition, err)
+		}
+		comparisons = append(comparisons, c)
+	}
+	return comparisons, nil
+}
+
+// parseComparison parses a single comparison in the form of `result <operator> <number>`.
+func parseComparison(expr string) (comparison, error) {
+	if !strings.HasPrefix(expr, resultVariable) {
+		return comparison{}, fmt.Errorf("the comparison %q must start with %q", expr, resultVariable)
+	}
+	rest := strings.TrimSpace(strings.TrimPrefix(expr, resultVariable))
+	for _, op := range comparisonOperators {
+		if !strings.HasPrefix(rest, op) {
+			continue
+		}
+		operandStr := strings.TrimSpace(strings.TrimPrefix(rest, op))
+		operand, err := strconv.ParseFloat(operandStr, 64)
+		if err != nil || math.IsNaN(operand) {
+			return comparison{}, fmt.Errorf("the operand %q of the comparison %q is not a number", operandStr, expr)
+		}
+		return comparison{operator: op, operand: operand}, nil
+	}
+	return comparison{}, fmt.Errorf("the comparison %q has no supported operator, want one of %s", expr, strings.Join(comparisonOperators, ", "))
+}
+
+// evaluate evaluates the comparison against a measured value.
+func (c comparison) evaluate(result float64) bool {
+	switch c.operator {
+	case "<=":
+		return result <= c.operand
+	case ">=":
+		return result >= c.operand
+	case "==":
+		return result == c.operand
+	case "!=":
+		return result != c.operand
+	case "<":
+		return result < c.operand
+	case ">":
+		return result > c.operand
+	default:
+		return false
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricanalysis
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestEvaluateCondition tests the EvaluateCondition function.
+func TestEvaluateCondition(t *testing.T) {
+	testCases := []struct {
+		name             string
+		condition        string
+		result           float64
+		want             bool
+		wantErred        bool
+		wantErrMsgSubStr string
+	}{
+		{
+			name:      "greater than or equal to, met",
+			condition: "result >= 0.99",
+			result:    0.99,
+			want:      true,
+		},
+		{
+			name:      "greater than or equal to, not met",
+			condition: "result >= 0.99",
+			result:    0.98,
+		},
+		{
+			name:      "less than, no spaces",
+			condition: "result<100",
+			result:    50,
+			want:      true,
+		},
+		{
+			name:      "equal to",
+			condition: "result == 0",
+			result:    0,
+			want:      true,
+		},
+		{
+			name:      "not equal to",
+			condition: "result != 0",
+			result:    0,
+		},
+		{
+			name:      "range, met",
+			condition: "result > 0 && result <= 1",
+			result:    1,
+			want:      true,
+		},
+		{
+			name:      "range, not met",
+			condition: "result > 0 && result <= 1",
+			result:    1.5,
+		},
+		{
+			name:             "empty condition",
+			condition:        " ",
+			wantErred:        true,
+			wantErrMsgSubStr: "the condition is empty",
+		},
+		{
+			name:             "unknown variable",
+			condition:        "value > 1",
+			wantErred:        true,
+			wantErrMsgSubStr: `must start with "result"`,
+		},
+		{
+			name:             "unsupported operator",
+			condition:        "result =~ 1",
+			wantErred:        true,
+			wantErrMsgSubStr: "has no supported operator",
+		},
+		{
+			name:             "non-numeric operand",
+			condition:        "result > threshold",
+			wantErred:        true,
+			wantErrMsgSubStr: "is not a number",
+		},
+		{
+			name:             "dangling conjunction",
+			condition:        "result > 1 &&",
+			wantErred:        true,
+			wantErrMsgSubStr: `must start with "result"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := EvaluateCondition(tc.condition, tc.result)
+			if tc.wantErred {
+				if err == nil {
+					t.Fatalf("EvaluateCondition() = %t, want error", got)
+				}
+				if !strings.Contains(err.Error(), tc.wantErrMsgSubStr) {
+					t.Fatalf("EvaluateCondition() error = %v, want error containing %s", err, tc.wantErrMsgSubStr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EvaluateCondition() error = %v, want no error", err)
+			}
+			if got != tc.want {
+				t.Errorf("EvaluateCondition() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
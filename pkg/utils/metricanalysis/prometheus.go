@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricanalysis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// prometheusQueryPath is the path of the Prometheus HTTP API endpoint for instant queries.
+	prometheusQueryPath = "/api/v1/query"
+
+	// prometheusQueryTimeout is the timeout of a single Prometheus query; it's kept short as the query blocks
+	// the reconciliation of the update run.
+	prometheusQueryTimeout = 10 * time.Second
+
+	// maxPrometheusResponseBytes is the maximum size of a Prometheus response that will be read.
+	maxPrometheusResponseBytes = 1 << 20
+)
+
+// prometheusHTTPClient is the HTTP client shared by all the Prometheus providers, so that the connections to the
+// Prometheus servers are reused; the timeout of each query is set with its context.
+var prometheusHTTPClient = &http.Client{}
+
+// prometheusProvider queries metrics with the Prometheus HTTP API.
+type prometheusProvider struct {
+	address    string
+	httpClient *http.Client
+}
+
+var _ MetricProvider = &prometheusProvider{}
+
+// NewPrometheusProvider returns a MetricProvider that queries the Prometheus server at the given address.
+func NewPrometheusProvider(address string) MetricProvider {
+	return &prometheusProvider{
+		address:    strings.TrimSuffix(address, "/"),
+		httpClient: prometheusHTTPClient,
+	}
+}
+
+// prometheusResponse is the envelope of a Prometheus HTTP API response.
+type prometheusResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Data      struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// prometheusSample is a sample in an instant vector.
+type prometheusSample struct {
+	Metric map[string]string `json:"metric"`
+	Value  []interface{}     `json:"value"`
+}
+
+// Query runs an instant query against the Prometheus server. The query times out after prometheusQueryTimeout,
+// or earlier if the given context has an earlier deadline.
+func (p *prometheusProvider) Query(ctx context.Context, query string) (float64, error) {
+	ctx, cancel := context.WithTimeout(ctx, prometheusQueryTimeout)
+	defer cancel()
+	reqURL := fmt.Sprintf("%s%s?%s", p.address, prometheusQueryPath, url.Values{"query": []string{query}}.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build the Prometheus query request: %w", err)
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query Prometheus: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxPrometheusResponseBytes))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read the Prometheus response: %w", err)
+	}
+	promResp := prometheusResponse{}
+	if err := json.Unmarshal(body, &promResp); err != nil {
+		return 0, fmt.Errorf("failed to parse the Prometheus response (HTTP status %d): %w", resp.StatusCode, err)
+	}
+	if promResp.Status != "success" {
+		return 0, fmt.Errorf("the Prometheus query failed (HTTP status %d): %s: %s", resp.StatusCode, promResp.ErrorType, promResp.Error)
+	}
+	return parsePrometheusResult(promResp.Data.ResultType, promResp.Data.Result)
+}
+
+// parsePrometheusResult parses the result of an instant query into a single number.
+func parsePrometheusResult(resultType string, result json.RawMessage) (float64, error) {
+	switch resultType {
+	case "scalar":
+		value := []interface{}{}
+		if err := json.Unmarshal(result, &value); err != nil {
+			return 0, fmt.Errorf("failed to parse the scalar result: %w", err)
+		}
+		return parsePrometheusValue(value)
+	case "vector":
+		samples := []prometheusSample{}
+		if err := json.Unmarshal(result, &samples); err != nil {
+			return 0, fmt.Errorf("failed to parse the vector result: %w", err)
+		}
+		if len(samples) != 1 {
+			return 0, fmt.Errorf("the query returned %d samples, want exactly 1", len(samples))
+		}
+		return parsePrometheusValue(samples[0].Value)
+	default:
+		return 0, fmt.Errorf("unsupported result type %q, want scalar or vector", resultType)
+	}
+}
+
+// parsePrometheusValue parses a Prometheus value, i.e., a [timestamp, "value"] pair.
+func parsePrometheusValue(value []interface{}) (float64, error) {
+	if len(value) != 2 {
+		return 0, fmt.Errorf("malformed value %v", value)
+	}
+	str, ok := value[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("malformed value %v", value)
+	}
+	f, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse value %q: %w", str, err)
+	}
+	return f, nil
+}
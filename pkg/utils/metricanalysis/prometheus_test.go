@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricanalysis
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// TestPrometheusProviderQuery tests the Query method of the Prometheus provider.
+func TestPrometheusProviderQuery(t *testing.T) {
+	testCases := []struct {
+		name             string
+		statusCode       int
+		body             string
+		want             float64
+		wantErred        bool
+		wantErrMsgSubStr string
+	}{
+		{
+			name:       "vector with one sample",
+			statusCode: http.StatusOK,
+			body:       `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"job":"app"},"value":[1700000000.123,"0.995"]}]}}`,
+			want:       0.995,
+		},
+		{
+			name:       "scalar",
+			statusCode: http.StatusOK,
+			body:       `{"status":"success","data":{"resultType":"scalar","result":[1700000000.123,"42"]}}`,
+			want:       42,
+		},
+		{
+			name:             "empty vector",
+			statusCode:       http.StatusOK,
+			body:             `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+			wantErred:        true,
+			wantErrMsgSubStr: "the query returned 0 samples, want exactly 1",
+		},
+		{
+			name:             "matrix",
+			statusCode:       http.StatusOK,
+			body:             `{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+			wantErred:        true,
+			wantErrMsgSubStr: `unsupported result type "matrix"`,
+		},
+		{
+			name:             "query error",
+			statusCode:       http.StatusBadRequest,
+			body:             `{"status":"error","errorType":"bad_data","error":"parse error"}`,
+			wantErred:        true,
+			wantErrMsgSubStr: "bad_data: parse error",
+		},
+		{
+			name:             "malformed response",
+			statusCode:       http.StatusBadGateway,
+			body:             `bad gateway`,
+			wantErred:        true,
+			wantErrMsgSubStr: "failed to parse the Prometheus response (HTTP status 502)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			query := `sum(rate(http_requests_total{code!~"5.."}[5m]))`
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != prometheusQueryPath {
+					t.Errorf("request path = %s, want %s", r.URL.Path, prometheusQueryPath)
+				}
+				if got := r.URL.Query().Get("query"); got != query {
+					t.Errorf("query = %s, want %s", got, query)
+				}
+				w.WriteHeader(tc.statusCode)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			provider, err := NewMetricProvider(placementv1beta1.MetricProviderConfig{
+				Type:    placementv1beta1.MetricProviderTypePrometheus,
+				Address: server.URL + "/",
+			})
+			if err != nil {
+				t.Fatalf("NewMetricProvider() error = %v, want no error", err)
+			}
+			got, err := provider.Query(context.Background(), query)
+			if tc.wantErred {
+				if err == nil {
+					t.Fatalf("Query() = %v, want error", got)
+				}
+				if !strings.Contains(err.Error(), tc.wantErrMsgSubStr) {
+					t.Fatalf("Query() error = %v, want error containing %s", err, tc.wantErrMsgSubStr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Query() error = %v, want no error", err)
+			}
+			if got != tc.want {
+				t.Errorf("Query() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestPrometheusProviderQuery_Deadline tests that the Prometheus queries respect the deadline of the given context.
+func TestPrometheusProviderQuery_Deadline(t *testing.T) {
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		select {
+		case <-unblock:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	provider := NewPrometheusProvider(server.URL)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if got, err := provider.Query(ctx, "up"); err == nil {
+		t.Fatalf("Query() = %v, want error", got)
+	}
+	if elapsed := time.Since(start); elapsed >= prometheusQueryTimeout {
+		t.Errorf("Query() returned after %v, want it to return at the context deadline", elapsed)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metricanalysis features the metric providers and the condition evaluation logic
+// used by the Analysis stage task of staged update runs.
+package metricanalysis
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// MetricProvider queries a metrics backend.
+type MetricProvider interface {
+	// Query runs the query against the metrics backend and returns the result, which must be
+	// a single number.
+	Query(ctx context.Context, query string) (float64, error)
+}
+
+// MetricProviderFactory builds a MetricProvider from its configuration.
+type MetricProviderFactory func(config placementv1beta1.MetricProviderConfig) (MetricProvider, error)
+
+// NewMetricProvider builds a MetricProvider from its configuration.
+func NewMetricProvider(config placementv1beta1.MetricProviderConfig) (MetricProvider, error) {
+	switch config.Type {
+	case placementv1beta1.MetricProviderTypePrometheus, "":
+		return NewPrometheusProvider(config.Address), nil
+	default:
+		return nil, fmt.Errorf("unsupported metric provider type: %s", config.Type)
+	}
+}
+
+// FakeMetricProvider is a MetricProvider that returns pre-configured results; it is meant to be used in tests.
+type FakeMetricProvider struct {
+	mu sync.Mutex
+	// Results are the results to return, keyed by query; for each query, the results are returned in
+	// order, and the last result is returned repeatedly once the others have been consumed.
+	Results map[string][]FakeResult
+	// Queries are the queries that have been run, in order.
+	Queries []string
+}
+
+// FakeResult is a result returned by the FakeMetricProvider.
+type FakeResult struct {
+	Value float64
+	Err   error
+}
+
+var _ MetricProvider = &FakeMetricProvider{}
+
+// Query returns the next pre-configured result of the query.
+func (f *FakeMetricProvider) Query(_ context.Context, query string) (float64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.Queries = append(f.Queries, query)
+	results := f.Results[query]
+	if len(results) == 0 {
+		return 0, fmt.Errorf("no result is configured for query %q", query)
+	}
+	result := results[0]
+	if len(results) > 1 {
+		f.Results[query] = results[1:]
+	}
+	return result.Value, result.Err
+}
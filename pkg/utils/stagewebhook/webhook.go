@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package stagewebhook features the client used by the Webhook stage task of staged update runs to ask
+// an external HTTP endpoint for a go/no-go decision.
+package stagewebhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	// maxResponseBytes is the maximum size of a webhook response that will be read.
+	maxResponseBytes = 1 << 20
+
+	// maxMessageLength is the maximum length of the message kept from a webhook response.
+	maxMessageLength = 1024
+)
+
+// Decision is the decision made by the webhook endpoint.
+type Decision string
+
+const (
+	// DecisionApprove lets the stage start or move on to the next stage.
+	DecisionApprove Decision = "Approve"
+
+	// DecisionReject fails the update run.
+	DecisionReject Decision = "Reject"
+
+	// DecisionRetryLater asks the update run to call the endpoint again later.
+	DecisionRetryLater Decision = "RetryLater"
+)
+
+// ObjectReference identifies an object on the hub cluster.
+type ObjectReference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// Request is the body of the request sent to the webhook endpoint.
+type Request struct {
+	// UpdateRun is the update run that calls the endpoint.
+	UpdateRun ObjectReference `json:"updateRun"`
+	// Placement is the placement that the update run rolls out.
+	Placement ObjectReference `json:"placement"`
+	// ResourceSnapshotIndex is the index of the resource snapshot that the update run rolls out.
+	ResourceSnapshotIndex string `json:"resourceSnapshotIndex,omitempty"`
+	// Stage is the name of the stage.
+	Stage string `json:"stage"`
+	// TaskType tells whether the task runs before the stage (beforeStage) or after it (afterStage).
+	TaskType string `json:"taskType"`
+	// Clusters are the names of the clusters in the stage.
+	Clusters []string `json:"clusters"`
+	// Attempt is the attempt number of the call, starting from 1.
+	Attempt int32 `json:"attempt"`
+}
+
+// Response is the body of the response returned by the webhook endpoint.
+type Response struct {
+	// Decision is the decision made by the endpoint.
+	Decision Decision `json:"decision"`
+	// Message is an optional human-readable explanation of the decision.
+	Message string `json:"message,omitempty"`
+	// RetryAfterSeconds is how long to wait before calling the endpoint again, if the decision is RetryLater.
+	RetryAfterSeconds int32 `json:"retryAfterSeconds,omitempty"`
+}
+
+// RetryAfter returns how long the endpoint asks to wait before calling it again, or 0 if not specified.
+func (r *Response) RetryAfter() time.Duration {
+	if r.RetryAfterSeconds <= 0 {
+		return 0
+	}
+	return time.Duration(r.RetryAfterSeconds) * time.Second
+}
+
+// Call posts the request to the webhook endpoint and parses its response.
+// It returns the HTTP status code of the response (0 if no response is received) along with the response;
+// an error is returned if the endpoint cannot be reached, responds with a non-2xx status code, or responds with
+// a malformed body.
+func Call(
+	ctx context.Context,
+	httpClient *http.Client,
+	url string,
+	headers map[string]string,
+	timeout time.Duration,
+	request *Request,
+) (int, *Response, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to marshal the webhook request: %w", err)
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to build the webhook request: %w", err)
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to call the webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return resp.StatusCode, nil, fmt.Errorf("failed to read the webhook response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, nil, fmt.Errorf("the webhook responded with HTTP status %d: %s", resp.StatusCode, truncate(string(respBody)))
+	}
+	webhookResp := &Response{}
+	if err := json.Unmarshal(respBody, webhookResp); err != nil {
+		return resp.StatusCode, nil, fmt.Errorf("failed to parse the webhook response: %w", err)
+	}
+	switch webhookResp.Decision {
+	case DecisionApprove, DecisionReject, DecisionRetryLater:
+	default:
+		return resp.StatusCode, nil, fmt.Errorf("the webhook responded with an unknown decision %q, want one of %s, %s, and %s",
+			webhookResp.Decision, DecisionApprove, DecisionReject, DecisionRetryLater)
+	}
+	webhookResp.Message = truncate(webhookResp.Message)
+	return resp.StatusCode, webhookResp, nil
+}
+
+// truncate shortens the string to at most maxMessageLength bytes so that it can be kept in the status.
+func truncate(s string) string {
+	if len(s) <= maxMessageLength {
+		return s
+	}
+	return s[:maxMessageLength] + "..."
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stagewebhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// TestCall tests the Call function against a local HTTP server.
+func TestCall(t *testing.T) {
+	testCases := []struct {
+		name             string
+		handler          http.HandlerFunc
+		timeout          time.Duration
+		wantStatusCode   int
+		wantResponse     *Response
+		wantErrMsgSubStr string
+	}{
+		{
+			name: "approve",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" || r.Header.Get("X-Api-Key") != "key" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				_, _ = w.Write([]byte(`{"decision": "Approve", "message": "ok"}`))
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   &Response{Decision: DecisionApprove, Message: "ok"},
+		},
+		{
+			name: "retry later",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusAccepted)
+				_, _ = w.Write([]byte(`{"decision": "RetryLater", "retryAfterSeconds": 120}`))
+			},
+			wantStatusCode: http.StatusAccepted,
+			wantResponse:   &Response{Decision: DecisionRetryLater, RetryAfterSeconds: 120},
+		},
+		{
+			name: "non-2xx status code",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte("forbidden"))
+			},
+			wantStatusCode:   http.StatusForbidden,
+			wantErrMsgSubStr: "the webhook responded with HTTP status 403: forbidden",
+		},
+		{
+			name: "malformed body",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("approved"))
+			},
+			wantStatusCode:   http.StatusOK,
+			wantErrMsgSubStr: "failed to parse the webhook response",
+		},
+		{
+			name: "missing decision",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"message": "ok"}`))
+			},
+			wantStatusCode:   http.StatusOK,
+			wantErrMsgSubStr: `unknown decision ""`,
+		},
+		{
+			name: "timeout",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				time.Sleep(time.Second)
+				_, _ = w.Write([]byte(`{"decision": "Approve"}`))
+			},
+			timeout:          100 * time.Millisecond,
+			wantErrMsgSubStr: "failed to call the webhook",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+
+			request := &Request{Stage: "canary", TaskType: "beforeStage", Clusters: []string{"cluster-1"}, Attempt: 1}
+			statusCode, resp, err := Call(context.Background(), server.Client(), server.URL, map[string]string{"X-Api-Key": "key"}, tc.timeout, request)
+			if statusCode != tc.wantStatusCode {
+				t.Errorf("Call() status code = %d, want %d", statusCode, tc.wantStatusCode)
+			}
+			if tc.wantErrMsgSubStr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrMsgSubStr) {
+					t.Fatalf("Call() error = %v, want error containing %s", err, tc.wantErrMsgSubStr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Call() error = %v, want no error", err)
+			}
+			if diff := cmp.Diff(resp, tc.wantResponse); diff != "" {
+				t.Errorf("Call() response mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package toleration features utilities to match the tolerations of placements against the taints of member clusters.
+package toleration
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// ToleratesTaint returns whether the toleration matches the taint, regardless of the toleration seconds.
+func ToleratesTaint(toleration placementv1beta1.Toleration, taint clusterv1beta1.Taint) bool {
+	if toleration.Effect != "" && toleration.Effect != taint.Effect {
+		return false
+	}
+	switch toleration.Operator {
+	case corev1.TolerationOpExists:
+		return toleration.Key == "" || toleration.Key == taint.Key
+	case corev1.TolerationOpEqual:
+		return toleration.Key == taint.Key && toleration.Value == taint.Value
+	}
+	return false
+}
@@ -32,10 +32,13 @@ func validateTaints(taints []clusterv1beta1.Taint) error {
 				allErr = append(allErr, fmt.Errorf(invalidTaintValueErrFmt, taint, msg))
 			}
 		}
-		if taintMap[taint] {
+		// The time a taint is added is set by Fleet and does not make a taint different.
+		taintKey := taint
+		taintKey.TimeAdded = nil
+		if taintMap[taintKey] {
 			allErr = append(allErr, fmt.Errorf(uniqueTaintErrFmt, taint))
 		}
-		taintMap[taint] = true
+		taintMap[taintKey] = true
 	}
 	return apiErrors.NewAggregate(allErr)
 }
@@ -3,6 +3,9 @@ package validator
 import (
 	"strings"
 	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 )
@@ -66,6 +69,23 @@ func TestValidateTaints(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "taints must be unique",
 		},
+		"invalid taint, non-unique taint with different time added": {
+			taints: []clusterv1beta1.Taint{
+				{
+					Key:       "key1",
+					Value:     "value1",
+					Effect:    "NoExecute",
+					TimeAdded: &metav1.Time{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+				},
+				{
+					Key:    "key1",
+					Value:  "value1",
+					Effect: "NoExecute",
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "taints must be unique",
+		},
 		"valid taints": {
 			taints: []clusterv1beta1.Taint{
 				{
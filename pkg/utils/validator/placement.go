@@ -21,7 +21,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net/http"
+	"slices"
 	"sort"
 	"strings"
 
@@ -35,6 +37,7 @@ import (
 	apiErrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/util/jsonpath"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -64,6 +67,15 @@ var (
 	// Below is the map of supported capacity types.
 	supportedResourceCapacityTypesMap = map[string]bool{propertyprovider.AllocatableCapacityName: true, propertyprovider.AvailableCapacityName: true, propertyprovider.TotalCapacityName: true}
 	resourceCapacityTypes             = supportedResourceCapacityTypes()
+
+	// Below is the map of supported aggregates over the property history.
+	supportedPropertyHistoryAggregatesMap = map[string]bool{
+		propertyprovider.MinAggregateName: true,
+		propertyprovider.MaxAggregateName: true,
+		propertyprovider.P50AggregateName: true,
+		propertyprovider.P95AggregateName: true,
+	}
+	propertyHistoryAggregates = slices.Sorted(maps.Keys(supportedPropertyHistoryAggregatesMap))
 )
 
 type operatorSpec struct {
@@ -277,6 +289,9 @@ func validatePolicyForPickFixedPlacementType(policy *placementv1beta1.PlacementP
 	if policy.Tolerations != nil {
 		allErr = append(allErr, fmt.Errorf("tolerations needs to be empty for policy type %s, only valid for PickAll/PickN", placementv1beta1.PickFixedPlacementType))
 	}
+	if policy.Failover != nil {
+		allErr = append(allErr, fmt.Errorf("failover must be nil for policy type %s, only valid for PickN policy type", placementv1beta1.PickFixedPlacementType))
+	}
 
 	return apiErrors.NewAggregate(allErr)
 }
@@ -293,9 +308,15 @@ func validatePolicyForPickAllPlacementType(policy *placementv1beta1.PlacementPol
 	if policy.Affinity != nil && policy.Affinity.ClusterAffinity != nil {
 		allErr = append(allErr, validateClusterAffinity(policy.Affinity.ClusterAffinity, policy.PlacementType))
 	}
+	if policy.Affinity != nil && policy.Affinity.ClusterProximity != nil {
+		allErr = append(allErr, fmt.Errorf("cluster proximity will be ignored for placement policy type %s", placementv1beta1.PickAllPlacementType))
+	}
 	if len(policy.TopologySpreadConstraints) > 0 {
 		allErr = append(allErr, fmt.Errorf("topology spread constraints needs to be empty for policy type %s, only valid for PickN policy type", placementv1beta1.PickAllPlacementType))
 	}
+	if policy.Failover != nil {
+		allErr = append(allErr, fmt.Errorf("failover must be nil for policy type %s, only valid for PickN policy type", placementv1beta1.PickAllPlacementType))
+	}
 	allErr = append(allErr, validateTolerations(policy.Tolerations))
 
 	return apiErrors.NewAggregate(allErr)
@@ -317,6 +338,9 @@ func validatePolicyForPickNPolicyType(policy *placementv1beta1.PlacementPolicy)
 	if policy.Affinity != nil && policy.Affinity.ClusterAffinity != nil {
 		allErr = append(allErr, validateClusterAffinity(policy.Affinity.ClusterAffinity, policy.PlacementType))
 	}
+	if policy.Affinity != nil && policy.Affinity.ClusterProximity != nil {
+		allErr = append(allErr, validateClusterProximity(policy.Affinity.ClusterProximity))
+	}
 	if len(policy.TopologySpreadConstraints) > 0 {
 		allErr = append(allErr, validateTopologySpreadConstraints(policy.TopologySpreadConstraints))
 	}
@@ -347,9 +371,21 @@ func validateClusterAffinity(clusterAffinity *placementv1beta1.ClusterAffinity,
 	return apiErrors.NewAggregate(allErr)
 }
 
+func validateClusterProximity(proximity *placementv1beta1.ClusterProximity) error {
+	allErr := make([]error, 0)
+	// The reference is a part of the name of the latency property that the member agents report.
+	if errs := validation.IsQualifiedName(proximity.Reference); errs != nil || strings.Contains(proximity.Reference, "/") {
+		allErr = append(allErr, fmt.Errorf("cluster proximity reference %s is not valid: it must be 63 characters or less, begin and end with an alphanumeric character, and contain only dashes (-), underscores (_), dots (.), and alphanumerics", proximity.Reference))
+	}
+	if proximity.Weight < 1 || proximity.Weight > 100 {
+		allErr = append(allErr, fmt.Errorf("cluster proximity weight %d is not valid, must be in the range [1, 100]", proximity.Weight))
+	}
+	return apiErrors.NewAggregate(allErr)
+}
+
 func validateTolerations(tolerations []placementv1beta1.Toleration) error {
 	allErr := make([]error, 0)
-	tolerationMap := make(map[placementv1beta1.Toleration]bool)
+	tolerationMap := make(map[tolerationKey]bool)
 	for _, toleration := range tolerations {
 		if toleration.Key != "" {
 			for _, msg := range validation.IsQualifiedName(toleration.Key) {
@@ -369,21 +405,42 @@ func validateTolerations(tolerations []placementv1beta1.Toleration) error {
 				allErr = append(allErr, fmt.Errorf(invalidTolerationValueErrFmt, toleration, msg))
 			}
 		}
-		if tolerationMap[toleration] {
+		if toleration.TolerationSeconds != nil && toleration.Effect != corev1.TaintEffectNoExecute {
+			allErr = append(allErr, fmt.Errorf(invalidTolerationErrFmt, toleration, "toleration effect must be NoExecute, when tolerationSeconds is set"))
+		}
+		key := keyOfToleration(toleration)
+		if tolerationMap[key] {
 			allErr = append(allErr, fmt.Errorf(uniqueTolerationErrFmt, toleration))
 		}
-		tolerationMap[toleration] = true
+		tolerationMap[key] = true
 	}
 	return apiErrors.NewAggregate(allErr)
 }
 
+// tolerationKey is a comparable form of a toleration which compares the toleration seconds by value.
+type tolerationKey struct {
+	toleration           placementv1beta1.Toleration
+	tolerationSeconds    int64
+	hasTolerationSeconds bool
+}
+
+func keyOfToleration(toleration placementv1beta1.Toleration) tolerationKey {
+	key := tolerationKey{toleration: toleration}
+	key.toleration.TolerationSeconds = nil
+	if toleration.TolerationSeconds != nil {
+		key.tolerationSeconds = *toleration.TolerationSeconds
+		key.hasTolerationSeconds = true
+	}
+	return key
+}
+
 func IsTolerationsUpdatedOrDeleted(oldTolerations []placementv1beta1.Toleration, newTolerations []placementv1beta1.Toleration) bool {
-	newTolerationsMap := make(map[placementv1beta1.Toleration]bool)
+	newTolerationsMap := make(map[tolerationKey]bool)
 	for _, newToleration := range newTolerations {
-		newTolerationsMap[newToleration] = true
+		newTolerationsMap[keyOfToleration(newToleration)] = true
 	}
 	for _, oldToleration := range oldTolerations {
-		if !newTolerationsMap[oldToleration] {
+		if !newTolerationsMap[keyOfToleration(oldToleration)] {
 			return true
 		}
 	}
@@ -477,6 +534,22 @@ func validateRolloutStrategy(rolloutStrategy placementv1beta1.RolloutStrategy) e
 				allErr = append(allErr, fmt.Errorf("maxSurge must be greater than or equal to 0, got `%+v`", rolloutStrategy.RollingUpdate.MaxSurge))
 			}
 		}
+		for i, step := range rolloutStrategy.RollingUpdate.Steps {
+			value, err := intstr.GetScaledValueFromIntOrPercent(&step.Clusters, 10, true)
+			if err != nil {
+				allErr = append(allErr, fmt.Errorf("clusters `%s` of rollout step %d is invalid: %w", step.Clusters.String(), i, err))
+			}
+			if value < 0 {
+				allErr = append(allErr, fmt.Errorf("clusters of rollout step %d must be greater than or equal to 0, got `%s`", i, step.Clusters.String()))
+			}
+			if step.PauseSeconds != nil && *step.PauseSeconds < 0 {
+				allErr = append(allErr, fmt.Errorf("pauseSeconds of rollout step %d must be greater than or equal to 0, got %d", i, *step.PauseSeconds))
+			}
+		}
+	}
+
+	if rolloutStrategy.AutoUpdateRun != nil && rolloutStrategy.Type != placementv1beta1.ExternalRolloutStrategyType {
+		allErr = append(allErr, errors.New("autoUpdateRun is only valid for External rollout strategy type"))
 	}
 
 	// server-side apply strategy type is only valid for server-side apply strategy type
@@ -486,9 +559,29 @@ func validateRolloutStrategy(rolloutStrategy placementv1beta1.RolloutStrategy) e
 		}
 	}
 
+	if rolloutStrategy.ReportBackStrategy != nil {
+		for _, path := range rolloutStrategy.ReportBackStrategy.StatusPaths {
+			if err := validateStatusPath(path); err != nil {
+				allErr = append(allErr, fmt.Errorf("status path `%s` is invalid: %w", path, err))
+			}
+		}
+	}
+
 	return apiErrors.NewAggregate(allErr)
 }
 
+// validateStatusPath validates a JSONPath expression that selects status fields for projected
+// status back-reporting.
+func validateStatusPath(path string) error {
+	if path != ".status" && !strings.HasPrefix(path, ".status.") && !strings.HasPrefix(path, ".status[") {
+		return errors.New("the expression must select the status field or fields under it")
+	}
+	if err := jsonpath.New(path).Parse(fmt.Sprintf("{%s}", path)); err != nil {
+		return fmt.Errorf("failed to parse the expression: %w", err)
+	}
+	return nil
+}
+
 // validatePropertySelector validates the property selector
 func validatePropertySelector(propertySelector *placementv1beta1.PropertySelector) error {
 	return validatePropertySelectorRequirements(propertySelector.MatchExpressions)
@@ -500,7 +593,7 @@ func validatePropertySelectorRequirements(propertySelectorRequirements []placeme
 	// contradictions (e.g., Eq 5 alongside Eq 10, or Gt 10 alongside Lt 5).
 	byName := make(map[string][]placementv1beta1.PropertySelectorRequirement, len(propertySelectorRequirements))
 	for _, req := range propertySelectorRequirements {
-		if err := validateName(req.Name); err != nil {
+		if err := ValidatePropertyName(req.Name); err != nil {
 			allErr = append(allErr, fmt.Errorf("invalid property name %s: %w", req.Name, err))
 		}
 		if err := validateOperatorAndValues(req.Operator, req.Values); err != nil {
@@ -518,7 +611,7 @@ func validatePropertySelectorRequirements(propertySelectorRequirements []placeme
 
 func validatePropertySorter(propertySorter *placementv1beta1.PropertySorter) error {
 	var allErr []error
-	if err := validateName(propertySorter.Name); err != nil {
+	if err := ValidatePropertyName(propertySorter.Name); err != nil {
 		allErr = append(allErr, err)
 	}
 	if propertySorter.SortOrder != placementv1beta1.Descending && propertySorter.SortOrder != placementv1beta1.Ascending {
@@ -527,7 +620,8 @@ func validatePropertySorter(propertySorter *placementv1beta1.PropertySorter) err
 	return apiErrors.NewAggregate(allErr)
 }
 
-func validateName(name string) error {
+// ValidatePropertyName validates the name of a cluster property, resource or non-resource.
+func ValidatePropertyName(name string) error {
 	// we expect the resource property names to be in this format `[PREFIX]/[CAPACITY_TYPE]-[RESOURCE_NAME]`.
 	if strings.HasPrefix(name, propertyprovider.ResourcePropertyNamePrefix) {
 		resourcePropertyName, _ := strings.CutPrefix(name, propertyprovider.ResourcePropertyNamePrefix)
@@ -546,6 +640,25 @@ func validateName(name string) error {
 		return nil
 	}
 
+	// we expect the property history aggregate names to be in this format `[PREFIX]/[AGGREGATE]/[PROPERTY_NAME]`.
+	if strings.HasPrefix(name, propertyprovider.PropertyHistoryNamePrefix) {
+		aggregatePropertyName, _ := strings.CutPrefix(name, propertyprovider.PropertyHistoryNamePrefix)
+		aggregate, propertyName, found := strings.Cut(aggregatePropertyName, "/")
+		if !found || len(propertyName) == 0 {
+			return fmt.Errorf("invalid property history name %s, expected format is [PREFIX]/[AGGREGATE]/[PROPERTY_NAME]", name)
+		}
+		if !supportedPropertyHistoryAggregatesMap[aggregate] {
+			return fmt.Errorf("invalid aggregate in property history name %s, supported values are %+v", name, propertyHistoryAggregates)
+		}
+		if strings.HasPrefix(propertyName, propertyprovider.PropertyHistoryNamePrefix) {
+			return fmt.Errorf("invalid property history name %s, the aggregates cannot be nested", name)
+		}
+		if err := ValidatePropertyName(propertyName); err != nil {
+			return fmt.Errorf("invalid property history name %s: %w", name, err)
+		}
+		return nil
+	}
+
 	// For other properties, they should have a name that is formatted as follows:
 	//
 	// It should be a string of one or more segments, separated by slashes (/) if applicable;
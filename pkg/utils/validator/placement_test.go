@@ -25,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
@@ -414,6 +415,25 @@ func TestValidateClusterResourcePlacement_RolloutStrategy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "rollingUpdateConifg is not valid for ExternalRollout strategy type",
 		},
+		"valid rollout strategy - External strategy with autoUpdateRun config": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.ExternalRolloutStrategyType,
+				AutoUpdateRun: &placementv1beta1.AutoUpdateRunConfig{
+					StagedUpdateStrategyName: "test-strategy",
+				},
+			},
+			wantErr: false,
+		},
+		"invalid rollout strategy - RollingUpdate strategy with autoUpdateRun config": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				AutoUpdateRun: &placementv1beta1.AutoUpdateRunConfig{
+					StagedUpdateStrategyName: "test-strategy",
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "autoUpdateRun is only valid for External rollout strategy type",
+		},
 		"invalid rollout strategy": {
 			strategy: placementv1beta1.RolloutStrategy{
 				Type: "random type",
@@ -495,6 +515,44 @@ func TestValidateClusterResourcePlacement_RolloutStrategy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "maxSurge must be greater than or equal to 0, got `-10`",
 		},
+		"valid rollout strategy - rollout steps": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				RollingUpdate: &placementv1beta1.RollingUpdateConfig{
+					Steps: []placementv1beta1.RollingUpdateStep{
+						{Clusters: intstr.FromInt32(1), PauseSeconds: ptr.To(600)},
+						{Clusters: intstr.FromString("10%")},
+						{Clusters: intstr.FromString("50%"), PauseSeconds: ptr.To(0)},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"invalid rollout strategy - % error rollout step clusters": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				RollingUpdate: &placementv1beta1.RollingUpdateConfig{
+					Steps: []placementv1beta1.RollingUpdateStep{
+						{Clusters: intstr.FromInt32(1)},
+						{Clusters: intstr.FromString("10")},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "clusters `10` of rollout step 1 is invalid",
+		},
+		"invalid rollout strategy - negative rollout step pauseSeconds": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				RollingUpdate: &placementv1beta1.RollingUpdateConfig{
+					Steps: []placementv1beta1.RollingUpdateStep{
+						{Clusters: intstr.FromInt32(1), PauseSeconds: ptr.To(-1)},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "pauseSeconds of rollout step 0 must be greater than or equal to 0, got -1",
+		},
 		"invalid rollout strategy - ServerSideApplyConfig not valid when type is not serversideApply": {
 			strategy: placementv1beta1.RolloutStrategy{
 				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
@@ -508,6 +566,35 @@ func TestValidateClusterResourcePlacement_RolloutStrategy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "serverSideApplyConfig is only valid for ServerSideApply strategy type",
 		},
+		"valid rollout strategy - status paths": {
+			strategy: placementv1beta1.RolloutStrategy{
+				ReportBackStrategy: &placementv1beta1.ReportBackStrategy{
+					Type:        placementv1beta1.ReportBackStrategyTypeProjected,
+					StatusPaths: []string{".status", ".status.readyReplicas", ".status.conditions[*].type", ".status['loadBalancer']"},
+				},
+			},
+			wantErr: false,
+		},
+		"invalid rollout strategy - status path outside the status field": {
+			strategy: placementv1beta1.RolloutStrategy{
+				ReportBackStrategy: &placementv1beta1.ReportBackStrategy{
+					Type:        placementv1beta1.ReportBackStrategyTypeProjected,
+					StatusPaths: []string{".statusFoo"},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "status path `.statusFoo` is invalid: the expression must select the status field or fields under it",
+		},
+		"invalid rollout strategy - unparsable status path": {
+			strategy: placementv1beta1.RolloutStrategy{
+				ReportBackStrategy: &placementv1beta1.ReportBackStrategy{
+					Type:        placementv1beta1.ReportBackStrategyTypeProjected,
+					StatusPaths: []string{".status.readyReplicas", ".status.conditions["},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "status path `.status.conditions[` is invalid: failed to parse the expression",
+		},
 	}
 
 	for testName, testCase := range tests {
@@ -722,6 +809,19 @@ func TestValidateClusterResourcePlacement_PickAllPlacementPolicy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "PreferredDuringSchedulingIgnoredDuringExecution will be ignored for placement policy type PickAll",
 		},
+		"invalid placement policy - PickAll with cluster proximity": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterProximity: &placementv1beta1.ClusterProximity{
+						Reference: "eastus",
+						Weight:    100,
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "cluster proximity will be ignored for placement policy type PickAll",
+		},
 		"invalid placement policy - PickAll with non empty topology constraints": {
 			policy: &placementv1beta1.PlacementPolicy{
 				PlacementType: placementv1beta1.PickAllPlacementType,
@@ -774,6 +874,16 @@ func TestValidateClusterResourcePlacement_PickAllPlacementPolicy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "toleration value needs to be empty, when operator is Exists",
 		},
+		"invalid placement policy - PickAll placementType, non nil failover": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				Failover: &placementv1beta1.FailoverPolicy{
+					Type: placementv1beta1.AutomaticFailoverType,
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "failover must be nil for policy type PickAll, only valid for PickN policy type",
+		},
 		"valid placement policy - PickAll with property selector in RequiredDuringSchedulingIgnoredDuringExecution affinity": {
 			policy: &placementv1beta1.PlacementPolicy{
 				PlacementType: placementv1beta1.PickAllPlacementType,
@@ -865,6 +975,83 @@ func TestValidateClusterResourcePlacement_PickAllPlacementPolicy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "invalid capacity type in resource property name resources.kubernetes-fleet.io/node-count, supported values are [allocatable available total]",
 		},
+		"valid placement policy - PickAll with property history aggregate in property selector": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									PropertySelector: &placementv1beta1.PropertySelector{
+										MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+											{
+												Name:     "history.kubernetes-fleet.io/p95/resources.kubernetes-fleet.io/available-cpu",
+												Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
+												Values:   []string{"2"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"invalid placement policy - PickAll with invalid property selector name, invalid property history aggregate": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									PropertySelector: &placementv1beta1.PropertySelector{
+										MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+											{
+												Name:     "history.kubernetes-fleet.io/p99/resources.kubernetes-fleet.io/available-cpu",
+												Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
+												Values:   []string{"2"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "invalid aggregate in property history name history.kubernetes-fleet.io/p99/resources.kubernetes-fleet.io/available-cpu, supported values are [max min p50 p95]",
+		},
+		"invalid placement policy - PickAll with invalid property selector name, invalid property in property history name": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									PropertySelector: &placementv1beta1.PropertySelector{
+										MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+											{
+												Name:     "history.kubernetes-fleet.io/min/resources.kubernetes-fleet.io/used-cpu",
+												Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
+												Values:   []string{"2"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "invalid capacity type in resource property name resources.kubernetes-fleet.io/used-cpu",
+		},
 		"invalid placement policy - PickAll with invalid property selector name, no segments": {
 			policy: &placementv1beta1.PlacementPolicy{
 				PlacementType: placementv1beta1.PickAllPlacementType,
@@ -951,6 +1138,58 @@ func TestValidateClusterResourcePlacement_PickNPlacementPolicy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "cluster names needs to be empty for policy type PickN, only valid for PickFixed policy type",
 		},
+		"valid placement policy - PickN with cluster proximity": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterProximity: &placementv1beta1.ClusterProximity{
+						Reference: "member-2",
+						Weight:    50,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"invalid placement policy - PickN with cluster proximity, invalid reference": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterProximity: &placementv1beta1.ClusterProximity{
+						Reference: "regions/eastus",
+						Weight:    50,
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "cluster proximity reference regions/eastus is not valid",
+		},
+		"invalid placement policy - PickN with cluster proximity, invalid weight": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterProximity: &placementv1beta1.ClusterProximity{
+						Reference: "member-2",
+						Weight:    0,
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "cluster proximity weight 0 is not valid, must be in the range [1, 100]",
+		},
+		"valid placement policy - PickN with failover": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Failover: &placementv1beta1.FailoverPolicy{
+					Type:         placementv1beta1.AutomaticFailoverType,
+					AfterSeconds: ptr.To(int32(600)),
+				},
+			},
+			wantErr: false,
+		},
 		"invalid placement policy - PickN with nil number of clusters": {
 			policy: &placementv1beta1.PlacementPolicy{
 				PlacementType: placementv1beta1.PickNPlacementType,
@@ -1747,6 +1986,47 @@ func TestValidateTolerations(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "tolerations must be unique",
 		},
+		"valid toleration, toleration seconds with NoExecute effect": {
+			tolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+			},
+			wantErr: false,
+		},
+		"invalid toleration, toleration seconds with NoSchedule effect": {
+			tolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoSchedule,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "toleration effect must be NoExecute, when tolerationSeconds is set",
+		},
+		"invalid toleration, non-unique toleration with toleration seconds": {
+			tolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "tolerations must be unique",
+		},
 	}
 	for testName, testCase := range tests {
 		t.Run(testName, func(t *testing.T) {
@@ -1881,6 +2161,44 @@ func TestIsTolerationsUpdatedOrDeleted(t *testing.T) {
 			},
 			want: false,
 		},
+		"old tolerations, new tolerations have the same toleration seconds": {
+			oldTolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+			},
+			newTolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+			},
+			want: false,
+		},
+		"toleration seconds was updated in new tolerations": {
+			oldTolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+			},
+			newTolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(600)),
+				},
+			},
+			want: true,
+		},
 		"a toleration was added to new tolerations": {
 			oldTolerations: []placementv1beta1.Toleration{
 				{
@@ -2576,6 +2576,26 @@ var _ = Describe("Test placement v1beta1 API validation", func() {
 			Expect(hubClient.Delete(ctx, &strategy)).Should(Succeed())
 		})
 
+		It("Should allow creation of ClusterStagedUpdateStrategy with MaxFailedClusters as 0%", func() {
+			maxFailedClusters := intstr.FromString("0%")
+			strategy := placementv1beta1.ClusterStagedUpdateStrategy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: fmt.Sprintf(updateRunStrategyNameTemplate, GinkgoParallelProcess()),
+				},
+				Spec: placementv1beta1.UpdateStrategySpec{
+					Stages: []placementv1beta1.StageConfig{
+						{
+							Name:              fmt.Sprintf(updateRunStageNameTemplate, GinkgoParallelProcess(), 1),
+							MaxFailedClusters: &maxFailedClusters,
+						},
+					},
+				},
+			}
+			Expect(hubClient.Create(ctx, &strategy)).Should(Succeed())
+			Expect(*strategy.Spec.Stages[0].MaxFailedClusters).Should(Equal(maxFailedClusters))
+			Expect(hubClient.Delete(ctx, &strategy)).Should(Succeed())
+		})
+
 		It("Should allow creation of ClusterStagedUpdateStrategy with waitTime set to '0s'", func() {
 			obj := &unstructured.Unstructured{
 				Object: map[string]interface{}{
@@ -3044,6 +3064,48 @@ var _ = Describe("Test placement v1beta1 API validation", func() {
 			Expect(statusErr.ErrStatus.Message).Should(MatchRegexp("spec.stages\\[0\\].maxConcurrency in body should match"))
 		})
 
+		It("Should deny creation of ClusterStagedUpdateStrategy with MaxFailedClusters set to a negative value", func() {
+			maxFailedClusters := intstr.FromInt(-1)
+			strategy := placementv1beta1.ClusterStagedUpdateStrategy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: fmt.Sprintf(updateRunStrategyNameTemplate, GinkgoParallelProcess()),
+				},
+				Spec: placementv1beta1.UpdateStrategySpec{
+					Stages: []placementv1beta1.StageConfig{
+						{
+							Name:              fmt.Sprintf(updateRunStageNameTemplate, GinkgoParallelProcess(), 1),
+							MaxFailedClusters: &maxFailedClusters,
+						},
+					},
+				},
+			}
+			err := hubClient.Create(ctx, &strategy)
+			var statusErr *k8sErrors.StatusError
+			Expect(errors.As(err, &statusErr)).To(BeTrue(), fmt.Sprintf("Create updateRunStrategy call produced error %s. Error type wanted is %s.", reflect.TypeOf(err), reflect.TypeOf(&k8sErrors.StatusError{})))
+			Expect(statusErr.ErrStatus.Message).Should(MatchRegexp("maxFailedClusters must be at least 0"))
+		})
+
+		It("Should deny creation of ClusterStagedUpdateStrategy with MaxFailedClusters set to 101%", func() {
+			maxFailedClusters := intstr.FromString("101%")
+			strategy := placementv1beta1.ClusterStagedUpdateStrategy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: fmt.Sprintf(updateRunStrategyNameTemplate, GinkgoParallelProcess()),
+				},
+				Spec: placementv1beta1.UpdateStrategySpec{
+					Stages: []placementv1beta1.StageConfig{
+						{
+							Name:              fmt.Sprintf(updateRunStageNameTemplate, GinkgoParallelProcess(), 1),
+							MaxFailedClusters: &maxFailedClusters,
+						},
+					},
+				},
+			}
+			err := hubClient.Create(ctx, &strategy)
+			var statusErr *k8sErrors.StatusError
+			Expect(errors.As(err, &statusErr)).To(BeTrue(), fmt.Sprintf("Create updateRunStrategy call produced error %s. Error type wanted is %s.", reflect.TypeOf(err), reflect.TypeOf(&k8sErrors.StatusError{})))
+			Expect(statusErr.ErrStatus.Message).Should(MatchRegexp("spec.stages\\[0\\].maxFailedClusters in body should match"))
+		})
+
 		It("Should deny creation of ClusterStagedUpdateStrategy with invalid waitTime '0abc'", func() {
 			obj := &unstructured.Unstructured{
 				Object: map[string]interface{}{
@@ -32,7 +32,6 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/test/e2e/framework"
 	testutilseviction "github.com/kubefleet-dev/kubefleet/test/utils/eviction"
-	toolsutils "github.com/kubefleet-dev/kubefleet/tools/utils"
 )
 
 var _ = Describe("Drain cluster successfully", Ordered, Serial, func() {
@@ -60,7 +59,7 @@ var _ = Describe("Drain cluster successfully", Ordered, Serial, func() {
 		for _, eviction := range drainEvictions {
 			ensureCRPEvictionDeleted(eviction.Name)
 		}
-		// remove taints from member cluster 1 again to guarantee clean up of cordon taint on test failure.
+		// remove taints from member cluster 1 again to guarantee clean up of maintenance on test failure.
 		removeTaintsFromMemberClusters([]string{memberCluster1EastProdName})
 		ensureCRPAndRelatedResourcesDeleted(crpName, allMemberClusters)
 	})
@@ -74,9 +73,9 @@ var _ = Describe("Drain cluster successfully", Ordered, Serial, func() {
 
 	It("drain cluster using binary, should succeed", func() { runDrainClusterBinary(hubClusterName, memberCluster1EastProdName) })
 
-	It("should update member cluster with cordon taint", func() {
-		taintAddedActual := memberClusterCordonTaintAddedActual(memberCluster1EastProdName)
-		Eventually(taintAddedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to add cordon taint to member cluster")
+	It("should update member cluster with maintenance taint", func() {
+		taintAddedActual := memberClusterMaintenanceTaintAddedActual(memberCluster1EastProdName)
+		Eventually(taintAddedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to add maintenance taint to member cluster")
 	})
 
 	It("should update drain cluster resource placement evictions status as expected", func() {
@@ -114,9 +113,9 @@ var _ = Describe("Drain cluster successfully", Ordered, Serial, func() {
 
 	It("uncordon cluster using binary", func() { runUncordonClusterBinary(hubClusterName, memberCluster1EastProdName) })
 
-	It("should remove cordon taint from member cluster", func() {
-		taintRemovedActual := memberClusterCordonTaintRemovedActual(memberCluster1EastProdName)
-		Eventually(taintRemovedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to remove cordon taint from member cluster")
+	It("should remove maintenance taint from member cluster", func() {
+		taintRemovedActual := memberClusterMaintenanceTaintRemovedActual(memberCluster1EastProdName)
+		Eventually(taintRemovedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to remove maintenance taint from member cluster")
 	})
 })
 
@@ -139,7 +138,7 @@ var _ = Describe("Drain cluster blocked - ClusterResourcePlacementDisruptionBudg
 		for _, eviction := range drainEvictions {
 			ensureCRPEvictionDeleted(eviction.Name)
 		}
-		// remove taints from member cluster 1 again to guarantee clean up of cordon taint on test failure.
+		// remove taints from member cluster 1 again to guarantee clean up of maintenance on test failure.
 		removeTaintsFromMemberClusters([]string{memberCluster1EastProdName})
 		ensureCRPDisruptionBudgetDeleted(crpName)
 		ensureCRPAndRelatedResourcesDeleted(crpName, allMemberClusters)
@@ -169,9 +168,9 @@ var _ = Describe("Drain cluster blocked - ClusterResourcePlacementDisruptionBudg
 
 	It("drain cluster using binary, should fail due to CRPDB", func() { runDrainClusterBinary(hubClusterName, memberCluster1EastProdName) })
 
-	It("should update member cluster with cordon taint", func() {
-		taintAddedActual := memberClusterCordonTaintAddedActual(memberCluster1EastProdName)
-		Eventually(taintAddedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to add cordon taint to member cluster")
+	It("should update member cluster with maintenance taint", func() {
+		taintAddedActual := memberClusterMaintenanceTaintAddedActual(memberCluster1EastProdName)
+		Eventually(taintAddedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to add maintenance taint to member cluster")
 	})
 
 	It("should update drain cluster resource placement evictions status as expected", func() {
@@ -197,9 +196,9 @@ var _ = Describe("Drain cluster blocked - ClusterResourcePlacementDisruptionBudg
 
 	It("uncordon cluster using binary", func() { runUncordonClusterBinary(hubClusterName, memberCluster1EastProdName) })
 
-	It("should remove cordon taint from member cluster", func() {
-		taintRemovedActual := memberClusterCordonTaintRemovedActual(memberCluster1EastProdName)
-		Eventually(taintRemovedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to remove cordon taint from member cluster")
+	It("should remove maintenance taint from member cluster", func() {
+		taintRemovedActual := memberClusterMaintenanceTaintRemovedActual(memberCluster1EastProdName)
+		Eventually(taintRemovedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to remove maintenance taint from member cluster")
 	})
 })
 
@@ -228,7 +227,7 @@ var _ = Describe("Drain is allowed on one cluster, blocked on others - ClusterRe
 		for _, eviction := range drainEvictions {
 			ensureCRPEvictionDeleted(eviction.Name)
 		}
-		// remove taints from member clusters 1,2 again to guarantee clean up of cordon taint on test failure.
+		// remove taints from member clusters 1,2 again to guarantee clean up of maintenance on test failure.
 		removeTaintsFromMemberClusters([]string{memberCluster1EastProdName, memberCluster2EastCanaryName})
 		ensureCRPDisruptionBudgetDeleted(crpName)
 		ensureCRPAndRelatedResourcesDeleted(crpName, allMemberClusters)
@@ -258,9 +257,9 @@ var _ = Describe("Drain is allowed on one cluster, blocked on others - ClusterRe
 
 	It("drain cluster using binary, should succeed", func() { runDrainClusterBinary(hubClusterName, memberCluster1EastProdName) })
 
-	It("should update member cluster with cordon taint", func() {
-		taintAddedActual := memberClusterCordonTaintAddedActual(memberCluster1EastProdName)
-		Eventually(taintAddedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to add cordon taint to member cluster")
+	It("should update member cluster with maintenance taint", func() {
+		taintAddedActual := memberClusterMaintenanceTaintAddedActual(memberCluster1EastProdName)
+		Eventually(taintAddedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to add maintenance taint to member cluster")
 	})
 
 	It("should update drain cluster resource placement evictions status as expected", func() {
@@ -304,9 +303,9 @@ var _ = Describe("Drain is allowed on one cluster, blocked on others - ClusterRe
 
 	It("drain cluster using binary, should fail due to CRPDB", func() { runDrainClusterBinary(hubClusterName, memberCluster2EastCanaryName) })
 
-	It("should update member cluster with cordon taint", func() {
-		taintAddedActual := memberClusterCordonTaintAddedActual(memberCluster2EastCanaryName)
-		Eventually(taintAddedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to add cordon taint to member cluster")
+	It("should update member cluster with maintenance taint", func() {
+		taintAddedActual := memberClusterMaintenanceTaintAddedActual(memberCluster2EastCanaryName)
+		Eventually(taintAddedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to add maintenance taint to member cluster")
 	})
 
 	It("should update drain cluster resource placement evictions status as expected", func() {
@@ -344,24 +343,27 @@ var _ = Describe("Drain is allowed on one cluster, blocked on others - ClusterRe
 
 	It("uncordon cluster using binary", func() { runUncordonClusterBinary(hubClusterName, memberCluster1EastProdName) })
 
-	It("should remove cordon taint from member cluster", func() {
-		taintRemovedActual := memberClusterCordonTaintRemovedActual(memberCluster1EastProdName)
-		Eventually(taintRemovedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to remove cordon taint from member cluster")
+	It("should remove maintenance taint from member cluster", func() {
+		taintRemovedActual := memberClusterMaintenanceTaintRemovedActual(memberCluster1EastProdName)
+		Eventually(taintRemovedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to remove maintenance taint from member cluster")
 	})
 
 	It("uncordon cluster using binary", func() { runUncordonClusterBinary(hubClusterName, memberCluster2EastCanaryName) })
 
-	It("should remove cordon taint from member cluster", func() {
-		taintRemovedActual := memberClusterCordonTaintRemovedActual(memberCluster2EastCanaryName)
-		Eventually(taintRemovedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to remove cordon taint from member cluster")
+	It("should remove maintenance taint from member cluster", func() {
+		taintRemovedActual := memberClusterMaintenanceTaintRemovedActual(memberCluster2EastCanaryName)
+		Eventually(taintRemovedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to remove maintenance taint from member cluster")
 	})
 })
 
 func runDrainClusterBinary(hubClusterName, memberClusterName string) {
 	By(fmt.Sprintf("draining cluster %s", memberClusterName))
+	// The drain command waits for the member cluster to be drained; the evictions blocked by disruption budgets are
+	// checked by the tests instead.
 	cmd := exec.Command(fleetBinaryPath, "draincluster",
 		"--hub-cluster-context", hubClusterName,
-		"--cluster-name", memberClusterName)
+		"--cluster-name", memberClusterName,
+		"--timeout", "1m")
 	_, err := cmd.CombinedOutput()
 	Expect(err).ToNot(HaveOccurred(), "Drain command failed with error: %v", err)
 }
@@ -391,7 +393,7 @@ func fetchDrainEvictions(crpName, clusterName string, testStartTime time.Time) (
 	return filteredDrainEvictions, nil
 }
 
-func memberClusterCordonTaintAddedActual(mcName string) func() error {
+func memberClusterMaintenanceTaintAddedActual(mcName string) func() error {
 	return func() error {
 		var mc clusterv1beta1.MemberCluster
 		if err := hubClient.Get(ctx, types.NamespacedName{Name: mcName}, &mc); err != nil {
@@ -399,24 +401,27 @@ func memberClusterCordonTaintAddedActual(mcName string) func() error {
 		}
 
 		for _, taint := range mc.Spec.Taints {
-			if taint == toolsutils.CordonTaint {
+			if taint.Key == clusterv1beta1.MaintenanceTaintKey {
 				return nil
 			}
 		}
-		return fmt.Errorf("cordon taint not found on member cluster %s", mcName)
+		return fmt.Errorf("maintenance taint not found on member cluster %s", mcName)
 	}
 }
 
-func memberClusterCordonTaintRemovedActual(mcName string) func() error {
+func memberClusterMaintenanceTaintRemovedActual(mcName string) func() error {
 	return func() error {
 		var mc clusterv1beta1.MemberCluster
 		if err := hubClient.Get(ctx, types.NamespacedName{Name: mcName}, &mc); err != nil {
 			return fmt.Errorf("failed to get member cluster %s: %w", mcName, err)
 		}
 
+		if mc.Spec.Maintenance != nil {
+			return fmt.Errorf("maintenance found on member cluster %s", mcName)
+		}
 		for _, taint := range mc.Spec.Taints {
-			if taint == toolsutils.CordonTaint {
-				return fmt.Errorf("cordon taint found on member cluster %s", mcName)
+			if taint.Key == clusterv1beta1.MaintenanceTaintKey {
+				return fmt.Errorf("maintenance taint found on member cluster %s", mcName)
 			}
 		}
 		return nil
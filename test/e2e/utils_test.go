@@ -1581,6 +1581,7 @@ func removeTaintsFromMemberClusters(memberClusterNames []string) {
 				return err
 			}
 			mc.Spec.Taints = nil
+			mc.Spec.Maintenance = nil
 			return hubClient.Update(ctx, &mc)
 		}, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to remove taints from member cluster %s", clusterName)
 	}
@@ -51,6 +51,9 @@ var approveKindConfigs = []approveKindConfig{
 			if o.namespace != "" {
 				return fmt.Errorf("%s is cluster-scoped and does not accept a namespace", fleetcmd.KindClusterApprovalRequest)
 			}
+			if o.cluster != "" {
+				return fmt.Errorf("%s does not accept a cluster", fleetcmd.KindClusterApprovalRequest)
+			}
 			return nil
 		},
 		handler: (*approveOptions).approveClusterApprovalRequest,
@@ -64,10 +67,45 @@ var approveKindConfigs = []approveKindConfig{
 			if o.namespace == "" {
 				return fmt.Errorf("namespace is required for %s (use --namespace or -n flag)", fleetcmd.KindApprovalRequest)
 			}
+			if o.cluster != "" {
+				return fmt.Errorf("%s does not accept a cluster", fleetcmd.KindApprovalRequest)
+			}
 			return nil
 		},
 		handler: (*approveOptions).approveApprovalRequest,
 	},
+	{
+		KindConfig: fleetcmd.KindConfig{
+			Canonical: fleetcmd.KindClusterStagedUpdateRun,
+			Aliases:   []string{fleetcmd.AliasClusterStagedUpdateRun},
+		},
+		validate: func(o *approveOptions) error {
+			if o.namespace != "" {
+				return fmt.Errorf("%s is cluster-scoped and does not accept a namespace", fleetcmd.KindClusterStagedUpdateRun)
+			}
+			if o.cluster == "" {
+				return fmt.Errorf("cluster is required for %s (use --cluster flag)", fleetcmd.KindClusterStagedUpdateRun)
+			}
+			return nil
+		},
+		handler: (*approveOptions).approveClusterInUpdateRun,
+	},
+	{
+		KindConfig: fleetcmd.KindConfig{
+			Canonical: fleetcmd.KindStagedUpdateRun,
+			Aliases:   []string{fleetcmd.AliasStagedUpdateRun},
+		},
+		validate: func(o *approveOptions) error {
+			if o.namespace == "" {
+				return fmt.Errorf("namespace is required for %s (use --namespace or -n flag)", fleetcmd.KindStagedUpdateRun)
+			}
+			if o.cluster == "" {
+				return fmt.Errorf("cluster is required for %s (use --cluster flag)", fleetcmd.KindStagedUpdateRun)
+			}
+			return nil
+		},
+		handler: (*approveOptions).approveClusterInUpdateRun,
+	},
 }
 
 // approveKinds maps canonical kind names and aliases to their approveKindConfig.
@@ -87,6 +125,8 @@ type approveOptions struct {
 	hubClusterContext string
 	name              string
 	namespace         string
+	cluster           string
+	skip              bool
 	timeout           time.Duration
 
 	hubClient client.Client
@@ -101,13 +141,22 @@ func NewCmdApprove() *cobra.Command {
 		Long: `Approve a resource by updating its status with an "Approved" condition.
 
 This command updates the approval request status with an "Approved" condition,
-allowing staged update runs to proceed to the next stage.
+allowing staged update runs to proceed to the next stage or cluster.
+
+For a staged update run, this command promotes a member cluster in the update run
+(specified with the --cluster flag), so that the cluster is updated right away
+regardless of the max concurrency of its stage or of its pending approval.
+
+With the --skip flag, the cluster targeted by the approval request or specified
+with the --cluster flag is skipped instead, and it's not updated by the update run.
 
 Supported kinds:
   clusterapprovalrequest (careq) - Approve a ClusterApprovalRequest (cluster-scoped)
   approvalrequest (areq)         - Approve an ApprovalRequest (namespace-scoped)
+  clusterstagedupdaterun (csur)  - Promote a cluster in a ClusterStagedUpdateRun (cluster-scoped)
+  stagedupdaterun (sur)          - Promote a cluster in a StagedUpdateRun (namespace-scoped)
 
-For namespace-scoped resources (approvalrequest), you must also specify the --namespace flag.`,
+For namespace-scoped resources (approvalrequest, stagedupdaterun), you must also specify the --namespace flag.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cfg, err := fleetcmd.ResolveKind(args[0], approveKinds)
@@ -129,6 +178,8 @@ For namespace-scoped resources (approvalrequest), you must also specify the --na
 	cmd.Flags().StringVar(&o.hubClusterContext, "hub-cluster-context", "", "The name of the kubeconfig context to use for the hub cluster")
 	cmd.Flags().StringVar(&o.name, "name", "", "The name of the resource to approve")
 	cmd.Flags().StringVarP(&o.namespace, "namespace", "n", "", "The namespace of the resource to approve (required for namespace-scoped resources)")
+	cmd.Flags().StringVar(&o.cluster, "cluster", "", "The name of the member cluster to promote or skip (required for staged update runs)")
+	cmd.Flags().BoolVar(&o.skip, "skip", false, "Skip the cluster instead of approving or promoting it")
 	cmd.Flags().DurationVar(&o.timeout, "timeout", 5*time.Minute, "Maximum time to wait for the operation to complete")
 
 	// Mark required flags.
@@ -158,17 +209,12 @@ func (o *approveOptions) approveClusterApprovalRequest(ctx context.Context) erro
 			return fmt.Errorf("failed to get ClusterApprovalRequest %q: %w", o.name, err)
 		}
 
-		// Add the Approved condition.
-		approvedCondition := metav1.Condition{
-			Type:               string(placementv1beta1.ApprovalRequestConditionApproved),
-			Status:             metav1.ConditionTrue,
-			Reason:             "ClusterApprovalRequestApproved",
-			Message:            "ClusterApprovalRequest has been approved",
-			ObservedGeneration: car.Generation,
+		if o.skip && car.Spec.TargetCluster == "" {
+			return fmt.Errorf("ClusterApprovalRequest %q does not target a cluster and cannot be skipped", o.name)
 		}
 
-		// Update or add the condition.
-		meta.SetStatusCondition(&car.Status.Conditions, approvedCondition)
+		// Update or add the Approved or Skipped condition.
+		meta.SetStatusCondition(&car.Status.Conditions, o.approvalCondition("ClusterApprovalRequest", car.Generation))
 
 		return o.hubClient.Status().Update(ctx, &car)
 	})
@@ -176,7 +222,7 @@ func (o *approveOptions) approveClusterApprovalRequest(ctx context.Context) erro
 		return fmt.Errorf("failed to approve ClusterApprovalRequest %q: %w", o.name, err)
 	}
 
-	log.Printf("ClusterApprovalRequest %q approved successfully\n", o.name)
+	log.Printf("ClusterApprovalRequest %q %s successfully\n", o.name, o.action())
 	return nil
 }
 
@@ -188,17 +234,12 @@ func (o *approveOptions) approveApprovalRequest(ctx context.Context) error {
 			return fmt.Errorf("failed to get ApprovalRequest %q in namespace %q: %w", o.name, o.namespace, err)
 		}
 
-		// Add the Approved condition.
-		approvedCondition := metav1.Condition{
-			Type:               string(placementv1beta1.ApprovalRequestConditionApproved),
-			Status:             metav1.ConditionTrue,
-			Reason:             "ApprovalRequestApproved",
-			Message:            "ApprovalRequest has been approved",
-			ObservedGeneration: ar.Generation,
+		if o.skip && ar.Spec.TargetCluster == "" {
+			return fmt.Errorf("ApprovalRequest %q in namespace %q does not target a cluster and cannot be skipped", o.name, o.namespace)
 		}
 
-		// Update or add the condition.
-		meta.SetStatusCondition(&ar.Status.Conditions, approvedCondition)
+		// Update or add the Approved or Skipped condition.
+		meta.SetStatusCondition(&ar.Status.Conditions, o.approvalCondition("ApprovalRequest", ar.Generation))
 
 		return o.hubClient.Status().Update(ctx, &ar)
 	})
@@ -206,10 +247,39 @@ func (o *approveOptions) approveApprovalRequest(ctx context.Context) error {
 		return fmt.Errorf("failed to approve ApprovalRequest %q in namespace %q: %w", o.name, o.namespace, err)
 	}
 
-	log.Printf("ApprovalRequest %q in namespace %q approved successfully\n", o.name, o.namespace)
+	log.Printf("ApprovalRequest %q in namespace %q %s successfully\n", o.name, o.namespace, o.action())
 	return nil
 }
 
+// approvalCondition returns the condition to add to an approval request of the given kind, which is the Skipped
+// condition if the cluster targeted by the approval request is skipped, or the Approved condition otherwise.
+func (o *approveOptions) approvalCondition(kind string, generation int64) metav1.Condition {
+	if o.skip {
+		return metav1.Condition{
+			Type:               string(placementv1beta1.ApprovalRequestConditionSkipped),
+			Status:             metav1.ConditionTrue,
+			Reason:             kind + "Skipped",
+			Message:            kind + " has been skipped",
+			ObservedGeneration: generation,
+		}
+	}
+	return metav1.Condition{
+		Type:               string(placementv1beta1.ApprovalRequestConditionApproved),
+		Status:             metav1.ConditionTrue,
+		Reason:             kind + "Approved",
+		Message:            kind + " has been approved",
+		ObservedGeneration: generation,
+	}
+}
+
+// action returns the past tense of the action taken on the approval request.
+func (o *approveOptions) action() string {
+	if o.skip {
+		return "skipped"
+	}
+	return "approved"
+}
+
 // setupClient creates and configures the Kubernetes client
 func (o *approveOptions) setupClient() error {
 	scheme, err := toolsutils.NewFleetScheme()
@@ -104,6 +104,55 @@ func TestValidate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "approvalrequest with cluster should fail",
+			kind: fleetcmd.KindApprovalRequest,
+			opts: approveOptions{
+				name:      "test-name",
+				namespace: "test-namespace",
+				cluster:   "test-cluster",
+			},
+			wantErr:    true,
+			wantErrMsg: "does not accept a cluster",
+		},
+		{
+			name: "clusterstagedupdaterun with cluster is valid",
+			kind: fleetcmd.AliasClusterStagedUpdateRun,
+			opts: approveOptions{
+				name:    "test-name",
+				cluster: "test-cluster",
+			},
+			wantErr: false,
+		},
+		{
+			name: "clusterstagedupdaterun without cluster should fail",
+			kind: fleetcmd.KindClusterStagedUpdateRun,
+			opts: approveOptions{
+				name: "test-name",
+			},
+			wantErr:    true,
+			wantErrMsg: "cluster is required for",
+		},
+		{
+			name: "stagedupdaterun without namespace should fail",
+			kind: fleetcmd.KindStagedUpdateRun,
+			opts: approveOptions{
+				name:    "test-name",
+				cluster: "test-cluster",
+			},
+			wantErr:    true,
+			wantErrMsg: "namespace is required for",
+		},
+		{
+			name: "stagedupdaterun with namespace and cluster is valid",
+			kind: fleetcmd.AliasStagedUpdateRun,
+			opts: approveOptions{
+				name:      "test-name",
+				namespace: "test-namespace",
+				cluster:   "test-cluster",
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tc := range tests {
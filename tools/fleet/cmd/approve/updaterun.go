@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approve
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// approveClusterInUpdateRun promotes or skips a member cluster in a staged update run (cluster-scoped if no
+// namespace is specified) by approving or skipping the per-cluster approval request of the cluster, which is
+// created if it does not exist yet.
+func (o *approveOptions) approveClusterInUpdateRun(ctx context.Context) error {
+	var updateRun placementv1beta1.UpdateRunObj
+	kind := "ClusterApprovalRequest"
+	if o.namespace == "" {
+		updateRun = &placementv1beta1.ClusterStagedUpdateRun{}
+	} else {
+		updateRun = &placementv1beta1.StagedUpdateRun{}
+		kind = "ApprovalRequest"
+	}
+	if err := o.hubClient.Get(ctx, types.NamespacedName{Name: o.name, Namespace: o.namespace}, updateRun); err != nil {
+		return fmt.Errorf("failed to get update run %q: %w", o.name, err)
+	}
+	stageName, err := findStageOfCluster(updateRun, o.cluster)
+	if err != nil {
+		return err
+	}
+
+	approvalRequest := buildClusterApprovalRequest(o.namespace, o.name, stageName, o.cluster)
+	if err := o.hubClient.Create(ctx, approvalRequest); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create %s %q: %w", kind, approvalRequest.GetName(), err)
+	}
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := o.hubClient.Get(ctx, types.NamespacedName{Name: approvalRequest.GetName(), Namespace: o.namespace}, approvalRequest); err != nil {
+			return fmt.Errorf("failed to get %s %q: %w", kind, approvalRequest.GetName(), err)
+		}
+		spec := approvalRequest.GetApprovalRequestSpec()
+		if spec.TargetUpdateRun != o.name || spec.TargetStage != stageName || spec.TargetCluster != o.cluster {
+			return fmt.Errorf("%s %q does not target cluster %q in stage %q of update run %q", kind, approvalRequest.GetName(), o.cluster, stageName, o.name)
+		}
+
+		// Update or add the Approved or Skipped condition.
+		status := approvalRequest.GetApprovalRequestStatus()
+		meta.SetStatusCondition(&status.Conditions, o.approvalCondition(kind, approvalRequest.GetGeneration()))
+		return o.hubClient.Status().Update(ctx, approvalRequest)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to approve cluster %q in update run %q: %w", o.cluster, o.name, err)
+	}
+
+	action := "promoted"
+	if o.skip {
+		action = "skipped"
+	}
+	log.Printf("Cluster %q in update run %q %s successfully\n", o.cluster, o.name, action)
+	return nil
+}
+
+// findStageOfCluster returns the name of the stage of a cluster in the update run.
+// It returns an error if the cluster is not found or has already started updating.
+func findStageOfCluster(updateRun placementv1beta1.UpdateRunObj, clusterName string) (string, error) {
+	for _, stage := range updateRun.GetUpdateRunStatus().StagesStatus {
+		for _, cluster := range stage.Clusters {
+			if cluster.ClusterName != clusterName {
+				continue
+			}
+			if meta.FindStatusCondition(cluster.Conditions, string(placementv1beta1.ClusterUpdatingConditionSucceeded)) != nil {
+				return "", fmt.Errorf("cluster %q in update run %q has already finished updating", clusterName, updateRun.GetName())
+			}
+			if meta.IsStatusConditionTrue(cluster.Conditions, string(placementv1beta1.ClusterUpdatingConditionStarted)) {
+				return "", fmt.Errorf("cluster %q in update run %q has already started updating", clusterName, updateRun.GetName())
+			}
+			return stage.StageName, nil
+		}
+	}
+	return "", fmt.Errorf("cluster %q is not found in the stages of update run %q, the update run may not be initialized yet", clusterName, updateRun.GetName())
+}
+
+// buildClusterApprovalRequest builds the per-cluster approval request of a cluster in a staged update run.
+// It returns a ClusterApprovalRequest if namespace is empty, otherwise returns an ApprovalRequest.
+func buildClusterApprovalRequest(namespace, updateRunName, stageName, clusterName string) placementv1beta1.ApprovalRequestObj {
+	objectMeta := metav1.ObjectMeta{
+		Name:      fmt.Sprintf(placementv1beta1.ClusterApprovalTaskNameFmt, updateRunName, stageName, clusterName),
+		Namespace: namespace,
+		Labels: map[string]string{
+			placementv1beta1.TargetUpdatingStageNameLabel:   stageName,
+			placementv1beta1.TargetUpdateRunLabel:           updateRunName,
+			placementv1beta1.TaskTypeLabel:                  placementv1beta1.ClusterApprovalTaskLabelValue,
+			placementv1beta1.IsLatestUpdateRunApprovalLabel: "true",
+		},
+	}
+	spec := placementv1beta1.ApprovalRequestSpec{
+		TargetUpdateRun: updateRunName,
+		TargetStage:     stageName,
+		TargetCluster:   clusterName,
+	}
+	if namespace == "" {
+		return &placementv1beta1.ClusterApprovalRequest{ObjectMeta: objectMeta, Spec: spec}
+	}
+	return &placementv1beta1.ApprovalRequest{ObjectMeta: objectMeta, Spec: spec}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approve
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func TestApproveClusterInUpdateRun(t *testing.T) {
+	updateRunStatus := placementv1beta1.UpdateRunStatus{
+		StagesStatus: []placementv1beta1.StageUpdatingStatus{
+			{
+				StageName: "stage-1",
+				Clusters: []placementv1beta1.ClusterUpdatingStatus{
+					{
+						ClusterName: "started-cluster",
+						Conditions: []metav1.Condition{
+							{Type: string(placementv1beta1.ClusterUpdatingConditionStarted), Status: metav1.ConditionTrue, Reason: "Started"},
+						},
+					},
+					{
+						ClusterName: "finished-cluster",
+						Conditions: []metav1.Condition{
+							{Type: string(placementv1beta1.ClusterUpdatingConditionSucceeded), Status: metav1.ConditionTrue, Reason: "Succeeded"},
+						},
+					},
+				},
+			},
+			{
+				StageName: "stage-2",
+				Clusters: []placementv1beta1.ClusterUpdatingStatus{
+					{ClusterName: "member-1"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		namespace     string
+		clusterName   string
+		skip          bool
+		existingObjs  []client.Object
+		wantRequest   string
+		wantCondition *metav1.Condition
+		wantErrMsg    string
+	}{
+		{
+			name:        "promote a cluster in a ClusterStagedUpdateRun",
+			clusterName: "member-1",
+			existingObjs: []client.Object{
+				&placementv1beta1.ClusterStagedUpdateRun{ObjectMeta: metav1.ObjectMeta{Name: "test-run"}, Status: updateRunStatus},
+			},
+			wantRequest: "test-run-stage-2-cluster-member-1",
+			wantCondition: &metav1.Condition{
+				Type:    string(placementv1beta1.ApprovalRequestConditionApproved),
+				Status:  metav1.ConditionTrue,
+				Reason:  "ClusterApprovalRequestApproved",
+				Message: "ClusterApprovalRequest has been approved",
+			},
+		},
+		{
+			name:        "skip a cluster in a StagedUpdateRun",
+			namespace:   "test-namespace",
+			clusterName: "member-1",
+			skip:        true,
+			existingObjs: []client.Object{
+				&placementv1beta1.StagedUpdateRun{ObjectMeta: metav1.ObjectMeta{Name: "test-run", Namespace: "test-namespace"}, Status: updateRunStatus},
+			},
+			wantRequest: "test-run-stage-2-cluster-member-1",
+			wantCondition: &metav1.Condition{
+				Type:    string(placementv1beta1.ApprovalRequestConditionSkipped),
+				Status:  metav1.ConditionTrue,
+				Reason:  "ApprovalRequestSkipped",
+				Message: "ApprovalRequest has been skipped",
+			},
+		},
+		{
+			name:        "approve the existing approval request of a cluster",
+			clusterName: "member-1",
+			existingObjs: []client.Object{
+				&placementv1beta1.ClusterStagedUpdateRun{ObjectMeta: metav1.ObjectMeta{Name: "test-run"}, Status: updateRunStatus},
+				buildClusterApprovalRequest("", "test-run", "stage-2", "member-1"),
+			},
+			wantRequest: "test-run-stage-2-cluster-member-1",
+			wantCondition: &metav1.Condition{
+				Type:    string(placementv1beta1.ApprovalRequestConditionApproved),
+				Status:  metav1.ConditionTrue,
+				Reason:  "ClusterApprovalRequestApproved",
+				Message: "ClusterApprovalRequest has been approved",
+			},
+		},
+		{
+			name:        "cluster that has started updating cannot be promoted",
+			clusterName: "started-cluster",
+			existingObjs: []client.Object{
+				&placementv1beta1.ClusterStagedUpdateRun{ObjectMeta: metav1.ObjectMeta{Name: "test-run"}, Status: updateRunStatus},
+			},
+			wantErrMsg: "has already started updating",
+		},
+		{
+			name:        "cluster that has finished updating cannot be skipped",
+			clusterName: "finished-cluster",
+			skip:        true,
+			existingObjs: []client.Object{
+				&placementv1beta1.ClusterStagedUpdateRun{ObjectMeta: metav1.ObjectMeta{Name: "test-run"}, Status: updateRunStatus},
+			},
+			wantErrMsg: "has already finished updating",
+		},
+		{
+			name:        "cluster not in the update run",
+			clusterName: "unknown-cluster",
+			existingObjs: []client.Object{
+				&placementv1beta1.ClusterStagedUpdateRun{ObjectMeta: metav1.ObjectMeta{Name: "test-run"}, Status: updateRunStatus},
+			},
+			wantErrMsg: "is not found in the stages",
+		},
+		{
+			name:        "update run not found",
+			clusterName: "member-1",
+			wantErrMsg:  "not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(setupScheme(t)).
+				WithObjects(tc.existingObjs...).
+				WithStatusSubresource(&placementv1beta1.ClusterApprovalRequest{}, &placementv1beta1.ApprovalRequest{}).
+				Build()
+
+			o := &approveOptions{
+				name:      "test-run",
+				namespace: tc.namespace,
+				cluster:   tc.clusterName,
+				skip:      tc.skip,
+				hubClient: fakeClient,
+			}
+			err := o.approveClusterInUpdateRun(context.Background())
+
+			if tc.wantErrMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrMsg) {
+					t.Errorf("approveClusterInUpdateRun() = %v, want error containing %q", err, tc.wantErrMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("approveClusterInUpdateRun() = %v, want nil", err)
+			}
+
+			var approvalRequest placementv1beta1.ApprovalRequestObj = &placementv1beta1.ClusterApprovalRequest{}
+			if tc.namespace != "" {
+				approvalRequest = &placementv1beta1.ApprovalRequest{}
+			}
+			if err := fakeClient.Get(context.Background(), client.ObjectKey{Name: tc.wantRequest, Namespace: tc.namespace}, approvalRequest); err != nil {
+				t.Fatalf("failed to get the approval request %q: %v", tc.wantRequest, err)
+			}
+			if got := approvalRequest.GetApprovalRequestSpec().TargetCluster; got != tc.clusterName {
+				t.Errorf("approval request target cluster = %q, want %q", got, tc.clusterName)
+			}
+			gotCondition := meta.FindStatusCondition(approvalRequest.GetApprovalRequestStatus().Conditions, tc.wantCondition.Type)
+			if diff := cmp.Diff(gotCondition, tc.wantCondition,
+				cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime", "ObservedGeneration")); diff != "" {
+				t.Errorf("condition mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
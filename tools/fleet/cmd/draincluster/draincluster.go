@@ -23,32 +23,29 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
-	k8errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/uuid"
-	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
-	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
-	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
-	evictionutils "github.com/kubefleet-dev/kubefleet/pkg/utils/eviction"
 	toolsutils "github.com/kubefleet-dev/kubefleet/tools/utils"
 )
 
 const (
-	uuidLength                  = 8
-	drainEvictionNameFormat     = "drain-eviction-%s-%s-%s"
-	resourceIdentifierKeyFormat = "%s/%s/%s/%s/%s"
+	defaultDrainReason = "Drained with the draincluster command"
+	drainPollInterval  = 5 * time.Second
+	// maintenanceObservedTimeout is how long to wait for the hub cluster to pick up the maintenance before assuming
+	// that the cluster maintenance controller is not running.
+	maintenanceObservedTimeout = 30 * time.Second
 )
 
 // drainOptions wraps common cluster connection parameters
 type drainOptions struct {
 	hubClusterContext string
 	clusterName       string
+	reason            string
 	timeout           time.Duration
 
 	hubClient client.Client
@@ -61,7 +58,7 @@ func NewCmdDrainCluster() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "draincluster",
 		Short: "Drain a member cluster",
-		Long:  "Drain a member cluster by cordoning it and removing propagated resources",
+		Long:  "Drain a member cluster by putting it in maintenance, which stops placing resources on it and evicts the placements on it",
 		RunE: func(command *cobra.Command, args []string) error {
 			if err := o.setupClient(); err != nil {
 				return err
@@ -73,6 +70,7 @@ func NewCmdDrainCluster() *cobra.Command {
 	// Add flags specific to drain command
 	cmd.Flags().StringVar(&o.hubClusterContext, "hub-cluster-context", "", "kubectl context for the hub cluster (required)")
 	cmd.Flags().StringVar(&o.clusterName, "cluster-name", "", "name of the member cluster (required)")
+	cmd.Flags().StringVar(&o.reason, "reason", defaultDrainReason, "reason of the maintenance, recorded on the member cluster")
 	cmd.Flags().DurationVar(&o.timeout, "timeout", 5*time.Minute, "Maximum time to wait for the operation to complete")
 
 	// Mark required flags
@@ -86,29 +84,36 @@ func (o *drainOptions) runDrain() error {
 	ctx, cancel := context.WithTimeout(context.Background(), o.timeout)
 	defer cancel()
 
-	isDrainSuccessful, err := o.drain(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to drain member cluster %s: %w", o.clusterName, err)
+	if err := o.startMaintenance(ctx); err != nil {
+		return fmt.Errorf("failed to put member cluster %s in maintenance: %w", o.clusterName, err)
 	}
+	log.Printf("put member cluster %s in maintenance; the hub cluster evicts all the placements from it", o.clusterName)
 
-	if isDrainSuccessful {
-		log.Printf("drain was successful for cluster %s", o.clusterName)
-	} else {
-		log.Printf("drain was not successful for cluster %s", o.clusterName)
+	// The cluster maintenance controller only runs when the hub agent enables the eviction APIs; fail fast instead
+	// of waiting for a drain that never happens.
+	observeCtx, observeCancel := context.WithTimeout(ctx, maintenanceObservedTimeout)
+	defer observeCancel()
+	isObserved, err := o.waitForMaintenanceObserved(observeCtx)
+	if err != nil {
+		return fmt.Errorf("failed to wait for the hub cluster to observe the maintenance of member cluster %s: %w", o.clusterName, err)
+	}
+	if !isObserved {
+		return fmt.Errorf("the hub cluster did not observe the maintenance of member cluster %s within %s; make sure that the hub agent runs with the eviction APIs enabled, "+
+			"which the cluster maintenance controller requires, and uncordon the cluster to withdraw the maintenance if needed", o.clusterName, maintenanceObservedTimeout)
 	}
 
-	log.Printf("retrying drain to ensure all resources propagated from hub cluster are evicted")
-	isDrainRetrySuccessful, err := o.drain(ctx)
+	isDrained, err := o.waitForDrained(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to drain cluster on retry %s: %w", o.clusterName, err)
+		return fmt.Errorf("failed to wait for member cluster %s to be drained: %w", o.clusterName, err)
 	}
-	if isDrainRetrySuccessful {
-		log.Printf("drain retry was successful for cluster %s", o.clusterName)
+	if isDrained {
+		log.Printf("drain was successful for cluster %s", o.clusterName)
 	} else {
-		log.Printf("drain retry was not successful for cluster %s", o.clusterName)
+		log.Printf("drain did not finish for cluster %s within %s; the hub cluster keeps draining it, check the %s condition of the member cluster",
+			o.clusterName, o.timeout, clusterv1beta1.ConditionTypeMemberClusterDrained)
 	}
 
-	log.Printf("reminder: uncordon the cluster %s to remove cordon taint if needed", o.clusterName)
+	log.Printf("reminder: uncordon the cluster %s to end the maintenance if needed", o.clusterName)
 	return nil
 }
 
@@ -128,175 +133,62 @@ func (o *drainOptions) setupClient() error {
 	return nil
 }
 
-func (o *drainOptions) drain(ctx context.Context) (bool, error) {
-	if err := o.cordon(ctx); err != nil {
-		return false, fmt.Errorf("failed to cordon member cluster %s: %w", o.clusterName, err)
-	}
-	log.Printf("Successfully cordoned member cluster %s by adding cordon taint", o.clusterName)
-
-	crpNameMap, err := o.fetchClusterResourcePlacementNamesToEvict(ctx)
-	if err != nil {
-		return false, err
-	}
-
-	if len(crpNameMap) == 0 {
-		log.Printf("There are currently no resources propagated to %s from fleet using ClusterResourcePlacement resources", o.clusterName)
-		return true, nil
-	}
-
-	isDrainSuccessful := true
-	// create eviction objects for all <crpName, targetCluster>.
-	for crpName := range crpNameMap {
-		evictionName, err := generateDrainEvictionName(crpName, o.clusterName)
-		if err != nil {
-			return false, err
-		}
-
-		err = retry.OnError(retry.DefaultBackoff, func(err error) bool {
-			return k8errors.IsAlreadyExists(err)
-		}, func() error {
-			eviction := placementv1beta1.ClusterResourcePlacementEviction{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: evictionName,
-				},
-				Spec: placementv1beta1.PlacementEvictionSpec{
-					PlacementName: crpName,
-					ClusterName:   o.clusterName,
-				},
-			}
-			return o.hubClient.Create(ctx, &eviction)
-		})
-
-		if err != nil {
-			return false, fmt.Errorf("failed to create eviction %s for CRP %s targeting member cluster %s: %w", evictionName, crpName, o.clusterName, err)
-		}
-
-		log.Printf("Created eviction %s for CRP %s targeting member cluster %s", evictionName, crpName, o.clusterName)
-
-		// wait until evictions reach a terminal state.
-		var eviction placementv1beta1.ClusterResourcePlacementEviction
-		err = wait.ExponentialBackoffWithContext(ctx, retry.DefaultBackoff, func(ctx context.Context) (bool, error) {
-			if err := o.hubClient.Get(ctx, types.NamespacedName{Name: evictionName}, &eviction); err != nil {
-				return false, fmt.Errorf("failed to get eviction %s for CRP %s targeting member cluster %s: %w", evictionName, crpName, o.clusterName, err)
-			}
-			return evictionutils.IsEvictionInTerminalState(&eviction), nil
-		})
-
-		if err != nil {
-			return false, fmt.Errorf("failed to wait for eviction %s for CRP %s targeting member cluster %s to reach terminal state: %w", evictionName, crpName, o.clusterName, err)
-		}
-
-		// TODO: add safeguards to check if eviction conditions are set to unknown.
-		validCondition := eviction.GetCondition(string(placementv1beta1.PlacementEvictionConditionTypeValid))
-		if validCondition != nil && validCondition.Status == metav1.ConditionFalse {
-			// check to see if CRP is missing or CRP is being deleted or CRB is missing.
-			if validCondition.Reason == condition.EvictionInvalidMissingCRPMessage ||
-				validCondition.Reason == condition.EvictionInvalidDeletingCRPMessage ||
-				validCondition.Reason == condition.EvictionInvalidMissingCRBMessage {
-				log.Printf("eviction %s is invalid with reason %s for CRP %s targeting member cluster %s, but drain will succeed", evictionName, validCondition.Reason, crpName, o.clusterName)
-				continue
-			}
-		}
-		executedCondition := eviction.GetCondition(string(placementv1beta1.PlacementEvictionConditionTypeExecuted))
-		if executedCondition == nil || executedCondition.Status == metav1.ConditionFalse {
-			isDrainSuccessful = false
-			log.Printf("eviction %s was not executed successfully for CRP %s targeting member cluster %s", evictionName, crpName, o.clusterName)
-			continue
-		}
-		log.Printf("eviction %s was executed successfully for CRP %s targeting member cluster %s", evictionName, crpName, o.clusterName)
-		// log each cluster scoped resource evicted for CRP.
-		clusterScopedResourceIdentifiers, err := o.collectClusterScopedResourcesSelectedByCRP(ctx, crpName)
-		if err != nil {
-			log.Printf("failed to collect cluster scoped resources selected by CRP %s: %v", crpName, err)
-			continue
-		}
-		for _, resourceIdentifier := range clusterScopedResourceIdentifiers {
-			log.Printf("evicted resource %s propagated by CRP %s targeting member cluster %s", generateResourceIdentifierKey(resourceIdentifier), crpName, o.clusterName)
-		}
+// startMaintenance puts the member cluster in maintenance right away, draining all the placements from it; the
+// maintenance lasts until the member cluster is uncordoned.
+func (o *drainOptions) startMaintenance(ctx context.Context) error {
+	maintenance := &clusterv1beta1.Maintenance{
+		Reason:      o.reason,
+		DrainPolicy: clusterv1beta1.MaintenanceDrainPolicyAll,
 	}
-
-	return isDrainSuccessful, nil
-}
-
-func (o *drainOptions) cordon(ctx context.Context) error {
-	// add taint to member cluster to ensure resources aren't scheduled on it.
 	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		var mc clusterv1beta1.MemberCluster
 		if err := o.hubClient.Get(ctx, types.NamespacedName{Name: o.clusterName}, &mc); err != nil {
 			return err
 		}
 
-		// search to see cordonTaint already exists on the cluster.
-		for i := range mc.Spec.Taints {
-			if mc.Spec.Taints[i] == toolsutils.CordonTaint {
-				return nil
-			}
+		if mc.Spec.Maintenance != nil && *mc.Spec.Maintenance == *maintenance {
+			return nil
 		}
-
-		// add taint to member cluster to cordon.
-		mc.Spec.Taints = append(mc.Spec.Taints, toolsutils.CordonTaint)
+		mc.Spec.Maintenance = maintenance
 
 		return o.hubClient.Update(ctx, &mc)
 	})
 }
 
-func (o *drainOptions) fetchClusterResourcePlacementNamesToEvict(ctx context.Context) (map[string]bool, error) {
-	var crbList placementv1beta1.ClusterResourceBindingList
-	if err := o.hubClient.List(ctx, &crbList); err != nil {
-		return map[string]bool{}, fmt.Errorf("failed to list cluster resource bindings: %w", err)
-	}
-
-	crpNameMap := make(map[string]bool)
-	// find all unique CRP names for which eviction needs to occur.
-	for i := range crbList.Items {
-		crb := crbList.Items[i]
-		if crb.Spec.TargetCluster == o.clusterName && crb.DeletionTimestamp == nil {
-			crpName, ok := crb.GetLabels()[placementv1beta1.PlacementTrackingLabel]
-			if !ok {
-				return map[string]bool{}, fmt.Errorf("failed to get CRP name from binding %s", crb.Name)
-			}
-			crpNameMap[crpName] = true
-		}
-	}
-
-	return crpNameMap, nil
-}
-
-func (o *drainOptions) collectClusterScopedResourcesSelectedByCRP(ctx context.Context, crpName string) ([]placementv1beta1.ResourceIdentifier, error) {
-	var crp placementv1beta1.ClusterResourcePlacement
-	if err := o.hubClient.Get(ctx, types.NamespacedName{Name: crpName}, &crp); err != nil {
-		return nil, fmt.Errorf("failed to get ClusterResourcePlacement %s: %w", crpName, err)
-	}
-
-	var resourcesPropagated []placementv1beta1.ResourceIdentifier
-	for _, selectedResource := range crp.Status.SelectedResources {
-		// only collect cluster scoped resources.
-		if len(selectedResource.Namespace) == 0 {
-			resourcesPropagated = append(resourcesPropagated, selectedResource)
-		}
-	}
-	return resourcesPropagated, nil
+// waitForMaintenanceObserved waits until the hub cluster reports the maintenance condition for the current
+// generation of the member cluster; it returns false if the condition is not reported before the context is done.
+func (o *drainOptions) waitForMaintenanceObserved(ctx context.Context) (bool, error) {
+	return o.pollMemberCluster(ctx, func(mc *clusterv1beta1.MemberCluster) bool {
+		maintenanceCond := mc.GetCondition(string(clusterv1beta1.ConditionTypeMemberClusterInMaintenance))
+		return maintenanceCond != nil && maintenanceCond.ObservedGeneration >= mc.Generation
+	})
 }
 
-func generateDrainEvictionName(crpName, targetCluster string) (string, error) {
-	evictionName := fmt.Sprintf(drainEvictionNameFormat, crpName, targetCluster, uuid.NewUUID()[:uuidLength])
-
-	// check to see if eviction name is a valid DNS1123 subdomain name https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#dns-subdomain-names.
-	if errs := validation.IsDNS1123Subdomain(evictionName); len(errs) != 0 {
-		return "", fmt.Errorf("failed to format a qualified name for drain eviction object with CRP name %s, cluster name %s: %v", crpName, targetCluster, errs)
-	}
-	return evictionName, nil
+// waitForDrained waits until the hub cluster reports that all the placements have been drained from the member
+// cluster; it returns false if they are not drained before the context is done.
+func (o *drainOptions) waitForDrained(ctx context.Context) (bool, error) {
+	return o.pollMemberCluster(ctx, func(mc *clusterv1beta1.MemberCluster) bool {
+		drainedCond := mc.GetCondition(string(clusterv1beta1.ConditionTypeMemberClusterDrained))
+		return drainedCond != nil && drainedCond.Status == metav1.ConditionTrue && drainedCond.ObservedGeneration >= mc.Generation
+	})
 }
 
-func generateResourceIdentifierKey(r placementv1beta1.ResourceIdentifier) string {
-	if len(r.Group) == 0 && len(r.Namespace) == 0 {
-		return fmt.Sprintf(resourceIdentifierKeyFormat, "''", r.Version, r.Kind, "''", r.Name)
-	}
-	if len(r.Group) == 0 {
-		return fmt.Sprintf(resourceIdentifierKeyFormat, "''", r.Version, r.Kind, r.Namespace, r.Name)
-	}
-	if len(r.Namespace) == 0 {
-		return fmt.Sprintf(resourceIdentifierKeyFormat, r.Group, r.Version, r.Kind, "''", r.Name)
+// pollMemberCluster polls the member cluster until it satisfies the given condition; it returns false if the
+// condition is not satisfied before the context is done.
+func (o *drainOptions) pollMemberCluster(ctx context.Context, done func(mc *clusterv1beta1.MemberCluster) bool) (bool, error) {
+	err := wait.PollUntilContextCancel(ctx, drainPollInterval, true, func(ctx context.Context) (bool, error) {
+		var mc clusterv1beta1.MemberCluster
+		if err := o.hubClient.Get(ctx, types.NamespacedName{Name: o.clusterName}, &mc); err != nil {
+			return false, err
+		}
+		return done(&mc), nil
+	})
+	switch {
+	case err == nil:
+		return true, nil
+	case wait.Interrupted(err):
+		return false, nil
+	default:
+		return false, err
 	}
-	return fmt.Sprintf(resourceIdentifierKeyFormat, r.Group, r.Version, r.Kind, r.Namespace, r.Name)
 }
@@ -19,8 +19,6 @@ package draincluster
 import (
 	"context"
 	"errors"
-	"fmt"
-	"strings"
 	"testing"
 	"time"
 
@@ -31,452 +29,210 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
-	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
-	toolsutils "github.com/kubefleet-dev/kubefleet/tools/utils"
 )
 
-func TestFetchClusterResourcePlacementNamesToEvict(t *testing.T) {
+func TestStartMaintenance(t *testing.T) {
+	wantMaintenance := &clusterv1beta1.Maintenance{
+		Reason:      "upgrade",
+		DrainPolicy: clusterv1beta1.MaintenanceDrainPolicyAll,
+	}
 	tests := []struct {
 		name          string
-		targetCluster string
-		bindings      []placementv1beta1.ClusterResourceBinding
+		memberCluster *clusterv1beta1.MemberCluster
 		wantErr       error
-		wantMap       map[string]bool
 	}{
 		{
-			name:          "successfully collected CRPs to evict",
-			targetCluster: "test-cluster1",
-			bindings: []placementv1beta1.ClusterResourceBinding{
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-crb1",
-						Labels: map[string]string{
-							placementv1beta1.PlacementTrackingLabel: "test-crp1",
-						},
-					},
-					Spec: placementv1beta1.ResourceBindingSpec{
-						TargetCluster: "test-cluster1",
-					},
-				},
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-crb2",
-						Labels: map[string]string{
-							placementv1beta1.PlacementTrackingLabel: "test-crp1",
-						},
-					},
-					Spec: placementv1beta1.ResourceBindingSpec{
-						TargetCluster: "test-cluster2",
-					},
-				},
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-crb3",
-						Labels: map[string]string{
-							placementv1beta1.PlacementTrackingLabel: "test-crp2",
-						},
-					},
-					Spec: placementv1beta1.ResourceBindingSpec{
-						TargetCluster: "test-cluster1",
-					},
-				},
-			},
-			wantErr: nil,
-			wantMap: map[string]bool{
-				"test-crp1": true,
-				"test-crp2": true,
-			},
-		},
-		{
-			name:          "no CRPs to evict",
-			targetCluster: "test-cluster1",
-			bindings: []placementv1beta1.ClusterResourceBinding{
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-crb1",
-						Labels: map[string]string{
-							placementv1beta1.PlacementTrackingLabel: "test-crp1",
-						},
-					},
-					Spec: placementv1beta1.ResourceBindingSpec{
-						TargetCluster: "test-cluster2",
-					},
-				},
-			},
-			wantErr: nil,
-			wantMap: map[string]bool{},
-		},
-		{
-			name:          "binding missing CRP label",
-			targetCluster: "test-cluster1",
-			bindings: []placementv1beta1.ClusterResourceBinding{
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-crb1",
-					},
-					Spec: placementv1beta1.ResourceBindingSpec{
-						TargetCluster: "test-cluster1",
-					},
+			name: "successfully start maintenance, no maintenance present",
+			memberCluster: &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-cluster",
 				},
 			},
-			wantErr: errors.New("failed to get CRP name from binding test-crb1"),
-			wantMap: map[string]bool{},
 		},
 		{
-			name:          "skip CRB with deletionTimestamp",
-			targetCluster: "test-cluster1",
-			bindings: []placementv1beta1.ClusterResourceBinding{
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "test-crb1",
-						Labels: map[string]string{
-							placementv1beta1.PlacementTrackingLabel: "test-crp1",
-						},
-						DeletionTimestamp: &metav1.Time{Time: time.Now()},
-						Finalizers:        []string{"test-finalizer"},
-					},
-					Spec: placementv1beta1.ResourceBindingSpec{
-						TargetCluster: "test-cluster1",
-					},
-				},
-			},
-			wantErr: nil,
-			wantMap: map[string]bool{},
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			var objects []client.Object
-			scheme := serviceScheme(t)
-			for i := range tc.bindings {
-				objects = append(objects, &tc.bindings[i])
-			}
-			fakeClient := fake.NewClientBuilder().
-				WithScheme(scheme).
-				WithObjects(objects...).
-				Build()
-			h := &drainOptions{
-				hubClient:   fakeClient,
-				clusterName: tc.targetCluster,
-			}
-			gotMap, gotErr := h.fetchClusterResourcePlacementNamesToEvict(context.Background())
-			if tc.wantErr == nil {
-				if gotErr != nil {
-					t.Errorf("fetchClusterResourcePlacementNamesToEvict test %s failed, got error %v, want error %v", tc.name, gotErr, tc.wantErr)
-				}
-				if diff := cmp.Diff(gotMap, tc.wantMap); diff != "" {
-					t.Errorf("fetchClusterResourcePlacementNamesToEvict test %s failed (-got +want):\n%s", tc.name, diff)
-				}
-			} else if gotErr == nil || gotErr.Error() != tc.wantErr.Error() {
-				t.Errorf("fetchClusterResourcePlacementNamesToEvict test %s failed, got error %v, want error %v", tc.name, gotErr, tc.wantErr)
-			}
-		})
-	}
-}
-
-func TestCollectClusterScopedResourcesSelectedByCRP(t *testing.T) {
-	tests := []struct {
-		name          string
-		crpName       string
-		crp           *placementv1beta1.ClusterResourcePlacement
-		wantResources []placementv1beta1.ResourceIdentifier
-		wantErr       error
-	}{
-		{
-			name:    "successfully collect cluster scoped resources",
-			crpName: "test-crp",
-			crp: &placementv1beta1.ClusterResourcePlacement{
+			name: "maintenance already started",
+			memberCluster: &clusterv1beta1.MemberCluster{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "test-crp",
-				},
-				Status: placementv1beta1.PlacementStatus{
-					SelectedResources: []placementv1beta1.ResourceIdentifier{
-						{
-							Group:   "rbac.authorization.k8s.io",
-							Version: "v1",
-							Kind:    "ClusterRole",
-							Name:    "test-cluster-role",
-						},
-						{
-							Group:     "",
-							Version:   "v1",
-							Kind:      "ConfigMap",
-							Name:      "test-cm",
-							Namespace: "test-ns",
-						},
-						{
-							Group:   "rbac.authorization.k8s.io",
-							Version: "v1",
-							Kind:    "ClusterRoleBinding",
-							Name:    "test-cluster-role-binding",
-						},
-					},
-				},
-			},
-			wantResources: []placementv1beta1.ResourceIdentifier{
-				{
-					Group:   "rbac.authorization.k8s.io",
-					Version: "v1",
-					Kind:    "ClusterRole",
-					Name:    "test-cluster-role",
+					Name: "test-cluster",
 				},
-				{
-					Group:   "rbac.authorization.k8s.io",
-					Version: "v1",
-					Kind:    "ClusterRoleBinding",
-					Name:    "test-cluster-role-binding",
+				Spec: clusterv1beta1.MemberClusterSpec{
+					Maintenance: wantMaintenance.DeepCopy(),
 				},
 			},
-			wantErr: nil,
 		},
 		{
-			name:    "no cluster scoped resources",
-			crpName: "test-crp",
-			crp: &placementv1beta1.ClusterResourcePlacement{
+			name: "successfully start maintenance, scheduled maintenance present",
+			memberCluster: &clusterv1beta1.MemberCluster{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "test-crp",
+					Name: "test-cluster",
 				},
-				Status: placementv1beta1.PlacementStatus{
-					SelectedResources: []placementv1beta1.ResourceIdentifier{
-						{
-							Group:     "",
-							Version:   "v1",
-							Kind:      "ConfigMap",
-							Name:      "test-cm",
-							Namespace: "test-ns",
-						},
+				Spec: clusterv1beta1.MemberClusterSpec{
+					Maintenance: &clusterv1beta1.Maintenance{
+						StartTime:   &metav1.Time{Time: time.Now().Add(time.Hour)},
+						DrainPolicy: clusterv1beta1.MaintenanceDrainPolicyPickN,
 					},
 				},
 			},
-			wantResources: nil,
-			wantErr:       nil,
 		},
 		{
-			name:          "crp not found",
-			crpName:       "test-crp",
-			crp:           nil,
-			wantResources: nil,
-			wantErr:       errors.New("failed to get ClusterResourcePlacement test-crp: clusterresourceplacements.placement.kubernetes-fleet.io \"test-crp\" not found"),
+			name:          "member cluster not found",
+			memberCluster: nil,
+			wantErr:       errors.New("memberclusters.cluster.kubernetes-fleet.io \"test-cluster\" not found"),
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			scheme := serviceScheme(t)
 			var objects []client.Object
-			if tc.crp != nil {
-				objects = append(objects, tc.crp)
+			if tc.memberCluster != nil {
+				objects = append(objects, tc.memberCluster)
 			}
+
 			fakeClient := fake.NewClientBuilder().
-				WithScheme(scheme).
+				WithScheme(serviceScheme(t)).
 				WithObjects(objects...).
 				Build()
 
 			h := &drainOptions{
-				hubClient: fakeClient,
+				hubClient:   fakeClient,
+				clusterName: "test-cluster",
+				reason:      "upgrade",
 			}
 
-			gotResources, gotErr := h.collectClusterScopedResourcesSelectedByCRP(context.Background(), tc.crpName)
+			gotErr := h.startMaintenance(context.Background())
 			if tc.wantErr == nil {
 				if gotErr != nil {
-					t.Errorf("collectClusterScopedResourcesSelectedByCRP test %s failed, got error %v, want error %v", tc.name, gotErr, tc.wantErr)
+					t.Errorf("startMaintenance test %s failed, got error %v, want error %v", tc.name, gotErr, tc.wantErr)
+				}
+				var updatedCluster clusterv1beta1.MemberCluster
+				if err := fakeClient.Get(context.Background(), client.ObjectKey{Name: "test-cluster"}, &updatedCluster); err != nil {
+					t.Errorf("failed to get updated cluster: %v", err)
 				}
-				if diff := cmp.Diff(gotResources, tc.wantResources); diff != "" {
-					t.Errorf("collectClusterScopedResourcesSelectedByCRP (-got +want):\n%s", diff)
+				if diff := cmp.Diff(updatedCluster.Spec.Maintenance, wantMaintenance); diff != "" {
+					t.Errorf("maintenance mismatch (-got +want):\n%s", diff)
 				}
 			} else if gotErr == nil || gotErr.Error() != tc.wantErr.Error() {
-				t.Errorf("collectClusterScopedResourcesSelectedByCRP test %s failed, got error %v, want error %v", tc.name, gotErr, tc.wantErr)
+				t.Errorf("startMaintenance test %s failed, got error %v, want error %v", tc.name, gotErr, tc.wantErr)
 			}
 		})
 	}
 }
 
-func TestGenerateResourceIdentifierKey(t *testing.T) {
+func TestWaitForDrained(t *testing.T) {
+	drainedCondition := func(status metav1.ConditionStatus, observedGeneration int64) metav1.Condition {
+		return metav1.Condition{
+			Type:               string(clusterv1beta1.ConditionTypeMemberClusterDrained),
+			Status:             status,
+			Reason:             "Reason",
+			ObservedGeneration: observedGeneration,
+			LastTransitionTime: metav1.Now(),
+		}
+	}
 	tests := []struct {
-		name     string
-		resource placementv1beta1.ResourceIdentifier
-		want     string
+		name        string
+		conditions  []metav1.Condition
+		wantDrained bool
 	}{
 		{
-			name: "cluster scoped resource with empty group",
-			resource: placementv1beta1.ResourceIdentifier{
-				Group:   "",
-				Version: "v1",
-				Kind:    "Namespace",
-				Name:    "test-ns",
-			},
-			want: "''/v1/Namespace/''/test-ns",
+			name:        "member cluster drained",
+			conditions:  []metav1.Condition{drainedCondition(metav1.ConditionTrue, 1)},
+			wantDrained: true,
 		},
 		{
-			name: "cluster scoped resource with non-empty group",
-			resource: placementv1beta1.ResourceIdentifier{
-				Group:   "rbac.authorization.k8s.io",
-				Version: "v1",
-				Kind:    "ClusterRole",
-				Name:    "test-role",
-			},
-			want: "rbac.authorization.k8s.io/v1/ClusterRole/''/test-role",
+			name:       "member cluster still draining",
+			conditions: []metav1.Condition{drainedCondition(metav1.ConditionFalse, 1)},
 		},
 		{
-			name: "namespaced resource with empty group",
-			resource: placementv1beta1.ResourceIdentifier{
-				Group:     "",
-				Version:   "v1",
-				Kind:      "ConfigMap",
-				Name:      "test-cm",
-				Namespace: "test-ns",
-			},
-			want: "''/v1/ConfigMap/test-ns/test-cm",
+			name:       "drained condition is stale",
+			conditions: []metav1.Condition{drainedCondition(metav1.ConditionTrue, 0)},
 		},
 		{
-			name: "namespaced resource with non-empty group",
-			resource: placementv1beta1.ResourceIdentifier{
-				Group:     "apps",
-				Version:   "v1",
-				Kind:      "Deployment",
-				Name:      "test-deploy",
-				Namespace: "test-ns",
-			},
-			want: "apps/v1/Deployment/test-ns/test-deploy",
+			name: "drained condition not reported",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			got := generateResourceIdentifierKey(tc.resource)
-			if got != tc.want {
-				t.Errorf("generateResourceIdentifierKey = %v, want %v", got, tc.want)
+			mc := &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-cluster",
+					Generation: 1,
+				},
+				Status: clusterv1beta1.MemberClusterStatus{
+					Conditions: tc.conditions,
+				},
 			}
-		})
-	}
-}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(mc).
+				Build()
 
-func TestGenerateDrainEvictionName(t *testing.T) {
-	tests := []struct {
-		name          string
-		crpName       string
-		targetCluster string
-		wantErr       error
-	}{
-		{
-			name:          "valid names",
-			crpName:       "test-crp",
-			targetCluster: "test-cluster",
-			wantErr:       nil,
-		},
-		{
-			name:          "name has invalid characters",
-			crpName:       "test-crp",
-			targetCluster: "test_cluster$",
-			wantErr:       errors.New("failed to format a qualified name for drain eviction object"),
-		},
-	}
+			h := &drainOptions{
+				hubClient:   fakeClient,
+				clusterName: "test-cluster",
+			}
 
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			evictionName, gotErr := generateDrainEvictionName(tc.crpName, tc.targetCluster)
-			if tc.wantErr == nil {
-				if gotErr != nil {
-					t.Errorf("generateDrainEvictionName() got error %v, want error %v", gotErr, tc.wantErr)
-				}
-				// Verify the generated name follows the expected format
-				prefix := fmt.Sprintf("drain-eviction-%s-%s-", tc.crpName, tc.targetCluster)
-				if !strings.HasPrefix(evictionName, prefix) {
-					t.Errorf("generateDrainEvictionName() = %v, want prefix %v", evictionName, prefix)
-				}
-				if len(evictionName) != len(prefix)+uuidLength {
-					t.Errorf("generateDrainEvictionName() generated name length = %v, want %v", len(evictionName), len(prefix)+uuidLength)
-				}
-			} else if gotErr == nil || !strings.Contains(gotErr.Error(), tc.wantErr.Error()) {
-				t.Errorf("generateDrainEvictionName() got error %v, want error %v", gotErr, tc.wantErr)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			gotDrained, err := h.waitForDrained(ctx)
+			if err != nil {
+				t.Fatalf("waitForDrained() = %v, want nil", err)
+			}
+			if gotDrained != tc.wantDrained {
+				t.Errorf("waitForDrained() = %v, want %v", gotDrained, tc.wantDrained)
 			}
 		})
 	}
 }
 
-func TestCordon(t *testing.T) {
+func TestWaitForMaintenanceObserved(t *testing.T) {
+	maintenanceCondition := func(status metav1.ConditionStatus, observedGeneration int64) metav1.Condition {
+		return metav1.Condition{
+			Type:               string(clusterv1beta1.ConditionTypeMemberClusterInMaintenance),
+			Status:             status,
+			Reason:             "Reason",
+			ObservedGeneration: observedGeneration,
+			LastTransitionTime: metav1.Now(),
+		}
+	}
 	tests := []struct {
-		name          string
-		memberCluster *clusterv1beta1.MemberCluster
-		wantTaints    []clusterv1beta1.Taint
-		wantErr       error
+		name         string
+		conditions   []metav1.Condition
+		wantObserved bool
 	}{
 		{
-			name: "successfully add cordon taint, no other taints present",
-			memberCluster: &clusterv1beta1.MemberCluster{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: "test-cluster",
-				},
-				Spec: clusterv1beta1.MemberClusterSpec{
-					Taints: []clusterv1beta1.Taint{},
-				},
-			},
-			wantTaints: []clusterv1beta1.Taint{toolsutils.CordonTaint},
-			wantErr:    nil,
+			name:         "maintenance in progress",
+			conditions:   []metav1.Condition{maintenanceCondition(metav1.ConditionTrue, 1)},
+			wantObserved: true,
 		},
 		{
-			name: "cordon taint already exists",
-			memberCluster: &clusterv1beta1.MemberCluster{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: "test-cluster",
-				},
-				Spec: clusterv1beta1.MemberClusterSpec{
-					Taints: []clusterv1beta1.Taint{toolsutils.CordonTaint},
-				},
-			},
-			wantTaints: []clusterv1beta1.Taint{toolsutils.CordonTaint},
-			wantErr:    nil,
+			name:         "maintenance scheduled",
+			conditions:   []metav1.Condition{maintenanceCondition(metav1.ConditionFalse, 1)},
+			wantObserved: true,
 		},
 		{
-			name: "successfully add cordon taint, cluster has other taints",
-			memberCluster: &clusterv1beta1.MemberCluster{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: "test-cluster",
-				},
-				Spec: clusterv1beta1.MemberClusterSpec{
-					Taints: []clusterv1beta1.Taint{
-						{
-							Key:    "other-key",
-							Value:  "other-value",
-							Effect: "NoSchedule",
-						},
-					},
-				},
-			},
-			wantTaints: []clusterv1beta1.Taint{
-				{
-					Key:    "other-key",
-					Value:  "other-value",
-					Effect: "NoSchedule",
-				},
-				toolsutils.CordonTaint,
-			},
-			wantErr: nil,
+			name:       "maintenance condition is stale",
+			conditions: []metav1.Condition{maintenanceCondition(metav1.ConditionTrue, 0)},
 		},
 		{
-			name:          "member cluster not found",
-			memberCluster: nil,
-			wantTaints:    nil,
-			wantErr:       errors.New("memberclusters.cluster.kubernetes-fleet.io \"test-cluster\" not found"),
+			name: "maintenance condition not reported, cluster maintenance controller not running",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			scheme := serviceScheme(t)
-			if err := clusterv1beta1.AddToScheme(scheme); err != nil {
-				t.Fatalf("failed to add cluster v1beta1 scheme: %v", err)
-			}
-
-			var objects []client.Object
-			if tc.memberCluster != nil {
-				objects = append(objects, tc.memberCluster)
+			mc := &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-cluster",
+					Generation: 1,
+				},
+				Status: clusterv1beta1.MemberClusterStatus{
+					Conditions: tc.conditions,
+				},
 			}
-
 			fakeClient := fake.NewClientBuilder().
-				WithScheme(scheme).
-				WithObjects(objects...).
+				WithScheme(serviceScheme(t)).
+				WithObjects(mc).
 				Build()
 
 			h := &drainOptions{
@@ -484,20 +240,14 @@ func TestCordon(t *testing.T) {
 				clusterName: "test-cluster",
 			}
 
-			gotErr := h.cordon(context.Background())
-			if tc.wantErr == nil {
-				if gotErr != nil {
-					t.Errorf("cordon test %s failed, got error %v, want error %v", tc.name, gotErr, tc.wantErr)
-				}
-				var updatedCluster clusterv1beta1.MemberCluster
-				if err := fakeClient.Get(context.Background(), client.ObjectKey{Name: "test-cluster"}, &updatedCluster); err != nil {
-					t.Errorf("failed to get updated cluster: %v", err)
-				}
-				if diff := cmp.Diff(updatedCluster.Spec.Taints, tc.wantTaints); diff != "" {
-					t.Errorf("cordon taints mismatch (-got +want):\n%s", diff)
-				}
-			} else if gotErr == nil || gotErr.Error() != tc.wantErr.Error() {
-				t.Errorf("cordon test %s failed, got error %v, want error %v", tc.name, gotErr, tc.wantErr)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			gotObserved, err := h.waitForMaintenanceObserved(ctx)
+			if err != nil {
+				t.Fatalf("waitForMaintenanceObserved() = %v, want nil", err)
+			}
+			if gotObserved != tc.wantObserved {
+				t.Errorf("waitForMaintenanceObserved() = %v, want %v", gotObserved, tc.wantObserved)
 			}
 		})
 	}
@@ -505,8 +255,8 @@ func TestCordon(t *testing.T) {
 
 func serviceScheme(t *testing.T) *runtime.Scheme {
 	scheme := runtime.NewScheme()
-	if err := placementv1beta1.AddToScheme(scheme); err != nil {
-		t.Fatalf("failed to add placement v1beta1 scheme: %v", err)
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add cluster v1beta1 scheme: %v", err)
 	}
 	return scheme
 }
@@ -27,12 +27,24 @@ const (
 	KindApprovalRequest        = "approvalrequest"
 )
 
+// Canonical kind names for staged update runs.
+const (
+	KindClusterStagedUpdateRun = "clusterstagedupdaterun"
+	KindStagedUpdateRun        = "stagedupdaterun"
+)
+
 // Aliases for approval request kinds.
 const (
 	AliasClusterApprovalRequest = "careq"
 	AliasApprovalRequest        = "areq"
 )
 
+// Aliases for staged update run kinds.
+const (
+	AliasClusterStagedUpdateRun = "csur"
+	AliasStagedUpdateRun        = "sur"
+)
+
 // KindConfig defines the configuration for a resource kind.
 // This allows commands to handle multiple resource kinds with different
 // validation rules and handlers without using switch statements.
@@ -47,7 +47,7 @@ func NewCmdUncordonCluster() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "uncordoncluster",
 		Short: "Uncordon a member cluster",
-		Long:  "Uncordon a previously drained member cluster by removing the cordon taint",
+		Long:  "Uncordon a previously drained member cluster by ending its maintenance",
 		RunE: func(command *cobra.Command, args []string) error {
 			if err := o.setupClient(); err != nil {
 				return err
@@ -96,6 +96,8 @@ func (o *uncordonOptions) setupClient() error {
 	return nil
 }
 
+// uncordon ends the maintenance of the member cluster, and removes the cordon taint added by earlier versions of
+// the draincluster command.
 func (o *uncordonOptions) uncordon(ctx context.Context) error {
 	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		var mc clusterv1beta1.MemberCluster
@@ -103,19 +105,22 @@ func (o *uncordonOptions) uncordon(ctx context.Context) error {
 			return err
 		}
 
-		if len(mc.Spec.Taints) == 0 {
+		if mc.Spec.Maintenance == nil && len(mc.Spec.Taints) == 0 {
 			return nil
 		}
 
 		var newTaints []clusterv1beta1.Taint
 		for i := range mc.Spec.Taints {
 			taint := mc.Spec.Taints[i]
-			if taint == toolsutils.CordonTaint {
+			// The maintenance taint is removed by the hub cluster as well; remove it here so that resources are
+			// placed on the member cluster again right away.
+			if taint == toolsutils.CordonTaint || taint.Key == clusterv1beta1.MaintenanceTaintKey {
 				continue
 			}
 			newTaints = append(newTaints, taint)
 		}
 		mc.Spec.Taints = newTaints
+		mc.Spec.Maintenance = nil
 
 		return o.hubClient.Update(ctx, &mc)
 	})
@@ -43,13 +43,18 @@ func TestUncordon(t *testing.T) {
 		Value:  "test-value2",
 		Effect: corev1.TaintEffectNoSchedule,
 	}
+	maintenanceTaint := clusterv1beta1.Taint{
+		Key:    clusterv1beta1.MaintenanceTaintKey,
+		Effect: corev1.TaintEffectNoSchedule,
+	}
 
 	// Define test cases
 	testCases := []struct {
-		name          string
-		initialTaints []clusterv1beta1.Taint
-		wantTaints    []clusterv1beta1.Taint
-		wantErr       error
+		name               string
+		initialTaints      []clusterv1beta1.Taint
+		initialMaintenance *clusterv1beta1.Maintenance
+		wantTaints         []clusterv1beta1.Taint
+		wantErr            error
 	}{
 		{
 			name:          "no taints present",
@@ -81,6 +86,20 @@ func TestUncordon(t *testing.T) {
 			wantTaints:    []clusterv1beta1.Taint{taint1, taint2},
 			wantErr:       nil,
 		},
+		{
+			name:               "maintenance present",
+			initialTaints:      []clusterv1beta1.Taint{taint1, maintenanceTaint},
+			initialMaintenance: &clusterv1beta1.Maintenance{DrainPolicy: clusterv1beta1.MaintenanceDrainPolicyAll},
+			wantTaints:         []clusterv1beta1.Taint{taint1},
+			wantErr:            nil,
+		},
+		{
+			name:               "maintenance present, no taints present",
+			initialTaints:      []clusterv1beta1.Taint{},
+			initialMaintenance: &clusterv1beta1.Maintenance{DrainPolicy: clusterv1beta1.MaintenanceDrainPolicyPickN},
+			wantTaints:         []clusterv1beta1.Taint{},
+			wantErr:            nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -92,7 +111,8 @@ func TestUncordon(t *testing.T) {
 					Name: "test-cluster",
 				},
 				Spec: clusterv1beta1.MemberClusterSpec{
-					Taints: tc.initialTaints,
+					Taints:      tc.initialTaints,
+					Maintenance: tc.initialMaintenance,
 				},
 			}
 			fakeClient := fake.NewClientBuilder().WithScheme(s).WithObjects(mc).Build()
@@ -117,6 +137,9 @@ func TestUncordon(t *testing.T) {
 				if diff := cmp.Diff(tc.wantTaints, gotMC.Spec.Taints, cmpopts.EquateEmpty()); diff != "" {
 					t.Errorf("Uncordon test %s failed, got taints %v, want taints %v", tc.name, gotMC.Spec.Taints, tc.wantTaints)
 				}
+				if gotMC.Spec.Maintenance != nil {
+					t.Errorf("Uncordon test %s failed, got maintenance %v, want nil", tc.name, gotMC.Spec.Maintenance)
+				}
 			} else if gotErr == nil || gotErr.Error() != tc.wantErr.Error() {
 				t.Errorf("Uncordon test %s failed, got error %v, want error %v", tc.name, gotErr, tc.wantErr)
 			}
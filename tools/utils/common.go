@@ -18,7 +18,9 @@ import (
 
 var (
 	kubeConfigPath = os.Getenv("KUBECONFIG")
-	CordonTaint    = clusterv1beta1.Taint{
+	// CordonTaint is the taint that earlier versions of the draincluster command added to cordon member clusters;
+	// the command now puts member clusters in maintenance instead.
+	CordonTaint = clusterv1beta1.Taint{
 		Key:    "cordon-key",
 		Value:  "cordon-value",
 		Effect: "NoSchedule",